@@ -89,6 +89,8 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
+		// Custom Archway x/cwfees grant tracking, which records the gas consumed by the ante handler for the settlement of a failed TX
+		cwfees.NewTrackGrantGasDecorator(options.CWFeesKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -52,7 +52,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -247,6 +246,7 @@ var (
 		wasmdTypes.ModuleName:                {authtypes.Burner},
 		rewardsTypes.TreasuryCollector:       {authtypes.Burner},
 		callbackTypes.ModuleName:             nil,
+		cwerrorsTypes.ModuleName:             nil,
 	}
 )
 
@@ -336,7 +336,7 @@ func NewArchwayApp(
 
 		trackingTypes.StoreKey, rewardsTypes.StoreKey, callbackTypes.StoreKey, cwfees.ModuleName, cwerrorsTypes.StoreKey,
 	)
-	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, cwerrorsTypes.TStoreKey)
+	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, cwerrorsTypes.TStoreKey, callbackTypes.TStoreKey)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	// register streaming services
@@ -586,7 +586,7 @@ func NewArchwayApp(
 		Stargate: wasmdKeeper.AcceptListStargateQuerier(getAcceptedStargateQueries(), app.GRPCQueryRouter(), appCodec),
 	}))
 	// Archway specific options (using a pointer as the keeper is post-initialized below)
-	wasmOpts = append(wasmOpts, wasmbinding.BuildWasmOptions(&app.Keepers.RewardsKeeper, &extendedGovKeeper)...)
+	wasmOpts = append(wasmOpts, wasmbinding.BuildWasmOptions(&app.Keepers.RewardsKeeper, &extendedGovKeeper, &app.Keepers.CallbackKeeper)...)
 
 	app.Keepers.WASMKeeper = wasmdKeeper.NewKeeper(
 		appCodec,
@@ -644,22 +644,26 @@ func NewArchwayApp(
 		govModuleAddr,
 	)
 
+	app.Keepers.CWFeesKeeper = cwfees.NewKeeper(
+		appCodec,
+		keys[cwfees.ModuleName],
+		app.Keepers.WASMKeeper,
+		govModuleAddr,
+	)
+
 	app.Keepers.CallbackKeeper = callbackKeeper.NewKeeper(
 		appCodec,
 		keys[callbackTypes.StoreKey],
+		tkeys[callbackTypes.TStoreKey],
 		app.Keepers.WASMKeeper,
 		app.Keepers.RewardsKeeper,
 		app.Keepers.BankKeeper,
+		app.Keepers.CWFeesKeeper,
+		app.Keepers.FeeGrantKeeper,
 		govModuleAddr,
 		logger,
 	)
 
-	app.Keepers.CWFeesKeeper = cwfees.NewKeeper(
-		appCodec,
-		keys[cwfees.ModuleName],
-		app.Keepers.WASMKeeper,
-	)
-
 	app.Keepers.CWICAKeeper = cwicakeeper.NewKeeper(
 		appCodec,
 		keys[cwicatypes.StoreKey],
@@ -809,6 +813,7 @@ func NewArchwayApp(
 		// wasm
 		ibchookstypes.ModuleName,
 		wasmdTypes.ModuleName,
+		cwfees.ModuleName,
 	)
 
 	app.ModuleManager.SetOrderEndBlockers(
@@ -843,6 +848,7 @@ func NewArchwayApp(
 		trackingTypes.ModuleName,
 		rewardsTypes.ModuleName,
 		callbackTypes.ModuleName,
+		cwfees.ModuleName,
 		// invariants checks are always the last to run
 		crisistypes.ModuleName,
 
@@ -900,7 +906,9 @@ func NewArchwayApp(
 
 	app.ModuleManager.RegisterInvariants(&app.Keepers.CrisisKeeper)
 
-	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	// The msg services are wrapped to capture the events x/callback delivers to the contracts subscribed to them
+	msgServer := callbackKeeper.NewEventCaptureMsgServer(app.MsgServiceRouter(), app.Keepers.CallbackKeeper)
+	app.configurator = module.NewConfigurator(app.appCodec, msgServer, app.GRPCQueryRouter())
 	err = app.ModuleManager.RegisterServices(app.configurator)
 	if err != nil {
 		panic(fmt.Errorf("failed to register services: %s", err))
@@ -957,8 +965,10 @@ func NewArchwayApp(
 	if err != nil {
 		panic(fmt.Errorf("failed to create AnteHandler: %s", err))
 	}
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+	postHandler, err := NewPostHandler(
+		PostHandlerOptions{
+			CWFeesKeeper: app.Keepers.CWFeesKeeper,
+		},
 	)
 	if err != nil {
 		panic(fmt.Errorf("failed to create PostHandler: %s", err))
@@ -1216,8 +1226,13 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 
 func getAcceptedStargateQueries() wasmdKeeper.AcceptedStargateQueries {
 	return wasmdKeeper.AcceptedStargateQueries{
-		"/archway.cwerrors.v1.Query/Errors":               &cwerrorsTypes.QueryErrorsRequest{},
-		"/archway.callback.v1.Query/EstimateCallbackFees": &callbackTypes.QueryEstimateCallbackFeesRequest{},
-		"/archway.callback.v1.Query/Params":               &callbackTypes.QueryParamsRequest{},
+		"/archway.cwerrors.v1.Query/Errors":                 &cwerrorsTypes.QueryErrorsRequest{},
+		"/archway.callback.v1.Query/EstimateCallbackFees":   &callbackTypes.QueryEstimateCallbackFeesRequest{},
+		"/archway.callback.v1.Query/Params":                 &callbackTypes.QueryParamsRequest{},
+		"/archway.callback.v1.Query/CallbacksByContract":    &callbackTypes.QueryCallbacksByContractRequest{},
+		"/archway.callback.v1.Query/CallbacksByReserver":    &callbackTypes.QueryCallbacksByReserverRequest{},
+		"/archway.callback.v1.Query/CallbackReceipts":       &callbackTypes.QueryCallbackReceiptsRequest{},
+		"/archway.callback.v1.Query/EventSubscriptions":     &callbackTypes.QueryEventSubscriptionsRequest{},
+		"/archway.callback.v1.Query/BlockReservationPrices": &callbackTypes.QueryBlockReservationPricesRequest{},
 	}
 }
@@ -0,0 +1,22 @@
+// DONTCOVER
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/cwfees"
+)
+
+// PostHandlerOptions are the options required for constructing the Archway PostHandler.
+type PostHandlerOptions struct {
+	CWFeesKeeper cwfees.Keeper
+}
+
+func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
+	postDecorators := []sdk.PostDecorator{
+		// Custom Archway x/cwfees grant settlement, which notifies the granting contract of the TX outcome
+		cwfees.NewSettleGrantDecorator(options.CWFeesKeeper),
+	}
+
+	return sdk.ChainPostDecorators(postDecorators...), nil
+}
@@ -3,6 +3,7 @@ package upgradelatest
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,6 +11,9 @@ import (
 
 	"github.com/archway-network/archway/app/keepers"
 	"github.com/archway-network/archway/app/upgrades"
+	callbackTypes "github.com/archway-network/archway/x/callback/types"
+	cwerrorstypes "github.com/archway-network/archway/x/cwerrors/types"
+	cwfeestypes "github.com/archway-network/archway/x/cwfees/types"
 )
 
 // This upgrade handler is used for all the current changes to the protocol
@@ -19,14 +23,73 @@ const NameAsciiArt = ""
 
 var Upgrade = upgrades.Upgrade{
 	UpgradeName: Name,
-	CreateUpgradeHandler: func(mm *module.Manager, cfg module.Configurator, _ keepers.ArchwayKeepers) upgradetypes.UpgradeHandler {
+	CreateUpgradeHandler: func(mm *module.Manager, cfg module.Configurator, keepers keepers.ArchwayKeepers) upgradetypes.UpgradeHandler {
 		return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 			migrations, err := mm.RunMigrations(ctx, cfg, fromVM)
 			if err != nil {
 				return nil, err
 			}
 
-			sdk.UnwrapSDKContext(ctx).Logger().Info(upgrades.ArchwayLogo + NameAsciiArt)
+			unwrappedCtx := sdk.UnwrapSDKContext(ctx)
+			// Setting the new callback params
+			callbackParams, err := keepers.CallbackKeeper.GetParams(unwrappedCtx)
+			if err != nil {
+				return nil, err
+			}
+			callbackParams.MaxPayloadSize = callbackTypes.DefaultMaxPayloadSize
+			callbackParams.PayloadFeeMultiplier = callbackTypes.DefaultPayloadFeeMultiplier
+			callbackParams.MaxFutureReservationTime = callbackTypes.DefaultMaxFutureReservationTime
+			callbackParams.FutureReservationTimeFeeMultiplier = callbackTypes.DefaultFutureReservationTimeFeeMultiplier
+			callbackParams.MaxCallbackGasLimit = callbackTypes.DefaultMaxCallbackGasLimit
+			callbackParams.BlockCallbackGasLimit = callbackTypes.DefaultBlockCallbackGasLimit
+			callbackParams.ReceiptRetentionBlocks = callbackTypes.DefaultReceiptRetentionBlocks
+			callbackParams.MaxEventSubscriptionsPerContract = callbackTypes.DefaultMaxEventSubscriptionsPerContract
+			callbackParams.MaxEventsPerDelivery = callbackTypes.DefaultMaxEventsPerDelivery
+			callbackParams.BlockReservationPricing = callbackTypes.DefaultBlockReservationPricing
+			callbackParams.TargetBlockReservations = callbackTypes.DefaultTargetBlockReservations
+			callbackParams.CongestionBaseFee = callbackTypes.DefaultCongestionBaseFee
+			callbackParams.CongestionFeeChangeRate = callbackTypes.DefaultCongestionFeeChangeRate
+			callbackParams.MaxEventSubscriptionsPerType = callbackTypes.DefaultMaxEventSubscriptionsPerType
+			err = keepers.CallbackKeeper.SetParams(unwrappedCtx, callbackParams)
+			if err != nil {
+				return nil, err
+			}
+			// Setting the new cwerrors params to their defaults. Subscription filters are free by default, in the denom of the subscription fee
+			cwerrorsParams, err := keepers.CWErrorsKeeper.GetParams(unwrappedCtx)
+			if err != nil {
+				return nil, err
+			}
+			cwerrorsParams.SubscriptionFilterFee = sdk.NewCoin(cwerrorsParams.SubscriptionFee.Denom, math.ZeroInt())
+			cwerrorsParams.ErrorCallbackGasLimit = cwerrorstypes.DefaultErrorCallbackGasLimit
+			cwerrorsParams.MaxErrorCallbackGasLimit = cwerrorstypes.DefaultMaxErrorCallbackGasLimit
+			cwerrorsParams.SubscriptionExpiryNoticePeriod = cwerrorstypes.DefaultSubscriptionExpiryNoticePeriod
+			cwerrorsParams.MaxErrorsPerContract = cwerrorstypes.DefaultMaxErrorsPerContract
+			err = keepers.CWErrorsKeeper.SetParams(unwrappedCtx, cwerrorsParams)
+			if err != nil {
+				return nil, err
+			}
+			// Recording the id and the deletion height of the existing errors, so they can be acknowledged
+			err = keepers.CWErrorsKeeper.SetErrorsMetadata(unwrappedCtx)
+			if err != nil {
+				return nil, err
+			}
+			// Counting the existing errors of each contract, so the oldest ones can be evicted
+			err = keepers.CWErrorsKeeper.SetContractErrorsCount(unwrappedCtx)
+			if err != nil {
+				return nil, err
+			}
+			// Setting the cwfees params, which did not exist before, to their defaults
+			err = keepers.CWFeesKeeper.SetParams(unwrappedCtx, cwfeestypes.DefaultParams())
+			if err != nil {
+				return nil, err
+			}
+			// Indexing the existing callbacks by contract address and reserver
+			err = keepers.CallbackKeeper.IndexCallbacks(unwrappedCtx)
+			if err != nil {
+				return nil, err
+			}
+
+			unwrappedCtx.Logger().Info(upgrades.ArchwayLogo + NameAsciiArt)
 			return migrations, nil
 		}
 	},
@@ -158,3 +158,16 @@ func WithCallbackParams(callbackGasLimit uint64) TestChainGenesisOption {
 		genesis[callbacktypes.ModuleName] = cdc.MustMarshalJSON(&callbackGenesis)
 	}
 }
+
+// WithCallbackGasLimits sets the max gas limit a callback can request and the total gas limit of the callbacks executed in a block.
+func WithCallbackGasLimits(maxCallbackGasLimit, blockCallbackGasLimit uint64) TestChainGenesisOption {
+	return func(cdc codec.Codec, genesis app.GenesisState) {
+		var callbackGenesis callbacktypes.GenesisState
+		cdc.MustUnmarshalJSON(genesis[callbacktypes.ModuleName], &callbackGenesis)
+
+		callbackGenesis.Params.MaxCallbackGasLimit = maxCallbackGasLimit
+		callbackGenesis.Params.BlockCallbackGasLimit = blockCallbackGasLimit
+
+		genesis[callbacktypes.ModuleName] = cdc.MustMarshalJSON(&callbackGenesis)
+	}
+}
@@ -26,7 +26,7 @@ func CallbackKeeper(tb testing.TB) (keeper.Keeper, sdk.Context) {
 	tb.Helper()
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey("m_callback")
-	tStoreKey := storetypes.NewTransientStoreKey("t_callback")
+	tStoreKey := storetypes.NewTransientStoreKey(types.TStoreKey)
 
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(tb), storemetrics.NewNoOpMetrics())
@@ -66,9 +66,12 @@ func CallbackKeeper(tb testing.TB) (keeper.Keeper, sdk.Context) {
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
+		tStoreKey,
 		nil,
 		rewardsKeeper,
 		bankKeeper,
+		nil,
+		nil,
 		"cosmos1a48wdtjn3egw7swhfkeshwdtjvs6hq9nlyrwut", // random addr for gov module
 		log.NewTestLogger(tb),
 	)
@@ -0,0 +1,26 @@
+package testutils
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type MockCWFeesKeeper struct {
+	IsGrantingContractFn func(ctx context.Context, granter sdk.AccAddress) (bool, error)
+	RequestGrantFn       func(ctx context.Context, grantingContract sdk.AccAddress, txMsgs []sdk.Msg, wantFees sdk.Coins, signers []sdk.AccAddress) (sdk.Coins, error)
+}
+
+func (k MockCWFeesKeeper) IsGrantingContract(ctx context.Context, granter sdk.AccAddress) (bool, error) {
+	if k.IsGrantingContractFn == nil {
+		panic("not supposed to be called!")
+	}
+	return k.IsGrantingContractFn(ctx, granter)
+}
+
+func (k MockCWFeesKeeper) RequestGrant(ctx context.Context, grantingContract sdk.AccAddress, txMsgs []sdk.Msg, wantFees sdk.Coins, signers []sdk.AccAddress) (sdk.Coins, error) {
+	if k.RequestGrantFn == nil {
+		panic("not supposed to be called!")
+	}
+	return k.RequestGrantFn(ctx, grantingContract, txMsgs, wantFees, signers)
+}
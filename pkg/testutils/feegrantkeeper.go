@@ -0,0 +1,27 @@
+package testutils
+
+import (
+	"context"
+
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type MockFeeGrantKeeper struct {
+	GetAllowanceFn   func(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+	UseGrantedFeesFn func(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
+func (k MockFeeGrantKeeper) GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+	if k.GetAllowanceFn == nil {
+		panic("not supposed to be called!")
+	}
+	return k.GetAllowanceFn(ctx, granter, grantee)
+}
+
+func (k MockFeeGrantKeeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	if k.UseGrantedFeesFn == nil {
+		panic("not supposed to be called!")
+	}
+	return k.UseGrantedFeesFn(ctx, granter, grantee, fee, msgs)
+}
@@ -0,0 +1,84 @@
+package callback
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	callbackWbTypes "github.com/archway-network/archway/wasmbinding/callback/types"
+	callbackKeeper "github.com/archway-network/archway/x/callback/keeper"
+	callbackTypes "github.com/archway-network/archway/x/callback/types"
+)
+
+// MsgHandler provides a custom WASM message handler for the x/callback module.
+// The messages are handled by the x/callback msg server, so they go through the same checks, fees and events as the
+// messages sent by accounts. A pointer to the keeper is kept, as the keeper is initialized after the x/wasm keeper.
+type MsgHandler struct {
+	callbackKeeper *callbackKeeper.Keeper
+}
+
+// NewMsgHandler creates a new MsgHandler instance.
+func NewMsgHandler(ck *callbackKeeper.Keeper) MsgHandler {
+	return MsgHandler{
+		callbackKeeper: ck,
+	}
+}
+
+// RequestCallback registers a callback sent by the contract and returns it.
+func (h MsgHandler) RequestCallback(ctx sdk.Context, senderAddr sdk.AccAddress, req callbackWbTypes.RequestCallbackRequest) ([]sdk.Event, [][]byte, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("requestCallback: %w", err)
+	}
+
+	msg := req.ToSDK(senderAddr)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, nil, fmt.Errorf("requestCallback: %w", err)
+	}
+
+	if _, err := callbackKeeper.NewMsgServer(*h.callbackKeeper).RequestCallback(ctx, msg); err != nil {
+		return nil, nil, err
+	}
+
+	var callback callbackTypes.Callback
+	var err error
+	if msg.CallbackTime != nil {
+		callback, err = h.callbackKeeper.GetTimedCallback(ctx, *msg.CallbackTime, msg.ContractAddress, msg.JobId)
+	} else {
+		callback, err = h.callbackKeeper.GetCallback(ctx, msg.CallbackHeight, msg.ContractAddress, msg.JobId)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resBz, err := json.Marshal(callbackWbTypes.RequestCallbackResponse{Callback: callbackWbTypes.NewCallback(callback)})
+	if err != nil {
+		return nil, nil, fmt.Errorf("result JSON marshal: %w", err)
+	}
+
+	return nil, [][]byte{resBz}, nil
+}
+
+// CancelCallback cancels a callback on behalf of the contract and returns the refunded fees.
+func (h MsgHandler) CancelCallback(ctx sdk.Context, senderAddr sdk.AccAddress, req callbackWbTypes.CancelCallbackRequest) ([]sdk.Event, [][]byte, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("cancelCallback: %w", err)
+	}
+
+	msg := req.ToSDK(senderAddr)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, nil, fmt.Errorf("cancelCallback: %w", err)
+	}
+
+	res, err := callbackKeeper.NewMsgServer(*h.callbackKeeper).CancelCallback(ctx, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resBz, err := json.Marshal(callbackWbTypes.NewCancelCallbackResponse(res.Refund))
+	if err != nil {
+		return nil, nil, fmt.Errorf("result JSON marshal: %w", err)
+	}
+
+	return nil, [][]byte{resBz}, nil
+}
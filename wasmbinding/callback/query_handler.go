@@ -0,0 +1,71 @@
+package callback
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/archway-network/archway/wasmbinding/callback/types"
+	callbackTypes "github.com/archway-network/archway/x/callback/types"
+)
+
+// KeeperReaderExpected defines the x/callback keeper expected read operations.
+type KeeperReaderExpected interface {
+	EstimateCallbackFees(ctx sdk.Context, blockHeight int64, payloadSize uint64, gasLimit uint64) (sdk.Coin, sdk.Coin, sdk.Coin, error)
+	EstimateTimedCallbackFees(ctx sdk.Context, callbackTime time.Time, payloadSize uint64, gasLimit uint64) (sdk.Coin, sdk.Coin, sdk.Coin, error)
+	GetCallbacksByContract(ctx sdk.Context, contractAddr sdk.AccAddress, pageReq *query.PageRequest) ([]*callbackTypes.Callback, *query.PageResponse, error)
+}
+
+// QueryHandler provides a custom WASM query handler for the x/callback module.
+type QueryHandler struct {
+	callbackKeeper KeeperReaderExpected
+}
+
+// NewQueryHandler creates a new QueryHandler instance.
+func NewQueryHandler(ck KeeperReaderExpected) QueryHandler {
+	return QueryHandler{
+		callbackKeeper: ck,
+	}
+}
+
+// EstimateCallbackFees returns the fees to be paid to register a callback at the given height or block time.
+func (h QueryHandler) EstimateCallbackFees(ctx sdk.Context, req types.EstimateCallbackFeesRequest) (types.EstimateCallbackFeesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return types.EstimateCallbackFeesResponse{}, fmt.Errorf("estimateCallbackFees: %w", err)
+	}
+
+	var futureReservationFee, blockReservationFee, transactionFee sdk.Coin
+	var err error
+	if callbackTime := req.MustGetCallbackTime(); callbackTime != nil {
+		futureReservationFee, blockReservationFee, transactionFee, err = h.callbackKeeper.EstimateTimedCallbackFees(ctx, *callbackTime, req.PayloadSize, req.GasLimit)
+	} else {
+		futureReservationFee, blockReservationFee, transactionFee, err = h.callbackKeeper.EstimateCallbackFees(ctx, req.CallbackHeight, req.PayloadSize, req.GasLimit)
+	}
+	if err != nil {
+		return types.EstimateCallbackFeesResponse{}, err
+	}
+
+	return types.NewEstimateCallbackFeesResponse(futureReservationFee, blockReservationFee, transactionFee), nil
+}
+
+// GetMyCallbacks returns the paginated list of callbacks registered for the given contract.
+func (h QueryHandler) GetMyCallbacks(ctx sdk.Context, req types.MyCallbacksRequest) (types.MyCallbacksResponse, error) {
+	if err := req.Validate(); err != nil {
+		return types.MyCallbacksResponse{}, fmt.Errorf("myCallbacks: %w", err)
+	}
+
+	var pageReq *query.PageRequest
+	if req.Pagination != nil {
+		req := req.Pagination.ToSDK()
+		pageReq = &req
+	}
+
+	callbacks, pageResp, err := h.callbackKeeper.GetCallbacksByContract(ctx, req.MustGetContractAddress(), pageReq)
+	if err != nil {
+		return types.MyCallbacksResponse{}, err
+	}
+
+	return types.NewMyCallbacksResponse(callbacks, *pageResp), nil
+}
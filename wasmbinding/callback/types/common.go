@@ -0,0 +1,142 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/archway-network/archway/wasmbinding/pkg"
+	callbackTypes "github.com/archway-network/archway/x/callback/types"
+)
+
+type (
+	// Callback is the WASM binding representation of a callbackTypes.Callback object.
+	Callback struct {
+		// ContractAddress is the address of the contract which requested the callback (bech32 encoded).
+		ContractAddress string `json:"contract_address"`
+		// JobID is an identifier the callback requestor can pass in to identify the callback when it happens.
+		JobID uint64 `json:"job_id"`
+		// CallbackHeight is the height at which the callback is executed.
+		// It is zero for a callback executed at a block time.
+		CallbackHeight int64 `json:"callback_height"`
+		// CallbackTime is the block time at which the callback is executed.
+		// RFC3339Nano is used to represent the time. It is empty for a callback executed at a height.
+		CallbackTime string `json:"callback_time"`
+		// ReservedBy is the address which reserved the callback (bech32 encoded).
+		ReservedBy string `json:"reserved_by"`
+		// FeeSplit is the breakdown of the fees paid by the contract to reserve the callback.
+		FeeSplit CallbackFeeSplit `json:"fee_split"`
+		// Interval is the number of blocks between executions of a recurring callback.
+		Interval uint64 `json:"interval"`
+		// RemainingExecutions is the number of executions of a recurring callback left after this one.
+		RemainingExecutions uint64 `json:"remaining_executions"`
+		// Payload is the data passed back to the contract on execution.
+		Payload []byte `json:"payload"`
+		// MaxGasLimit is the gas limit of the callback execution.
+		MaxGasLimit uint64 `json:"max_gas_limit"`
+		// FeePayer is the address which pays the callback fees instead of the sender (bech32 encoded).
+		FeePayer string `json:"fee_payer"`
+		// RefundAddress is the address the unused fees are refunded to (bech32 encoded).
+		RefundAddress string `json:"refund_address"`
+		// RetryPolicy is the policy to retry a failed execution, if any.
+		RetryPolicy *RetryPolicy `json:"retry_policy"`
+		// FailedAttempts is the number of failed execution attempts so far.
+		FailedAttempts uint64 `json:"failed_attempts"`
+	}
+
+	// RetryPolicy is the WASM binding representation of a callbackTypes.RetryPolicy object.
+	RetryPolicy struct {
+		// MaxAttempts is the maximum number of times an execution is attempted, including the first attempt.
+		MaxAttempts uint64 `json:"max_attempts"`
+		// BackoffBlocks is the number of blocks after which a failed execution is attempted again.
+		BackoffBlocks uint64 `json:"backoff_blocks"`
+	}
+
+	// CallbackFeeSplit is the WASM binding representation of a callbackTypes.CallbackFeesFeeSplit object.
+	CallbackFeeSplit struct {
+		// TransactionFees is the transaction fees for the callback based on its gas consumption.
+		TransactionFees wasmVmTypes.Coin `json:"transaction_fees"`
+		// BlockReservationFees is the block reservation fees portion of the callback reservation fees.
+		BlockReservationFees wasmVmTypes.Coin `json:"block_reservation_fees"`
+		// FutureReservationFees is the future reservation fees portion of the callback reservation fees.
+		FutureReservationFees wasmVmTypes.Coin `json:"future_reservation_fees"`
+		// SurplusFees is any extra fees passed in for the registration of the callback.
+		SurplusFees wasmVmTypes.Coin `json:"surplus_fees"`
+	}
+)
+
+// NewCallback converts the callbackTypes.Callback to the WASM bindings version.
+func NewCallback(callback callbackTypes.Callback) Callback {
+	c := Callback{
+		ContractAddress:     callback.ContractAddress,
+		JobID:               callback.JobId,
+		CallbackHeight:      callback.CallbackHeight,
+		ReservedBy:          callback.ReservedBy,
+		Interval:            callback.Interval,
+		RemainingExecutions: callback.RemainingExecutions,
+		Payload:             callback.Payload,
+		MaxGasLimit:         callback.MaxGasLimit,
+		FeePayer:            callback.FeePayer,
+		RefundAddress:       callback.RefundAddress,
+		FailedAttempts:      callback.FailedAttempts,
+	}
+	if callback.RetryPolicy != nil {
+		c.RetryPolicy = &RetryPolicy{
+			MaxAttempts:   callback.RetryPolicy.MaxAttempts,
+			BackoffBlocks: callback.RetryPolicy.BackoffBlocks,
+		}
+	}
+	if callback.CallbackTime != nil {
+		c.CallbackTime = callback.CallbackTime.Format(time.RFC3339Nano)
+	}
+	if callback.FeeSplit != nil {
+		c.FeeSplit = NewCallbackFeeSplit(*callback.FeeSplit)
+	}
+
+	return c
+}
+
+// ToSDK converts the retry policy to the callbackTypes.RetryPolicy.
+func (p *RetryPolicy) ToSDK() *callbackTypes.RetryPolicy {
+	if p == nil {
+		return nil
+	}
+	return &callbackTypes.RetryPolicy{
+		MaxAttempts:   p.MaxAttempts,
+		BackoffBlocks: p.BackoffBlocks,
+	}
+}
+
+// NewCallbackFeeSplit converts the callbackTypes.CallbackFeesFeeSplit to the WASM bindings version.
+func NewCallbackFeeSplit(feeSplit callbackTypes.CallbackFeesFeeSplit) CallbackFeeSplit {
+	var s CallbackFeeSplit
+	if feeSplit.TransactionFees != nil {
+		s.TransactionFees = pkg.SDKCoinToWasm(*feeSplit.TransactionFees)
+	}
+	if feeSplit.BlockReservationFees != nil {
+		s.BlockReservationFees = pkg.SDKCoinToWasm(*feeSplit.BlockReservationFees)
+	}
+	if feeSplit.FutureReservationFees != nil {
+		s.FutureReservationFees = pkg.SDKCoinToWasm(*feeSplit.FutureReservationFees)
+	}
+	if feeSplit.SurplusFees != nil {
+		s.SurplusFees = pkg.SDKCoinToWasm(*feeSplit.SurplusFees)
+	}
+
+	return s
+}
+
+// parseCallbackTime parses the RFC3339Nano encoded callback time or returns nil if it is empty.
+func parseCallbackTime(callbackTime string) (*time.Time, error) {
+	if callbackTime == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, callbackTime)
+	if err != nil {
+		return nil, fmt.Errorf("callbackTime: parsing: %w", err)
+	}
+
+	return &t, nil
+}
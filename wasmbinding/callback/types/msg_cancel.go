@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/wasmbinding/pkg"
+	callbackTypes "github.com/archway-network/archway/x/callback/types"
+)
+
+// CancelCallbackRequest is the Msg.CancelCallback request.
+type CancelCallbackRequest struct {
+	// ContractAddress is the contract address the callback is registered for (bech32 encoded).
+	// If omitted, the calling contract address is used.
+	ContractAddress string `json:"contract_address"`
+	// JobID is the identifier of the callback.
+	JobID uint64 `json:"job_id"`
+	// CallbackHeight is the height the callback is registered at.
+	// Only one of (CallbackHeight, CallbackTime) should be set.
+	CallbackHeight int64 `json:"callback_height"`
+	// CallbackTime is the block time the callback is registered at.
+	// RFC3339Nano is used to represent the time.
+	// Only one of (CallbackHeight, CallbackTime) should be set.
+	CallbackTime string `json:"callback_time"`
+}
+
+// CancelCallbackResponse is the Msg.CancelCallback response.
+type CancelCallbackResponse struct {
+	// Refund is the fees refunded for the cancelled callback.
+	Refund wasmVmTypes.Coin `json:"refund"`
+}
+
+// Validate performs request fields validation.
+func (r CancelCallbackRequest) Validate() error {
+	if r.ContractAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
+			return fmt.Errorf("contractAddress: parsing: %w", err)
+		}
+	}
+
+	if _, err := parseCallbackTime(r.CallbackTime); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ToSDK converts the request to the callbackTypes.MsgCancelCallback sent by the given contract.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r CancelCallbackRequest) ToSDK(senderAddr sdk.AccAddress) *callbackTypes.MsgCancelCallback {
+	contractAddress := r.ContractAddress
+	if contractAddress == "" {
+		contractAddress = senderAddr.String()
+	}
+
+	callbackTime, err := parseCallbackTime(r.CallbackTime)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: cancelCallback request: %w", err))
+	}
+
+	return &callbackTypes.MsgCancelCallback{
+		Sender:          senderAddr.String(),
+		ContractAddress: contractAddress,
+		JobId:           r.JobID,
+		CallbackHeight:  r.CallbackHeight,
+		CallbackTime:    callbackTime,
+	}
+}
+
+// NewCancelCallbackResponse creates a new CancelCallbackResponse.
+func NewCancelCallbackResponse(refund sdk.Coin) CancelCallbackResponse {
+	return CancelCallbackResponse{
+		Refund: pkg.SDKCoinToWasm(refund),
+	}
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"fmt"
+
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/wasmbinding/pkg"
+	callbackTypes "github.com/archway-network/archway/x/callback/types"
+)
+
+// RequestCallbackRequest is the Msg.RequestCallback request.
+type RequestCallbackRequest struct {
+	// ContractAddress is the contract address to register the callback for (bech32 encoded).
+	// If omitted, the calling contract address is used.
+	ContractAddress string `json:"contract_address"`
+	// JobID is an identifier passed back to the contract when the callback is executed.
+	JobID uint64 `json:"job_id"`
+	// CallbackHeight is the height at which the callback is executed.
+	// Only one of (CallbackHeight, CallbackTime) should be set.
+	CallbackHeight int64 `json:"callback_height"`
+	// CallbackTime is the block time at which the callback is executed.
+	// RFC3339Nano is used to represent the time.
+	// Only one of (CallbackHeight, CallbackTime) should be set.
+	CallbackTime string `json:"callback_time"`
+	// Fees are the fees sent by the contract to reserve the callback.
+	Fees wasmVmTypes.Coin `json:"fees"`
+	// Interval is the number of blocks between executions of a recurring callback.
+	Interval uint64 `json:"interval"`
+	// MaxExecutions is the total number of executions of a recurring callback.
+	MaxExecutions uint64 `json:"max_executions"`
+	// Payload is the optional data passed back to the contract on execution.
+	Payload []byte `json:"payload"`
+	// GasLimit is the gas limit of the callback execution. If 0, the CallbackGasLimit param is used.
+	GasLimit uint64 `json:"gas_limit"`
+	// FeePayer is the optional address which pays the callback fees instead of the sender (bech32 encoded).
+	FeePayer string `json:"fee_payer"`
+	// RefundAddress is the optional address the unused fees are refunded to (bech32 encoded).
+	RefundAddress string `json:"refund_address"`
+	// RetryPolicy is the optional policy to retry a failed execution.
+	RetryPolicy *RetryPolicy `json:"retry_policy"`
+}
+
+// RequestCallbackResponse is the Msg.RequestCallback response.
+type RequestCallbackResponse struct {
+	// Callback is the registered callback.
+	Callback Callback `json:"callback"`
+}
+
+// Validate performs request fields validation.
+func (r RequestCallbackRequest) Validate() error {
+	if r.ContractAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
+			return fmt.Errorf("contractAddress: parsing: %w", err)
+		}
+	}
+
+	if _, err := parseCallbackTime(r.CallbackTime); err != nil {
+		return err
+	}
+
+	if _, err := pkg.WasmCoinToSDK(r.Fees); err != nil {
+		return fmt.Errorf("fees: %w", err)
+	}
+
+	return nil
+}
+
+// ToSDK converts the request to the callbackTypes.MsgRequestCallback sent by the given contract.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r RequestCallbackRequest) ToSDK(senderAddr sdk.AccAddress) *callbackTypes.MsgRequestCallback {
+	contractAddress := r.ContractAddress
+	if contractAddress == "" {
+		contractAddress = senderAddr.String()
+	}
+
+	callbackTime, err := parseCallbackTime(r.CallbackTime)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: requestCallback request: %w", err))
+	}
+
+	fees, err := pkg.WasmCoinToSDK(r.Fees)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: requestCallback request: parsing fees: %w", err))
+	}
+
+	return &callbackTypes.MsgRequestCallback{
+		Sender:          senderAddr.String(),
+		ContractAddress: contractAddress,
+		JobId:           r.JobID,
+		CallbackHeight:  r.CallbackHeight,
+		Fees:            fees,
+		Interval:        r.Interval,
+		MaxExecutions:   r.MaxExecutions,
+		Payload:         r.Payload,
+		CallbackTime:    callbackTime,
+		GasLimit:        r.GasLimit,
+		FeePayer:        r.FeePayer,
+		RefundAddress:   r.RefundAddress,
+		RetryPolicy:     r.RetryPolicy.ToSDK(),
+	}
+}
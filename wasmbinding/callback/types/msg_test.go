@@ -0,0 +1,169 @@
+package types
+
+import (
+	"testing"
+
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestCallbackRequestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         RequestCallbackRequest
+		errExpected bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "OK: height callback",
+			msg: RequestCallbackRequest{
+				ContractAddress: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c",
+				JobID:           1,
+				CallbackHeight:  100,
+				Fees:            wasmVmTypes.NewCoin(100, "stake"),
+			},
+		},
+		{
+			name: "OK: timed callback without contract address",
+			msg: RequestCallbackRequest{
+				CallbackTime: "2024-01-01T00:00:00.5Z",
+				Fees:         wasmVmTypes.NewCoin(100, "stake"),
+			},
+		},
+		{
+			name: "Fail: invalid ContractAddress",
+			msg: RequestCallbackRequest{
+				ContractAddress: "invalid",
+				CallbackHeight:  100,
+				Fees:            wasmVmTypes.NewCoin(100, "stake"),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid CallbackTime",
+			msg: RequestCallbackRequest{
+				CallbackTime: "tomorrow",
+				Fees:         wasmVmTypes.NewCoin(100, "stake"),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid Fees",
+			msg: RequestCallbackRequest{
+				CallbackHeight: 100,
+				Fees:           wasmVmTypes.Coin{Denom: "stake", Amount: "abc"},
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRequestCallbackRequestToSDK(t *testing.T) {
+	senderAddr, err := sdk.AccAddressFromBech32("cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c")
+	require.NoError(t, err)
+
+	t.Run("Contract address defaults to the sender", func(t *testing.T) {
+		msg := RequestCallbackRequest{
+			JobID:        1,
+			CallbackTime: "2024-01-01T00:00:00.5Z",
+			Fees:         wasmVmTypes.NewCoin(100, "stake"),
+		}.ToSDK(senderAddr)
+
+		assert.Equal(t, senderAddr.String(), msg.Sender)
+		assert.Equal(t, senderAddr.String(), msg.ContractAddress)
+		assert.Equal(t, uint64(1), msg.JobId)
+		assert.Equal(t, "100stake", msg.Fees.String())
+		require.NotNil(t, msg.CallbackTime)
+		assert.Equal(t, int64(1704067200), msg.CallbackTime.Unix())
+		assert.Equal(t, 500_000_000, msg.CallbackTime.Nanosecond())
+	})
+
+	t.Run("Contract address is kept", func(t *testing.T) {
+		msg := RequestCallbackRequest{
+			ContractAddress: "cosmos1x394ype3x8nt9wz0j78m8c8kcezpslrcnvs6ef",
+			CallbackHeight:  100,
+			Fees:            wasmVmTypes.NewCoin(100, "stake"),
+		}.ToSDK(senderAddr)
+
+		assert.Equal(t, senderAddr.String(), msg.Sender)
+		assert.Equal(t, "cosmos1x394ype3x8nt9wz0j78m8c8kcezpslrcnvs6ef", msg.ContractAddress)
+		assert.Nil(t, msg.CallbackTime)
+		assert.Nil(t, msg.RetryPolicy)
+	})
+
+	t.Run("Retry policy is passed through", func(t *testing.T) {
+		msg := RequestCallbackRequest{
+			CallbackHeight: 100,
+			Fees:           wasmVmTypes.NewCoin(100, "stake"),
+			RetryPolicy:    &RetryPolicy{MaxAttempts: 3, BackoffBlocks: 10},
+		}.ToSDK(senderAddr)
+
+		require.NotNil(t, msg.RetryPolicy)
+		assert.Equal(t, uint64(3), msg.RetryPolicy.MaxAttempts)
+		assert.Equal(t, uint64(10), msg.RetryPolicy.BackoffBlocks)
+	})
+}
+
+func TestCancelCallbackRequestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         CancelCallbackRequest
+		errExpected bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "OK: height callback",
+			msg: CancelCallbackRequest{
+				ContractAddress: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c",
+				CallbackHeight:  100,
+			},
+		},
+		{
+			name: "OK: timed callback without contract address",
+			msg: CancelCallbackRequest{
+				CallbackTime: "2024-01-01T00:00:00Z",
+			},
+		},
+		{
+			name: "Fail: invalid ContractAddress",
+			msg: CancelCallbackRequest{
+				ContractAddress: "invalid",
+				CallbackHeight:  100,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid CallbackTime",
+			msg: CancelCallbackRequest{
+				CallbackTime: "tomorrow",
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/archway-network/archway/wasmbinding/pkg"
+	callbackTypes "github.com/archway-network/archway/x/callback/types"
+)
+
+// MyCallbacksRequest is the Query.MyCallbacks request.
+type MyCallbacksRequest struct {
+	// ContractAddress is the bech32 encoded address of the contract to list the callbacks of.
+	// Custom queries are not aware of the calling contract, so the contract is expected to pass its own address.
+	ContractAddress string `json:"contract_address"`
+	// Pagination is an optional pagination options for the request.
+	Pagination *pkg.PageRequest `json:"pagination"`
+}
+
+// MyCallbacksResponse is the Query.MyCallbacks response.
+type MyCallbacksResponse struct {
+	// Callbacks is the list of callbacks registered for the contract.
+	Callbacks []Callback `json:"callbacks"`
+	// Pagination is the pagination details in the response.
+	Pagination pkg.PageResponse `json:"pagination"`
+}
+
+// Validate performs request fields validation.
+func (r MyCallbacksRequest) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: parsing: %w", err)
+	}
+
+	return nil
+}
+
+// MustGetContractAddress returns the contract address as sdk.AccAddress.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r MyCallbacksRequest) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(r.ContractAddress)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: myCallbacks request: parsing contractAddress: %w", err))
+	}
+
+	return addr
+}
+
+// NewMyCallbacksResponse builds a new MyCallbacksResponse.
+func NewMyCallbacksResponse(callbacks []*callbackTypes.Callback, pageResp query.PageResponse) MyCallbacksResponse {
+	resp := MyCallbacksResponse{
+		Callbacks:  make([]Callback, 0, len(callbacks)),
+		Pagination: pkg.NewPageResponseFromSDK(pageResp),
+	}
+
+	for _, callback := range callbacks {
+		resp.Callbacks = append(resp.Callbacks, NewCallback(*callback))
+	}
+
+	return resp
+}
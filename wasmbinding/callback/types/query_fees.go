@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/wasmbinding/pkg"
+)
+
+// EstimateCallbackFeesRequest is the Query.EstimateCallbackFees request.
+type EstimateCallbackFeesRequest struct {
+	// CallbackHeight is the height the callback would be registered at.
+	// Only one of (CallbackHeight, CallbackTime) should be set.
+	CallbackHeight int64 `json:"callback_height"`
+	// CallbackTime is the block time the callback would be registered at.
+	// RFC3339Nano is used to represent the time.
+	// Only one of (CallbackHeight, CallbackTime) should be set.
+	CallbackTime string `json:"callback_time"`
+	// PayloadSize is the size in bytes of the payload attached to the callback.
+	PayloadSize uint64 `json:"payload_size"`
+	// GasLimit is the gas limit requested for the callback. If 0, the CallbackGasLimit param is used.
+	GasLimit uint64 `json:"gas_limit"`
+}
+
+// EstimateCallbackFeesResponse is the Query.EstimateCallbackFees response.
+type EstimateCallbackFeesResponse struct {
+	// FeeSplit is the breakdown of the fees to be paid. The surplus fees are always empty.
+	FeeSplit CallbackFeeSplit `json:"fee_split"`
+	// TotalFees is the total fees to be paid to register the callback.
+	TotalFees wasmVmTypes.Coin `json:"total_fees"`
+}
+
+// Validate performs request fields validation.
+func (r EstimateCallbackFeesRequest) Validate() error {
+	if r.CallbackHeight != 0 && r.CallbackTime != "" {
+		return fmt.Errorf("only one of (CallbackHeight, CallbackTime) fields must be set")
+	}
+
+	if _, err := parseCallbackTime(r.CallbackTime); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MustGetCallbackTime returns the callback time or nil if it is not set.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r EstimateCallbackFeesRequest) MustGetCallbackTime() *time.Time {
+	callbackTime, err := parseCallbackTime(r.CallbackTime)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: estimateCallbackFees request: %w", err))
+	}
+
+	return callbackTime
+}
+
+// NewEstimateCallbackFeesResponse creates a new EstimateCallbackFeesResponse.
+func NewEstimateCallbackFeesResponse(futureReservationFee, blockReservationFee, transactionFee sdk.Coin) EstimateCallbackFeesResponse {
+	return EstimateCallbackFeesResponse{
+		FeeSplit: CallbackFeeSplit{
+			TransactionFees:       pkg.SDKCoinToWasm(transactionFee),
+			BlockReservationFees:  pkg.SDKCoinToWasm(blockReservationFee),
+			FutureReservationFees: pkg.SDKCoinToWasm(futureReservationFee),
+			SurplusFees:           pkg.SDKCoinToWasm(sdk.NewInt64Coin(transactionFee.Denom, 0)),
+		},
+		TotalFees: pkg.SDKCoinToWasm(transactionFee.Add(blockReservationFee).Add(futureReservationFee)),
+	}
+}
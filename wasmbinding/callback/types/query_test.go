@@ -0,0 +1,98 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateCallbackFeesRequestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		query       EstimateCallbackFeesRequest
+		errExpected bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "OK: height",
+			query: EstimateCallbackFeesRequest{
+				CallbackHeight: 100,
+				PayloadSize:    10,
+			},
+		},
+		{
+			name: "OK: time",
+			query: EstimateCallbackFeesRequest{
+				CallbackTime: "2024-01-01T00:00:00Z",
+				GasLimit:     100_000,
+			},
+		},
+		{
+			name: "Fail: height and time",
+			query: EstimateCallbackFeesRequest{
+				CallbackHeight: 100,
+				CallbackTime:   "2024-01-01T00:00:00Z",
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid time",
+			query: EstimateCallbackFeesRequest{
+				CallbackTime: "tomorrow",
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.query.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMyCallbacksRequestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		query       MyCallbacksRequest
+		errExpected bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			query: MyCallbacksRequest{
+				ContractAddress: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c",
+			},
+		},
+		{
+			name:        "Fail: empty ContractAddress",
+			query:       MyCallbacksRequest{},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractAddress",
+			query: MyCallbacksRequest{
+				ContractAddress: "invalid",
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.query.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/archway-network/archway/wasmbinding/callback"
 	"github.com/archway-network/archway/wasmbinding/rewards"
 	"github.com/archway-network/archway/wasmbinding/types"
 )
@@ -20,14 +21,16 @@ var _ wasmKeeper.Messenger = MsgDispatcher{}
 // MsgDispatcher dispatches custom WASM queries.
 type MsgDispatcher struct {
 	rewardsHandler   rewards.MsgHandler
+	callbackHandler  callback.MsgHandler
 	wrappedMessenger wasmKeeper.Messenger
 }
 
 // NewMsgDispatcher creates a new MsgDispatcher instance.
-func NewMsgDispatcher(wrappedMessenger wasmKeeper.Messenger, rh rewards.MsgHandler) MsgDispatcher {
+func NewMsgDispatcher(wrappedMessenger wasmKeeper.Messenger, rh rewards.MsgHandler, ch callback.MsgHandler) MsgDispatcher {
 	return MsgDispatcher{
 		wrappedMessenger: wrappedMessenger,
 		rewardsHandler:   rh,
+		callbackHandler:  ch,
 	}
 }
 
@@ -55,6 +58,10 @@ func (d MsgDispatcher) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress,
 		return d.rewardsHandler.WithdrawContractRewards(ctx, contractAddr, *customMsg.WithdrawRewards)
 	case customMsg.SetFlatFee != nil:
 		return d.rewardsHandler.SetFlatFee(ctx, contractAddr, *customMsg.SetFlatFee)
+	case customMsg.RequestCallback != nil:
+		return d.callbackHandler.RequestCallback(ctx, contractAddr, *customMsg.RequestCallback)
+	case customMsg.CancelCallback != nil:
+		return d.callbackHandler.CancelCallback(ctx, contractAddr, *customMsg.CancelCallback)
 	default:
 		// That should never happen, since we validate the input above
 		return nil, nil, errorsmod.Wrap(wasmdTypes.ErrUnknownMsg, "no custom handler found")
@@ -3,8 +3,10 @@ package wasmbinding
 import (
 	wasmKeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 
+	"github.com/archway-network/archway/wasmbinding/callback"
 	"github.com/archway-network/archway/wasmbinding/gov"
 	"github.com/archway-network/archway/wasmbinding/rewards"
+	callbackKeeper "github.com/archway-network/archway/x/callback/keeper"
 )
 
 // RewardsKeeperExpected is the expected x/rewards keeper.
@@ -18,29 +20,31 @@ type GovKeeperExpected interface {
 }
 
 // BuildWasmOptions returns x/wasmd module options to support WASM bindings functionality.
-func BuildWasmOptions(rKeeper RewardsKeeperExpected, govKeeper GovKeeperExpected) []wasmKeeper.Option {
+func BuildWasmOptions(rKeeper RewardsKeeperExpected, govKeeper GovKeeperExpected, cbKeeper *callbackKeeper.Keeper) []wasmKeeper.Option {
 	return []wasmKeeper.Option{
-		wasmKeeper.WithMessageHandlerDecorator(BuildWasmMsgDecorator(rKeeper)),
-		wasmKeeper.WithQueryPlugins(BuildWasmQueryPlugin(rKeeper, govKeeper)),
+		wasmKeeper.WithMessageHandlerDecorator(BuildWasmMsgDecorator(rKeeper, cbKeeper)),
+		wasmKeeper.WithQueryPlugins(BuildWasmQueryPlugin(rKeeper, govKeeper, cbKeeper)),
 	}
 }
 
 // BuildWasmMsgDecorator returns the Wasm custom message handler decorator.
-func BuildWasmMsgDecorator(rKeeper RewardsKeeperExpected) func(old wasmKeeper.Messenger) wasmKeeper.Messenger {
+func BuildWasmMsgDecorator(rKeeper RewardsKeeperExpected, cbKeeper *callbackKeeper.Keeper) func(old wasmKeeper.Messenger) wasmKeeper.Messenger {
 	return func(old wasmKeeper.Messenger) wasmKeeper.Messenger {
 		return NewMsgDispatcher(
 			old,
 			rewards.NewRewardsMsgHandler(rKeeper),
+			callback.NewMsgHandler(cbKeeper),
 		)
 	}
 }
 
 // BuildWasmQueryPlugin returns the Wasm custom querier plugin.
-func BuildWasmQueryPlugin(rKeeper RewardsKeeperExpected, govKeeper GovKeeperExpected) *wasmKeeper.QueryPlugins {
+func BuildWasmQueryPlugin(rKeeper RewardsKeeperExpected, govKeeper GovKeeperExpected, cbKeeper *callbackKeeper.Keeper) *wasmKeeper.QueryPlugins {
 	return &wasmKeeper.QueryPlugins{
 		Custom: NewQueryDispatcher(
 			rewards.NewQueryHandler(rKeeper),
 			gov.NewQueryHandler(govKeeper),
+			callback.NewQueryHandler(cbKeeper),
 		).DispatchQuery,
 	}
 }
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/archway-network/archway/wasmbinding/callback"
 	"github.com/archway-network/archway/wasmbinding/gov"
 	"github.com/archway-network/archway/wasmbinding/rewards"
 	"github.com/archway-network/archway/wasmbinding/types"
@@ -16,15 +17,17 @@ import (
 
 // QueryDispatcher dispatches custom WASM messages.
 type QueryDispatcher struct {
-	rewardsHandler rewards.QueryHandler
-	govHandler     gov.QueryHandler
+	rewardsHandler  rewards.QueryHandler
+	govHandler      gov.QueryHandler
+	callbackHandler callback.QueryHandler
 }
 
 // NewQueryDispatcher returns a new QueryDispatcher instance.
-func NewQueryDispatcher(rewardsHandler rewards.QueryHandler, govHandler gov.QueryHandler) QueryDispatcher {
+func NewQueryDispatcher(rewardsHandler rewards.QueryHandler, govHandler gov.QueryHandler, callbackHandler callback.QueryHandler) QueryDispatcher {
 	return QueryDispatcher{
-		rewardsHandler: rewardsHandler,
-		govHandler:     govHandler,
+		rewardsHandler:  rewardsHandler,
+		govHandler:      govHandler,
+		callbackHandler: callbackHandler,
 	}
 }
 
@@ -52,6 +55,10 @@ func (d QueryDispatcher) DispatchQuery(ctx sdk.Context, request json.RawMessage)
 		resData, resErr = d.govHandler.GetVote(ctx, *req.GovVote)
 	case req.FlatFee != nil:
 		resData, resErr = d.rewardsHandler.GetFlatFee(ctx, *req.FlatFee)
+	case req.EstimateCallbackFees != nil:
+		resData, resErr = d.callbackHandler.EstimateCallbackFees(ctx, *req.EstimateCallbackFees)
+	case req.MyCallbacks != nil:
+		resData, resErr = d.callbackHandler.GetMyCallbacks(ctx, *req.MyCallbacks)
 	default:
 		// That should never happen, since we validate the input above
 		return nil, wasmVmTypes.UnsupportedRequest{Kind: "no custom querier found"}
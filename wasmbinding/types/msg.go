@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	callbackTypes "github.com/archway-network/archway/wasmbinding/callback/types"
 	rewardsTypes "github.com/archway-network/archway/wasmbinding/rewards/types"
 )
 
@@ -19,6 +20,14 @@ type Msg struct {
 	// SetFlatFee is a request to set contract flat fee
 	// Request is authorized only if the contract has meta data
 	SetFlatFee *rewardsTypes.SetFlatFeeRequest `json:"set_flat_fee"`
+
+	// RequestCallback is a request to register a callback.
+	// The contract address is used as the sender, and as the callback contract address if none is set.
+	RequestCallback *callbackTypes.RequestCallbackRequest `json:"request_callback"`
+
+	// CancelCallback is a request to cancel a callback.
+	// The contract address is used as the sender, and as the callback contract address if none is set.
+	CancelCallback *callbackTypes.CancelCallbackRequest `json:"cancel_callback"`
 }
 
 // Validate validates the msg fields.
@@ -37,6 +46,14 @@ func (m Msg) Validate() error {
 		cnt++
 	}
 
+	if m.RequestCallback != nil {
+		cnt++
+	}
+
+	if m.CancelCallback != nil {
+		cnt++
+	}
+
 	if cnt != 1 {
 		return fmt.Errorf("one and only one field must be set (fields=%v)", cnt)
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	callbackTypes "github.com/archway-network/archway/wasmbinding/callback/types"
 	"github.com/archway-network/archway/wasmbinding/rewards/types"
 )
 
@@ -25,6 +26,15 @@ func TestMsgValidate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "OK: Callback",
+			msg: Msg{
+				CancelCallback: &callbackTypes.CancelCallbackRequest{
+					JobID:          1,
+					CallbackHeight: 100,
+				},
+			},
+		},
 		{
 			name: "Fail: not one of",
 			msg: Msg{
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	callbackTypes "github.com/archway-network/archway/wasmbinding/callback/types"
 	govTypes "github.com/archway-network/archway/wasmbinding/gov/types"
 	rewardsTypes "github.com/archway-network/archway/wasmbinding/rewards/types"
 )
@@ -21,6 +22,13 @@ type Query struct {
 
 	// FlatFee returns the contracts flat fee
 	FlatFee *rewardsTypes.ContractFlatFeeRequest `json:"flat_fee"`
+
+	// EstimateCallbackFees returns the fees to be paid to register a callback at a given height or block time.
+	EstimateCallbackFees *callbackTypes.EstimateCallbackFeesRequest `json:"estimate_callback_fees"`
+
+	// MyCallbacks returns a list of callbacks registered for the contract.
+	// The request is paginated.
+	MyCallbacks *callbackTypes.MyCallbacksRequest `json:"my_callbacks"`
 }
 
 // Validate validates the query fields.
@@ -43,6 +51,14 @@ func (q Query) Validate() error {
 		cnt++
 	}
 
+	if q.EstimateCallbackFees != nil {
+		cnt++
+	}
+
+	if q.MyCallbacks != nil {
+		cnt++
+	}
+
 	if cnt != 1 {
 		return fmt.Errorf("one and only one sub-query must be set (fields=%v)", cnt)
 	}
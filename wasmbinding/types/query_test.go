@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	callbackTypes "github.com/archway-network/archway/wasmbinding/callback/types"
 	govTypes "github.com/archway-network/archway/wasmbinding/gov/types"
 	rewardsTypes "github.com/archway-network/archway/wasmbinding/rewards/types"
 )
@@ -34,6 +35,14 @@ func TestQueryValidate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "OK: MyCallbacks",
+			query: Query{
+				MyCallbacks: &callbackTypes.MyCallbacksRequest{
+					ContractAddress: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c",
+				},
+			},
+		},
 		{
 			name:        "Fail: empty",
 			query:       Query{},
@@ -1,8 +1,8 @@
 package callback
 
 import (
-	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,18 +13,134 @@ import (
 	"github.com/archway-network/archway/x/callback/types"
 )
 
-// EndBlocker fetches all the callbacks registered for the current block height and executes them
+// EndBlocker executes all the callbacks deferred from the previous blocks, all the callbacks registered for the current
+// block height and all the callbacks registered for a block time which has been reached, in that order.
+// Callbacks which do not fit in the BlockCallbackGasLimit module param are executed in the next blocks.
+// The callbacks registered for a block time are counted against the MaxBlockReservationLimit module param along with
+// the callbacks registered for the current block height, the ones over the limit are executed in the next blocks.
+// Then the events of the block matching an event subscription are delivered to the subscribed contracts
 func EndBlocker(ctx sdk.Context, k keeper.Keeper, wk types.WasmKeeperExpected, ek types.ErrorsKeeperExpected) ([]abci.ValidatorUpdate, error) {
-	k.IterateCallbacksByHeight(ctx, ctx.BlockHeight(), callbackExec(ctx, k, wk, ek))
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduler := newBlockScheduler(ctx, k, params.BlockCallbackGasLimit, callbackExec(ctx, k, wk, ek))
+	// The deferred callbacks are removed from the queue one at a time as they are executed,
+	// the ones which still do not fit stay queued for the next block
+	for {
+		seq, callback, found, err := k.NextDeferredCallback(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !found || !scheduler.fits(callback.MaxGasLimit) {
+			break
+		}
+		if err := k.RemoveDeferredCallback(ctx, seq); err != nil {
+			return nil, err
+		}
+		scheduler.exec(callback)
+	}
+	reservations := uint64(0)
+	k.IterateCallbacksByHeight(ctx, ctx.BlockHeight(), func(callback types.Callback) bool {
+		if scheduler.fits(callback.MaxGasLimit) {
+			scheduler.exec(callback)
+		} else {
+			scheduler.deferCallback(callback)
+		}
+		reservations++
+		return false
+	})
+	// The callbacks registered for a block time are spread over the seconds the block covers, so they are limited
+	// to the reservations left in the block. The ones over the limit, or which do not fit in the block callback gas
+	// limit, stay stored and are due in the next blocks
+	k.IterateTimedCallbacksUntil(ctx, ctx.BlockTime(), func(callback types.Callback) bool {
+		if reservations >= params.MaxBlockReservationLimit || !scheduler.fits(callback.MaxGasLimit) {
+			return true
+		}
+		scheduler.exec(callback)
+		reservations++
+		return false
+	})
+	// Deliver the events captured in the block to the subscribed contracts, within the gas left in the block
+	for _, subscriptionID := range k.GetSubscriptionsWithCapturedEvents(ctx) {
+		deliverEvents(ctx, k, wk, ek, scheduler, subscriptionID, params.MaxEventsPerDelivery)
+	}
+	// Prune any execution receipts that have expired in the current block height
+	if err := k.PruneCallbackReceipts(ctx); err != nil {
+		return nil, err
+	}
 	return nil, nil
 }
 
-// callbackExec returns a function which executes the callback and deletes it from state after execution
-func callbackExec(ctx sdk.Context, k keeper.Keeper, wk types.WasmKeeperExpected, ek types.ErrorsKeeperExpected) func(types.Callback) bool {
+// blockScheduler keeps track of the gas consumed by the callbacks executed and the events delivered in a block,
+// so the callbacks which do not fit in the block callback gas limit are executed in the next blocks
+type blockScheduler struct {
+	ctx         sdk.Context
+	k           keeper.Keeper
+	execFn      func(types.Callback) uint64
+	gasLimit    uint64
+	gasConsumed uint64
+	filled      bool
+}
+
+func newBlockScheduler(ctx sdk.Context, k keeper.Keeper, gasLimit uint64, execFn func(types.Callback) uint64) *blockScheduler {
+	return &blockScheduler{
+		ctx:      ctx,
+		k:        k,
+		execFn:   execFn,
+		gasLimit: gasLimit,
+	}
+}
+
+// fits returns true if the given gas limit fits in the gas left in the block.
+// Once a gas limit does not fit, the block is filled and nothing else fits, to preserve the execution order.
+// The first callback of the block always fits, so a callback can not be delayed indefinitely
+func (s *blockScheduler) fits(gasLimit uint64) bool {
+	if s.gasConsumed > 0 && s.gasConsumed+gasLimit > s.gasLimit {
+		s.filled = true
+	}
+	return !s.filled
+}
+
+// consume counts the given gas against the block callback gas limit
+func (s *blockScheduler) consume(gasUsed uint64) {
+	s.gasConsumed += gasUsed
+}
+
+// exec executes the callback and counts the gas it consumed against the block callback gas limit
+func (s *blockScheduler) exec(callback types.Callback) {
+	s.consume(s.execFn(callback))
+}
+
+// deferCallback queues the callback to be executed in a later block
+func (s *blockScheduler) deferCallback(callback types.Callback) {
+	if err := s.k.DeferCallback(s.ctx, callback); err != nil {
+		panic(err)
+	}
+	s.k.Logger(s.ctx).Info(
+		"callback deferred to the next block",
+		"contract_address", callback.ContractAddress,
+		"job_id", callback.JobId,
+		"block_gas_consumed", s.gasConsumed,
+	)
+	types.EmitCallbackDeferredEvent(
+		s.ctx,
+		callback.ContractAddress,
+		callback.JobId,
+		callback.CallbackHeight,
+		callback.CallbackTime,
+		s.gasConsumed,
+	)
+}
+
+// callbackExec returns a function which executes the callback, deletes it from state after execution
+// and returns the gas consumed by the execution
+func callbackExec(ctx sdk.Context, k keeper.Keeper, wk types.WasmKeeperExpected, ek types.ErrorsKeeperExpected) func(types.Callback) uint64 {
 	logger := k.Logger(ctx)
-	return func(callback types.Callback) bool {
+	return func(callback types.Callback) uint64 {
 		// creating CallbackMsg which is encoded to json and passed as input to contract execution
-		callbackMsg := types.NewCallbackMsg(callback.JobId)
+		callbackMsg := types.NewCallbackMsg(callback.JobId, callback.Payload)
 		callbackMsgString := callbackMsg.String()
 
 		logger.Debug(
@@ -34,11 +150,24 @@ func callbackExec(ctx sdk.Context, k keeper.Keeper, wk types.WasmKeeperExpected,
 			"msg", callbackMsgString,
 		)
 
+		// Charging the transaction fees to the fee payer of a callback paid on execution. If it does not pay, the callback is dropped.
+		// The gas of the charge, grant request included, is counted against the block callback gas limit along with the execution
+		feePayerGasUsed := uint64(0)
+		if callback.IsPaidOnExecution() {
+			var err error
+			feePayerGasUsed, err = chargeFeePayer(ctx, k, ek, callback, callbackMsgString)
+			if err != nil {
+				return feePayerGasUsed
+			}
+		}
+
 		gasUsed, err := pkg.ExecuteWithGasLimit(ctx, callback.MaxGasLimit, func(ctx sdk.Context) error {
 			// executing the callback on the contract
 			_, err := wk.Sudo(ctx, sdk.MustAccAddressFromBech32(callback.ContractAddress), callbackMsg.Bytes())
 			return err
 		})
+		success := err == nil
+		retried := false
 		if err != nil {
 			logger.Error(
 				"error executing callback",
@@ -54,28 +183,25 @@ func callbackExec(ctx sdk.Context, k keeper.Keeper, wk types.WasmKeeperExpected,
 				callbackMsgString,
 				gasUsed,
 				err.Error(),
+				callback.Attempt(),
 			)
 
-			errorCode := types.ModuleErrors_ERR_UNKNOWN
-			// check if out of gas error
-			if errorsmod.IsOf(err, sdkerrors.ErrOutOfGas) {
-				errorCode = types.ModuleErrors_ERR_OUT_OF_GAS
-			}
-			// check if the error was due to contract execution failure
-			if errorsmod.IsOf(err, wasmtypes.ErrExecuteFailed) {
-				errorCode = types.ModuleErrors_ERR_CONTRACT_EXECUTION_FAILED
-			}
-
-			// Save error in the errors keeper
-			sudoErr := types.NewSudoError(
-				errorCode,
-				callback.ContractAddress,
-				callbackMsgString,
-				err.Error(),
-			)
-			err := ek.SetError(ctx, sudoErr)
-			if err != nil {
-				panic(err)
+			// Retry the execution if the retry policy of the callback allows it. Only the final failure is saved as an error
+			var retryGasUsed uint64
+			retried, retryGasUsed = retryCallback(ctx, k, callback, err)
+			feePayerGasUsed += retryGasUsed
+			if !retried {
+				// Save error in the errors keeper
+				sudoErr := types.NewSudoError(
+					sudoErrorCode(err),
+					callback.ContractAddress,
+					callbackMsgString,
+					err.Error(),
+				)
+				err := ek.SetError(ctx, sudoErr)
+				if err != nil {
+					panic(err)
+				}
 			}
 
 			// This is because gasUsed amount returned is greater than the gas limit. cuz ofc.
@@ -99,6 +225,7 @@ func callbackExec(ctx sdk.Context, k keeper.Keeper, wk types.WasmKeeperExpected,
 				callback.JobId,
 				callbackMsgString,
 				gasUsed,
+				callback.Attempt(),
 			)
 		}
 
@@ -111,9 +238,10 @@ func callbackExec(ctx sdk.Context, k keeper.Keeper, wk types.WasmKeeperExpected,
 
 		// Calculate current tx fees based on gasConsumed. Refund any leftover to the address which reserved the callback
 		txFeesConsumed := k.CalculateTransactionFees(ctx, gasUsed)
+		refundAmount := sdk.NewCoin(callback.FeeSplit.TransactionFees.Denom, math.ZeroInt())
 		if txFeesConsumed.IsLT(*callback.FeeSplit.TransactionFees) {
-			refundAmount := callback.FeeSplit.TransactionFees.Sub(txFeesConsumed)
-			err := k.RefundFromCallbackModule(ctx, callback.ReservedBy, refundAmount)
+			refundAmount = callback.FeeSplit.TransactionFees.Sub(txFeesConsumed)
+			err := k.RefundFromCallbackModule(ctx, callback.RefundRecipient(), refundAmount)
 			if err != nil {
 				panic(err)
 			}
@@ -126,25 +254,301 @@ func callbackExec(ctx sdk.Context, k keeper.Keeper, wk types.WasmKeeperExpected,
 		// Send fees to fee collector
 		feeCollectorAmount := callback.FeeSplit.BlockReservationFees.
 			Add(*callback.FeeSplit.FutureReservationFees).
-			Add(*callback.FeeSplit.SurplusFees).
 			Add(txFeesConsumed)
+		// Retried and recurring callbacks carry their surplus fees over to pay for the next execution
+		switch {
+		case retried:
+			// A recurring callback is rescheduled once its retry succeeds
+		case callback.HasNextExecution():
+			feePayerGasUsed += rescheduleCallback(ctx, k, callback)
+		default:
+			feeCollectorAmount = feeCollectorAmount.Add(*callback.FeeSplit.SurplusFees)
+		}
 		err = k.SendToFeeCollector(ctx, feeCollectorAmount)
 		if err != nil {
 			panic(err)
 		}
 
 		// deleting the callback after execution
-		if err := k.Callbacks.Remove(
+		if err := k.RemoveCallback(ctx, callback); err != nil {
+			panic(err)
+		}
+
+		// Keeping a receipt of the execution
+		err = k.SaveCallbackReceipt(ctx, types.CallbackReceipt{
+			ContractAddress: callback.ContractAddress,
+			JobId:           callback.JobId,
+			Height:          ctx.BlockHeight(),
+			GasUsed:         gasUsed,
+			Success:         success,
+			RefundAmount:    refundAmount,
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		return feePayerGasUsed + gasUsed
+	}
+}
+
+// chargeFeePayer charges the transaction fees of a callback paid on execution to its fee payer and returns the gas consumed.
+// If the fee payer does not pay, the error is reported to the contract and the callback is removed without being executed,
+// keeping the reservation fees it paid
+func chargeFeePayer(ctx sdk.Context, k keeper.Keeper, ek types.ErrorsKeeperExpected, callback types.Callback, callbackMsgString string) (uint64, error) {
+	gasUsed, err := executeFeePayerCharge(ctx, callback, func(ctx sdk.Context) error {
+		return k.ChargeFeePayer(ctx, callback, *callback.FeeSplit.TransactionFees)
+	})
+	if err == nil {
+		return gasUsed, nil
+	}
+
+	k.Logger(ctx).Info(
+		"callback fee payer did not pay the callback fees",
+		"contract_address", callback.ContractAddress,
+		"job_id", callback.JobId,
+		"fee_payer", callback.FeePayer,
+		"error", err,
+	)
+	types.EmitCallbackExecutedFailedEvent(
+		ctx,
+		callback.ContractAddress,
+		callback.JobId,
+		callbackMsgString,
+		gasUsed,
+		err.Error(),
+		callback.Attempt(),
+	)
+	sudoErr := types.NewSudoError(
+		types.ModuleErrors_ERR_FEE_PAYER_REJECTED,
+		callback.ContractAddress,
+		callbackMsgString,
+		err.Error(),
+	)
+	if err := ek.SetError(ctx, sudoErr); err != nil {
+		panic(err)
+	}
+	if err := k.RemoveCallback(ctx, callback); err != nil {
+		panic(err)
+	}
+	if err := k.SendToFeeCollector(ctx, callback.FeeSplit.BlockReservationFees.Add(*callback.FeeSplit.FutureReservationFees)); err != nil {
+		panic(err)
+	}
+	if err := k.SaveCallbackReceipt(ctx, types.CallbackReceipt{
+		ContractAddress: callback.ContractAddress,
+		JobId:           callback.JobId,
+		Height:          ctx.BlockHeight(),
+		GasUsed:         gasUsed,
+		Success:         false,
+		RefundAmount:    sdk.NewCoin(callback.FeeSplit.TransactionFees.Denom, math.ZeroInt()),
+	}); err != nil {
+		panic(err)
+	}
+	return gasUsed, err
+}
+
+// executeFeePayerCharge runs the function and returns the gas it consumed. If the callback is paid by a fee payer,
+// the function charges the fee payer, which might run the grant request of a granting contract, so it runs within
+// the callback gas limit and its state changes are dropped if it fails
+func executeFeePayerCharge(ctx sdk.Context, callback types.Callback, fn func(ctx sdk.Context) error) (uint64, error) {
+	if !callback.IsPaidOnExecution() {
+		return 0, fn(ctx)
+	}
+	return pkg.ExecuteWithGasLimit(ctx, callback.MaxGasLimit, fn)
+}
+
+// retryCallback saves another attempt of a failed callback if its retry policy allows it and returns true if it was saved,
+// along with the gas consumed charging the fee payer of the callback, if any.
+// If the retry cannot be saved, e.g. if the surplus fees do not cover it, the failure is final
+func retryCallback(ctx sdk.Context, k keeper.Keeper, callback types.Callback, execErr error) (bool, uint64) {
+	if !callback.CanRetry() {
+		return false, 0
+	}
+	var retry types.Callback
+	gasUsed, err := executeFeePayerCharge(ctx, callback, func(ctx sdk.Context) (err error) {
+		retry, err = k.RetryCallback(ctx, callback)
+		return err
+	})
+	if err != nil {
+		k.Logger(ctx).Error(
+			"error retrying callback",
+			"contract_address", callback.ContractAddress,
+			"job_id", callback.JobId,
+			"error", err,
+		)
+		return false, gasUsed
+	}
+	types.EmitCallbackRetryScheduledEvent(
+		ctx,
+		retry.ContractAddress,
+		retry.JobId,
+		retry.FailedAttempts,
+		retry.CallbackHeight,
+		retry.FeeSplit,
+		execErr.Error(),
+	)
+	return true, gasUsed
+}
+
+// rescheduleCallback schedules the next execution of a recurring callback and returns the gas consumed charging
+// the fee payer of the callback, if any.
+// If the callback cannot be rescheduled, the surplus fees are refunded to the address which reserved the callback
+func rescheduleCallback(ctx sdk.Context, k keeper.Keeper, callback types.Callback) uint64 {
+	var next types.Callback
+	gasUsed, err := executeFeePayerCharge(ctx, callback, func(ctx sdk.Context) (err error) {
+		next, err = k.RescheduleCallback(ctx, callback)
+		return err
+	})
+	if err != nil {
+		k.Logger(ctx).Info(
+			"callback could not be rescheduled",
+			"contract_address", callback.ContractAddress,
+			"job_id", callback.JobId,
+			"error", err,
+		)
+		types.EmitCallbackRescheduleFailedEvent(
 			ctx,
-			collections.Join3(
-				callback.CallbackHeight,
-				sdk.MustAccAddressFromBech32(callback.ContractAddress).Bytes(),
-				callback.JobId,
-			),
-		); err != nil {
+			callback.ContractAddress,
+			callback.JobId,
+			ctx.BlockHeight()+int64(callback.Interval),
+			err.Error(),
+		)
+		if err := k.RefundFromCallbackModule(ctx, callback.RefundRecipient(), *callback.FeeSplit.SurplusFees); err != nil {
 			panic(err)
 		}
+		return gasUsed
+	}
 
-		return false
+	types.EmitCallbackRescheduledEvent(
+		ctx,
+		next.ContractAddress,
+		next.JobId,
+		ctx.BlockHeight(),
+		next.CallbackHeight,
+		next.FeeSplit,
+		next.RemainingExecutions,
+	)
+	return gasUsed
+}
+
+// sudoErrorCode returns the x/cwerrors error code for an error returned by a sudo call to a contract
+func sudoErrorCode(err error) types.ModuleErrors {
+	// check if the error was due to contract execution failure
+	if errorsmod.IsOf(err, wasmtypes.ErrExecuteFailed) {
+		return types.ModuleErrors_ERR_CONTRACT_EXECUTION_FAILED
+	}
+	// check if out of gas error
+	if errorsmod.IsOf(err, sdkerrors.ErrOutOfGas) {
+		return types.ModuleErrors_ERR_OUT_OF_GAS
 	}
+	return types.ModuleErrors_ERR_UNKNOWN
+}
+
+// deliverEvents delivers the events captured in the block for the given subscription to the subscribed contract
+// in a single sudo call, and charges the transaction fees of the delivery from the prepaid balance of the subscription.
+// If the balance is not enough to pay for the delivery, the subscription is removed and the balance is refunded.
+// The gas of the delivery is counted against the block callback gas limit, and the events are dropped if it does not fit
+func deliverEvents(ctx sdk.Context, k keeper.Keeper, wk types.WasmKeeperExpected, ek types.ErrorsKeeperExpected, scheduler *blockScheduler, subscriptionID uint64, maxEvents uint64) {
+	logger := k.Logger(ctx)
+	subscription, err := k.GetEventSubscription(ctx, subscriptionID)
+	if err != nil {
+		// The subscription was removed after the events were captured
+		return
+	}
+
+	deliveryFees := k.CalculateTransactionFees(ctx, subscription.GasLimit)
+	if subscription.Balance.Denom != deliveryFees.Denom || subscription.Balance.IsLT(deliveryFees) {
+		logger.Info(
+			"event subscription removed as its balance is insufficient",
+			"contract_address", subscription.ContractAddress,
+			"subscription_id", subscription.Id,
+			"balance", subscription.Balance,
+		)
+		if err := k.RemoveEventSubscription(ctx, subscription); err != nil {
+			panic(err)
+		}
+		if subscription.Balance.IsPositive() {
+			if err := k.RefundFromCallbackModule(ctx, subscription.ReservedBy, subscription.Balance); err != nil {
+				panic(err)
+			}
+		}
+		types.EmitEventSubscriptionRemovedEvent(
+			ctx,
+			subscription.Id,
+			subscription.ContractAddress,
+			subscription.Balance,
+			types.EventSubscriptionRemovedReasonInsufficientBalance,
+		)
+		return
+	}
+
+	events := k.GetCapturedEvents(ctx, subscriptionID, maxEvents)
+	if !scheduler.fits(subscription.GasLimit) {
+		logger.Info(
+			"events dropped as the block callback gas limit was reached",
+			"contract_address", subscription.ContractAddress,
+			"subscription_id", subscription.Id,
+			"block_gas_consumed", scheduler.gasConsumed,
+		)
+		types.EmitEventsDeliveredEvent(
+			ctx,
+			subscription.Id,
+			subscription.ContractAddress,
+			0,
+			0,
+			sdk.NewCoin(subscription.Balance.Denom, math.ZeroInt()),
+			types.ErrBlockCallbackGasLimitReached.Error(),
+		)
+		return
+	}
+	eventsMsg := types.NewEventsMsg(subscriptionID, events)
+	gasUsed, err := pkg.ExecuteWithGasLimit(ctx, subscription.GasLimit, func(ctx sdk.Context) error {
+		_, err := wk.Sudo(ctx, sdk.MustAccAddressFromBech32(subscription.ContractAddress), eventsMsg.Bytes())
+		return err
+	})
+	errMsg := ""
+	if err != nil {
+		logger.Error(
+			"error delivering events",
+			"contract_address", subscription.ContractAddress,
+			"subscription_id", subscription.Id,
+			"error", err,
+		)
+		errMsg = err.Error()
+		sudoErr := types.NewSudoError(
+			sudoErrorCode(err),
+			subscription.ContractAddress,
+			eventsMsg.String(),
+			err.Error(),
+		)
+		if err := ek.SetError(ctx, sudoErr); err != nil {
+			panic(err)
+		}
+		// Same as for callbacks, the whole gas limit is charged on failure
+		gasUsed = subscription.GasLimit
+	}
+	scheduler.consume(gasUsed)
+
+	// Charge the transaction fees of the delivery from the prepaid balance
+	feesConsumed := k.CalculateTransactionFees(ctx, gasUsed)
+	if subscription.Balance.IsLT(feesConsumed) {
+		feesConsumed = subscription.Balance
+	}
+	if feesConsumed.IsPositive() {
+		if err := k.SendToFeeCollector(ctx, feesConsumed); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SetEventSubscriptionBalance(ctx, subscription, subscription.Balance.Sub(feesConsumed)); err != nil {
+		panic(err)
+	}
+
+	types.EmitEventsDeliveredEvent(
+		ctx,
+		subscription.Id,
+		subscription.ContractAddress,
+		uint64(len(events)),
+		gasUsed,
+		feesConsumed,
+		errMsg,
+	)
 }
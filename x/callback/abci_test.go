@@ -4,13 +4,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
+	"cosmossdk.io/x/feegrant"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 
 	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	callbackKeeper "github.com/archway-network/archway/x/callback/keeper"
 	"github.com/archway-network/archway/x/callback/types"
 	cwerrortypes "github.com/archway-network/archway/x/cwerrors/types"
 )
@@ -89,12 +94,23 @@ func TestEndBlocker(t *testing.T) {
 	}
 
 	// Ensure error is captured by the cwerrors module - the case is when job id = 2
-	sudoErrs, err := errorsKeeper.GetErrorsByContractAddress(chain.GetContext(), contractAddr)
+	sudoErrs, _, err := errorsKeeper.GetErrorsByContractAddress(chain.GetContext(), contractAddr, cwerrortypes.ErrorsFilter{}, nil)
 	require.NoError(t, err)
 	require.Len(t, sudoErrs, 1)
 	require.Equal(t, "SomeError: execute wasm contract failed", sudoErrs[0].ErrorMessage)
 	require.Equal(t, types.ModuleName, sudoErrs[0].ModuleName)
 	require.Equal(t, int32(types.ModuleErrors_ERR_CONTRACT_EXECUTION_FAILED), sudoErrs[0].ErrorCode)
+
+	// Ensure an execution receipt is kept for every callback, in the order they were executed
+	receipts, _, err := chain.GetApp().Keepers.CallbackKeeper.GetCallbackReceipts(chain.GetContext(), contractAddr, nil)
+	require.NoError(t, err)
+	require.Len(t, receipts, len(testCases))
+	for i, tc := range testCases {
+		require.Equal(t, contractAddr.String(), receipts[i].ContractAddress)
+		require.Equal(t, tc.jobId, receipts[i].JobId)
+		require.NotZero(t, receipts[i].GasUsed)
+		require.Equal(t, tc.jobId != ERROR_JOBID, receipts[i].Success)
+	}
 }
 
 func TestEndBlockerWithCallbackGasLimit(t *testing.T) {
@@ -142,18 +158,495 @@ func TestEndBlockerWithCallbackGasLimit(t *testing.T) {
 	count := getCount(t, chain, contractAddr)
 	require.Equal(t, initMsg.Count, count)
 
-	sudoErrs, err := errorsKeeper.GetErrorsByContractAddress(chain.GetContext(), contractAddr)
+	sudoErrs, _, err := errorsKeeper.GetErrorsByContractAddress(chain.GetContext(), contractAddr, cwerrortypes.ErrorsFilter{}, nil)
 	require.NoError(t, err)
 	require.Len(t, sudoErrs, 1)
 	require.Equal(t, cwerrortypes.ModuleName, sudoErrs[0].ModuleName) // because Sudo::Error entrypoint does not exist on the contract
 	require.Equal(t, int32(cwerrortypes.ModuleErrors_ERR_CALLBACK_EXECUTION_FAILED), sudoErrs[0].ErrorCode)
 }
 
+func TestEndBlockerWithRecurringCallback(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keeper := chain.GetApp().Keepers.CallbackKeeper
+	contractAdminAcc := chain.GetAccount(0)
+
+	// Upload and instantiate contract
+	// The test contract is based on the default counter contract and behaves the following way:
+	// When job_id = 1, it increments the count value
+	codeID := chain.UploadContract(contractAdminAcc, "../../contracts/callback-test/artifacts/callback_test.wasm", wasmdTypes.DefaultUploadAccess)
+	initMsg := CallbackContractInstantiateMsg{Count: 100}
+	contractAddr, _ := chain.InstantiateContract(contractAdminAcc, codeID, contractAdminAcc.Address.String(), "callback_test", nil, initMsg)
+
+	// Paying enough fees upfront for all three executions, with headroom for the changing price of gas
+	feesToPay, err := getCallbackRegistrationFees(chain)
+	require.NoError(t, err)
+	reqMsg := &types.MsgRequestCallback{
+		ContractAddress: contractAddr.String(),
+		JobId:           INCREMENT_JOBID,
+		CallbackHeight:  chain.GetContext().BlockHeight() + 2,
+		Sender:          contractAdminAcc.Address.String(),
+		Fees:            sdk.NewCoin(feesToPay.Denom, feesToPay.Amount.MulRaw(4)),
+		Interval:        1,
+		MaxExecutions:   3,
+	}
+	_, _, _, err = chain.SendMsgs(contractAdminAcc, true, []sdk.Msg{reqMsg})
+	require.NoError(t, err)
+
+	// Increment block height
+	chain.NextBlock(1)
+	chain.NextBlock(1)
+
+	// Checking the callback was executed and rescheduled after every block
+	require.Equal(t, initMsg.Count+2, getCount(t, chain, contractAddr))
+	callbacks, err := keeper.GetAllCallbacks(chain.GetContext())
+	require.NoError(t, err)
+	require.Len(t, callbacks, 1)
+	require.Equal(t, reqMsg.CallbackHeight+2, callbacks[0].CallbackHeight)
+	require.Equal(t, uint64(1), callbacks[0].RemainingExecutions)
+
+	chain.NextBlock(1)
+	require.Equal(t, initMsg.Count+3, getCount(t, chain, contractAddr))
+
+	// Checking the callback is not executed or rescheduled after the max executions
+	chain.NextBlock(1)
+	require.Equal(t, initMsg.Count+3, getCount(t, chain, contractAddr))
+	callbacks, err = keeper.GetAllCallbacks(chain.GetContext())
+	require.NoError(t, err)
+	require.Empty(t, callbacks)
+}
+
+func TestEndBlockerWithTimedCallback(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keeper := chain.GetApp().Keepers.CallbackKeeper
+	contractAdminAcc := chain.GetAccount(0)
+
+	// Upload and instantiate contract
+	// The test contract is based on the default counter contract and behaves the following way:
+	// When job_id = 1, it increments the count value
+	codeID := chain.UploadContract(contractAdminAcc, "../../contracts/callback-test/artifacts/callback_test.wasm", wasmdTypes.DefaultUploadAccess)
+	initMsg := CallbackContractInstantiateMsg{Count: 100}
+	contractAddr, _ := chain.InstantiateContract(contractAdminAcc, codeID, contractAdminAcc.Address.String(), "callback_test", nil, initMsg)
+
+	callbackTime := chain.GetBlockTime().Add(10 * time.Second)
+	futureResFee, blockResFee, txFee, err := keeper.EstimateTimedCallbackFees(chain.GetContext(), callbackTime, 0, 0)
+	require.NoError(t, err)
+	feesToPay := futureResFee.Add(blockResFee).Add(txFee).Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	reqMsg := &types.MsgRequestCallback{
+		ContractAddress: contractAddr.String(),
+		JobId:           INCREMENT_JOBID,
+		CallbackTime:    &callbackTime,
+		Sender:          contractAdminAcc.Address.String(),
+		Fees:            feesToPay,
+	}
+	_, _, _, err = chain.SendMsgs(contractAdminAcc, true, []sdk.Msg{reqMsg})
+	require.NoError(t, err)
+
+	// Checking the callback is not executed before the block time is reached
+	chain.NextBlock(5 * time.Second)
+	require.Equal(t, initMsg.Count, getCount(t, chain, contractAddr))
+
+	// Checking the callback is executed once the block time is reached and removed from state
+	chain.NextBlock(5 * time.Second)
+	require.Equal(t, initMsg.Count+1, getCount(t, chain, contractAddr))
+	callbacks, err := keeper.GetAllCallbacks(chain.GetContext())
+	require.NoError(t, err)
+	require.Empty(t, callbacks)
+}
+
+func TestEndBlockerWithTimedCallbacksOverBlockReservationLimit(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keeper := chain.GetApp().Keepers.CallbackKeeper
+	contractAdminAcc := chain.GetAccount(0)
+
+	// Upload and instantiate contract
+	// The test contract is based on the default counter contract and behaves the following way:
+	// When job_id = 1, it increments the count value
+	codeID := chain.UploadContract(contractAdminAcc, "../../contracts/callback-test/artifacts/callback_test.wasm", wasmdTypes.DefaultUploadAccess)
+	initMsg := CallbackContractInstantiateMsg{Count: 100}
+	contractAddr, _ := chain.InstantiateContract(contractAdminAcc, codeID, contractAdminAcc.Address.String(), "callback_test", nil, initMsg)
+
+	params, err := keeper.GetParams(chain.GetContext())
+	require.NoError(t, err)
+
+	// Registering one more callback than the block reservation limit, each in a different second of the same block
+	var msgs []sdk.Msg
+	for i := uint64(0); i <= params.MaxBlockReservationLimit; i++ {
+		callbackTime := chain.GetBlockTime().Add(time.Duration(i+1) * time.Second)
+		futureResFee, blockResFee, txFee, err := keeper.EstimateTimedCallbackFees(chain.GetContext(), callbackTime, 0, 0)
+		require.NoError(t, err)
+		msgs = append(msgs, &types.MsgRequestCallback{
+			ContractAddress: contractAddr.String(),
+			JobId:           INCREMENT_JOBID,
+			CallbackTime:    &callbackTime,
+			Sender:          contractAdminAcc.Address.String(),
+			Fees:            futureResFee.Add(blockResFee).Add(txFee).Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+		})
+	}
+	_, _, _, err = chain.SendMsgs(contractAdminAcc, true, msgs)
+	require.NoError(t, err)
+
+	// Checking only the callbacks within the block reservation limit are executed in the block
+	chain.NextBlock(time.Duration(params.MaxBlockReservationLimit+1) * time.Second)
+	require.Equal(t, initMsg.Count+int32(params.MaxBlockReservationLimit), getCount(t, chain, contractAddr))
+	callbacks, err := keeper.GetAllCallbacks(chain.GetContext())
+	require.NoError(t, err)
+	require.Len(t, callbacks, 1)
+
+	// Checking the remaining callback is executed in the next block
+	chain.NextBlock(1)
+	require.Equal(t, initMsg.Count+int32(params.MaxBlockReservationLimit)+1, getCount(t, chain, contractAddr))
+	callbacks, err = keeper.GetAllCallbacks(chain.GetContext())
+	require.NoError(t, err)
+	require.Empty(t, callbacks)
+}
+
+func TestEndBlockerWithBlockCallbackGasLimit(t *testing.T) {
+	// Only one callback with the default callback gas limit fits in a block
+	chain := e2eTesting.NewTestChain(t, 1,
+		e2eTesting.WithCallbackGasLimits(types.DefaultCallbackGasLimit, types.DefaultCallbackGasLimit),
+	)
+	keeper := chain.GetApp().Keepers.CallbackKeeper
+	queryServer := callbackKeeper.NewQueryServer(keeper)
+	contractAdminAcc := chain.GetAccount(0)
+
+	// Upload and instantiate contract
+	// The test contract is based on the default counter contract and behaves the following way:
+	// When job_id = 1, it increments the count value
+	// When job_id = 0, it decrements the count value
+	// For any other job_id, it does nothing
+	codeID := chain.UploadContract(contractAdminAcc, "../../contracts/callback-test/artifacts/callback_test.wasm", wasmdTypes.DefaultUploadAccess)
+	initMsg := CallbackContractInstantiateMsg{Count: 100}
+	contractAddr, _ := chain.InstantiateContract(contractAdminAcc, codeID, contractAdminAcc.Address.String(), "callback_test", nil, initMsg)
+
+	// Registering three callbacks at the same height
+	feesToPay, err := getCallbackRegistrationFees(chain)
+	require.NoError(t, err)
+	callbackHeight := chain.GetContext().BlockHeight() + 2
+	var msgs []sdk.Msg
+	for _, jobID := range []uint64{DONOTHING_JOBID, INCREMENT_JOBID, DECREMENT_JOBID} {
+		msgs = append(msgs, &types.MsgRequestCallback{
+			ContractAddress: contractAddr.String(),
+			JobId:           jobID,
+			CallbackHeight:  callbackHeight,
+			Sender:          contractAdminAcc.Address.String(),
+			Fees:            feesToPay,
+		})
+	}
+	_, _, _, err = chain.SendMsgs(contractAdminAcc, true, msgs)
+	require.NoError(t, err)
+
+	getDeferredJobIDs := func() (jobIDs []uint64) {
+		res, err := queryServer.DeferredCallbacks(chain.GetContext(), &types.QueryDeferredCallbacksRequest{})
+		require.NoError(t, err)
+		for _, callback := range res.Callbacks {
+			require.Equal(t, callbackHeight, callback.CallbackHeight)
+			jobIDs = append(jobIDs, callback.JobId)
+		}
+		return jobIDs
+	}
+
+	// Checking only the first callback in the key order is executed, and the rest are deferred
+	events := chain.NextBlock(1)
+	require.Equal(t, initMsg.Count-1, getCount(t, chain, contractAddr))
+	require.Equal(t, []uint64{INCREMENT_JOBID, DONOTHING_JOBID}, getDeferredJobIDs())
+	deferredEvents := 0
+	for _, event := range events {
+		if event.Type == "archway.callback.v1.CallbackDeferredEvent" {
+			deferredEvents++
+		}
+	}
+	require.Equal(t, 2, deferredEvents)
+
+	// Checking the deferred callbacks are executed in the following blocks, in order
+	chain.NextBlock(1)
+	require.Equal(t, initMsg.Count, getCount(t, chain, contractAddr))
+	require.Equal(t, []uint64{DONOTHING_JOBID}, getDeferredJobIDs())
+
+	chain.NextBlock(1)
+	require.Equal(t, initMsg.Count, getCount(t, chain, contractAddr))
+	require.Empty(t, getDeferredJobIDs())
+	callbacks, err := keeper.GetAllCallbacks(chain.GetContext())
+	require.NoError(t, err)
+	require.Empty(t, callbacks)
+}
+
+func TestEndBlockerWithEventSubscription(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keeper := chain.GetApp().Keepers.CallbackKeeper
+	errorsKeeper := chain.GetApp().Keepers.CWErrorsKeeper
+	contractAdminAcc := chain.GetAccount(0)
+
+	// Upload and instantiate contract
+	// The test contract does not implement the events sudo entrypoint, so every delivery fails
+	codeID := chain.UploadContract(contractAdminAcc, "../../contracts/callback-test/artifacts/callback_test.wasm", wasmdTypes.DefaultUploadAccess)
+	initMsg := CallbackContractInstantiateMsg{Count: 100}
+	contractAddr, _ := chain.InstantiateContract(contractAdminAcc, codeID, contractAdminAcc.Address.String(), "callback_test", nil, initMsg)
+
+	// Subscribing the contract to the bank transfers it receives, prepaying two deliveries
+	params, err := keeper.GetParams(chain.GetContext())
+	require.NoError(t, err)
+	deliveryFees := keeper.CalculateTransactionFees(chain.GetContext(), params.CallbackGasLimit)
+	prepaidFees := deliveryFees.AddAmount(deliveryFees.Amount)
+	subscribeMsg := types.NewMsgSubscribeToEvents(
+		contractAdminAcc.Address,
+		contractAddr,
+		banktypes.EventTypeTransfer,
+		[]types.EventAttribute{{Key: banktypes.AttributeKeyRecipient, Value: contractAddr.String()}},
+		0,
+		prepaidFees,
+	)
+	_, _, _, err = chain.SendMsgs(contractAdminAcc, true, []sdk.Msg{subscribeMsg})
+	require.NoError(t, err)
+
+	countDeliveries := func(events []abci.Event) (deliveries int) {
+		for _, event := range events {
+			if event.Type == "archway.callback.v1.EventsDeliveredEvent" {
+				deliveries++
+			}
+		}
+		return deliveries
+	}
+
+	// A transfer to another address is not delivered
+	sendMsg := banktypes.NewMsgSend(contractAdminAcc.Address, chain.GetAccount(0).Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	_, _, events, err := chain.SendMsgs(contractAdminAcc, true, []sdk.Msg{sendMsg})
+	require.NoError(t, err)
+	require.Equal(t, 0, countDeliveries(events))
+
+	// A transfer to the contract is delivered at the end of the block, and the failure is routed to x/cwerrors
+	sendMsg = banktypes.NewMsgSend(contractAdminAcc.Address, contractAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	_, _, events, err = chain.SendMsgs(contractAdminAcc, true, []sdk.Msg{sendMsg})
+	require.NoError(t, err)
+	require.Equal(t, 1, countDeliveries(events))
+
+	sudoErrs, _, err := errorsKeeper.GetErrorsByContractAddress(chain.GetContext(), contractAddr, cwerrortypes.ErrorsFilter{}, nil)
+	require.NoError(t, err)
+	require.Len(t, sudoErrs, 1)
+	require.Equal(t, types.ModuleName, sudoErrs[0].ModuleName)
+	require.Equal(t, int32(types.ModuleErrors_ERR_CONTRACT_EXECUTION_FAILED), sudoErrs[0].ErrorCode)
+
+	// The failed delivery is charged from the prepaid balance
+	subscription, err := keeper.GetEventSubscription(chain.GetContext(), 0)
+	require.NoError(t, err)
+	require.True(t, subscription.Balance.IsLT(prepaidFees))
+	require.True(t, subscription.Balance.IsPositive())
+
+	// No events are delivered in a block without matching events
+	require.Equal(t, 0, countDeliveries(chain.NextBlock(1)))
+}
+
+func TestEndBlockerWithEventDeliveryOverBlockCallbackGasLimit(t *testing.T) {
+	// The block callback gas limit only fits a single callback or delivery at the default gas limit
+	chain := e2eTesting.NewTestChain(t, 1,
+		e2eTesting.WithCallbackGasLimits(types.DefaultCallbackGasLimit, types.DefaultCallbackGasLimit),
+	)
+	keeper := chain.GetApp().Keepers.CallbackKeeper
+	contractAdminAcc := chain.GetAccount(0)
+
+	codeID := chain.UploadContract(contractAdminAcc, "../../contracts/callback-test/artifacts/callback_test.wasm", wasmdTypes.DefaultUploadAccess)
+	initMsg := CallbackContractInstantiateMsg{Count: 100}
+	contractAddr, _ := chain.InstantiateContract(contractAdminAcc, codeID, contractAdminAcc.Address.String(), "callback_test", nil, initMsg)
+
+	params, err := keeper.GetParams(chain.GetContext())
+	require.NoError(t, err)
+	deliveryFees := keeper.CalculateTransactionFees(chain.GetContext(), params.CallbackGasLimit)
+	prepaidFees := deliveryFees.AddAmount(deliveryFees.Amount)
+	subscribeMsg := types.NewMsgSubscribeToEvents(
+		contractAdminAcc.Address,
+		contractAddr,
+		banktypes.EventTypeTransfer,
+		[]types.EventAttribute{{Key: banktypes.AttributeKeyRecipient, Value: contractAddr.String()}},
+		0,
+		prepaidFees,
+	)
+	_, _, _, err = chain.SendMsgs(contractAdminAcc, true, []sdk.Msg{subscribeMsg})
+	require.NoError(t, err)
+
+	// Registering a callback executed in the same block as the transfer to the contract
+	feesToPay, err := getCallbackRegistrationFees(chain)
+	require.NoError(t, err)
+	reqMsg := &types.MsgRequestCallback{
+		ContractAddress: contractAddr.String(),
+		JobId:           DONOTHING_JOBID,
+		CallbackHeight:  chain.GetContext().BlockHeight() + 2,
+		Sender:          contractAdminAcc.Address.String(),
+		Fees:            feesToPay,
+	}
+	_, _, _, err = chain.SendMsgs(contractAdminAcc, true, []sdk.Msg{reqMsg})
+	require.NoError(t, err)
+
+	// The callback consumes the block callback gas, so the events are dropped without charging the subscription
+	sendMsg := banktypes.NewMsgSend(contractAdminAcc.Address, contractAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	_, _, events, err := chain.SendMsgs(contractAdminAcc, true, []sdk.Msg{sendMsg})
+	require.NoError(t, err)
+	callbacks, err := keeper.GetAllCallbacks(chain.GetContext())
+	require.NoError(t, err)
+	require.Empty(t, callbacks)
+
+	var deliveredEvents []abci.Event
+	for _, event := range events {
+		if event.Type == "archway.callback.v1.EventsDeliveredEvent" {
+			deliveredEvents = append(deliveredEvents, event)
+		}
+	}
+	require.Len(t, deliveredEvents, 1)
+	deliveryErr := ""
+	for _, attr := range deliveredEvents[0].Attributes {
+		if attr.Key == "error" {
+			deliveryErr = attr.Value
+		}
+	}
+	require.Contains(t, deliveryErr, types.ErrBlockCallbackGasLimitReached.Error())
+
+	subscription, err := keeper.GetEventSubscription(chain.GetContext(), 0)
+	require.NoError(t, err)
+	require.Equal(t, prepaidFees, subscription.Balance)
+}
+
+func TestEndBlockerWithFeePayer(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keeper := chain.GetApp().Keepers.CallbackKeeper
+	errorsKeeper := chain.GetApp().Keepers.CWErrorsKeeper
+	contractAdminAcc := chain.GetAccount(0)
+	feePayerAcc := chain.GetAccount(1)
+
+	// Upload and instantiate contract
+	// The test contract is based on the default counter contract and behaves the following way:
+	// When job_id = 1, it increments the count value
+	codeID := chain.UploadContract(contractAdminAcc, "../../contracts/callback-test/artifacts/callback_test.wasm", wasmdTypes.DefaultUploadAccess)
+	initMsg := CallbackContractInstantiateMsg{Count: 100}
+	contractAddr, _ := chain.InstantiateContract(contractAdminAcc, codeID, contractAdminAcc.Address.String(), "callback_test", nil, initMsg)
+
+	newRequestMsg := func() *types.MsgRequestCallback {
+		return &types.MsgRequestCallback{
+			ContractAddress: contractAddr.String(),
+			JobId:           INCREMENT_JOBID,
+			CallbackHeight:  chain.GetContext().BlockHeight() + 2,
+			Sender:          contractAdminAcc.Address.String(),
+			Fees:            sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+			FeePayer:        feePayerAcc.Address.String(),
+		}
+	}
+
+	// The fee payer has not granted an allowance to the sender yet
+	_, _, _, err := chain.SendMsgs(contractAdminAcc, false, []sdk.Msg{newRequestMsg()})
+	require.ErrorIs(t, err, types.ErrFeePayerNotAllowed)
+
+	grantMsg, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{}, feePayerAcc.Address, contractAdminAcc.Address)
+	require.NoError(t, err)
+	_, _, _, err = chain.SendMsgs(feePayerAcc, true, []sdk.Msg{grantMsg})
+	require.NoError(t, err)
+
+	// The fee payer is charged the reservation fees when the callback is registered
+	moduleBalance := chain.GetModuleBalance(types.ModuleName)
+	feePayerBalance := chain.GetBalance(feePayerAcc.Address)
+	msg := newRequestMsg()
+	_, _, _, err = chain.SendMsgs(contractAdminAcc, true, []sdk.Msg{msg})
+	require.NoError(t, err)
+	callback, err := keeper.GetCallback(chain.GetContext(), msg.CallbackHeight, msg.ContractAddress, msg.JobId)
+	require.NoError(t, err)
+	reservationFees := callback.FeeSplit.BlockReservationFees.Add(*callback.FeeSplit.FutureReservationFees)
+	require.True(t, reservationFees.IsPositive())
+	require.Equal(t, moduleBalance.Add(reservationFees), chain.GetModuleBalance(types.ModuleName))
+	require.Equal(t, feePayerBalance.Sub(reservationFees), chain.GetBalance(feePayerAcc.Address))
+
+	// The fee payer is charged the transaction fees when the callback is executed
+	feePayerBalance = chain.GetBalance(feePayerAcc.Address)
+	chain.NextBlock(1)
+	require.Equal(t, initMsg.Count+1, getCount(t, chain, contractAddr))
+	require.True(t, chain.GetBalance(feePayerAcc.Address).IsAllLT(feePayerBalance))
+	require.Equal(t, moduleBalance, chain.GetModuleBalance(types.ModuleName))
+
+	// Once the allowance is revoked, the callback is dropped without being executed
+	_, _, _, err = chain.SendMsgs(contractAdminAcc, true, []sdk.Msg{newRequestMsg()})
+	require.NoError(t, err)
+	revokeMsg := feegrant.NewMsgRevokeAllowance(feePayerAcc.Address, contractAdminAcc.Address)
+	_, _, _, err = chain.SendMsgs(feePayerAcc, true, []sdk.Msg{&revokeMsg})
+	require.NoError(t, err)
+
+	require.Equal(t, initMsg.Count+1, getCount(t, chain, contractAddr))
+	callbacks, err := keeper.GetAllCallbacks(chain.GetContext())
+	require.NoError(t, err)
+	require.Empty(t, callbacks)
+	require.Equal(t, moduleBalance, chain.GetModuleBalance(types.ModuleName))
+	// The gas consumed asking the fee payer is counted even though it did not pay
+	receipts, _, err := keeper.GetCallbackReceipts(chain.GetContext(), contractAddr, nil)
+	require.NoError(t, err)
+	require.False(t, receipts[len(receipts)-1].Success)
+	require.Positive(t, receipts[len(receipts)-1].GasUsed)
+	sudoErrs, _, err := errorsKeeper.GetErrorsByContractAddress(chain.GetContext(), contractAddr, cwerrortypes.ErrorsFilter{}, nil)
+	require.NoError(t, err)
+	require.Len(t, sudoErrs, 1)
+	require.Equal(t, int32(types.ModuleErrors_ERR_FEE_PAYER_REJECTED), sudoErrs[0].ErrorCode)
+}
+
+func TestEndBlockerWithRetryPolicy(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keeper := chain.GetApp().Keepers.CallbackKeeper
+	errorsKeeper := chain.GetApp().Keepers.CWErrorsKeeper
+	contractAdminAcc := chain.GetAccount(0)
+
+	// Upload and instantiate contract
+	// The test contract is based on the default counter contract and behaves the following way:
+	// When job_id = 2, it throws an error
+	codeID := chain.UploadContract(contractAdminAcc, "../../contracts/callback-test/artifacts/callback_test.wasm", wasmdTypes.DefaultUploadAccess)
+	initMsg := CallbackContractInstantiateMsg{Count: 100}
+	contractAddr, _ := chain.InstantiateContract(contractAdminAcc, codeID, contractAdminAcc.Address.String(), "callback_test", nil, initMsg)
+
+	// Paying enough fees upfront for all three attempts, with headroom for the changing price of gas
+	feesToPay, err := getCallbackRegistrationFees(chain)
+	require.NoError(t, err)
+	reqMsg := &types.MsgRequestCallback{
+		ContractAddress: contractAddr.String(),
+		JobId:           ERROR_JOBID,
+		CallbackHeight:  chain.GetContext().BlockHeight() + 2,
+		Sender:          contractAdminAcc.Address.String(),
+		Fees:            sdk.NewCoin(feesToPay.Denom, feesToPay.Amount.MulRaw(4)),
+		RetryPolicy:     &types.RetryPolicy{MaxAttempts: 3, BackoffBlocks: 2},
+	}
+	_, _, _, err = chain.SendMsgs(contractAdminAcc, true, []sdk.Msg{reqMsg})
+	require.NoError(t, err)
+
+	// Checking the failed callback is retried after the backoff and the error is not reported yet
+	chain.NextBlock(1)
+	callbacks, err := keeper.GetAllCallbacks(chain.GetContext())
+	require.NoError(t, err)
+	require.Len(t, callbacks, 1)
+	require.Equal(t, reqMsg.CallbackHeight+2, callbacks[0].CallbackHeight)
+	require.Equal(t, uint64(1), callbacks[0].FailedAttempts)
+	require.Equal(t, reqMsg.RetryPolicy, callbacks[0].RetryPolicy)
+	sudoErrs, _, err := errorsKeeper.GetErrorsByContractAddress(chain.GetContext(), contractAddr, cwerrortypes.ErrorsFilter{}, nil)
+	require.NoError(t, err)
+	require.Empty(t, sudoErrs)
+
+	chain.NextBlock(1)
+	chain.NextBlock(1)
+	callbacks, err = keeper.GetAllCallbacks(chain.GetContext())
+	require.NoError(t, err)
+	require.Len(t, callbacks, 1)
+	require.Equal(t, reqMsg.CallbackHeight+4, callbacks[0].CallbackHeight)
+	require.Equal(t, uint64(2), callbacks[0].FailedAttempts)
+
+	// Checking the final failure is reported once and the callback is removed
+	moduleBalance := chain.GetModuleBalance(types.ModuleName)
+	chain.NextBlock(1)
+	chain.NextBlock(1)
+	callbacks, err = keeper.GetAllCallbacks(chain.GetContext())
+	require.NoError(t, err)
+	require.Empty(t, callbacks)
+	require.True(t, chain.GetModuleBalance(types.ModuleName).IsAllLT(moduleBalance))
+	sudoErrs, _, err = errorsKeeper.GetErrorsByContractAddress(chain.GetContext(), contractAddr, cwerrortypes.ErrorsFilter{}, nil)
+	require.NoError(t, err)
+	require.Len(t, sudoErrs, 1)
+	require.Equal(t, types.ModuleName, sudoErrs[0].ModuleName)
+	require.Equal(t, int32(types.ModuleErrors_ERR_CONTRACT_EXECUTION_FAILED), sudoErrs[0].ErrorCode)
+}
+
 func getCallbackRegistrationFees(chain *e2eTesting.TestChain) (sdk.Coin, error) {
 	ctx := chain.GetContext()
 	currentBlockHeight := ctx.BlockHeight()
 	callbackHeight := currentBlockHeight + 2
-	futureResFee, blockResFee, txFee, err := chain.GetApp().Keepers.CallbackKeeper.EstimateCallbackFees(ctx, callbackHeight)
+	futureResFee, blockResFee, txFee, err := chain.GetApp().Keepers.CallbackKeeper.EstimateCallbackFees(ctx, callbackHeight, 0, 0)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
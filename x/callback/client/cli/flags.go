@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/archway-network/archway/pkg"
+	"github.com/archway-network/archway/x/callback/types"
+)
+
+const (
+	flagInterval        = "interval"
+	flagMaxExecutions   = "max-executions"
+	flagPayload         = "payload"
+	flagPayloadSize     = "payload-size"
+	flagCallbackTime    = "callback-time"
+	flagGasLimit        = "callback-gas-limit"
+	flagNewCallbackTime = "new-callback-time"
+	flagFeeAmount       = "fee-amount"
+	flagContractAddress = "contract-address"
+	flagAttribute       = "attribute"
+	flagFeePayer        = "fee-payer"
+	flagRefundAddress   = "refund-address"
+	flagMaxAttempts     = "max-attempts"
+	flagRetryBackoff    = "retry-backoff"
+)
+
+func addIntervalFlag(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagInterval, 0, "Number of blocks between executions of a recurring callback (value can not be higher than the MaxFutureReservationLimit module param)")
+}
+
+func addMaxExecutionsFlag(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagMaxExecutions, 0, "Total number of executions of a recurring callback (leave empty to repeat until cancelled)")
+}
+
+func addPayloadFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagPayload, "", "Data passed back to the contract when the callback is executed (size can not be higher than the MaxPayloadSize module param)")
+}
+
+func addPayloadSizeFlag(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagPayloadSize, 0, "Size in bytes of the payload attached to the callback")
+}
+
+func addCallbackTimeFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagCallbackTime, "", "Block time (RFC3339) at which the callback is executed instead of a block height (callback-height must be 0)")
+}
+
+func addGasLimitFlag(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagGasLimit, 0, "Max gas the callback can consume when executed (leave empty to use the CallbackGasLimit module param)")
+}
+
+func addNewCallbackTimeFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagNewCallbackTime, "", "Block time (RFC3339) the callback is moved to instead of a block height (new-callback-height must be 0)")
+}
+
+func addFeeAmountFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagFeeAmount, "", "Fees paid if the reservation fees at the new height or block time are higher (leave empty if none are due)")
+}
+
+func addContractAddressFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagContractAddress, "", "Contract address to filter the results by (bech32 encoded)")
+}
+
+func addAttributeFiltersFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(flagAttribute, nil, "Attribute (key=value, or key to match any value) the events need to have to be delivered (can be repeated)")
+}
+
+func addFeePayerFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagFeePayer, "", "Granting contract or allowance granter paying the callback fees instead of the sender (fee-amount must be 0)")
+}
+
+func addRefundAddressFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagRefundAddress, "", "Address the unused callback fees are refunded to (leave empty to refund the fee payer or the sender)")
+}
+
+func addRetryPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagMaxAttempts, 0, "Max number of attempts of a failing callback execution, the first one included (leave empty to not retry)")
+	cmd.Flags().Uint64(flagRetryBackoff, 1, "Number of blocks to wait before retrying a failed callback execution (value can not be higher than the MaxFutureReservationLimit module param)")
+}
+
+// getCallbackTimeFlag returns the parsed callback time flag value or nil if the flag is not set.
+func getCallbackTimeFlag(cmd *cobra.Command) (*time.Time, error) {
+	return getTimeFlag(cmd, flagCallbackTime)
+}
+
+// getNewCallbackTimeFlag returns the parsed new callback time flag value or nil if the flag is not set.
+func getNewCallbackTimeFlag(cmd *cobra.Command) (*time.Time, error) {
+	return getTimeFlag(cmd, flagNewCallbackTime)
+}
+
+// getFeeAmountFlag returns the parsed fee amount flag value or an empty coin if the flag is not set.
+func getFeeAmountFlag(cmd *cobra.Command) (sdk.Coin, error) {
+	v, err := cmd.Flags().GetString(flagFeeAmount)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("parsing %s flag: %w", flagFeeAmount, err)
+	}
+	if v == "" {
+		return sdk.Coin{}, nil
+	}
+
+	return pkg.ParseCoinArg(flagFeeAmount, v)
+}
+
+// getRetryPolicyFlags returns the parsed retry policy flag values or nil if no max attempts are set.
+func getRetryPolicyFlags(cmd *cobra.Command) (*types.RetryPolicy, error) {
+	maxAttempts, err := pkg.GetUint64Flag(cmd, flagMaxAttempts, true)
+	if err != nil {
+		return nil, err
+	}
+	if maxAttempts == 0 {
+		return nil, nil
+	}
+
+	backoffBlocks, err := pkg.GetUint64Flag(cmd, flagRetryBackoff, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RetryPolicy{MaxAttempts: maxAttempts, BackoffBlocks: backoffBlocks}, nil
+}
+
+// getAttributeFiltersFlag returns the parsed attribute filters flag values.
+func getAttributeFiltersFlag(cmd *cobra.Command) ([]types.EventAttribute, error) {
+	v, err := cmd.Flags().GetStringSlice(flagAttribute)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s flag: %w", flagAttribute, err)
+	}
+
+	filters := make([]types.EventAttribute, 0, len(v))
+	for _, attr := range v {
+		key, value, _ := strings.Cut(attr, "=")
+		if key == "" {
+			return nil, fmt.Errorf("parsing %s flag: empty attribute key", flagAttribute)
+		}
+		filters = append(filters, types.EventAttribute{Key: key, Value: value})
+	}
+
+	return filters, nil
+}
+
+// getOptionalAccAddressFlag returns the parsed address flag value or an empty address if the flag is not set.
+func getOptionalAccAddressFlag(cmd *cobra.Command, flagName string) (sdk.AccAddress, error) {
+	addr, err := pkg.ParseAccAddressFlag(cmd, flagName, false)
+	if err != nil || addr == nil {
+		return nil, err
+	}
+
+	return *addr, nil
+}
+
+func getTimeFlag(cmd *cobra.Command, flagName string) (*time.Time, error) {
+	v, err := cmd.Flags().GetString(flagName)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s flag: %w", flagName, err)
+	}
+	if v == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s flag: %w", flagName, err)
+	}
+
+	return &t, nil
+}
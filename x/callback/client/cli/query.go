@@ -22,6 +22,12 @@ func GetQueryCmd() *cobra.Command {
 		getQueryParamsCmd(),
 		getQueryEstimateCallbackFeesCmd(),
 		getQueryCallbacksCmd(),
+		getQueryDeferredCallbacksCmd(),
+		getQueryCallbacksByContractCmd(),
+		getQueryCallbacksByReserverCmd(),
+		getQueryCallbackReceiptsCmd(),
+		getQueryEventSubscriptionsCmd(),
+		getQueryBlockReservationPricesCmd(),
 	)
 	return cmd
 }
@@ -66,9 +72,27 @@ func getQueryEstimateCallbackFeesCmd() *cobra.Command {
 				return err
 			}
 
+			payloadSize, err := pkg.GetUint64Flag(cmd, flagPayloadSize, true)
+			if err != nil {
+				return err
+			}
+
+			callbackTime, err := getCallbackTimeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := pkg.GetUint64Flag(cmd, flagGasLimit, true)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.EstimateCallbackFees(cmd.Context(), &types.QueryEstimateCallbackFeesRequest{
-				BlockHeight: blockHeight,
+				BlockHeight:  blockHeight,
+				PayloadSize:  payloadSize,
+				CallbackTime: callbackTime,
+				GasLimit:     gasLimit,
 			})
 			if err != nil {
 				return err
@@ -78,6 +102,9 @@ func getQueryEstimateCallbackFeesCmd() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	addPayloadSizeFlag(cmd)
+	addCallbackTimeFlag(cmd)
+	addGasLimitFlag(cmd)
 	return cmd
 }
 
@@ -110,3 +137,247 @@ func getQueryCallbacksCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func getQueryDeferredCallbacksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deferred-callbacks",
+		Args:  cobra.NoArgs,
+		Short: "Query callbacks deferred to a later block as the block callback gas limit was reached with pagination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := pkg.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DeferredCallbacks(cmd.Context(), &types.QueryDeferredCallbacksRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deferred-callbacks")
+	return cmd
+}
+
+func getQueryCallbacksByContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "callbacks-by-contract [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query callbacks registered for a given contract address with pagination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := pkg.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CallbacksByContract(cmd.Context(), &types.QueryCallbacksByContractRequest{
+				ContractAddress: contractAddress.String(),
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "callbacks-by-contract")
+	return cmd
+}
+
+func getQueryCallbacksByReserverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "callbacks-by-reserver [reserver-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query callbacks reserved by a given address with pagination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reservedBy, err := pkg.ParseAccAddressArg("reserver-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := pkg.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CallbacksByReserver(cmd.Context(), &types.QueryCallbacksByReserverRequest{
+				ReservedBy: reservedBy.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "callbacks-by-reserver")
+	return cmd
+}
+
+func getQueryCallbackReceiptsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "callback-receipts",
+		Args:  cobra.NoArgs,
+		Short: "Query the execution receipts of the recently executed callbacks with pagination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddress, err := pkg.ParseAccAddressFlag(cmd, flagContractAddress, false)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := pkg.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCallbackReceiptsRequest{
+				Pagination: pageReq,
+			}
+			if contractAddress != nil {
+				req.ContractAddress = contractAddress.String()
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CallbackReceipts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "callback-receipts")
+	addContractAddressFlag(cmd)
+	return cmd
+}
+
+func getQueryEventSubscriptionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "event-subscriptions",
+		Args:  cobra.NoArgs,
+		Short: "Query the registered event subscriptions with pagination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddress, err := pkg.ParseAccAddressFlag(cmd, flagContractAddress, false)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := pkg.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryEventSubscriptionsRequest{
+				Pagination: pageReq,
+			}
+			if contractAddress != nil {
+				req.ContractAddress = contractAddress.String()
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EventSubscriptions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "event-subscriptions")
+	addContractAddressFlag(cmd)
+	return cmd
+}
+
+func getQueryBlockReservationPricesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-reservation-prices [start-height] [end-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query callback registration fees for every height of a range of future heights",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			startHeight, err := pkg.ParseInt64Arg("start-height", args[0])
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := pkg.ParseInt64Arg("end-height", args[1])
+			if err != nil {
+				return err
+			}
+
+			payloadSize, err := pkg.GetUint64Flag(cmd, flagPayloadSize, true)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := pkg.GetUint64Flag(cmd, flagGasLimit, true)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BlockReservationPrices(cmd.Context(), &types.QueryBlockReservationPricesRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				PayloadSize: payloadSize,
+				GasLimit:    gasLimit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPayloadSizeFlag(cmd)
+	addGasLimitFlag(cmd)
+	return cmd
+}
@@ -1,9 +1,13 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 
 	"github.com/archway-network/archway/pkg"
@@ -22,6 +26,11 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		getTxRequestCallbackCmd(),
 		getTxCancelCallbackCmd(),
+		getTxUpdateCallbackCmd(),
+		getTxRequestCallbacksCmd(),
+		getTxCancelCallbacksCmd(),
+		getTxSubscribeToEventsCmd(),
+		getTxUnsubscribeFromEventsCmd(),
 	)
 
 	return cmd
@@ -60,12 +69,60 @@ func getTxRequestCallbackCmd() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgRequestCallback(senderAddr, contractAddress, jobID, callbackHeight, fees)
+			interval, err := pkg.GetUint64Flag(cmd, flagInterval, true)
+			if err != nil {
+				return err
+			}
+
+			maxExecutions, err := pkg.GetUint64Flag(cmd, flagMaxExecutions, true)
+			if err != nil {
+				return err
+			}
+
+			payload, err := cmd.Flags().GetString(flagPayload)
+			if err != nil {
+				return err
+			}
+
+			callbackTime, err := getCallbackTimeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := pkg.GetUint64Flag(cmd, flagGasLimit, true)
+			if err != nil {
+				return err
+			}
+
+			feePayer, err := getOptionalAccAddressFlag(cmd, flagFeePayer)
+			if err != nil {
+				return err
+			}
+
+			refundAddress, err := getOptionalAccAddressFlag(cmd, flagRefundAddress)
+			if err != nil {
+				return err
+			}
+
+			retryPolicy, err := getRetryPolicyFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRequestCallback(senderAddr, contractAddress, jobID, callbackHeight, fees, interval, maxExecutions, []byte(payload), callbackTime, gasLimit, feePayer, refundAddress, retryPolicy)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addIntervalFlag(cmd)
+	addMaxExecutionsFlag(cmd)
+	addPayloadFlag(cmd)
+	addCallbackTimeFlag(cmd)
+	addGasLimitFlag(cmd)
+	addFeePayerFlag(cmd)
+	addRefundAddressFlag(cmd)
+	addRetryPolicyFlags(cmd)
 
 	return cmd
 }
@@ -98,12 +155,239 @@ func getTxCancelCallbackCmd() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgCancelCallback(senderAddr, contractAddress, jobID, callbackHeight)
+			callbackTime, err := getCallbackTimeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelCallback(senderAddr, contractAddress, jobID, callbackHeight, callbackTime)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addCallbackTimeFlag(cmd)
+
+	return cmd
+}
+
+func getTxUpdateCallbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-callback [contract-address] [job-id] [callback-height] [new-callback-height]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Move an existing callback given the contract address and its job ID at the specified height to a new height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			jobID, err := pkg.ParseUint64Arg("job-id", args[1])
+			if err != nil {
+				return err
+			}
+
+			callbackHeight, err := pkg.ParseInt64Arg("callback-height", args[2])
+			if err != nil {
+				return err
+			}
+
+			newCallbackHeight, err := pkg.ParseInt64Arg("new-callback-height", args[3])
+			if err != nil {
+				return err
+			}
+
+			callbackTime, err := getCallbackTimeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			newCallbackTime, err := getNewCallbackTimeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			fees, err := getFeeAmountFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateCallback(senderAddr, contractAddress, jobID, callbackHeight, callbackTime, newCallbackHeight, newCallbackTime, fees)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addCallbackTimeFlag(cmd)
+	addNewCallbackTimeFlag(cmd)
+	addFeeAmountFlag(cmd)
 
 	return cmd
 }
+
+func getTxSubscribeToEventsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe-to-events [contract-address] [event-type] [fee-amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Subscribe the given contract address to the events of the given type, prepaying the fees of the deliveries",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			eventType := args[1]
+
+			fees, err := pkg.ParseCoinArg("fee-amount", args[2])
+			if err != nil {
+				return err
+			}
+
+			attributeFilters, err := getAttributeFiltersFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := pkg.GetUint64Flag(cmd, flagGasLimit, true)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubscribeToEvents(senderAddr, contractAddress, eventType, attributeFilters, gasLimit, fees)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addAttributeFiltersFlag(cmd)
+	addGasLimitFlag(cmd)
+
+	return cmd
+}
+
+func getTxUnsubscribeFromEventsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unsubscribe-from-events [contract-address] [subscription-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove an event subscription of the given contract address and refund its prepaid fees left",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			subscriptionID, err := pkg.ParseUint64Arg("subscription-id", args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnsubscribeFromEvents(senderAddr, contractAddress, subscriptionID)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getTxRequestCallbacksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-callbacks [batch-json-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Request a batch of callbacks read from a JSON file and pay their fees in a single transfer",
+		Long: `Request a batch of callbacks read from a JSON file and pay their fees in a single transfer.
+The file contains the callbacks to register, for example:
+{
+  "callbacks": [
+    {"contract_address": "archway1...", "job_id": "1", "callback_height": "1000", "fees": {"denom": "aarch", "amount": "100"}},
+    {"contract_address": "archway1...", "job_id": "2", "callback_height": "1000", "fees": {"denom": "aarch", "amount": "120"}}
+  ]
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var msg types.MsgRequestCallbacks
+			if err := readBatchFile(clientCtx, args[0], &msg); err != nil {
+				return err
+			}
+			msg.Sender = clientCtx.GetFromAddress().String()
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getTxCancelCallbacksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-callbacks [batch-json-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a batch of existing callbacks read from a JSON file and get their fees refunded in a single transfer",
+		Long: `Cancel a batch of existing callbacks read from a JSON file and get their fees refunded in a single transfer.
+The file contains the callbacks to cancel, for example:
+{
+  "callbacks": [
+    {"contract_address": "archway1...", "job_id": "1", "callback_height": "1000"},
+    {"contract_address": "archway1...", "job_id": "2", "callback_time": "2024-01-01T00:00:00Z"}
+  ]
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var msg types.MsgCancelCallbacks
+			if err := readBatchFile(clientCtx, args[0], &msg); err != nil {
+				return err
+			}
+			msg.Sender = clientCtx.GetFromAddress().String()
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readBatchFile reads a batch message from the given JSON file
+func readBatchFile(clientCtx client.Context, path string, msg proto.Message) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading batch file: %w", err)
+	}
+	if err := clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+		return fmt.Errorf("parsing batch file: %w", err)
+	}
+	return nil
+}
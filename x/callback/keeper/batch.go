@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/callback/types"
+)
+
+// RequestCallback registers a callback requested by the sender and returns it along with its fee split.
+// The fees of the request are expected to cover the estimated fees, unless the callback is paid by a fee payer on execution
+func (k Keeper) RequestCallback(ctx sdk.Context, sender string, request types.CallbackRequest) (types.Callback, error) {
+	return k.requestCallback(ctx, newCallbackReservations(k), sender, request)
+}
+
+// RequestCallbacks registers a batch of callbacks requested by the sender and returns them along with their fee splits.
+// The callbacks registered earlier in the batch are counted when estimating the fees of the next ones, so the block
+// reservation fees increase progressively as the batch fills a height. If any of the callbacks can not be registered,
+// an error is returned and the whole batch is reverted along with the transaction
+func (k Keeper) RequestCallbacks(ctx sdk.Context, sender string, requests []types.CallbackRequest) ([]types.Callback, error) {
+	reservations := newCallbackReservations(k)
+	callbacks := make([]types.Callback, 0, len(requests))
+	for i, request := range requests {
+		callback, err := k.requestCallback(ctx, reservations, sender, request)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "callbacks[%d]", i)
+		}
+		callbacks = append(callbacks, callback)
+	}
+	return callbacks, nil
+}
+
+func (k Keeper) requestCallback(ctx sdk.Context, reservations *callbackReservations, sender string, request types.CallbackRequest) (types.Callback, error) {
+	// Get the expected fees which is to be paid
+	var futureReservationFee, blockReservationFee, transactionFee sdk.Coin
+	var err error
+	if request.CallbackTime != nil {
+		futureReservationFee, blockReservationFee, transactionFee, err = k.estimateTimedCallbackFees(ctx, reservations, *request.CallbackTime, uint64(len(request.Payload)), request.GasLimit)
+	} else {
+		futureReservationFee, blockReservationFee, transactionFee, err = k.estimateCallbackFees(ctx, reservations, request.CallbackHeight, uint64(len(request.Payload)), request.GasLimit)
+	}
+	if err != nil {
+		return types.Callback{}, err
+	}
+	expectedFees := transactionFee.Add(blockReservationFee).Add(futureReservationFee)
+
+	surplusFees := sdk.NewCoin(expectedFees.Denom, math.ZeroInt())
+	if request.FeePayer != "" {
+		// The reservation fees are charged to the fee payer once the callback is saved, the transaction fees when it is executed
+		if err := k.ValidateFeePayer(ctx, request.FeePayer, sender); err != nil {
+			return types.Callback{}, err
+		}
+	} else {
+		// If the fees sent for the callback is less than the expected fees, return error
+		if request.Fees.Denom != expectedFees.Denom || request.Fees.IsLT(expectedFees) {
+			return types.Callback{}, errorsmod.Wrapf(types.ErrInsufficientFees, "expected %s, got %s", expectedFees, request.Fees)
+		}
+		surplusFees = request.Fees.Sub(expectedFees) // Calculating any surplus user has sent
+	}
+
+	callback := types.NewCallback(
+		sender,
+		request.ContractAddress,
+		request.CallbackHeight,
+		request.JobId,
+		transactionFee,
+		blockReservationFee,
+		futureReservationFee,
+		surplusFees,
+	)
+	callback.Interval = request.Interval
+	callback.RemainingExecutions = request.MaxExecutions
+	callback.Payload = request.Payload
+	callback.CallbackTime = request.CallbackTime
+	callback.FeePayer = request.FeePayer
+	callback.RefundAddress = request.RefundAddress
+	callback.RetryPolicy = request.RetryPolicy
+	// The gas limit is resolved upfront, so the fee payer is asked for the callback as it is saved
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.Callback{}, err
+	}
+	callback.MaxGasLimit, err = callbackGasLimit(params, request.GasLimit)
+	if err != nil {
+		return types.Callback{}, err
+	}
+	if err := k.authorizeCallback(ctx, callback.ContractAddress, callback.ReservedBy); err != nil {
+		return types.Callback{}, err
+	}
+	if err := k.saveCallback(ctx, reservations, callback); err != nil {
+		return types.Callback{}, err
+	}
+	// The fee payer is only charged once the callback is known to be valid, a failed charge reverts the saved callback
+	// along with the transaction
+	if callback.IsPaidOnExecution() {
+		if err := k.ChargeFeePayer(ctx, callback, blockReservationFee.Add(futureReservationFee)); err != nil {
+			return types.Callback{}, err
+		}
+	}
+	return callback, nil
+}
+
+// callbackReservations counts the callbacks registered per block height and per second of block time.
+// The count of a height or a second is read from state the first time it is needed and is kept in memory after,
+// so registering a batch of callbacks does not walk the callbacks of the same height for every entry
+type callbackReservations struct {
+	k       Keeper
+	heights map[int64]int
+	seconds map[int64]int
+}
+
+func newCallbackReservations(k Keeper) *callbackReservations {
+	return &callbackReservations{
+		k:       k,
+		heights: make(map[int64]int),
+		seconds: make(map[int64]int),
+	}
+}
+
+// atHeight returns the number of callbacks registered at the given height
+func (r *callbackReservations) atHeight(ctx sdk.Context, height int64) (int, error) {
+	if count, ok := r.heights[height]; ok {
+		return count, nil
+	}
+	callbacks, err := r.k.GetCallbacksByHeight(ctx, height)
+	if err != nil {
+		return 0, err
+	}
+	r.heights[height] = len(callbacks)
+	return len(callbacks), nil
+}
+
+// atTime returns the number of callbacks registered within the same second as the given block time
+func (r *callbackReservations) atTime(ctx sdk.Context, callbackTime time.Time) (int, error) {
+	second := callbackTime.Truncate(time.Second).Unix()
+	if count, ok := r.seconds[second]; ok {
+		return count, nil
+	}
+	callbacks, err := r.k.GetTimedCallbacksBySecond(ctx, callbackTime)
+	if err != nil {
+		return 0, err
+	}
+	r.seconds[second] = len(callbacks)
+	return len(callbacks), nil
+}
+
+// add counts a newly registered callback. The count of its height or second is expected to have been read already
+func (r *callbackReservations) add(callback types.Callback) {
+	if callback.IsTimed() {
+		r.seconds[callback.CallbackTime.Truncate(time.Second).Unix()]++
+		return
+	}
+	r.heights[callback.CallbackHeight]++
+}
+
+// remove stops counting a registered callback, which is expected to be removed from state
+func (r *callbackReservations) remove(ctx sdk.Context, callback types.Callback) error {
+	if callback.IsTimed() {
+		if _, err := r.atTime(ctx, *callback.CallbackTime); err != nil {
+			return err
+		}
+		r.seconds[callback.CallbackTime.Truncate(time.Second).Unix()]--
+		return nil
+	}
+	if _, err := r.atHeight(ctx, callback.CallbackHeight); err != nil {
+		return err
+	}
+	r.heights[callback.CallbackHeight]--
+	return nil
+}
@@ -1,10 +1,19 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/archway-network/archway/x/callback/types"
 )
@@ -15,6 +24,13 @@ func (k Keeper) GetAllCallbacks(ctx sdk.Context) (callbacks []types.Callback, er
 		callbacks = append(callbacks, value)
 		return false, nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.TimedCallbacks.Walk(ctx, nil, func(key collections.Triple[time.Time, []byte, uint64], value types.Callback) (bool, error) {
+		callbacks = append(callbacks, value)
+		return false, nil
+	})
 	return callbacks, err
 }
 
@@ -37,6 +53,28 @@ func (k Keeper) IterateCallbacksByHeight(ctx sdk.Context, height int64, exec fun
 	})
 }
 
+// GetTimedCallbacksBySecond returns the callbacks registered within the same second as the given block time
+func (k Keeper) GetTimedCallbacksBySecond(ctx sdk.Context, callbackTime time.Time) (callbacks []*types.Callback, err error) {
+	start := callbackTime.Truncate(time.Second)
+	rng := new(collections.Range[collections.Triple[time.Time, []byte, uint64]]).
+		StartInclusive(collections.TriplePrefix[time.Time, []byte, uint64](start)).
+		EndExclusive(collections.TriplePrefix[time.Time, []byte, uint64](start.Add(time.Second)))
+	err = k.TimedCallbacks.Walk(ctx, rng, func(key collections.Triple[time.Time, []byte, uint64], value types.Callback) (bool, error) {
+		callbacks = append(callbacks, &value)
+		return false, nil
+	})
+	return callbacks, err
+}
+
+// IterateTimedCallbacksUntil iterates over callbacks registered for the given block time or before it and executes them.
+// The iteration stops once exec returns true
+func (k Keeper) IterateTimedCallbacksUntil(ctx sdk.Context, blockTime time.Time, exec func(types.Callback) bool) {
+	rng := collections.NewPrefixUntilTripleRange[time.Time, []byte, uint64](blockTime)
+	_ = k.TimedCallbacks.Walk(ctx, rng, func(key collections.Triple[time.Time, []byte, uint64], value types.Callback) (bool, error) {
+		return exec(value), nil
+	})
+}
+
 // ExistsCallback returns true if the callback exists for height with same contract address and same job id
 func (k Keeper) ExistsCallback(ctx sdk.Context, height int64, contractAddr string, jobID uint64) (bool, error) {
 	contractAddress, err := sdk.AccAddressFromBech32(contractAddr)
@@ -56,6 +94,237 @@ func (k Keeper) GetCallback(ctx sdk.Context, height int64, contractAddr string,
 	return k.Callbacks.Get(ctx, collections.Join3(height, contractAddress.Bytes(), jobID))
 }
 
+// GetTimedCallback returns the callback given the block time, contract address and job id
+func (k Keeper) GetTimedCallback(ctx sdk.Context, callbackTime time.Time, contractAddr string, jobID uint64) (types.Callback, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(contractAddr)
+	if err != nil {
+		return types.Callback{}, err
+	}
+
+	return k.TimedCallbacks.Get(ctx, collections.Join3(callbackTime, contractAddress.Bytes(), jobID))
+}
+
+// GetStoredCallback returns the callback stored for the height or block time, contract address and job id of the given callback
+func (k Keeper) GetStoredCallback(ctx sdk.Context, callback types.Callback) (types.Callback, error) {
+	if callback.IsTimed() {
+		return k.GetTimedCallback(ctx, *callback.CallbackTime, callback.ContractAddress, callback.JobId)
+	}
+	return k.GetCallback(ctx, callback.CallbackHeight, callback.ContractAddress, callback.JobId)
+}
+
+// DeferCallback queues the callback to be executed in a later block.
+// The callback stays stored at its height or block time, so it can still be cancelled while deferred
+func (k Keeper) DeferCallback(ctx sdk.Context, callback types.Callback) error {
+	seq, err := k.DeferredCallbackSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+	return k.DeferredCallbacks.Set(ctx, seq, callback)
+}
+
+// GetDeferredCallbacks returns the deferred callbacks paginated in the order they were deferred.
+// Callbacks which were cancelled after they were deferred are skipped
+func (k Keeper) GetDeferredCallbacks(ctx sdk.Context, pageReq *query.PageRequest) ([]*types.Callback, *query.PageResponse, error) {
+	return query.CollectionFilteredPaginate(
+		ctx,
+		k.DeferredCallbacks,
+		pageReq,
+		func(_ uint64, value types.Callback) (bool, error) {
+			_, err := k.GetStoredCallback(ctx, value)
+			if errors.Is(err, collections.ErrNotFound) {
+				return false, nil
+			}
+			return err == nil, err
+		},
+		func(_ uint64, value types.Callback) (*types.Callback, error) {
+			callback, err := k.GetStoredCallback(ctx, value)
+			return &callback, err
+		},
+	)
+}
+
+// NextDeferredCallback returns the first callback of the deferred queue along with its sequence, or false if the queue is empty.
+// Callbacks which were cancelled after they were deferred are removed from the queue on the way
+func (k Keeper) NextDeferredCallback(ctx sdk.Context) (uint64, types.Callback, bool, error) {
+	for {
+		seq, value, found, err := k.firstDeferredCallback(ctx)
+		if err != nil || !found {
+			return 0, types.Callback{}, false, err
+		}
+		callback, err := k.GetStoredCallback(ctx, value)
+		if err == nil {
+			return seq, callback, true, nil
+		}
+		if !errors.Is(err, collections.ErrNotFound) {
+			return 0, types.Callback{}, false, err
+		}
+		if err := k.DeferredCallbacks.Remove(ctx, seq); err != nil {
+			return 0, types.Callback{}, false, err
+		}
+	}
+}
+
+// RemoveDeferredCallback removes the callback with the given sequence from the deferred queue
+func (k Keeper) RemoveDeferredCallback(ctx sdk.Context, seq uint64) error {
+	return k.DeferredCallbacks.Remove(ctx, seq)
+}
+
+// firstDeferredCallback returns the first entry of the deferred queue. The iterator is closed before returning,
+// so the queue can be modified by the caller
+func (k Keeper) firstDeferredCallback(ctx sdk.Context) (uint64, types.Callback, bool, error) {
+	iter, err := k.DeferredCallbacks.Iterate(ctx, nil)
+	if err != nil {
+		return 0, types.Callback{}, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, types.Callback{}, false, nil
+	}
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return 0, types.Callback{}, false, err
+	}
+	return kv.Key, kv.Value, true, nil
+}
+
+// GetCallbacksByContract returns the callbacks registered for the given contract address paginated
+func (k Keeper) GetCallbacksByContract(ctx sdk.Context, contractAddr sdk.AccAddress, pageReq *query.PageRequest) ([]*types.Callback, *query.PageResponse, error) {
+	return k.getIndexedCallbacks(ctx, types.CallbacksByContractKeyPrefix, types.TimedCallbacksByContractKeyPrefix, contractAddr, pageReq)
+}
+
+// GetCallbacksByReserver returns the callbacks reserved by the given address paginated
+func (k Keeper) GetCallbacksByReserver(ctx sdk.Context, reservedBy sdk.AccAddress, pageReq *query.PageRequest) ([]*types.Callback, *query.PageResponse, error) {
+	return k.getIndexedCallbacks(ctx, types.CallbacksByReserverKeyPrefix, types.TimedCallbacksByReserverKeyPrefix, reservedBy, pageReq)
+}
+
+// IndexCallbacks rebuilds the contract address and reserver indexes of all the stored callbacks
+func (k Keeper) IndexCallbacks(ctx sdk.Context) error {
+	err := k.Callbacks.Walk(ctx, nil, func(key collections.Triple[int64, []byte, uint64], value types.Callback) (bool, error) {
+		return false, k.Callbacks.Set(ctx, key, value)
+	})
+	if err != nil {
+		return err
+	}
+	return k.TimedCallbacks.Walk(ctx, nil, func(key collections.Triple[time.Time, []byte, uint64], value types.Callback) (bool, error) {
+		return false, k.TimedCallbacks.Set(ctx, key, value)
+	})
+}
+
+// callbackIndexStore is the part of a callback index referencing the callbacks of an address
+type callbackIndexStore struct {
+	// marker is the first byte of the page keys continuing in the index
+	marker byte
+	store  prefix.Store
+	// get returns the callback referenced by a key of the store
+	get func(key []byte) (types.Callback, error)
+}
+
+// getIndexedCallbacks paginates over the callbacks referenced by the given address in the height callbacks index,
+// followed by the timed callbacks index. The first byte of the page key marks the index the page starts in
+func (k Keeper) getIndexedCallbacks(ctx sdk.Context, heightIndexPrefix, timedIndexPrefix collections.Prefix, addr sdk.AccAddress, pageReq *query.PageRequest) ([]*types.Callback, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	indexStores := []callbackIndexStore{
+		{
+			marker: 0,
+			store:  prefix.NewStore(ctx.KVStore(k.storeKey), append(heightIndexPrefix.Bytes(), address.MustLengthPrefix(addr)...)),
+			get: func(key []byte) (types.Callback, error) {
+				_, pk, err := k.Callbacks.KeyCodec().Decode(key)
+				if err != nil {
+					return types.Callback{}, err
+				}
+				return k.Callbacks.Get(ctx, pk)
+			},
+		},
+		{
+			marker: 1,
+			store:  prefix.NewStore(ctx.KVStore(k.storeKey), append(timedIndexPrefix.Bytes(), address.MustLengthPrefix(addr)...)),
+			get: func(key []byte) (types.Callback, error) {
+				_, pk, err := k.TimedCallbacks.KeyCodec().Decode(key)
+				if err != nil {
+					return types.Callback{}, err
+				}
+				return k.TimedCallbacks.Get(ctx, pk)
+			},
+		},
+	}
+	if pageReq.Reverse {
+		indexStores[0], indexStores[1] = indexStores[1], indexStores[0]
+	}
+
+	start, key := 0, []byte(nil)
+	if len(pageReq.Key) > 0 {
+		start = slices.IndexFunc(indexStores, func(s callbackIndexStore) bool { return s.marker == pageReq.Key[0] })
+		if start < 0 {
+			return nil, nil, fmt.Errorf("invalid request, unknown key")
+		}
+		key = pageReq.Key[1:]
+	}
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit, countTotal = query.DefaultLimit, true
+	}
+
+	var callbacks []*types.Callback
+	pageResp := &query.PageResponse{}
+	offset := pageReq.Offset
+	for i := start; i < len(indexStores) && pageResp.NextKey == nil; i++ {
+		indexStore := indexStores[i]
+		// Skipping the whole index if the offset goes past it
+		if offset > 0 {
+			if count := countKeys(indexStore.store); offset >= count {
+				offset -= count
+				continue
+			}
+		}
+		// The page is full, the next one starts at the beginning of the index if there is anything in it
+		remaining := limit - uint64(len(callbacks))
+		if remaining == 0 {
+			if countKeys(indexStore.store) > 0 {
+				pageResp.NextKey = []byte{indexStore.marker}
+			}
+			break
+		}
+
+		res, err := query.Paginate(indexStore.store, &query.PageRequest{Key: key, Offset: offset, Limit: remaining, Reverse: pageReq.Reverse}, func(key, _ []byte) error {
+			callback, err := indexStore.get(key)
+			if err != nil {
+				return err
+			}
+			callbacks = append(callbacks, &callback)
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if res.NextKey != nil {
+			pageResp.NextKey = append([]byte{indexStore.marker}, res.NextKey...)
+		}
+		key, offset = nil, 0
+	}
+	if countTotal && len(pageReq.Key) == 0 {
+		for _, indexStore := range indexStores {
+			pageResp.Total += countKeys(indexStore.store)
+		}
+	}
+	return callbacks, pageResp, nil
+}
+
+// countKeys returns the number of keys in the store
+func countKeys(store prefix.Store) (count uint64) {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
+
 // DeleteCallback deletes a callback given the height, contract address and job id
 func (k Keeper) DeleteCallback(ctx sdk.Context, sender string, callback types.Callback) error {
 	contractAddress, err := sdk.AccAddressFromBech32(callback.ContractAddress)
@@ -66,12 +335,38 @@ func (k Keeper) DeleteCallback(ctx sdk.Context, sender string, callback types.Ca
 	if !isAuthorizedToModify(ctx, k, contractAddress, sender) {
 		return types.ErrUnauthorized
 	}
-	return k.Callbacks.Remove(ctx, collections.Join3(callback.CallbackHeight, contractAddress.Bytes(), callback.JobId))
+	return k.RemoveCallback(ctx, callback)
+}
+
+// RemoveCallback removes a callback from state given its height or block time, contract address and job id.
+// Removing a callback which does not exist is a no-op
+func (k Keeper) RemoveCallback(ctx sdk.Context, callback types.Callback) error {
+	contractAddress, err := sdk.AccAddressFromBech32(callback.ContractAddress)
+	if err != nil {
+		return err
+	}
+	if callback.IsTimed() {
+		err = k.TimedCallbacks.Remove(ctx, collections.Join3(*callback.CallbackTime, contractAddress.Bytes(), callback.JobId))
+	} else {
+		err = k.Callbacks.Remove(ctx, collections.Join3(callback.CallbackHeight, contractAddress.Bytes(), callback.JobId))
+	}
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	return err
 }
 
 // SaveCallback saves a callback given the height, contract address and job id and callback data
 func (k Keeper) SaveCallback(ctx sdk.Context, callback types.Callback) error {
-	contractAddress, err := sdk.AccAddressFromBech32(callback.ContractAddress)
+	if err := k.authorizeCallback(ctx, callback.ContractAddress, callback.ReservedBy); err != nil {
+		return err
+	}
+	return k.saveCallback(ctx, newCallbackReservations(k), callback)
+}
+
+// authorizeCallback checks the contract exists and the sender is authorized to modify its callbacks
+func (k Keeper) authorizeCallback(ctx sdk.Context, contractAddr string, sender string) error {
+	contractAddress, err := sdk.AccAddressFromBech32(contractAddr)
 	if err != nil {
 		return err
 	}
@@ -80,9 +375,27 @@ func (k Keeper) SaveCallback(ctx sdk.Context, callback types.Callback) error {
 		return types.ErrContractNotFound
 	}
 	// If callback is requested by someone which is not authorized, return error
-	if !isAuthorizedToModify(ctx, k, contractAddress, callback.ReservedBy) {
+	if !isAuthorizedToModify(ctx, k, contractAddress, sender) {
 		return types.ErrUnauthorized
 	}
+	return nil
+}
+
+// saveCallback saves a callback, counting the callbacks already registered at its height or block time from the given reservations.
+// The sender is not authorized here: the callers authorize the requests, while the callbacks rescheduled by the module
+// are saved regardless of whether their reserver is still authorized
+func (k Keeper) saveCallback(ctx sdk.Context, reservations *callbackReservations, callback types.Callback) error {
+	contractAddress, err := sdk.AccAddressFromBech32(callback.ContractAddress)
+	if err != nil {
+		return err
+	}
+	// If contract with given address does not exist, return error
+	if !k.wasmKeeper.HasContractInfo(ctx, contractAddress) {
+		return types.ErrContractNotFound
+	}
+	if callback.IsTimed() {
+		return k.saveTimedCallback(ctx, reservations, contractAddress, callback)
+	}
 	// If a callback with same job id exists at same height, return error
 	exists, err := k.ExistsCallback(ctx, callback.CallbackHeight, contractAddress.String(), callback.JobId)
 	if err != nil {
@@ -105,22 +418,188 @@ func (k Keeper) SaveCallback(ctx sdk.Context, callback types.Callback) error {
 	if callback.CallbackHeight > maxFutureReservationHeight {
 		return types.ErrCallbackHeightTooFarInFuture
 	}
+	// If the payload attached to the callback is too large, return error
+	if uint64(len(callback.Payload)) > params.MaxPayloadSize {
+		return errorsmod.Wrapf(types.ErrPayloadTooLarge, "payload size %d exceeds the max payload size %d", len(callback.Payload), params.MaxPayloadSize)
+	}
+	// If a recurring callback would be rescheduled too far in the future, return error
+	if callback.Interval > params.MaxFutureReservationLimit {
+		return errorsmod.Wrapf(types.ErrInvalidRecurrence, "interval %d exceeds the max future reservation limit %d", callback.Interval, params.MaxFutureReservationLimit)
+	}
+	// If a failed callback would be retried too far in the future, return error
+	if err := validateRetryBackoff(params, callback); err != nil {
+		return err
+	}
 	// If there are already too many callbacks registered in a given block, return error
-	callbacksForBlock, err := k.GetCallbacksByHeight(ctx, callback.CallbackHeight)
+	callbacksForBlock, err := reservations.atHeight(ctx, callback.CallbackHeight)
 	if err != nil {
 		return err
 	}
-	if len(callbacksForBlock) >= int(params.MaxBlockReservationLimit) {
+	if callbacksForBlock >= int(params.MaxBlockReservationLimit) {
 		return types.ErrBlockFilled
 	}
 
-	// Setting the callback gas limit to the module param CallbackGasLimit.
+	// Setting the callback gas limit to the requested gas limit, or to the module param CallbackGasLimit if none was requested.
 	// This is to ensure that if the param value is decreased in the future, before the callback is executed,
 	// it does not fail with "out of gas" error. it wouldnt be fair for the contract to err out of the callback
 	// if it wouldnt have been expected to at the time of registration
-	callback.MaxGasLimit = params.CallbackGasLimit
+	callback.MaxGasLimit, err = callbackGasLimit(params, callback.MaxGasLimit)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Callbacks.Set(ctx, collections.Join3(callback.CallbackHeight, contractAddress.Bytes(), callback.JobId), callback); err != nil {
+		return err
+	}
+	reservations.add(callback)
+	return nil
+}
 
-	return k.Callbacks.Set(ctx, collections.Join3(callback.CallbackHeight, contractAddress.Bytes(), callback.JobId), callback)
+// saveTimedCallback saves a callback given the block time, contract address and job id and callback data
+func (k Keeper) saveTimedCallback(ctx sdk.Context, reservations *callbackReservations, contractAddress sdk.AccAddress, callback types.Callback) error {
+	callbackTime := *callback.CallbackTime
+	// If a callback with same job id exists at same block time, return error
+	exists, err := k.TimedCallbacks.Has(ctx, collections.Join3(callbackTime, contractAddress.Bytes(), callback.JobId))
+	if err != nil {
+		return err
+	}
+	if exists {
+		return types.ErrCallbackExists
+	}
+	// If callback is requested for block time in the past or present, return error
+	if !callbackTime.After(ctx.BlockTime()) {
+		return types.ErrCallbackTimeNotInFuture
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	// If callback is requested for block time which is too far in the future, return error
+	if callbackTime.After(ctx.BlockTime().Add(params.MaxFutureReservationTime)) {
+		return types.ErrCallbackTimeTooFarInFuture
+	}
+	// If the payload attached to the callback is too large, return error
+	if uint64(len(callback.Payload)) > params.MaxPayloadSize {
+		return errorsmod.Wrapf(types.ErrPayloadTooLarge, "payload size %d exceeds the max payload size %d", len(callback.Payload), params.MaxPayloadSize)
+	}
+	// Recurrence is counted in blocks, so it is not supported for callbacks executed at a block time
+	if callback.IsRecurring() {
+		return errorsmod.Wrap(types.ErrInvalidRecurrence, "callbacks executed at a block time can not be recurring")
+	}
+	// If a failed callback would be retried too far in the future, return error
+	if err := validateRetryBackoff(params, callback); err != nil {
+		return err
+	}
+	// If there are already too many callbacks registered within the same second, return error
+	callbacksForTime, err := reservations.atTime(ctx, callbackTime)
+	if err != nil {
+		return err
+	}
+	if callbacksForTime >= int(params.MaxBlockReservationLimit) {
+		return types.ErrBlockFilled
+	}
+
+	// Setting the callback gas limit. Same as for callbacks registered at a height
+	callback.MaxGasLimit, err = callbackGasLimit(params, callback.MaxGasLimit)
+	if err != nil {
+		return err
+	}
+
+	if err := k.TimedCallbacks.Set(ctx, collections.Join3(callbackTime, contractAddress.Bytes(), callback.JobId), callback); err != nil {
+		return err
+	}
+	reservations.add(callback)
+	return nil
+}
+
+// validateRetryBackoff checks the failed executions of the callback are not retried beyond the max future reservation limit
+func validateRetryBackoff(params types.Params, callback types.Callback) error {
+	if callback.RetryPolicy != nil && callback.RetryPolicy.BackoffBlocks > params.MaxFutureReservationLimit {
+		return errorsmod.Wrapf(types.ErrInvalidRetryPolicy, "backoff blocks %d exceeds the max future reservation limit %d", callback.RetryPolicy.BackoffBlocks, params.MaxFutureReservationLimit)
+	}
+	return nil
+}
+
+// RescheduleCallback saves the next execution of an executed recurring callback.
+// The fees for the next execution are charged from the surplus fees of the executed callback.
+func (k Keeper) RescheduleCallback(ctx sdk.Context, callback types.Callback) (types.Callback, error) {
+	// Rescheduling from the current height, as the callback might have been executed after its height if it was deferred
+	next, err := k.nextExecution(ctx, callback, ctx.BlockHeight()+int64(callback.Interval))
+	if err != nil {
+		return types.Callback{}, err
+	}
+	if callback.RemainingExecutions > 0 {
+		next.RemainingExecutions = callback.RemainingExecutions - 1
+	}
+	// The next execution was authorized when the callback was requested, so it is not authorized again
+	if err := k.saveCallback(ctx, newCallbackReservations(k), next); err != nil {
+		return types.Callback{}, err
+	}
+	return next, nil
+}
+
+// RetryCallback saves another attempt of a callback which failed to execute, after the backoff of its retry policy.
+// The fees for the retry are charged from the surplus fees of the failed callback.
+func (k Keeper) RetryCallback(ctx sdk.Context, callback types.Callback) (types.Callback, error) {
+	if !callback.CanRetry() {
+		return types.Callback{}, errorsmod.Wrapf(types.ErrInvalidRetryPolicy, "callback can not be retried after %d failed attempts", callback.Attempt())
+	}
+	// Retrying from the current height, as the callback might have been executed after its height if it was deferred.
+	// A callback executed at a block time is retried at a height too, as the backoff is counted in blocks
+	retry, err := k.nextExecution(ctx, callback, ctx.BlockHeight()+int64(callback.RetryPolicy.BackoffBlocks))
+	if err != nil {
+		return types.Callback{}, err
+	}
+	retry.RemainingExecutions = callback.RemainingExecutions
+	retry.FailedAttempts = callback.FailedAttempts + 1
+	// The retry was authorized when the callback was requested, so it is not authorized again
+	if err := k.saveCallback(ctx, newCallbackReservations(k), retry); err != nil {
+		return types.Callback{}, err
+	}
+	return retry, nil
+}
+
+// nextExecution returns a copy of the callback to be executed at the given height.
+// The fees of the execution are charged from the surplus fees of the callback, unless it is paid by a fee payer,
+// in which case the fee payer is charged the reservation fees now and the transaction fees when it is executed
+func (k Keeper) nextExecution(ctx sdk.Context, callback types.Callback, height int64) (types.Callback, error) {
+	futureReservationFee, blockReservationFee, transactionFee, err := k.EstimateCallbackFees(ctx, height, uint64(len(callback.Payload)), callback.MaxGasLimit)
+	if err != nil {
+		return types.Callback{}, err
+	}
+	expectedFees := transactionFee.Add(blockReservationFee).Add(futureReservationFee)
+
+	// If the surplus left is not enough to pay for the execution, return error
+	surplusFees := *callback.FeeSplit.SurplusFees
+	if callback.IsPaidOnExecution() {
+		expectedFees = sdk.NewCoin(surplusFees.Denom, math.ZeroInt())
+	} else if surplusFees.Denom != expectedFees.Denom || surplusFees.IsLT(expectedFees) {
+		return types.Callback{}, errorsmod.Wrapf(types.ErrInsufficientFees, "expected %s, got %s", expectedFees, surplusFees)
+	}
+
+	next := types.NewCallback(
+		callback.ReservedBy,
+		callback.ContractAddress,
+		height,
+		callback.JobId,
+		transactionFee,
+		blockReservationFee,
+		futureReservationFee,
+		surplusFees.Sub(expectedFees),
+	)
+	next.Interval = callback.Interval
+	next.Payload = callback.Payload
+	next.MaxGasLimit = callback.MaxGasLimit
+	next.FeePayer = callback.FeePayer
+	next.RefundAddress = callback.RefundAddress
+	next.RetryPolicy = callback.RetryPolicy
+	if next.IsPaidOnExecution() {
+		if err := k.ChargeFeePayer(ctx, next, blockReservationFee.Add(futureReservationFee)); err != nil {
+			return types.Callback{}, err
+		}
+	}
+	return next, nil
 }
 
 func isAuthorizedToModify(ctx sdk.Context, k Keeper, contractAddress sdk.AccAddress, sender string) bool {
@@ -3,8 +3,10 @@ package keeper_test
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
 	"github.com/archway-network/archway/pkg/testutils"
@@ -166,6 +168,24 @@ func (s *KeeperTestSuite) TestSaveCallback() {
 			expectError: true,
 			errorType:   types.ErrCallbackHeightTooFarInFuture,
 		},
+		{
+			testCase: "FAIL: callback payload is too large",
+			callback: types.Callback{
+				ContractAddress: contractAddr.String(),
+				JobId:           1,
+				CallbackHeight:  101,
+				ReservedBy:      contractAddr.String(),
+				Payload:         make([]byte, params.MaxPayloadSize+1),
+				FeeSplit: &types.CallbackFeesFeeSplit{
+					TransactionFees:       &validCoin,
+					BlockReservationFees:  &validCoin,
+					FutureReservationFees: &validCoin,
+					SurplusFees:           &validCoin,
+				},
+			},
+			expectError: true,
+			errorType:   types.ErrPayloadTooLarge,
+		},
 		{
 			testCase: "FAIL: sender is a blocked address",
 			callback: types.Callback{
@@ -602,3 +622,526 @@ func (s *KeeperTestSuite) TestIterateCallbacksByHeight() {
 		s.Assert().Equal(1, count)
 	})
 }
+
+func (s *KeeperTestSuite) TestRescheduleCallback() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext().WithBlockHeight(100), s.chain.GetApp().Keepers.CallbackKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	validCoin := sdk.NewInt64Coin("stake", 10)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := s.chain.GetAccount(0)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+
+	futureReservationFee, blockReservationFee, transactionFee, err := keeper.EstimateCallbackFees(ctx, ctx.BlockHeight()+5, 0, 0)
+	s.Require().NoError(err)
+	nextFees := futureReservationFee.Add(blockReservationFee).Add(transactionFee)
+
+	callback := types.Callback{
+		ContractAddress:     contractAddr.String(),
+		JobId:               1,
+		CallbackHeight:      ctx.BlockHeight(),
+		ReservedBy:          contractAddr.String(),
+		Interval:            5,
+		RemainingExecutions: 3,
+		FeeSplit: &types.CallbackFeesFeeSplit{
+			TransactionFees:       &validCoin,
+			BlockReservationFees:  &validCoin,
+			FutureReservationFees: &validCoin,
+			SurplusFees:           &validCoin,
+		},
+	}
+
+	s.Run("FAIL: surplus fees do not cover the next execution", func() {
+		_, err := keeper.RescheduleCallback(ctx, callback)
+		s.Assert().ErrorIs(err, types.ErrInsufficientFees)
+	})
+
+	s.Run("OK: callback is rescheduled after the interval", func() {
+		surplusFees := nextFees.AddAmount(validCoin.Amount)
+		callback.FeeSplit.SurplusFees = &surplusFees
+
+		next, err := keeper.RescheduleCallback(ctx, callback)
+		s.Require().NoError(err)
+		s.Assert().Equal(ctx.BlockHeight()+5, next.CallbackHeight)
+		s.Assert().Equal(uint64(5), next.Interval)
+		s.Assert().Equal(uint64(2), next.RemainingExecutions)
+		s.Assert().Equal(validCoin, *next.FeeSplit.SurplusFees)
+
+		saved, err := keeper.GetCallback(ctx, next.CallbackHeight, next.ContractAddress, next.JobId)
+		s.Require().NoError(err)
+		s.Assert().Equal(next.FeeSplit, saved.FeeSplit)
+	})
+
+	s.Run("OK: callback reserved by a former admin of the contract is rescheduled", func() {
+		surplusFees := nextFees.AddAmount(validCoin.Amount)
+		callback.FeeSplit.SurplusFees = &surplusFees
+		callback.JobId = 2
+		callback.ReservedBy = s.chain.GetAccount(1).Address.String()
+
+		next, err := keeper.RescheduleCallback(ctx, callback)
+		s.Require().NoError(err)
+		s.Assert().Equal(callback.ReservedBy, next.ReservedBy)
+
+		_, err = keeper.GetCallback(ctx, next.CallbackHeight, next.ContractAddress, next.JobId)
+		s.Require().NoError(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestRetryCallback() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext().WithBlockHeight(100), s.chain.GetApp().Keepers.CallbackKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	validCoin := sdk.NewInt64Coin("stake", 10)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := s.chain.GetAccount(0)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+
+	futureReservationFee, blockReservationFee, transactionFee, err := keeper.EstimateCallbackFees(ctx, ctx.BlockHeight()+2, 0, 0)
+	s.Require().NoError(err)
+	surplusFees := futureReservationFee.Add(blockReservationFee).Add(transactionFee).Add(validCoin)
+
+	// The callback was reserved by a former admin of the contract
+	callback := types.Callback{
+		ContractAddress: contractAddr.String(),
+		JobId:           1,
+		CallbackHeight:  ctx.BlockHeight(),
+		ReservedBy:      s.chain.GetAccount(1).Address.String(),
+		RetryPolicy:     &types.RetryPolicy{MaxAttempts: 2, BackoffBlocks: 2},
+		FeeSplit: &types.CallbackFeesFeeSplit{
+			TransactionFees:       &validCoin,
+			BlockReservationFees:  &validCoin,
+			FutureReservationFees: &validCoin,
+			SurplusFees:           &surplusFees,
+		},
+	}
+
+	s.Run("OK: callback reserved by a former admin of the contract is retried", func() {
+		retry, err := keeper.RetryCallback(ctx, callback)
+		s.Require().NoError(err)
+		s.Assert().Equal(ctx.BlockHeight()+2, retry.CallbackHeight)
+		s.Assert().Equal(uint64(1), retry.FailedAttempts)
+		s.Assert().Equal(callback.ReservedBy, retry.ReservedBy)
+
+		_, err = keeper.GetCallback(ctx, retry.CallbackHeight, retry.ContractAddress, retry.JobId)
+		s.Require().NoError(err)
+	})
+
+	s.Run("FAIL: no attempts left", func() {
+		callback.FailedAttempts = 1
+		_, err := keeper.RetryCallback(ctx, callback)
+		s.Assert().ErrorIs(err, types.ErrInvalidRetryPolicy)
+	})
+}
+
+func (s *KeeperTestSuite) TestSaveTimedCallback() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext().WithBlockHeight(100), s.chain.GetApp().Keepers.CallbackKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	validCoin := sdk.NewInt64Coin("stake", 10)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := s.chain.GetAccount(0)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.MaxBlockReservationLimit = 2
+	err = keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	newCallback := func(jobID uint64, callbackTime time.Time) types.Callback {
+		return types.Callback{
+			ContractAddress: contractAddr.String(),
+			JobId:           jobID,
+			CallbackTime:    &callbackTime,
+			ReservedBy:      contractAddr.String(),
+			FeeSplit: &types.CallbackFeesFeeSplit{
+				TransactionFees:       &validCoin,
+				BlockReservationFees:  &validCoin,
+				FutureReservationFees: &validCoin,
+				SurplusFees:           &validCoin,
+			},
+		}
+	}
+	callbackTime := ctx.BlockTime().Add(time.Minute)
+
+	testCases := []struct {
+		testCase    string
+		callback    types.Callback
+		expectError error
+	}{
+		{
+			testCase:    "FAIL: block time is the current block time",
+			callback:    newCallback(1, ctx.BlockTime()),
+			expectError: types.ErrCallbackTimeNotInFuture,
+		},
+		{
+			testCase:    "FAIL: block time is too far in the future",
+			callback:    newCallback(1, ctx.BlockTime().Add(params.MaxFutureReservationTime).Add(time.Second)),
+			expectError: types.ErrCallbackTimeTooFarInFuture,
+		},
+		{
+			testCase: "FAIL: callback is recurring",
+			callback: func() types.Callback {
+				callback := newCallback(1, callbackTime)
+				callback.Interval = 5
+				return callback
+			}(),
+			expectError: types.ErrInvalidRecurrence,
+		},
+		{
+			testCase:    "OK: save callback",
+			callback:    newCallback(1, callbackTime),
+			expectError: nil,
+		},
+		{
+			testCase:    "FAIL: callback with same job id exists at same block time",
+			callback:    newCallback(1, callbackTime),
+			expectError: types.ErrCallbackExists,
+		},
+		{
+			testCase:    "OK: save callback within the same second",
+			callback:    newCallback(2, callbackTime.Add(500*time.Millisecond)),
+			expectError: nil,
+		},
+		{
+			testCase:    "FAIL: second is filled with max reservation limit",
+			callback:    newCallback(3, callbackTime.Add(100*time.Millisecond)),
+			expectError: types.ErrBlockFilled,
+		},
+		{
+			testCase:    "OK: save callback in the next second",
+			callback:    newCallback(3, callbackTime.Add(time.Second)),
+			expectError: nil,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case: %s", tc.testCase), func() {
+			err := keeper.SaveCallback(ctx, tc.callback)
+			if tc.expectError != nil {
+				s.Assert().ErrorIs(err, tc.expectError)
+				return
+			}
+			s.Require().NoError(err)
+
+			callback, err := keeper.GetTimedCallback(ctx, *tc.callback.CallbackTime, tc.callback.ContractAddress, tc.callback.JobId)
+			s.Require().NoError(err)
+			s.Assert().Equal(int64(0), callback.CallbackHeight)
+			s.Assert().Equal(params.CallbackGasLimit, callback.MaxGasLimit)
+		})
+	}
+
+	s.Run("OK: callbacks are iterated until the block time", func() {
+		var jobIDs []uint64
+		keeper.IterateTimedCallbacksUntil(ctx, callbackTime.Add(500*time.Millisecond), func(callback types.Callback) bool {
+			jobIDs = append(jobIDs, callback.JobId)
+			return false
+		})
+		s.Assert().Equal([]uint64{1, 2}, jobIDs)
+	})
+
+	s.Run("OK: callbacks are returned with all callbacks", func() {
+		callbacks, err := keeper.GetAllCallbacks(ctx)
+		s.Require().NoError(err)
+		s.Assert().Len(callbacks, 3)
+	})
+}
+
+func (s *KeeperTestSuite) TestSaveCallbackGasLimit() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext().WithBlockHeight(100), s.chain.GetApp().Keepers.CallbackKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	validCoin := sdk.NewInt64Coin("stake", 10)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := s.chain.GetAccount(0)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+
+	newCallback := func(jobID uint64, gasLimit uint64) types.Callback {
+		return types.Callback{
+			ContractAddress: contractAddr.String(),
+			JobId:           jobID,
+			CallbackHeight:  101,
+			ReservedBy:      contractAddr.String(),
+			MaxGasLimit:     gasLimit,
+			FeeSplit: &types.CallbackFeesFeeSplit{
+				TransactionFees:       &validCoin,
+				BlockReservationFees:  &validCoin,
+				FutureReservationFees: &validCoin,
+				SurplusFees:           &validCoin,
+			},
+		}
+	}
+
+	testCases := []struct {
+		testCase         string
+		callback         types.Callback
+		expectError      error
+		expectedGasLimit uint64
+	}{
+		{
+			testCase:    "FAIL: gas limit is higher than the max callback gas limit",
+			callback:    newCallback(1, params.MaxCallbackGasLimit+1),
+			expectError: types.ErrGasLimitTooHigh,
+		},
+		{
+			testCase:         "OK: gas limit defaults to the callback gas limit",
+			callback:         newCallback(1, 0),
+			expectedGasLimit: params.CallbackGasLimit,
+		},
+		{
+			testCase:         "OK: requested gas limit is used",
+			callback:         newCallback(2, 100),
+			expectedGasLimit: 100,
+		},
+		{
+			testCase:         "OK: requested gas limit can be the max callback gas limit",
+			callback:         newCallback(3, params.MaxCallbackGasLimit),
+			expectedGasLimit: params.MaxCallbackGasLimit,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case: %s", tc.testCase), func() {
+			err := keeper.SaveCallback(ctx, tc.callback)
+			if tc.expectError != nil {
+				s.Assert().ErrorIs(err, tc.expectError)
+				return
+			}
+			s.Require().NoError(err)
+
+			callback, err := keeper.GetCallback(ctx, tc.callback.CallbackHeight, tc.callback.ContractAddress, tc.callback.JobId)
+			s.Require().NoError(err)
+			s.Assert().Equal(tc.expectedGasLimit, callback.MaxGasLimit)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestDeferredCallbacks() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext().WithBlockHeight(100), s.chain.GetApp().Keepers.CallbackKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	validCoin := sdk.NewInt64Coin("stake", 10)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := s.chain.GetAccount(0)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+
+	var callbacks []types.Callback
+	for _, jobID := range []uint64{3, 1, 2} {
+		callback := types.Callback{
+			ContractAddress: contractAddr.String(),
+			JobId:           jobID,
+			CallbackHeight:  101,
+			ReservedBy:      contractAddr.String(),
+			FeeSplit: &types.CallbackFeesFeeSplit{
+				TransactionFees:       &validCoin,
+				BlockReservationFees:  &validCoin,
+				FutureReservationFees: &validCoin,
+				SurplusFees:           &validCoin,
+			},
+		}
+		s.Require().NoError(keeper.SaveCallback(ctx, callback))
+		callbacks = append(callbacks, callback)
+	}
+
+	s.Run("OK: callbacks are returned in the order they were deferred", func() {
+		for _, callback := range callbacks {
+			s.Require().NoError(keeper.DeferCallback(ctx, callback))
+		}
+		deferred, _, err := keeper.GetDeferredCallbacks(ctx, nil)
+		s.Require().NoError(err)
+		s.Require().Len(deferred, 3)
+		s.Assert().Equal([]uint64{3, 1, 2}, []uint64{deferred[0].JobId, deferred[1].JobId, deferred[2].JobId})
+	})
+
+	s.Run("OK: deferred callbacks are paginated", func() {
+		deferred, pageResp, err := keeper.GetDeferredCallbacks(ctx, &query.PageRequest{Limit: 2})
+		s.Require().NoError(err)
+		s.Require().Len(deferred, 2)
+		s.Assert().Equal([]uint64{3, 1}, []uint64{deferred[0].JobId, deferred[1].JobId})
+		s.Require().NotNil(pageResp.NextKey)
+
+		deferred, pageResp, err = keeper.GetDeferredCallbacks(ctx, &query.PageRequest{Key: pageResp.NextKey, Limit: 2})
+		s.Require().NoError(err)
+		s.Require().Len(deferred, 1)
+		s.Assert().Equal(uint64(2), deferred[0].JobId)
+		s.Assert().Nil(pageResp.NextKey)
+	})
+
+	s.Run("OK: cancelled callbacks are skipped", func() {
+		s.Require().NoError(keeper.DeleteCallback(ctx, contractAddr.String(), callbacks[1]))
+		deferred, _, err := keeper.GetDeferredCallbacks(ctx, nil)
+		s.Require().NoError(err)
+		s.Require().Len(deferred, 2)
+		s.Assert().Equal([]uint64{3, 2}, []uint64{deferred[0].JobId, deferred[1].JobId})
+	})
+
+	s.Run("OK: callbacks are removed from the queue one at a time", func() {
+		seq, callback, found, err := keeper.NextDeferredCallback(ctx)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Assert().Equal(uint64(3), callback.JobId)
+		s.Require().NoError(keeper.RemoveDeferredCallback(ctx, seq))
+
+		// The cancelled callback is dropped from the queue on the way
+		seq, callback, found, err = keeper.NextDeferredCallback(ctx)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Assert().Equal(uint64(2), callback.JobId)
+		s.Require().NoError(keeper.RemoveDeferredCallback(ctx, seq))
+
+		_, _, found, err = keeper.NextDeferredCallback(ctx)
+		s.Require().NoError(err)
+		s.Assert().False(found)
+		deferred, _, err := keeper.GetDeferredCallbacks(ctx, nil)
+		s.Require().NoError(err)
+		s.Assert().Empty(deferred)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetCallbacksByContractAndReserver() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext().WithBlockHeight(100), s.chain.GetApp().Keepers.CallbackKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	validCoin := sdk.NewInt64Coin("stake", 10)
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	contractAdminAcc := s.chain.GetAccount(0)
+	for _, contractAddr := range contractAddrs {
+		contractViewer.AddContractAdmin(
+			contractAddr.String(),
+			contractAdminAcc.Address.String(),
+		)
+	}
+	callbackTime := ctx.BlockTime().Add(time.Minute)
+
+	newCallback := func(contractAddr sdk.AccAddress, reservedBy sdk.AccAddress, jobID uint64, timed bool) types.Callback {
+		callback := types.Callback{
+			ContractAddress: contractAddr.String(),
+			JobId:           jobID,
+			CallbackHeight:  101,
+			ReservedBy:      reservedBy.String(),
+			FeeSplit: &types.CallbackFeesFeeSplit{
+				TransactionFees:       &validCoin,
+				BlockReservationFees:  &validCoin,
+				FutureReservationFees: &validCoin,
+				SurplusFees:           &validCoin,
+			},
+		}
+		if timed {
+			callback.CallbackHeight = 0
+			callback.CallbackTime = &callbackTime
+		}
+		return callback
+	}
+
+	callbacks := []types.Callback{
+		newCallback(contractAddrs[0], contractAddrs[0], 1, false),
+		newCallback(contractAddrs[0], contractAdminAcc.Address, 2, false),
+		newCallback(contractAddrs[0], contractAddrs[0], 3, true),
+		newCallback(contractAddrs[1], contractAdminAcc.Address, 1, false),
+	}
+	for _, callback := range callbacks {
+		s.Require().NoError(keeper.SaveCallback(ctx, callback))
+	}
+
+	s.Run("OK: get callbacks by contract", func() {
+		res, _, err := keeper.GetCallbacksByContract(ctx, contractAddrs[0], nil)
+		s.Require().NoError(err)
+		s.Require().Len(res, 3)
+		for _, callback := range res {
+			s.Assert().Equal(contractAddrs[0].String(), callback.ContractAddress)
+		}
+
+		res, _, err = keeper.GetCallbacksByContract(ctx, contractAddrs[1], nil)
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(callbacks[3].ContractAddress, res[0].ContractAddress)
+		s.Assert().Equal(callbacks[3].JobId, res[0].JobId)
+	})
+
+	s.Run("OK: get callbacks by reserver", func() {
+		res, _, err := keeper.GetCallbacksByReserver(ctx, contractAdminAcc.Address, nil)
+		s.Require().NoError(err)
+		s.Require().Len(res, 2)
+		for _, callback := range res {
+			s.Assert().Equal(contractAdminAcc.Address.String(), callback.ReservedBy)
+		}
+	})
+
+	s.Run("OK: get callbacks by contract with pagination", func() {
+		res, pageResp, err := keeper.GetCallbacksByContract(ctx, contractAddrs[0], &query.PageRequest{Limit: 2, CountTotal: true})
+		s.Require().NoError(err)
+		s.Require().Len(res, 2)
+		s.Assert().EqualValues(3, pageResp.Total)
+		s.Require().NotNil(pageResp.NextKey)
+
+		res, pageResp, err = keeper.GetCallbacksByContract(ctx, contractAddrs[0], &query.PageRequest{Key: pageResp.NextKey})
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Nil(pageResp.NextKey)
+	})
+
+	s.Run("OK: get callbacks by contract with offset and reverse pagination", func() {
+		res, pageResp, err := keeper.GetCallbacksByContract(ctx, contractAddrs[0], &query.PageRequest{Offset: 1, Limit: 1})
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(callbacks[1].JobId, res[0].JobId)
+		s.Require().NotNil(pageResp.NextKey)
+
+		res, pageResp, err = keeper.GetCallbacksByContract(ctx, contractAddrs[0], &query.PageRequest{Offset: 2})
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(callbacks[2].JobId, res[0].JobId)
+		s.Assert().Nil(pageResp.NextKey)
+
+		// The timed callbacks come first in reverse order
+		res, _, err = keeper.GetCallbacksByContract(ctx, contractAddrs[0], &query.PageRequest{Reverse: true})
+		s.Require().NoError(err)
+		s.Require().Len(res, 3)
+		s.Assert().Equal([]uint64{3, 2, 1}, []uint64{res[0].JobId, res[1].JobId, res[2].JobId})
+	})
+
+	s.Run("OK: deleted callbacks are removed from the indexes", func() {
+		s.Require().NoError(keeper.DeleteCallback(ctx, contractAddrs[0].String(), callbacks[2]))
+		s.Require().NoError(keeper.DeleteCallback(ctx, contractAdminAcc.Address.String(), callbacks[1]))
+
+		res, _, err := keeper.GetCallbacksByContract(ctx, contractAddrs[0], nil)
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(callbacks[0].ContractAddress, res[0].ContractAddress)
+		s.Assert().Equal(callbacks[0].JobId, res[0].JobId)
+
+		res, _, err = keeper.GetCallbacksByReserver(ctx, contractAdminAcc.Address, nil)
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(callbacks[3].ContractAddress, res[0].ContractAddress)
+		s.Assert().Equal(callbacks[3].JobId, res[0].JobId)
+	})
+}
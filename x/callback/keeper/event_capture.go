@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+)
+
+// EventCaptureMsgServer wraps the msg service router, so the events emitted by the messages delivered in a block
+// which match an event subscription are captured to be delivered to the subscribed contracts at the end of the block
+type EventCaptureMsgServer struct {
+	gogogrpc.Server
+	keeper Keeper
+}
+
+// eventCaptureDepthKey is the context key of the number of messages being handled by the execution of a message,
+// as messages dispatched by contracts or by x/authz are routed through the same handlers as the top level messages
+// of a transaction. It is kept in the context, as blocks and simulations are executed concurrently
+type eventCaptureDepthKey struct{}
+
+// NewEventCaptureMsgServer creates a new EventCaptureMsgServer wrapping the given msg service router.
+func NewEventCaptureMsgServer(msgServer gogogrpc.Server, keeper Keeper) EventCaptureMsgServer {
+	return EventCaptureMsgServer{
+		Server: msgServer,
+		keeper: keeper,
+	}
+}
+
+// RegisterService implements gogogrpc.Server.
+func (s EventCaptureMsgServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	wrapped := *sd
+	wrapped.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler:    s.captureEvents(method.Handler),
+		}
+	}
+	s.Server.RegisterService(&wrapped, ss)
+}
+
+// captureEvents returns a method handler which captures the events emitted by a successful top level message of a transaction.
+// Events are captured in simulate mode as well, so the gas of the capture is included in the gas estimates.
+// The messages dispatched by contracts outside of a transaction, e.g. by the callbacks or the error callbacks executed
+// in the end blockers, are not captured, as the captured events are delivered by the x/callback end blocker
+func (s EventCaptureMsgServer) captureEvents(handler grpc.MethodHandler) grpc.MethodHandler {
+	return func(srv interface{}, c context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		ctx, ok := c.(sdk.Context)
+		if !ok || (ctx.ExecMode() != sdk.ExecModeFinalize && ctx.ExecMode() != sdk.ExecModeSimulate) || len(ctx.TxBytes()) == 0 {
+			return handler(srv, c, dec, interceptor)
+		}
+
+		depth, _ := ctx.Value(eventCaptureDepthKey{}).(int)
+		res, err := handler(srv, ctx.WithValue(eventCaptureDepthKey{}, depth+1), dec, interceptor)
+		// The events of nested messages are emitted again by the top level message, so they are only captured once
+		if err != nil || depth > 0 {
+			return res, err
+		}
+		if err := s.keeper.CaptureEvents(ctx, ctx.EventManager().Events()); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	callbackKeeper "github.com/archway-network/archway/x/callback/keeper"
+	"github.com/archway-network/archway/x/callback/types"
+)
+
+// mockMsgRouter records the method handlers registered by the services.
+type mockMsgRouter struct {
+	handlers map[string]grpc.MethodHandler
+}
+
+func (r *mockMsgRouter) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	for _, method := range sd.Methods {
+		r.handlers[method.MethodName] = method.Handler
+	}
+}
+
+func TestEventCaptureMsgServer(t *testing.T) {
+	// Setting up chain and contract in mock wasm keeper
+	keeper, ctx := testutils.CallbackKeeper(t)
+	wasmKeeper := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(wasmKeeper)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := testutils.AccAddress()
+	wasmKeeper.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.String(),
+	)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	deliveryFees := keeper.CalculateTransactionFees(ctx, params.CallbackGasLimit)
+	_, err = callbackKeeper.NewMsgServer(keeper).SubscribeToEvents(ctx, types.NewMsgSubscribeToEvents(contractAdminAcc, contractAddr, "transfer", nil, 0, deliveryFees))
+	require.NoError(t, err)
+
+	// The handler emits a transfer event, and runs the given function as if it dispatched a nested message
+	var onHandle func(ctx sdk.Context)
+	router := &mockMsgRouter{handlers: make(map[string]grpc.MethodHandler)}
+	callbackKeeper.NewEventCaptureMsgServer(router, keeper).RegisterService(&grpc.ServiceDesc{
+		ServiceName: "test.Msg",
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Transfer",
+			Handler: func(_ interface{}, c context.Context, _ func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				ctx := sdk.UnwrapSDKContext(c)
+				ctx.EventManager().EmitEvent(sdk.NewEvent("transfer", sdk.NewAttribute("recipient", contractAddr.String())))
+				if handle := onHandle; handle != nil {
+					onHandle = nil
+					handle(ctx)
+				}
+				return nil, nil
+			},
+		}},
+	}, nil)
+	handle := func(ctx sdk.Context) {
+		_, err := router.handlers["Transfer"](nil, ctx.WithEventManager(sdk.NewEventManager()), nil, nil)
+		require.NoError(t, err)
+	}
+	countCaptured := func() int {
+		return len(keeper.GetCapturedEvents(ctx, 0, 100))
+	}
+	txCtx := ctx.WithExecMode(sdk.ExecModeFinalize).WithTxBytes([]byte("tx"))
+
+	t.Run("OK: messages outside of a transaction are not captured", func(t *testing.T) {
+		handle(ctx.WithExecMode(sdk.ExecModeFinalize))
+		require.Equal(t, 0, countCaptured())
+	})
+
+	t.Run("OK: the events of a nested message are captured once", func(t *testing.T) {
+		onHandle = handle
+		handle(txCtx)
+		require.Equal(t, 1, countCaptured())
+	})
+
+	t.Run("OK: an execution running alongside another one is captured", func(t *testing.T) {
+		onHandle = func(sdk.Context) {
+			handle(txCtx.WithExecMode(sdk.ExecModeSimulate))
+		}
+		handle(txCtx)
+		require.Equal(t, 3, countCaptured())
+	})
+}
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/archway-network/archway/x/callback/types"
+)
+
+// GetEventSubscription returns the event subscription with the given id
+func (k Keeper) GetEventSubscription(ctx sdk.Context, subscriptionID uint64) (types.EventSubscription, error) {
+	subscription, err := k.EventSubscriptions.Get(ctx, subscriptionID)
+	if err != nil {
+		return types.EventSubscription{}, types.ErrEventSubscriptionNotFound
+	}
+	return subscription, nil
+}
+
+// GetEventSubscriptions returns the event subscriptions in the order they were registered.
+// If a contract address is given, only the subscriptions of the given contract are returned
+func (k Keeper) GetEventSubscriptions(ctx sdk.Context, contractAddr sdk.AccAddress, pageReq *query.PageRequest) ([]*types.EventSubscription, *query.PageResponse, error) {
+	if contractAddr.Empty() {
+		return query.CollectionPaginate(
+			ctx,
+			k.EventSubscriptions,
+			pageReq,
+			func(_ uint64, subscription types.EventSubscription) (*types.EventSubscription, error) {
+				return &subscription, nil
+			},
+		)
+	}
+	return query.CollectionPaginate(
+		ctx,
+		k.EventSubscriptionsByContract,
+		pageReq,
+		func(key collections.Pair[[]byte, uint64], _ collections.NoValue) (*types.EventSubscription, error) {
+			subscription, err := k.EventSubscriptions.Get(ctx, key.K2())
+			if err != nil {
+				return nil, err
+			}
+			return &subscription, nil
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, uint64](contractAddr.Bytes()),
+	)
+}
+
+// SaveEventSubscription registers a new event subscription and returns it with its assigned id and gas limit.
+// The prepaid balance of the subscription needs to cover the transaction fees of at least one delivery
+func (k Keeper) SaveEventSubscription(ctx sdk.Context, subscription types.EventSubscription) (types.EventSubscription, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(subscription.ContractAddress)
+	if err != nil {
+		return types.EventSubscription{}, err
+	}
+	// If contract with given address does not exist, return error
+	if !k.wasmKeeper.HasContractInfo(ctx, contractAddress) {
+		return types.EventSubscription{}, types.ErrContractNotFound
+	}
+	// If subscription is requested by someone which is not authorized, return error
+	if !isAuthorizedToModify(ctx, k, contractAddress, subscription.ReservedBy) {
+		return types.EventSubscription{}, types.ErrUnauthorized
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.EventSubscription{}, err
+	}
+	// If the contract already has too many subscriptions, return error
+	subscriptionsCount := uint64(0)
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](contractAddress.Bytes())
+	err = k.EventSubscriptionsByContract.Walk(ctx, rng, func(_ collections.Pair[[]byte, uint64]) (bool, error) {
+		subscriptionsCount++
+		return false, nil
+	})
+	if err != nil {
+		return types.EventSubscription{}, err
+	}
+	if subscriptionsCount >= params.MaxEventSubscriptionsPerContract {
+		return types.EventSubscription{}, errorsmod.Wrapf(types.ErrTooManyEventSubscriptions, "contract has %d event subscriptions", subscriptionsCount)
+	}
+	// If the event type already has too many subscriptions, return error. Every event of the type emitted by a message
+	// is matched against all its subscriptions at the expense of the transaction sender, so they are capped
+	subscriptionsCount = 0
+	typeRng := collections.NewPrefixedPairRange[string, uint64](subscription.EventType)
+	err = k.EventSubscriptionsByType.Walk(ctx, typeRng, func(_ collections.Pair[string, uint64]) (bool, error) {
+		subscriptionsCount++
+		return false, nil
+	})
+	if err != nil {
+		return types.EventSubscription{}, err
+	}
+	if subscriptionsCount >= params.MaxEventSubscriptionsPerType {
+		return types.EventSubscription{}, errorsmod.Wrapf(types.ErrTooManyEventSubscriptions, "event type %s has %d event subscriptions", subscription.EventType, subscriptionsCount)
+	}
+
+	// Setting the gas limit of the deliveries the same way it is done for callbacks
+	subscription.GasLimit, err = callbackGasLimit(params, subscription.GasLimit)
+	if err != nil {
+		return types.EventSubscription{}, err
+	}
+	// If the prepaid fees are not enough to pay for a single delivery, return error
+	deliveryFees := k.CalculateTransactionFees(ctx, subscription.GasLimit)
+	if subscription.Balance.Denom != deliveryFees.Denom || subscription.Balance.IsLT(deliveryFees) {
+		return types.EventSubscription{}, errorsmod.Wrapf(types.ErrInsufficientFees, "expected at least %s, got %s", deliveryFees, subscription.Balance)
+	}
+
+	subscription.Id, err = k.EventSubscriptionSequence.Next(ctx)
+	if err != nil {
+		return types.EventSubscription{}, err
+	}
+	if err := k.EventSubscriptionsByType.Set(ctx, collections.Join(subscription.EventType, subscription.Id)); err != nil {
+		return types.EventSubscription{}, err
+	}
+	if err := k.EventSubscriptionsByContract.Set(ctx, collections.Join(contractAddress.Bytes(), subscription.Id)); err != nil {
+		return types.EventSubscription{}, err
+	}
+	if err := k.EventSubscriptions.Set(ctx, subscription.Id, subscription); err != nil {
+		return types.EventSubscription{}, err
+	}
+	return subscription, nil
+}
+
+// DeleteEventSubscription deletes an event subscription given the sender is authorized to modify the contract subscriptions
+func (k Keeper) DeleteEventSubscription(ctx sdk.Context, sender string, subscription types.EventSubscription) error {
+	contractAddress, err := sdk.AccAddressFromBech32(subscription.ContractAddress)
+	if err != nil {
+		return err
+	}
+	// If the sender is not authorized to modify the subscription, return error
+	if !isAuthorizedToModify(ctx, k, contractAddress, sender) {
+		return types.ErrUnauthorized
+	}
+	return k.RemoveEventSubscription(ctx, subscription)
+}
+
+// RemoveEventSubscription removes an event subscription and its indexes from state
+func (k Keeper) RemoveEventSubscription(ctx sdk.Context, subscription types.EventSubscription) error {
+	contractAddress, err := sdk.AccAddressFromBech32(subscription.ContractAddress)
+	if err != nil {
+		return err
+	}
+	if err := k.EventSubscriptionsByType.Remove(ctx, collections.Join(subscription.EventType, subscription.Id)); err != nil {
+		return err
+	}
+	if err := k.EventSubscriptionsByContract.Remove(ctx, collections.Join(contractAddress.Bytes(), subscription.Id)); err != nil {
+		return err
+	}
+	return k.EventSubscriptions.Remove(ctx, subscription.Id)
+}
+
+// SetEventSubscriptionBalance updates the prepaid balance left on an event subscription
+func (k Keeper) SetEventSubscriptionBalance(ctx sdk.Context, subscription types.EventSubscription, balance sdk.Coin) error {
+	subscription.Balance = balance
+	return k.EventSubscriptions.Set(ctx, subscription.Id, subscription)
+}
+
+// CaptureEvents stores in the transient store the given events which match an event subscription,
+// so they can be delivered to the subscribed contracts at the end of the block
+func (k Keeper) CaptureEvents(ctx sdk.Context, events sdk.Events) error {
+	for _, event := range events {
+		var subscriptionIDs []uint64
+		rng := collections.NewPrefixedPairRange[string, uint64](event.Type)
+		err := k.EventSubscriptionsByType.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+			subscriptionIDs = append(subscriptionIDs, key.K2())
+			return false, nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, subscriptionID := range subscriptionIDs {
+			subscription, err := k.EventSubscriptions.Get(ctx, subscriptionID)
+			if err != nil {
+				return err
+			}
+			if subscription.MatchesEvent(event) {
+				k.setCapturedEvent(ctx, subscriptionID, types.NewContractEvent(event))
+			}
+		}
+	}
+	return nil
+}
+
+// setCapturedEvent stores a captured event for the given subscription in the transient store.
+// Events are keyed by a block-wide sequence so they are delivered in the order they were emitted
+func (k Keeper) setCapturedEvent(ctx sdk.Context, subscriptionID uint64, event types.ContractEvent) {
+	tStore := ctx.TransientStore(k.tStoreKey)
+	eventSeq := uint64(0)
+	if bz := tStore.Get(types.CapturedEventsCountKey); bz != nil {
+		eventSeq = binary.BigEndian.Uint64(bz)
+	}
+	tStore.Set(types.GetCapturedEventsStoreKey(subscriptionID, eventSeq), k.cdc.MustMarshal(&event))
+	tStore.Set(types.CapturedEventsCountKey, sdk.Uint64ToBigEndian(eventSeq+1))
+}
+
+// GetCapturedEvents returns the events captured in the block for the given subscription, up to the given limit
+func (k Keeper) GetCapturedEvents(ctx sdk.Context, subscriptionID uint64, limit uint64) (events []types.ContractEvent) {
+	tStore := ctx.TransientStore(k.tStoreKey)
+	itr := storetypes.KVStorePrefixIterator(tStore, types.GetCapturedEventsPrefix(subscriptionID))
+	defer itr.Close()
+	for ; itr.Valid() && uint64(len(events)) < limit; itr.Next() {
+		var event types.ContractEvent
+		k.cdc.MustUnmarshal(itr.Value(), &event)
+		events = append(events, event)
+	}
+	return events
+}
+
+// GetSubscriptionsWithCapturedEvents returns the ids of the subscriptions which have events captured in the block
+func (k Keeper) GetSubscriptionsWithCapturedEvents(ctx sdk.Context) (subscriptionIDs []uint64) {
+	tStore := ctx.TransientStore(k.tStoreKey)
+	itr := storetypes.KVStorePrefixIterator(tStore, types.CapturedEventsKey)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		key := itr.Key()[len(types.CapturedEventsKey):]
+		subscriptionID := binary.BigEndian.Uint64(key[:8])
+		if len(subscriptionIDs) == 0 || subscriptionIDs[len(subscriptionIDs)-1] != subscriptionID {
+			subscriptionIDs = append(subscriptionIDs, subscriptionID)
+		}
+	}
+	return subscriptionIDs
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	callbackKeeper "github.com/archway-network/archway/x/callback/keeper"
+	"github.com/archway-network/archway/x/callback/types"
+)
+
+func TestEventSubscriptions(t *testing.T) {
+	// Setting up chain and contract in mock wasm keeper
+	keeper, ctx := testutils.CallbackKeeper(t)
+	wasmKeeper := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(wasmKeeper)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := testutils.AccAddress()
+	wasmKeeper.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.String(),
+	)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxEventSubscriptionsPerContract = 2
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	msgServer := callbackKeeper.NewMsgServer(keeper)
+	deliveryFees := keeper.CalculateTransactionFees(ctx, params.CallbackGasLimit)
+	newSubscribeMsg := func(eventType string, fees sdk.Coin, filters ...types.EventAttribute) *types.MsgSubscribeToEvents {
+		return types.NewMsgSubscribeToEvents(contractAdminAcc, contractAddr, eventType, filters, 0, fees)
+	}
+
+	t.Run("FAIL: empty request", func(t *testing.T) {
+		_, err := msgServer.SubscribeToEvents(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("FAIL: contract does not exist", func(t *testing.T) {
+		msg := newSubscribeMsg("transfer", deliveryFees)
+		msg.ContractAddress = e2eTesting.GenContractAddresses(2)[1].String()
+		_, err := msgServer.SubscribeToEvents(ctx, msg)
+		require.ErrorIs(t, err, types.ErrContractNotFound)
+	})
+
+	t.Run("FAIL: sender is not authorized to subscribe", func(t *testing.T) {
+		msg := newSubscribeMsg("transfer", deliveryFees)
+		msg.Sender = testutils.AccAddress().String()
+		_, err := msgServer.SubscribeToEvents(ctx, msg)
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("FAIL: fees do not cover a delivery", func(t *testing.T) {
+		_, err := msgServer.SubscribeToEvents(ctx, newSubscribeMsg("transfer", deliveryFees.SubAmount(deliveryFees.Amount.QuoRaw(2))))
+		require.ErrorIs(t, err, types.ErrInsufficientFees)
+	})
+
+	t.Run("OK: subscribe to events", func(t *testing.T) {
+		res, err := msgServer.SubscribeToEvents(ctx, newSubscribeMsg("transfer", deliveryFees, types.EventAttribute{Key: "recipient", Value: contractAddr.String()}))
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), res.SubscriptionId)
+
+		res, err = msgServer.SubscribeToEvents(ctx, newSubscribeMsg("wasm-liquidation", deliveryFees.AddAmount(deliveryFees.Amount), types.EventAttribute{Key: "position"}))
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), res.SubscriptionId)
+
+		subscription, err := keeper.GetEventSubscription(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, contractAdminAcc.String(), subscription.ReservedBy)
+		require.Equal(t, params.CallbackGasLimit, subscription.GasLimit)
+		require.Equal(t, deliveryFees.AddAmount(deliveryFees.Amount), subscription.Balance)
+
+		subscriptions, _, err := keeper.GetEventSubscriptions(ctx, contractAddr, nil)
+		require.NoError(t, err)
+		require.Len(t, subscriptions, 2)
+	})
+
+	t.Run("FAIL: too many subscriptions for the contract", func(t *testing.T) {
+		_, err := msgServer.SubscribeToEvents(ctx, newSubscribeMsg("transfer", deliveryFees))
+		require.ErrorIs(t, err, types.ErrTooManyEventSubscriptions)
+	})
+
+	t.Run("FAIL: too many subscriptions for the event type", func(t *testing.T) {
+		typeParams := params
+		typeParams.MaxEventSubscriptionsPerContract = 3
+		typeParams.MaxEventSubscriptionsPerType = 1
+		require.NoError(t, keeper.SetParams(ctx, typeParams))
+		defer func() { require.NoError(t, keeper.SetParams(ctx, params)) }()
+
+		_, err := msgServer.SubscribeToEvents(ctx, newSubscribeMsg("transfer", deliveryFees))
+		require.ErrorIs(t, err, types.ErrTooManyEventSubscriptions)
+		require.ErrorContains(t, err, "event type transfer")
+	})
+
+	t.Run("OK: capture matching events", func(t *testing.T) {
+		events := sdk.Events{
+			sdk.NewEvent("transfer", sdk.NewAttribute("recipient", contractAddr.String()), sdk.NewAttribute("amount", "10stake")),
+			sdk.NewEvent("transfer", sdk.NewAttribute("recipient", testutils.AccAddress().String())),
+			sdk.NewEvent("wasm-liquidation", sdk.NewAttribute("position", "1")),
+			sdk.NewEvent("wasm-liquidation", sdk.NewAttribute("owner", "1")),
+			sdk.NewEvent("transfer", sdk.NewAttribute("recipient", contractAddr.String()), sdk.NewAttribute("amount", "20stake")),
+		}
+		require.NoError(t, keeper.CaptureEvents(ctx, events))
+
+		require.Equal(t, []uint64{0, 1}, keeper.GetSubscriptionsWithCapturedEvents(ctx))
+
+		captured := keeper.GetCapturedEvents(ctx, 0, params.MaxEventsPerDelivery)
+		require.Len(t, captured, 2)
+		require.Equal(t, types.NewContractEvent(events[0]), captured[0])
+		require.Equal(t, types.NewContractEvent(events[4]), captured[1])
+		require.Len(t, keeper.GetCapturedEvents(ctx, 0, 1), 1)
+
+		captured = keeper.GetCapturedEvents(ctx, 1, params.MaxEventsPerDelivery)
+		require.Len(t, captured, 1)
+		require.Equal(t, types.NewContractEvent(events[2]), captured[0])
+	})
+
+	t.Run("FAIL: unsubscribe a subscription of another contract", func(t *testing.T) {
+		msg := types.NewMsgUnsubscribeFromEvents(contractAdminAcc, e2eTesting.GenContractAddresses(2)[1], 0)
+		_, err := msgServer.UnsubscribeFromEvents(ctx, msg)
+		require.ErrorIs(t, err, types.ErrEventSubscriptionNotFound)
+	})
+
+	t.Run("FAIL: sender is not authorized to unsubscribe", func(t *testing.T) {
+		msg := types.NewMsgUnsubscribeFromEvents(testutils.AccAddress(), contractAddr, 0)
+		_, err := msgServer.UnsubscribeFromEvents(ctx, msg)
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("OK: unsubscribe refunds the balance", func(t *testing.T) {
+		res, err := msgServer.UnsubscribeFromEvents(ctx, types.NewMsgUnsubscribeFromEvents(contractAdminAcc, contractAddr, 0))
+		require.NoError(t, err)
+		require.Equal(t, deliveryFees, res.Refund)
+
+		_, err = keeper.GetEventSubscription(ctx, 0)
+		require.ErrorIs(t, err, types.ErrEventSubscriptionNotFound)
+		subscriptions, _, err := keeper.GetEventSubscriptions(ctx, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, subscriptions, 1)
+	})
+}
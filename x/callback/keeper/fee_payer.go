@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/callback/types"
+)
+
+// ValidateFeePayer checks the fee payer can pay the fees of the callbacks reserved by the sender.
+// The fee payer needs to be either a x/cwfees granting contract, or an account which granted a x/feegrant allowance to the sender
+func (k Keeper) ValidateFeePayer(ctx sdk.Context, feePayer string, sender string) error {
+	feePayerAddr, err := sdk.AccAddressFromBech32(feePayer)
+	if err != nil {
+		return err
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+
+	isGranter, err := k.cwFeesKeeper.IsGrantingContract(ctx, feePayerAddr)
+	if err != nil {
+		return err
+	}
+	if isGranter {
+		return nil
+	}
+	if allowance, err := k.feeGrantKeeper.GetAllowance(ctx, feePayerAddr, senderAddr); err == nil && allowance != nil {
+		return nil
+	}
+	return errorsmod.Wrapf(types.ErrFeePayerNotAllowed, "%s is not a granting contract and has not granted an allowance to %s", feePayer, sender)
+}
+
+// ChargeFeePayer transfers the given fees of a callback paid by a fee payer from the fee payer into the module account.
+// The reservation fees are charged when the callback is registered or rescheduled, and the transaction fees when it
+// is executed. A granting contract is asked to accept the grant the same way it is for the transaction fees it pays,
+// while an allowance granted to the address which reserved the callback is used otherwise. The fee payer is asked
+// even when the fees are zero, so a callback is never reserved without its consent. A granting contract covering
+// only a part of the fees does not pay them
+func (k Keeper) ChargeFeePayer(ctx sdk.Context, callback types.Callback, fees sdk.Coin) error {
+	feePayerAddr, err := sdk.AccAddressFromBech32(callback.FeePayer)
+	if err != nil {
+		return err
+	}
+	reservedByAddr, err := sdk.AccAddressFromBech32(callback.ReservedBy)
+	if err != nil {
+		return err
+	}
+
+	// The granter sees the callback as the request which registered it
+	msgs := []sdk.Msg{&types.MsgRequestCallback{
+		Sender:          callback.ReservedBy,
+		ContractAddress: callback.ContractAddress,
+		JobId:           callback.JobId,
+		CallbackHeight:  callback.CallbackHeight,
+		Interval:        callback.Interval,
+		MaxExecutions:   callback.RemainingExecutions,
+		Payload:         callback.Payload,
+		CallbackTime:    callback.CallbackTime,
+		GasLimit:        callback.MaxGasLimit,
+		FeePayer:        callback.FeePayer,
+		RefundAddress:   callback.RefundAddress,
+		RetryPolicy:     callback.RetryPolicy,
+	}}
+
+	isGranter, err := k.cwFeesKeeper.IsGrantingContract(ctx, feePayerAddr)
+	if err != nil {
+		return err
+	}
+	if isGranter {
+		var covered sdk.Coins
+		covered, err = k.cwFeesKeeper.RequestGrant(ctx, feePayerAddr, msgs, sdk.NewCoins(fees), []sdk.AccAddress{reservedByAddr})
+		// There is no signer to pay the rest, so the granter has to cover the fees in full
+		if err == nil && !covered.Equal(sdk.NewCoins(fees)) {
+			err = fmt.Errorf("covered only %s", covered)
+		}
+	} else {
+		err = k.feeGrantKeeper.UseGrantedFees(ctx, feePayerAddr, reservedByAddr, sdk.NewCoins(fees), msgs)
+	}
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrFeePayerNotAllowed, "%s did not pay the callback fees: %s", callback.FeePayer, err)
+	}
+	if !fees.IsPositive() {
+		return nil
+	}
+	return k.SendToCallbackModule(ctx, callback.FeePayer, fees)
+}
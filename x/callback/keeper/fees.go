@@ -1,18 +1,30 @@
 package keeper
 
 import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/archway-network/archway/x/callback/types"
 )
 
 // EstimateCallbackFees returns the fees that will be charged for registering a callback at the given block height
+// with a payload of the given size in bytes and the given gas limit. If the gas limit is 0, the CallbackGasLimit module param is used
 // The returned value is in the order of:
 // 1. Future reservation fees
 // 2. Block reservation fees
 // 3. Transaction fees
 // 4. Errors, if any
-func (k Keeper) EstimateCallbackFees(ctx sdk.Context, blockHeight int64) (sdk.Coin, sdk.Coin, sdk.Coin, error) {
+func (k Keeper) EstimateCallbackFees(ctx sdk.Context, blockHeight int64, payloadSize uint64, gasLimit uint64) (sdk.Coin, sdk.Coin, sdk.Coin, error) {
+	return k.estimateCallbackFees(ctx, newCallbackReservations(k), blockHeight, payloadSize, gasLimit)
+}
+
+// estimateCallbackFees returns the fees of a callback at the given block height, counting the callbacks already
+// registered at that height from the given reservations
+func (k Keeper) estimateCallbackFees(ctx sdk.Context, reservations *callbackReservations, blockHeight int64, payloadSize uint64, gasLimit uint64) (sdk.Coin, sdk.Coin, sdk.Coin, error) {
 	if blockHeight <= ctx.BlockHeight() {
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, status.Errorf(codes.InvalidArgument, "block height %d is not in the future", blockHeight)
 	}
@@ -22,6 +34,17 @@ func (k Keeper) EstimateCallbackFees(ctx sdk.Context, blockHeight int64) (sdk.Co
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, status.Errorf(codes.NotFound, "could not fetch the module params: %s", err.Error())
 	}
 
+	// If the payload is too large to be attached to the callback, return error
+	if payloadSize > params.MaxPayloadSize {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, status.Errorf(codes.InvalidArgument, "payload size %d exceeds the max payload size %d", payloadSize, params.MaxPayloadSize)
+	}
+
+	// If the requested gas limit is too high, return error
+	gasLimit, err = callbackGasLimit(params, gasLimit)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
 	// Calculates the fees based on how far in the future the callback is registered
 	futureReservationThreshold := ctx.BlockHeight() + int64(params.MaxFutureReservationLimit)
 	if blockHeight > futureReservationThreshold {
@@ -31,24 +54,146 @@ func (k Keeper) EstimateCallbackFees(ctx sdk.Context, blockHeight int64) (sdk.Co
 	futureReservationFeesAmount := params.FutureReservationFeeMultiplier.MulInt64((blockHeight - ctx.BlockHeight()))
 
 	// Calculates the fees based on how many callbacks are registered at the given block height
-	callbacksForHeight, err := k.GetCallbacksByHeight(ctx, blockHeight)
+	totalCallbacks, err := reservations.atHeight(ctx, blockHeight)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, status.Errorf(codes.NotFound, "could not fetch callbacks for given height: %s", err.Error())
 	}
-	totalCallbacks := len(callbacksForHeight)
 	if totalCallbacks >= int(params.MaxBlockReservationLimit) {
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, status.Errorf(codes.OutOfRange, "block height %d has reached max reservation limit", blockHeight)
 	}
-	// blockReservationFeeMultiplier * totalCallbacksRegistered
-	blockReservationFeesAmount := params.BlockReservationFeeMultiplier.MulInt64(int64(totalCallbacks))
+	// blockReservationFee(totalCallbacksRegistered) + payloadFeeMultiplier * payloadSize
+	blockReservationFeesAmount := params.BlockReservationFee(uint64(totalCallbacks)).
+		Add(params.PayloadFeeMultiplier.MulInt64(int64(payloadSize)))
+
+	// Calculates the fees based on the gas limit of the callback and current price of gas
+	transactionFee := k.CalculateTransactionFees(ctx, gasLimit)
+	futureReservationFee := sdk.NewCoin(transactionFee.Denom, futureReservationFeesAmount.RoundInt())
+	blockReservationFee := sdk.NewCoin(transactionFee.Denom, blockReservationFeesAmount.RoundInt())
+	return futureReservationFee, blockReservationFee, transactionFee, nil
+}
+
+// maxBlockReservationPricesRange is the maximum number of heights the block reservation prices can be returned for at once
+const maxBlockReservationPricesRange = 1000
+
+// GetBlockReservationPrices returns the fees of a callback with a payload of the given size in bytes and the given gas limit
+// for every height from the start height to the end height, both inclusive. The heights at which the max reservation limit
+// is reached are returned as filled, without fees
+func (k Keeper) GetBlockReservationPrices(ctx sdk.Context, startHeight int64, endHeight int64, payloadSize uint64, gasLimit uint64) ([]types.BlockReservationPrice, error) {
+	if startHeight > endHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "start height %d is after end height %d", startHeight, endHeight)
+	}
+	if endHeight-startHeight >= maxBlockReservationPricesRange {
+		return nil, status.Errorf(codes.InvalidArgument, "range of heights exceeds %d heights", maxBlockReservationPricesRange)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "could not fetch the module params: %s", err.Error())
+	}
+
+	reservations := newCallbackReservations(k)
+	prices := make([]types.BlockReservationPrice, 0, endHeight-startHeight+1)
+	for height := startHeight; height <= endHeight; height++ {
+		totalCallbacks, err := reservations.atHeight(ctx, height)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "could not fetch callbacks for given height: %s", err.Error())
+		}
+		price := types.BlockReservationPrice{
+			BlockHeight:       height,
+			ReservedCallbacks: uint64(totalCallbacks),
+			Filled:            totalCallbacks >= int(params.MaxBlockReservationLimit),
+		}
+		if !price.Filled {
+			futureReservationFee, blockReservationFee, transactionFee, err := k.estimateCallbackFees(ctx, reservations, height, payloadSize, gasLimit)
+			if err != nil {
+				return nil, err
+			}
+			totalFees := transactionFee.Add(blockReservationFee).Add(futureReservationFee)
+			price.FeeSplit = &types.CallbackFeesFeeSplit{
+				TransactionFees:       &transactionFee,
+				BlockReservationFees:  &blockReservationFee,
+				FutureReservationFees: &futureReservationFee,
+			}
+			price.TotalFees = &totalFees
+		}
+		prices = append(prices, price)
+	}
+	return prices, nil
+}
+
+// EstimateTimedCallbackFees returns the fees that will be charged for registering a callback at the given block time
+// with a payload of the given size in bytes and the given gas limit. If the gas limit is 0, the CallbackGasLimit module param is used
+// The returned value is in the order of:
+// 1. Future reservation fees
+// 2. Block reservation fees
+// 3. Transaction fees
+// 4. Errors, if any
+func (k Keeper) EstimateTimedCallbackFees(ctx sdk.Context, callbackTime time.Time, payloadSize uint64, gasLimit uint64) (sdk.Coin, sdk.Coin, sdk.Coin, error) {
+	return k.estimateTimedCallbackFees(ctx, newCallbackReservations(k), callbackTime, payloadSize, gasLimit)
+}
+
+// estimateTimedCallbackFees returns the fees of a callback at the given block time, counting the callbacks already
+// registered within the same second from the given reservations
+func (k Keeper) estimateTimedCallbackFees(ctx sdk.Context, reservations *callbackReservations, callbackTime time.Time, payloadSize uint64, gasLimit uint64) (sdk.Coin, sdk.Coin, sdk.Coin, error) {
+	if !callbackTime.After(ctx.BlockTime()) {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, status.Errorf(codes.InvalidArgument, "block time %s is not in the future", callbackTime)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, status.Errorf(codes.NotFound, "could not fetch the module params: %s", err.Error())
+	}
+
+	// If the payload is too large to be attached to the callback, return error
+	if payloadSize > params.MaxPayloadSize {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, status.Errorf(codes.InvalidArgument, "payload size %d exceeds the max payload size %d", payloadSize, params.MaxPayloadSize)
+	}
+
+	// If the requested gas limit is too high, return error
+	gasLimit, err = callbackGasLimit(params, gasLimit)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	// Calculates the fees based on how far in the future the callback is registered
+	futureReservationThreshold := ctx.BlockTime().Add(params.MaxFutureReservationTime)
+	if callbackTime.After(futureReservationThreshold) {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, status.Errorf(codes.OutOfRange, "block time %s is too far in the future. max block time callback can be registered at %s", callbackTime, futureReservationThreshold)
+	}
+	// futureReservationTimeFeeMultiplier * (requestBlockTime - currentBlockTime) in seconds
+	futureReservationFeesAmount := params.FutureReservationTimeFeeMultiplier.MulInt64(int64(callbackTime.Sub(ctx.BlockTime()).Seconds()))
+
+	// Calculates the fees based on how many callbacks are registered within the same second
+	totalCallbacks, err := reservations.atTime(ctx, callbackTime)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, status.Errorf(codes.NotFound, "could not fetch callbacks for given block time: %s", err.Error())
+	}
+	if totalCallbacks >= int(params.MaxBlockReservationLimit) {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, status.Errorf(codes.OutOfRange, "block time %s has reached max reservation limit", callbackTime)
+	}
+	// blockReservationFee(totalCallbacksRegistered) + payloadFeeMultiplier * payloadSize
+	blockReservationFeesAmount := params.BlockReservationFee(uint64(totalCallbacks)).
+		Add(params.PayloadFeeMultiplier.MulInt64(int64(payloadSize)))
 
-	// Calculates the fees based on the max gas limit of the callback and current price of gas
-	transactionFee := k.CalculateTransactionFees(ctx, params.GetCallbackGasLimit())
+	// Calculates the fees based on the gas limit of the callback and current price of gas
+	transactionFee := k.CalculateTransactionFees(ctx, gasLimit)
 	futureReservationFee := sdk.NewCoin(transactionFee.Denom, futureReservationFeesAmount.RoundInt())
 	blockReservationFee := sdk.NewCoin(transactionFee.Denom, blockReservationFeesAmount.RoundInt())
 	return futureReservationFee, blockReservationFee, transactionFee, nil
 }
 
+// callbackGasLimit returns the gas limit of a callback which requested the given gas limit.
+// If no gas limit is requested, the CallbackGasLimit module param is used
+func callbackGasLimit(params types.Params, gasLimit uint64) (uint64, error) {
+	if gasLimit == 0 {
+		return params.CallbackGasLimit, nil
+	}
+	if gasLimit > params.MaxCallbackGasLimit {
+		return 0, errorsmod.Wrapf(types.ErrGasLimitTooHigh, "gas limit %d exceeds the max callback gas limit %d", gasLimit, params.MaxCallbackGasLimit)
+	}
+	return gasLimit, nil
+}
+
 func (k Keeper) CalculateTransactionFees(ctx sdk.Context, gasAmount uint64) sdk.Coin {
 	computationPriceOfGas := k.rewardsKeeper.ComputationalPriceOfGas(ctx)
 	transactionFeeAmount := computationPriceOfGas.Amount.MulInt64(int64(gasAmount))
@@ -40,13 +40,133 @@ func (qs *QueryServer) Callbacks(c context.Context, request *types.QueryCallback
 	}, nil
 }
 
+// CallbacksByContract implements types.QueryServer.
+func (qs *QueryServer) CallbacksByContract(c context.Context, request *types.QueryCallbacksByContractRequest) (*types.QueryCallbacksByContractResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.GetContractAddress())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %v", err)
+	}
+
+	callbacks, pageResp, err := qs.keeper.GetCallbacksByContract(sdk.UnwrapSDKContext(c), contractAddr, request.GetPagination())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not fetch the callbacks for contract %s: %s", request.GetContractAddress(), err.Error())
+	}
+
+	return &types.QueryCallbacksByContractResponse{
+		Callbacks:  callbacks,
+		Pagination: pageResp,
+	}, nil
+}
+
+// CallbacksByReserver implements types.QueryServer.
+func (qs *QueryServer) CallbacksByReserver(c context.Context, request *types.QueryCallbacksByReserverRequest) (*types.QueryCallbacksByReserverResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	reservedBy, err := sdk.AccAddressFromBech32(request.GetReservedBy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reserver address: %v", err)
+	}
+
+	callbacks, pageResp, err := qs.keeper.GetCallbacksByReserver(sdk.UnwrapSDKContext(c), reservedBy, request.GetPagination())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not fetch the callbacks reserved by %s: %s", request.GetReservedBy(), err.Error())
+	}
+
+	return &types.QueryCallbacksByReserverResponse{
+		Callbacks:  callbacks,
+		Pagination: pageResp,
+	}, nil
+}
+
+// CallbackReceipts implements types.QueryServer.
+func (qs *QueryServer) CallbackReceipts(c context.Context, request *types.QueryCallbackReceiptsRequest) (*types.QueryCallbackReceiptsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var contractAddr sdk.AccAddress
+	if request.GetContractAddress() != "" {
+		var err error
+		contractAddr, err = sdk.AccAddressFromBech32(request.GetContractAddress())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %v", err)
+		}
+	}
+
+	receipts, pageResp, err := qs.keeper.GetCallbackReceipts(sdk.UnwrapSDKContext(c), contractAddr, request.GetPagination())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not fetch the callback receipts: %s", err.Error())
+	}
+
+	return &types.QueryCallbackReceiptsResponse{
+		Receipts:   receipts,
+		Pagination: pageResp,
+	}, nil
+}
+
+// DeferredCallbacks implements types.QueryServer.
+func (qs *QueryServer) DeferredCallbacks(c context.Context, request *types.QueryDeferredCallbacksRequest) (*types.QueryDeferredCallbacksResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	callbacks, pageResp, err := qs.keeper.GetDeferredCallbacks(sdk.UnwrapSDKContext(c), request.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not fetch the deferred callbacks: %s", err.Error())
+	}
+
+	return &types.QueryDeferredCallbacksResponse{
+		Callbacks:  callbacks,
+		Pagination: pageResp,
+	}, nil
+}
+
+// EventSubscriptions implements types.QueryServer.
+func (qs *QueryServer) EventSubscriptions(c context.Context, request *types.QueryEventSubscriptionsRequest) (*types.QueryEventSubscriptionsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var contractAddr sdk.AccAddress
+	if request.GetContractAddress() != "" {
+		var err error
+		contractAddr, err = sdk.AccAddressFromBech32(request.GetContractAddress())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %v", err)
+		}
+	}
+
+	subscriptions, pageResp, err := qs.keeper.GetEventSubscriptions(sdk.UnwrapSDKContext(c), contractAddr, request.GetPagination())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not fetch the event subscriptions: %s", err.Error())
+	}
+
+	return &types.QueryEventSubscriptionsResponse{
+		Subscriptions: subscriptions,
+		Pagination:    pageResp,
+	}, nil
+}
+
 // EstimateCallbackFees implements types.QueryServer.
 func (qs *QueryServer) EstimateCallbackFees(c context.Context, request *types.QueryEstimateCallbackFeesRequest) (*types.QueryEstimateCallbackFeesResponse, error) {
 	if request == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	futureReservationFee, blockReservationFee, transactionFee, err := qs.keeper.EstimateCallbackFees(sdk.UnwrapSDKContext(c), request.GetBlockHeight())
+	ctx := sdk.UnwrapSDKContext(c)
+	var futureReservationFee, blockReservationFee, transactionFee sdk.Coin
+	var err error
+	if request.GetCallbackTime() != nil {
+		futureReservationFee, blockReservationFee, transactionFee, err = qs.keeper.EstimateTimedCallbackFees(ctx, *request.GetCallbackTime(), request.GetPayloadSize(), request.GetGasLimit())
+	} else {
+		futureReservationFee, blockReservationFee, transactionFee, err = qs.keeper.EstimateCallbackFees(ctx, request.GetBlockHeight(), request.GetPayloadSize(), request.GetGasLimit())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +182,22 @@ func (qs *QueryServer) EstimateCallbackFees(c context.Context, request *types.Qu
 	}, nil
 }
 
+// BlockReservationPrices implements types.QueryServer.
+func (qs *QueryServer) BlockReservationPrices(c context.Context, request *types.QueryBlockReservationPricesRequest) (*types.QueryBlockReservationPricesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	prices, err := qs.keeper.GetBlockReservationPrices(sdk.UnwrapSDKContext(c), request.GetStartHeight(), request.GetEndHeight(), request.GetPayloadSize(), request.GetGasLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlockReservationPricesResponse{
+		Prices: prices,
+	}, nil
+}
+
 // Params implements types.QueryServer.
 func (qs *QueryServer) Params(c context.Context, request *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if request == nil {
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -113,6 +114,68 @@ func (s *KeeperTestSuite) TestCallbacks() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCallbacksByContractAndReserver() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext().WithBlockHeight(101), s.chain.GetApp().Keepers.CallbackKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	validCoin := sdk.NewInt64Coin("stake", 10)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := s.chain.GetAccount(0)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+	callback := types.Callback{
+		ContractAddress: contractAddr.String(),
+		JobId:           1,
+		CallbackHeight:  102,
+		ReservedBy:      contractAddr.String(),
+		FeeSplit: &types.CallbackFeesFeeSplit{
+			TransactionFees:       &validCoin,
+			BlockReservationFees:  &validCoin,
+			FutureReservationFees: &validCoin,
+			SurplusFees:           &validCoin,
+		},
+	}
+	err := keeper.SaveCallback(ctx, callback)
+	s.Require().NoError(err)
+	// Same contract with a callback reserved by the contract admin
+	callback.JobId = 2
+	callback.ReservedBy = contractAdminAcc.Address.String()
+	err = keeper.SaveCallback(ctx, callback)
+	s.Require().NoError(err)
+
+	queryServer := callbackKeeper.NewQueryServer(keeper)
+
+	s.Run("FAIL: empty request", func() {
+		_, err := queryServer.CallbacksByContract(ctx, nil)
+		s.Require().Error(err)
+		_, err = queryServer.CallbacksByReserver(ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("FAIL: invalid address", func() {
+		_, err := queryServer.CallbacksByContract(ctx, &types.QueryCallbacksByContractRequest{ContractAddress: "👻"})
+		s.Require().Error(err)
+		_, err = queryServer.CallbacksByReserver(ctx, &types.QueryCallbacksByReserverRequest{ReservedBy: "👻"})
+		s.Require().Error(err)
+	})
+
+	s.Run("OK: get callbacks by contract", func() {
+		res, err := queryServer.CallbacksByContract(ctx, &types.QueryCallbacksByContractRequest{ContractAddress: contractAddr.String()})
+		s.Require().NoError(err)
+		s.Require().Len(res.Callbacks, 2)
+	})
+
+	s.Run("OK: get callbacks by reserver", func() {
+		res, err := queryServer.CallbacksByReserver(ctx, &types.QueryCallbacksByReserverRequest{ReservedBy: contractAdminAcc.Address.String()})
+		s.Require().NoError(err)
+		s.Require().Len(res.Callbacks, 1)
+		s.Assert().EqualValues(2, res.Callbacks[0].JobId)
+	})
+}
+
 func (s *KeeperTestSuite) TestEstimateCallbackFees() {
 	ctx, keeper := s.chain.GetContext().WithBlockHeight(101), s.chain.GetApp().Keepers.CallbackKeeper
 	queryServer := callbackKeeper.NewQueryServer(keeper)
@@ -123,15 +186,28 @@ func (s *KeeperTestSuite) TestEstimateCallbackFees() {
 	params, err := keeper.GetParams(ctx)
 	s.Require().NoError(err)
 	err = keeper.SetParams(ctx, types.Params{
-		CallbackGasLimit:               1,
-		MaxBlockReservationLimit:       params.MaxBlockReservationLimit,
-		MaxFutureReservationLimit:      params.MaxFutureReservationLimit,
-		FutureReservationFeeMultiplier: math.LegacyMustNewDecFromStr("0"),
-		BlockReservationFeeMultiplier:  math.LegacyMustNewDecFromStr("0"),
+		CallbackGasLimit:                   1,
+		MaxBlockReservationLimit:           params.MaxBlockReservationLimit,
+		MaxFutureReservationLimit:          params.MaxFutureReservationLimit,
+		FutureReservationFeeMultiplier:     math.LegacyMustNewDecFromStr("0"),
+		BlockReservationFeeMultiplier:      math.LegacyMustNewDecFromStr("0"),
+		MaxPayloadSize:                     10,
+		PayloadFeeMultiplier:               math.LegacyMustNewDecFromStr("2"),
+		MaxFutureReservationTime:           time.Hour,
+		FutureReservationTimeFeeMultiplier: math.LegacyMustNewDecFromStr("1"),
+		MaxCallbackGasLimit:                5,
 	})
 	s.Require().NoError(err)
 	expectedTxFeeAmount := s.chain.GetApp().Keepers.RewardsKeeper.ComputationalPriceOfGas(ctx).Amount
 	expectedTxFeeCoin := sdk.NewInt64Coin("stake", expectedTxFeeAmount.RoundInt().Int64())
+	expectedPayloadFeeCoin := sdk.NewInt64Coin("stake", 20)
+	expectedTotalFeeCoin := expectedTxFeeCoin.Add(expectedPayloadFeeCoin)
+	expectedGasLimitTxFeeCoin := sdk.NewInt64Coin("stake", expectedTxFeeAmount.MulInt64(5).RoundInt().Int64())
+	expectedTimeFeeCoin := sdk.NewInt64Coin("stake", 10)
+	expectedTimedTotalFeeCoin := expectedTxFeeCoin.Add(expectedTimeFeeCoin)
+	pastTime := ctx.BlockTime().Add(-time.Second)
+	futureTime := ctx.BlockTime().Add(10 * time.Second)
+	tooFarFutureTime := ctx.BlockTime().Add(2 * time.Hour)
 
 	testCases := []struct {
 		testCase       string
@@ -184,6 +260,102 @@ func (s *KeeperTestSuite) TestEstimateCallbackFees() {
 				TotalFees: &expectedTxFeeCoin,
 			},
 		},
+		{
+			testCase: "FAIL: payload is too large",
+			input: func() *types.QueryEstimateCallbackFeesRequest {
+				return &types.QueryEstimateCallbackFeesRequest{
+					BlockHeight: 102,
+					PayloadSize: 11,
+				}
+			},
+			expectError:    true,
+			expectedOutput: nil,
+		},
+		{
+			testCase: "OK: fetch fees for next height with payload",
+			input: func() *types.QueryEstimateCallbackFeesRequest {
+				return &types.QueryEstimateCallbackFeesRequest{
+					BlockHeight: 102,
+					PayloadSize: 10,
+				}
+			},
+			expectError: false,
+			expectedOutput: &types.QueryEstimateCallbackFeesResponse{
+				FeeSplit: &types.CallbackFeesFeeSplit{
+					TransactionFees:       &expectedTxFeeCoin,
+					BlockReservationFees:  &expectedPayloadFeeCoin,
+					FutureReservationFees: &zeroCoin,
+				},
+				TotalFees: &expectedTotalFeeCoin,
+			},
+		},
+		{
+			testCase: "FAIL: block time is in the past",
+			input: func() *types.QueryEstimateCallbackFeesRequest {
+				return &types.QueryEstimateCallbackFeesRequest{
+					CallbackTime: &pastTime,
+				}
+			},
+			expectError:    true,
+			expectedOutput: nil,
+		},
+		{
+			testCase: "FAIL: block time is too far in the future",
+			input: func() *types.QueryEstimateCallbackFeesRequest {
+				return &types.QueryEstimateCallbackFeesRequest{
+					CallbackTime: &tooFarFutureTime,
+				}
+			},
+			expectError:    true,
+			expectedOutput: nil,
+		},
+		{
+			testCase: "OK: fetch fees for block time",
+			input: func() *types.QueryEstimateCallbackFeesRequest {
+				return &types.QueryEstimateCallbackFeesRequest{
+					BlockHeight:  102, // ignored as callback time is set
+					CallbackTime: &futureTime,
+				}
+			},
+			expectError: false,
+			expectedOutput: &types.QueryEstimateCallbackFeesResponse{
+				FeeSplit: &types.CallbackFeesFeeSplit{
+					TransactionFees:       &expectedTxFeeCoin,
+					BlockReservationFees:  &zeroCoin,
+					FutureReservationFees: &expectedTimeFeeCoin,
+				},
+				TotalFees: &expectedTimedTotalFeeCoin,
+			},
+		},
+		{
+			testCase: "FAIL: gas limit is higher than the max callback gas limit",
+			input: func() *types.QueryEstimateCallbackFeesRequest {
+				return &types.QueryEstimateCallbackFeesRequest{
+					BlockHeight: 102,
+					GasLimit:    6,
+				}
+			},
+			expectError:    true,
+			expectedOutput: nil,
+		},
+		{
+			testCase: "OK: fetch fees for requested gas limit",
+			input: func() *types.QueryEstimateCallbackFeesRequest {
+				return &types.QueryEstimateCallbackFeesRequest{
+					BlockHeight: 102,
+					GasLimit:    5,
+				}
+			},
+			expectError: false,
+			expectedOutput: &types.QueryEstimateCallbackFeesResponse{
+				FeeSplit: &types.CallbackFeesFeeSplit{
+					TransactionFees:       &expectedGasLimitTxFeeCoin,
+					BlockReservationFees:  &zeroCoin,
+					FutureReservationFees: &zeroCoin,
+				},
+				TotalFees: &expectedGasLimitTxFeeCoin,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(fmt.Sprintf("Case: %s", tc.testCase), func() {
@@ -202,3 +374,73 @@ func (s *KeeperTestSuite) TestEstimateCallbackFees() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestBlockReservationPrices() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext().WithBlockHeight(101), s.chain.GetApp().Keepers.CallbackKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	validCoin := sdk.NewInt64Coin("stake", 10)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractViewer.AddContractAdmin(contractAddr.String(), s.chain.GetAccount(0).Address.String())
+	queryServer := callbackKeeper.NewQueryServer(keeper)
+
+	// Setting up congestion pricing where the block reservation fees are 100 at one callback registered
+	// and change by 50% for every callback above or below, with no future reservation fees
+	params := types.DefaultParams()
+	params.MaxBlockReservationLimit = 3
+	params.FutureReservationFeeMultiplier = math.LegacyZeroDec()
+	params.BlockReservationPricing = types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION
+	params.TargetBlockReservations = 1
+	params.CongestionBaseFee = math.LegacyMustNewDecFromStr("100")
+	params.CongestionFeeChangeRate = math.LegacyMustNewDecFromStr("0.5")
+	err := keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	// Registering one callback at height 103 and filling height 104
+	callback := types.Callback{
+		ContractAddress: contractAddr.String(),
+		ReservedBy:      contractAddr.String(),
+		CallbackHeight:  103,
+		FeeSplit: &types.CallbackFeesFeeSplit{
+			TransactionFees:       &validCoin,
+			BlockReservationFees:  &validCoin,
+			FutureReservationFees: &validCoin,
+			SurplusFees:           &validCoin,
+		},
+	}
+	s.Require().NoError(keeper.SaveCallback(ctx, callback))
+	callback.CallbackHeight = 104
+	for jobID := uint64(1); jobID <= 3; jobID++ {
+		callback.JobId = jobID
+		s.Require().NoError(keeper.SaveCallback(ctx, callback))
+	}
+
+	// Failing on an empty request, an inverted range and a range starting in the past
+	_, err = queryServer.BlockReservationPrices(ctx, nil)
+	s.Require().Error(err)
+	_, err = queryServer.BlockReservationPrices(ctx, &types.QueryBlockReservationPricesRequest{StartHeight: 103, EndHeight: 102})
+	s.Require().Error(err)
+	_, err = queryServer.BlockReservationPrices(ctx, &types.QueryBlockReservationPricesRequest{StartHeight: 101, EndHeight: 102})
+	s.Require().Error(err)
+
+	res, err := queryServer.BlockReservationPrices(ctx, &types.QueryBlockReservationPricesRequest{StartHeight: 102, EndHeight: 104})
+	s.Require().NoError(err)
+	s.Require().Len(res.Prices, 3)
+
+	expectedTxFee := keeper.CalculateTransactionFees(ctx, params.CallbackGasLimit)
+	s.Require().Equal(int64(102), res.Prices[0].BlockHeight)
+	s.Require().Equal(uint64(0), res.Prices[0].ReservedCallbacks)
+	s.Require().False(res.Prices[0].Filled)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 67), *res.Prices[0].FeeSplit.BlockReservationFees)
+	s.Require().Equal(expectedTxFee.AddAmount(math.NewInt(67)), *res.Prices[0].TotalFees)
+
+	s.Require().Equal(int64(103), res.Prices[1].BlockHeight)
+	s.Require().Equal(uint64(1), res.Prices[1].ReservedCallbacks)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 100), *res.Prices[1].FeeSplit.BlockReservationFees)
+
+	s.Require().Equal(int64(104), res.Prices[2].BlockHeight)
+	s.Require().Equal(uint64(3), res.Prices[2].ReservedCallbacks)
+	s.Require().True(res.Prices[2].Filled)
+	s.Require().Nil(res.Prices[2].FeeSplit)
+}
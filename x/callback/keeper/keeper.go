@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"time"
+
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -12,55 +15,205 @@ import (
 	"github.com/archway-network/archway/x/callback/types"
 )
 
+// CallbacksIndex indexes the callbacks registered for a block height
+type CallbacksIndex struct {
+	// Contract maps the contract address to the callbacks registered for it
+	Contract *indexes.Multi[[]byte, collections.Triple[int64, []byte, uint64], types.Callback]
+	// Reserver maps the address which reserved the callbacks to the callbacks
+	Reserver *indexes.Multi[[]byte, collections.Triple[int64, []byte, uint64], types.Callback]
+}
+
+func (i CallbacksIndex) IndexesList() []collections.Index[collections.Triple[int64, []byte, uint64], types.Callback] {
+	return []collections.Index[collections.Triple[int64, []byte, uint64], types.Callback]{i.Contract, i.Reserver}
+}
+
+func NewCallbacksIndex(sb *collections.SchemaBuilder) CallbacksIndex {
+	pkCodec := collections.TripleKeyCodec(collections.Int64Key, collections.BytesKey, collections.Uint64Key)
+	return CallbacksIndex{
+		Contract: indexes.NewMulti(sb, types.CallbacksByContractKeyPrefix, "callbacks_by_contract", collections.BytesKey, pkCodec, func(_ collections.Triple[int64, []byte, uint64], value types.Callback) ([]byte, error) {
+			return sdk.AccAddressFromBech32(value.ContractAddress)
+		}),
+		Reserver: indexes.NewMulti(sb, types.CallbacksByReserverKeyPrefix, "callbacks_by_reserver", collections.BytesKey, pkCodec, func(_ collections.Triple[int64, []byte, uint64], value types.Callback) ([]byte, error) {
+			return sdk.AccAddressFromBech32(value.ReservedBy)
+		}),
+	}
+}
+
+// TimedCallbacksIndex indexes the callbacks registered for a block time
+type TimedCallbacksIndex struct {
+	// Contract maps the contract address to the callbacks registered for it
+	Contract *indexes.Multi[[]byte, collections.Triple[time.Time, []byte, uint64], types.Callback]
+	// Reserver maps the address which reserved the callbacks to the callbacks
+	Reserver *indexes.Multi[[]byte, collections.Triple[time.Time, []byte, uint64], types.Callback]
+}
+
+func (i TimedCallbacksIndex) IndexesList() []collections.Index[collections.Triple[time.Time, []byte, uint64], types.Callback] {
+	return []collections.Index[collections.Triple[time.Time, []byte, uint64], types.Callback]{i.Contract, i.Reserver}
+}
+
+func NewTimedCallbacksIndex(sb *collections.SchemaBuilder) TimedCallbacksIndex {
+	pkCodec := collections.TripleKeyCodec(sdk.TimeKey, collections.BytesKey, collections.Uint64Key)
+	return TimedCallbacksIndex{
+		Contract: indexes.NewMulti(sb, types.TimedCallbacksByContractKeyPrefix, "timed_callbacks_by_contract", collections.BytesKey, pkCodec, func(_ collections.Triple[time.Time, []byte, uint64], value types.Callback) ([]byte, error) {
+			return sdk.AccAddressFromBech32(value.ContractAddress)
+		}),
+		Reserver: indexes.NewMulti(sb, types.TimedCallbacksByReserverKeyPrefix, "timed_callbacks_by_reserver", collections.BytesKey, pkCodec, func(_ collections.Triple[time.Time, []byte, uint64], value types.Callback) ([]byte, error) {
+			return sdk.AccAddressFromBech32(value.ReservedBy)
+		}),
+	}
+}
+
 // Keeper provides module state operations.
 type Keeper struct {
-	cdc           codec.Codec
-	storeKey      storetypes.StoreKey
-	wasmKeeper    types.WasmKeeperExpected
-	rewardsKeeper types.RewardsKeeperExpected
-	bankKeeper    types.BankKeeperExpected
-	authority     string // this should be the x/gov module account
-	logger        log.Logger
+	cdc            codec.Codec
+	storeKey       storetypes.StoreKey
+	tStoreKey      storetypes.StoreKey
+	wasmKeeper     types.WasmKeeperExpected
+	rewardsKeeper  types.RewardsKeeperExpected
+	bankKeeper     types.BankKeeperExpected
+	cwFeesKeeper   types.CWFeesKeeperExpected
+	feeGrantKeeper types.FeeGrantKeeperExpected
+	authority      string // this should be the x/gov module account
+	logger         log.Logger
 
 	Schema collections.Schema
 
 	// Params key: ParamsKeyPrefix | value: Params
 	Params collections.Item[types.Params]
 	// Callbacks key: CallbackKeyPrefix | value: []Callback
-	Callbacks collections.Map[collections.Triple[int64, []byte, uint64], types.Callback]
+	// indexed by contract address (CallbacksByContractKeyPrefix) and reserver (CallbacksByReserverKeyPrefix)
+	Callbacks *collections.IndexedMap[collections.Triple[int64, []byte, uint64], types.Callback, CallbacksIndex]
+	// TimedCallbacks key: TimedCallbackKeyPrefix | value: []Callback
+	// indexed by contract address (TimedCallbacksByContractKeyPrefix) and reserver (TimedCallbacksByReserverKeyPrefix)
+	TimedCallbacks *collections.IndexedMap[collections.Triple[time.Time, []byte, uint64], types.Callback, TimedCallbacksIndex]
+	// DeferredCallbacks key: DeferredCallbackKeyPrefix | value: []Callback
+	DeferredCallbacks collections.Map[uint64, types.Callback]
+	// DeferredCallbackSequence key: DeferredCallbackSequenceKeyPrefix | value: uint64
+	DeferredCallbackSequence collections.Sequence
+	// Receipts key: ReceiptKeyPrefix | ReceiptID | value: CallbackReceipt
+	Receipts collections.Map[uint64, types.CallbackReceipt]
+	// ReceiptSequence key: ReceiptSequenceKeyPrefix | value: uint64
+	ReceiptSequence collections.Sequence
+	// ContractReceipts key: ContractReceiptsKeyPrefix | ContractAddress | ReceiptID
+	ContractReceipts collections.KeySet[collections.Pair[[]byte, uint64]]
+	// ReceiptDeletionBlocks key: ReceiptDeletionBlocksKeyPrefix | BlockHeight | ReceiptID
+	ReceiptDeletionBlocks collections.KeySet[collections.Pair[int64, uint64]]
+	// EventSubscriptions key: EventSubscriptionKeyPrefix | SubscriptionID | value: EventSubscription
+	EventSubscriptions collections.Map[uint64, types.EventSubscription]
+	// EventSubscriptionSequence key: EventSubscriptionSequenceKeyPrefix | value: uint64
+	EventSubscriptionSequence collections.Sequence
+	// EventSubscriptionsByType key: EventSubscriptionsByTypeKeyPrefix | EventType | SubscriptionID
+	EventSubscriptionsByType collections.KeySet[collections.Pair[string, uint64]]
+	// EventSubscriptionsByContract key: EventSubscriptionsByContractKeyPrefix | ContractAddress | SubscriptionID
+	EventSubscriptionsByContract collections.KeySet[collections.Pair[[]byte, uint64]]
 }
 
 // NewKeeper creates a new Keeper instance.
 func NewKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
+	tStoreKey storetypes.StoreKey,
 	wk types.WasmKeeperExpected,
 	rk types.RewardsKeeperExpected,
 	bk types.BankKeeperExpected,
+	cfk types.CWFeesKeeperExpected,
+	fgk types.FeeGrantKeeperExpected,
 	authority string,
 	logger log.Logger,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(collcompat.NewKVStoreService(storeKey))
 	k := Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		wasmKeeper:    wk,
-		rewardsKeeper: rk,
-		bankKeeper:    bk,
-		authority:     authority,
-		logger:        logger.With("module", "x/"+types.ModuleName),
+		cdc:            cdc,
+		storeKey:       storeKey,
+		tStoreKey:      tStoreKey,
+		wasmKeeper:     wk,
+		rewardsKeeper:  rk,
+		bankKeeper:     bk,
+		cwFeesKeeper:   cfk,
+		feeGrantKeeper: fgk,
+		authority:      authority,
+		logger:         logger.With("module", "x/"+types.ModuleName),
 		Params: collections.NewItem(
 			sb,
 			types.ParamsKeyPrefix,
 			"params",
 			collcompat.ProtoValue[types.Params](cdc),
 		),
-		Callbacks: collections.NewMap(
+		Callbacks: collections.NewIndexedMap(
 			sb,
 			types.CallbackKeyPrefix,
 			"callbacks",
 			collections.TripleKeyCodec(collections.Int64Key, collections.BytesKey, collections.Uint64Key),
 			collcompat.ProtoValue[types.Callback](cdc),
+			NewCallbacksIndex(sb),
+		),
+		TimedCallbacks: collections.NewIndexedMap(
+			sb,
+			types.TimedCallbackKeyPrefix,
+			"timed_callbacks",
+			collections.TripleKeyCodec(sdk.TimeKey, collections.BytesKey, collections.Uint64Key),
+			collcompat.ProtoValue[types.Callback](cdc),
+			NewTimedCallbacksIndex(sb),
+		),
+		DeferredCallbacks: collections.NewMap(
+			sb,
+			types.DeferredCallbackKeyPrefix,
+			"deferred_callbacks",
+			collections.Uint64Key,
+			collcompat.ProtoValue[types.Callback](cdc),
+		),
+		DeferredCallbackSequence: collections.NewSequence(
+			sb,
+			types.DeferredCallbackSequenceKeyPrefix,
+			"deferred_callback_sequence",
+		),
+		Receipts: collections.NewMap(
+			sb,
+			types.ReceiptKeyPrefix,
+			"receipts",
+			collections.Uint64Key,
+			collcompat.ProtoValue[types.CallbackReceipt](cdc),
+		),
+		ReceiptSequence: collections.NewSequence(
+			sb,
+			types.ReceiptSequenceKeyPrefix,
+			"receipt_sequence",
+		),
+		ContractReceipts: collections.NewKeySet(
+			sb,
+			types.ContractReceiptsKeyPrefix,
+			"contract_receipts",
+			collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key),
+		),
+		ReceiptDeletionBlocks: collections.NewKeySet(
+			sb,
+			types.ReceiptDeletionBlocksKeyPrefix,
+			"receipt_deletion_blocks",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+		),
+		EventSubscriptions: collections.NewMap(
+			sb,
+			types.EventSubscriptionKeyPrefix,
+			"event_subscriptions",
+			collections.Uint64Key,
+			collcompat.ProtoValue[types.EventSubscription](cdc),
+		),
+		EventSubscriptionSequence: collections.NewSequence(
+			sb,
+			types.EventSubscriptionSequenceKeyPrefix,
+			"event_subscription_sequence",
+		),
+		EventSubscriptionsByType: collections.NewKeySet(
+			sb,
+			types.EventSubscriptionsByTypeKeyPrefix,
+			"event_subscriptions_by_type",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		EventSubscriptionsByContract: collections.NewKeySet(
+			sb,
+			types.EventSubscriptionsByContractKeyPrefix,
+			"event_subscriptions_by_contract",
+			collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key),
 		),
 	}
 	schema, err := sb.Build()
@@ -87,6 +240,13 @@ func (k *Keeper) SetWasmKeeper(wk types.WasmKeeperExpected) {
 	k.wasmKeeper = wk
 }
 
+// SetFeePayerKeepers sets the given x/cwfees and x/feegrant keepers.
+// Only for testing purposes
+func (k *Keeper) SetFeePayerKeepers(cfk types.CWFeesKeeperExpected, fgk types.FeeGrantKeeperExpected) {
+	k.cwFeesKeeper = cfk
+	k.feeGrantKeeper = fgk
+}
+
 // SendToCallbackModule sends coins from the sender to the x/callback module account.
 func (k Keeper) SendToCallbackModule(ctx sdk.Context, sender string, amount sdk.Coin) error {
 	senderAddr, err := sdk.AccAddressFromBech32(sender)
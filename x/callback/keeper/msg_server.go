@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -34,9 +37,9 @@ func (s MsgServer) CancelCallback(c context.Context, request *types.MsgCancelCal
 	ctx := sdk.UnwrapSDKContext(c)
 
 	// If a callback with same job id does not exist, return error
-	callback, err := s.keeper.GetCallback(ctx, request.CallbackHeight, request.ContractAddress, request.JobId)
+	callback, err := s.getRegisteredCallback(ctx, request.ContractAddress, request.JobId, request.CallbackHeight, request.CallbackTime)
 	if err != nil {
-		return nil, errorsmod.Wrap(types.ErrCallbackNotFound, "callback with given job id does not exist for given height")
+		return nil, err
 	}
 
 	// Deleting the callback from state
@@ -46,14 +49,13 @@ func (s MsgServer) CancelCallback(c context.Context, request *types.MsgCancelCal
 	}
 
 	// Returning the transaction fees + surplus fees as the callback was never executed
-	refundFees := callback.FeeSplit.TransactionFees.Add(*callback.FeeSplit.SurplusFees)
-	err = s.keeper.RefundFromCallbackModule(ctx, request.Sender, refundFees)
+	refundFees, reservationFees := cancellationFees(callback)
+	err = s.keeper.RefundFromCallbackModule(ctx, refundRecipient(callback, request.Sender), refundFees)
 	if err != nil {
 		return nil, err
 	}
 
 	// Sending the reservation fees to fee collector
-	reservationFees := callback.FeeSplit.BlockReservationFees.Add(*callback.FeeSplit.FutureReservationFees)
 	err = s.keeper.SendToFeeCollector(ctx, reservationFees)
 	if err != nil {
 		return nil, err
@@ -65,6 +67,7 @@ func (s MsgServer) CancelCallback(c context.Context, request *types.MsgCancelCal
 		request.ContractAddress,
 		request.JobId,
 		request.CallbackHeight,
+		request.CallbackTime,
 		request.Sender,
 		refundFees,
 	)
@@ -74,54 +77,191 @@ func (s MsgServer) CancelCallback(c context.Context, request *types.MsgCancelCal
 	}, nil
 }
 
-// RequestCallback implements types.MsgServer.
-func (s MsgServer) RequestCallback(c context.Context, request *types.MsgRequestCallback) (*types.MsgRequestCallbackResponse, error) {
+// UpdateCallback implements types.MsgServer.
+func (s MsgServer) UpdateCallback(c context.Context, request *types.MsgUpdateCallback) (*types.MsgUpdateCallbackResponse, error) {
 	if request == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	// Get the expected fees which is to be paid
-	futureReservationFee, blockReservationFee, transactionFee, err := s.keeper.EstimateCallbackFees(ctx, request.CallbackHeight)
+	// If a callback with same job id does not exist, return error
+	callback, err := s.getRegisteredCallback(ctx, request.ContractAddress, request.JobId, request.CallbackHeight, request.CallbackTime)
 	if err != nil {
 		return nil, err
 	}
-	expectedFees := transactionFee.Add(blockReservationFee).Add(futureReservationFee)
+	if request.NewCallbackHeight == callback.CallbackHeight && (request.NewCallbackTime == nil) == (callback.CallbackTime == nil) &&
+		(request.NewCallbackTime == nil || request.NewCallbackTime.Equal(*callback.CallbackTime)) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "callback is already registered at the given height or block time")
+	}
 
-	// If the fees sent by the sender is less than the expected fees, return error
-	if request.Fees.IsLT(expectedFees) {
-		return nil, errorsmod.Wrapf(types.ErrInsufficientFees, "expected %s, got %s", expectedFees, request.Fees)
+	// If the sender is not authorized to modify the callbacks of the contract, return error.
+	// The callback can be updated by the contract, its current admin or owner, not only by the address which reserved it
+	err = s.keeper.authorizeCallback(ctx, callback.ContractAddress, request.Sender)
+	if err != nil {
+		return nil, err
 	}
-	surplusFees := request.Fees.Sub(expectedFees) // Calculating any surplus user has sent
 
-	// Save the callback in state
-	callback := types.NewCallback(
-		request.Sender,
-		request.ContractAddress,
-		request.CallbackHeight,
-		request.JobId,
-		transactionFee,
+	// Get the reservation fees at the new height or block time. The transaction fees were already paid for the same gas limit.
+	// The callback is not counted in the reservations, so it does not pay for its own reservation when moved within the same second
+	reservations := newCallbackReservations(s.keeper)
+	err = reservations.remove(ctx, callback)
+	if err != nil {
+		return nil, err
+	}
+	var futureReservationFee, blockReservationFee sdk.Coin
+	if request.NewCallbackTime != nil {
+		futureReservationFee, blockReservationFee, _, err = s.keeper.estimateTimedCallbackFees(ctx, reservations, *request.NewCallbackTime, uint64(len(callback.Payload)), callback.MaxGasLimit)
+	} else {
+		futureReservationFee, blockReservationFee, _, err = s.keeper.estimateCallbackFees(ctx, reservations, request.NewCallbackHeight, uint64(len(callback.Payload)), callback.MaxGasLimit)
+	}
+	if err != nil {
+		return nil, err
+	}
+	paidReservationFees := callback.FeeSplit.BlockReservationFees.Add(*callback.FeeSplit.FutureReservationFees)
+	expectedReservationFees := blockReservationFee.Add(futureReservationFee)
+
+	// Charging only the difference in reservation fees, or refunding it if the new reservation fees are lower
+	fees := sdk.NewCoin(expectedReservationFees.Denom, math.ZeroInt())
+	if !request.Fees.IsNil() {
+		fees = request.Fees
+	}
+	refundFees := sdk.NewCoin(expectedReservationFees.Denom, math.ZeroInt())
+	surplusFees := *callback.FeeSplit.SurplusFees
+	feePayerFees := sdk.NewCoin(expectedReservationFees.Denom, math.ZeroInt())
+	if callback.IsPaidOnExecution() {
+		// The difference in reservation fees is charged to or refunded to the fee payer, not the sender
+		if fees.IsPositive() {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fees can not be sent for a callback paid by a fee payer")
+		}
+		if paidReservationFees.IsLT(expectedReservationFees) {
+			feePayerFees = expectedReservationFees.Sub(paidReservationFees)
+		} else {
+			refundFees = paidReservationFees.Sub(expectedReservationFees)
+		}
+	} else if paidReservationFees.IsLT(expectedReservationFees) {
+		feesDiff := expectedReservationFees.Sub(paidReservationFees)
+		// If the fees sent by the sender is less than the difference in fees, return error
+		if fees.IsLT(feesDiff) {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientFees, "expected %s, got %s", feesDiff, fees)
+		}
+		surplusFees = surplusFees.Add(fees.Sub(feesDiff))
+	} else {
+		refundFees = paidReservationFees.Sub(expectedReservationFees)
+		surplusFees = surplusFees.Add(fees)
+	}
+
+	// Deleting the callback from its current height or block time
+	err = s.keeper.RemoveCallback(ctx, callback)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save the callback at the new height or block time
+	updated := types.NewCallback(
+		callback.ReservedBy,
+		callback.ContractAddress,
+		request.NewCallbackHeight,
+		callback.JobId,
+		*callback.FeeSplit.TransactionFees,
 		blockReservationFee,
 		futureReservationFee,
 		surplusFees,
 	)
-	err = s.keeper.SaveCallback(ctx, callback)
+	updated.Interval = callback.Interval
+	updated.RemainingExecutions = callback.RemainingExecutions
+	updated.Payload = callback.Payload
+	updated.CallbackTime = request.NewCallbackTime
+	updated.MaxGasLimit = callback.MaxGasLimit
+	updated.FeePayer = callback.FeePayer
+	updated.RefundAddress = callback.RefundAddress
+	updated.RetryPolicy = callback.RetryPolicy
+	updated.FailedAttempts = callback.FailedAttempts
+	// The callback is kept reserved by the same address, which is not authorized again as the sender already was
+	err = s.keeper.saveCallback(ctx, reservations, updated)
 	if err != nil {
 		return nil, err
 	}
 
-	// Send the fees into module account
-	err = s.keeper.SendToCallbackModule(ctx, request.Sender, request.Fees)
+	// Send the fees into module account, and refund the reservation fees difference
+	if callback.IsPaidOnExecution() {
+		err = s.keeper.ChargeFeePayer(ctx, updated, feePayerFees)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if fees.IsPositive() {
+		err = s.keeper.SendToCallbackModule(ctx, request.Sender, fees)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if refundFees.IsPositive() {
+		err = s.keeper.RefundFromCallbackModule(ctx, refundRecipient(callback, request.Sender), refundFees)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Emit event
+	types.EmitCallbackUpdatedEvent(
+		ctx,
+		request.ContractAddress,
+		request.JobId,
+		callback.CallbackHeight,
+		callback.CallbackTime,
+		updated.CallbackHeight,
+		updated.CallbackTime,
+		updated.FeeSplit,
+		request.Sender,
+		refundFees,
+	)
+
+	return &types.MsgUpdateCallbackResponse{
+		Refund: refundFees,
+	}, nil
+}
+
+// RequestCallback implements types.MsgServer.
+func (s MsgServer) RequestCallback(c context.Context, request *types.MsgRequestCallback) (*types.MsgRequestCallbackResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Save the callback in state
+	callback, err := s.keeper.RequestCallback(ctx, request.Sender, types.CallbackRequest{
+		ContractAddress: request.ContractAddress,
+		JobId:           request.JobId,
+		CallbackHeight:  request.CallbackHeight,
+		Fees:            request.Fees,
+		Interval:        request.Interval,
+		MaxExecutions:   request.MaxExecutions,
+		Payload:         request.Payload,
+		CallbackTime:    request.CallbackTime,
+		GasLimit:        request.GasLimit,
+		FeePayer:        request.FeePayer,
+		RefundAddress:   request.RefundAddress,
+		RetryPolicy:     request.RetryPolicy,
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	// Send the fees into module account, unless the callback is paid by a fee payer, which was charged when it was saved
+	if !callback.IsPaidOnExecution() {
+		err = s.keeper.SendToCallbackModule(ctx, request.Sender, request.Fees)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Emit event
 	types.EmitCallbackRegisteredEvent(
 		ctx,
 		request.ContractAddress,
 		request.JobId,
 		request.CallbackHeight,
+		request.CallbackTime,
 		callback.FeeSplit,
 		request.Sender,
 	)
@@ -129,6 +269,200 @@ func (s MsgServer) RequestCallback(c context.Context, request *types.MsgRequestC
 	return &types.MsgRequestCallbackResponse{}, nil
 }
 
+// SubscribeToEvents implements types.MsgServer.
+func (s MsgServer) SubscribeToEvents(c context.Context, request *types.MsgSubscribeToEvents) (*types.MsgSubscribeToEventsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Save the subscription in state. The fees sent are kept as the prepaid balance of the deliveries
+	subscription, err := s.keeper.SaveEventSubscription(ctx, types.EventSubscription{
+		ContractAddress:  request.ContractAddress,
+		EventType:        request.EventType,
+		AttributeFilters: request.AttributeFilters,
+		GasLimit:         request.GasLimit,
+		ReservedBy:       request.Sender,
+		Balance:          request.Fees,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Send the fees into module account
+	err = s.keeper.SendToCallbackModule(ctx, request.Sender, request.Fees)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit event
+	types.EmitEventSubscriptionRegisteredEvent(ctx, subscription, request.Fees)
+
+	return &types.MsgSubscribeToEventsResponse{
+		SubscriptionId: subscription.Id,
+	}, nil
+}
+
+// UnsubscribeFromEvents implements types.MsgServer.
+func (s MsgServer) UnsubscribeFromEvents(c context.Context, request *types.MsgUnsubscribeFromEvents) (*types.MsgUnsubscribeFromEventsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// If a subscription with the given id does not exist for the contract, return error
+	subscription, err := s.keeper.GetEventSubscription(ctx, request.SubscriptionId)
+	if err != nil || subscription.ContractAddress != request.ContractAddress {
+		return nil, errorsmod.Wrap(types.ErrEventSubscriptionNotFound, "event subscription with given id does not exist for given contract")
+	}
+
+	// Deleting the subscription from state
+	err = s.keeper.DeleteEventSubscription(ctx, request.Sender, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	// Returning the prepaid balance left
+	if subscription.Balance.IsPositive() {
+		err = s.keeper.RefundFromCallbackModule(ctx, request.Sender, subscription.Balance)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Emit event
+	types.EmitEventSubscriptionRemovedEvent(
+		ctx,
+		subscription.Id,
+		subscription.ContractAddress,
+		subscription.Balance,
+		types.EventSubscriptionRemovedReasonUnsubscribed,
+	)
+
+	return &types.MsgUnsubscribeFromEventsResponse{
+		Refund: subscription.Balance,
+	}, nil
+}
+
+// RequestCallbacks implements types.MsgServer.
+func (s MsgServer) RequestCallbacks(c context.Context, request *types.MsgRequestCallbacks) (*types.MsgRequestCallbacksResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Save all the callbacks in state
+	callbacks, err := s.keeper.RequestCallbacks(ctx, request.Sender, request.Callbacks)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send the fees of all the callbacks into module account at once, except for the ones paid by a fee payer
+	var fees sdk.Coins
+	for _, callback := range request.Callbacks {
+		if callback.FeePayer == "" {
+			fees = fees.Add(callback.Fees)
+		}
+	}
+	for _, fee := range fees {
+		err = s.keeper.SendToCallbackModule(ctx, request.Sender, fee)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Emit events
+	feeSplits := make([]types.CallbackFeesFeeSplit, 0, len(callbacks))
+	for _, callback := range callbacks {
+		types.EmitCallbackRegisteredEvent(
+			ctx,
+			callback.ContractAddress,
+			callback.JobId,
+			callback.CallbackHeight,
+			callback.CallbackTime,
+			callback.FeeSplit,
+			request.Sender,
+		)
+		feeSplits = append(feeSplits, *callback.FeeSplit)
+	}
+
+	return &types.MsgRequestCallbacksResponse{
+		FeeSplits: feeSplits,
+	}, nil
+}
+
+// CancelCallbacks implements types.MsgServer.
+func (s MsgServer) CancelCallbacks(c context.Context, request *types.MsgCancelCallbacks) (*types.MsgCancelCallbacksResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	refunds := make([]sdk.Coin, 0, len(request.Callbacks))
+	// The fees are accumulated per denom, as callbacks registered under different params could be paid in different denoms
+	var refundFees, reservationFees sdk.Coins
+	// Refunds are grouped per recipient, as callbacks can have a refund address other than the sender
+	var recipients []string
+	recipientRefunds := make(map[string]sdk.Coins)
+	for i, cancellation := range request.Callbacks {
+		// If a callback with same job id does not exist, return error
+		callback, err := s.getRegisteredCallback(ctx, cancellation.ContractAddress, cancellation.JobId, cancellation.CallbackHeight, cancellation.CallbackTime)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "callbacks[%d]", i)
+		}
+
+		// Deleting the callback from state
+		err = s.keeper.DeleteCallback(ctx, request.Sender, callback)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "callbacks[%d]", i)
+		}
+
+		// Returning the transaction fees + surplus fees as the callback was never executed, and keeping the reservation fees
+		refund, reservation := cancellationFees(callback)
+		refundFees, reservationFees = refundFees.Add(refund), reservationFees.Add(reservation)
+		refunds = append(refunds, refund)
+		recipient := refundRecipient(callback, request.Sender)
+		if _, ok := recipientRefunds[recipient]; !ok {
+			recipients = append(recipients, recipient)
+		}
+		recipientRefunds[recipient] = recipientRefunds[recipient].Add(refund)
+
+		// Emit event
+		types.EmitCallbackCancelledEvent(
+			ctx,
+			cancellation.ContractAddress,
+			cancellation.JobId,
+			cancellation.CallbackHeight,
+			cancellation.CallbackTime,
+			request.Sender,
+			refund,
+		)
+	}
+
+	// Refunding the fees of all the callbacks at once for each recipient
+	for _, recipient := range recipients {
+		for _, refund := range recipientRefunds[recipient] {
+			err := s.keeper.RefundFromCallbackModule(ctx, recipient, refund)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Sending the reservation fees to fee collector
+	for _, reservation := range reservationFees {
+		err := s.keeper.SendToFeeCollector(ctx, reservation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgCancelCallbacksResponse{
+		Refunds: refunds,
+		Refund:  refundFees,
+	}, nil
+}
+
 // UpdateParams implements types.MsgServer.
 func (s MsgServer) UpdateParams(c context.Context, request *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if request == nil {
@@ -156,3 +490,39 @@ func (s MsgServer) UpdateParams(c context.Context, request *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// getRegisteredCallback returns the callback registered at the given height or block time, for the given contract and job id
+func (s MsgServer) getRegisteredCallback(ctx sdk.Context, contractAddress string, jobID uint64, callbackHeight int64, callbackTime *time.Time) (types.Callback, error) {
+	if callbackTime != nil {
+		callback, err := s.keeper.GetTimedCallback(ctx, *callbackTime, contractAddress, jobID)
+		if err != nil {
+			return types.Callback{}, errorsmod.Wrap(types.ErrCallbackNotFound, "callback with given job id does not exist for given block time")
+		}
+		return callback, nil
+	}
+	callback, err := s.keeper.GetCallback(ctx, callbackHeight, contractAddress, jobID)
+	if err != nil {
+		return types.Callback{}, errorsmod.Wrap(types.ErrCallbackNotFound, "callback with given job id does not exist for given height")
+	}
+	return callback, nil
+}
+
+// cancellationFees returns the fees refunded when the callback is cancelled and the reservation fees which are kept.
+// Callbacks paid by a fee payer have only paid their reservation fees, so there is nothing to refund
+func cancellationFees(callback types.Callback) (refundFees sdk.Coin, reservationFees sdk.Coin) {
+	reservationFees = callback.FeeSplit.BlockReservationFees.Add(*callback.FeeSplit.FutureReservationFees)
+	if callback.IsPaidOnExecution() {
+		return sdk.NewCoin(callback.FeeSplit.TransactionFees.Denom, math.ZeroInt()), reservationFees
+	}
+	refundFees = callback.FeeSplit.TransactionFees.Add(*callback.FeeSplit.SurplusFees)
+	return refundFees, reservationFees
+}
+
+// refundRecipient returns the address the fees of a callback modified by the sender are refunded to.
+// The fees of a callback paid by a fee payer are refunded to the fee payer rather than the sender
+func refundRecipient(callback types.Callback, sender string) string {
+	if callback.RefundAddress != "" || callback.IsPaidOnExecution() {
+		return callback.RefundRecipient()
+	}
+	return sender
+}
@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"cosmossdk.io/x/feegrant"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
@@ -102,6 +105,21 @@ func TestRequestCallback(t *testing.T) {
 			expectError: false,
 			errorType:   nil,
 		},
+		{
+			testCase: "OK: successfully register callback at block time",
+			input: func() *types.MsgRequestCallback {
+				callbackTime := ctx.BlockTime().Add(time.Minute)
+				return &types.MsgRequestCallback{
+					ContractAddress: contractAddr.String(),
+					JobId:           1,
+					CallbackTime:    &callbackTime,
+					Sender:          contractAdminAcc.String(),
+					Fees:            sdk.NewInt64Coin("stake", 3500000000),
+				}
+			},
+			expectError: false,
+			errorType:   nil,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(fmt.Sprintf("Case: %s", tc.testCase), func(t *testing.T) {
@@ -114,6 +132,11 @@ func TestRequestCallback(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, &types.MsgRequestCallbackResponse{}, res)
 				// Ensuring the callback exists now
+				if req.CallbackTime != nil {
+					_, err := keeper.GetTimedCallback(ctx, *req.CallbackTime, req.ContractAddress, req.JobId)
+					require.NoError(t, err)
+					return
+				}
 				exists, err := keeper.ExistsCallback(ctx, req.CallbackHeight, req.ContractAddress, req.JobId)
 				require.NoError(t, err)
 				require.True(t, exists)
@@ -225,3 +248,549 @@ func TestCancelCallback(t *testing.T) {
 		})
 	}
 }
+
+func TestCancelTimedCallback(t *testing.T) {
+	// Setting up chain and contract in mock wasm keeper
+	keeper, ctx := testutils.CallbackKeeper(t)
+	wasmKeeper := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(wasmKeeper)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := testutils.AccAddress()
+	wasmKeeper.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.String(),
+	)
+
+	msgServer := callbackKeeper.NewMsgServer(keeper)
+	// Setting up an existing callback to delete
+	callbackTime := ctx.BlockTime().Add(time.Minute)
+	reqMsg := &types.MsgRequestCallback{
+		ContractAddress: contractAddr.String(),
+		JobId:           1,
+		CallbackTime:    &callbackTime,
+		Sender:          contractAdminAcc.String(),
+		Fees:            sdk.NewInt64Coin("stake", 3500000000),
+	}
+	_, err := msgServer.RequestCallback(ctx, reqMsg)
+	require.NoError(t, err)
+	callback, err := keeper.GetTimedCallback(ctx, callbackTime, reqMsg.ContractAddress, reqMsg.JobId)
+	require.NoError(t, err)
+
+	otherTime := callbackTime.Add(time.Second)
+	_, err = msgServer.CancelCallback(ctx, &types.MsgCancelCallback{
+		ContractAddress: contractAddr.String(),
+		JobId:           1,
+		CallbackTime:    &otherTime,
+		Sender:          contractAdminAcc.String(),
+	})
+	require.ErrorIs(t, err, types.ErrCallbackNotFound)
+
+	res, err := msgServer.CancelCallback(ctx, &types.MsgCancelCallback{
+		ContractAddress: contractAddr.String(),
+		JobId:           1,
+		CallbackTime:    &callbackTime,
+		Sender:          contractAdminAcc.String(),
+	})
+	require.NoError(t, err)
+	refundAmount := callback.FeeSplit.TransactionFees.Add(*callback.FeeSplit.SurplusFees)
+	require.Equal(t, refundAmount, res.Refund)
+	_, err = keeper.GetTimedCallback(ctx, callbackTime, reqMsg.ContractAddress, reqMsg.JobId)
+	require.Error(t, err)
+}
+
+func TestUpdateCallback(t *testing.T) {
+	// Setting up chain and contract in mock wasm keeper
+	keeper, ctx := testutils.CallbackKeeper(t)
+	wasmKeeper := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(wasmKeeper)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := testutils.AccAddress()
+	wasmKeeper.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.String(),
+	)
+
+	msgServer := callbackKeeper.NewMsgServer(keeper)
+	// Setting up an existing callback to update
+	reqMsg := &types.MsgRequestCallback{
+		ContractAddress: contractAddr.String(),
+		JobId:           1,
+		CallbackHeight:  130,
+		Sender:          contractAdminAcc.String(),
+		Fees:            sdk.NewInt64Coin("stake", 3500000000),
+	}
+	_, err := msgServer.RequestCallback(ctx, reqMsg)
+	require.NoError(t, err)
+	callback, err := keeper.GetCallback(ctx, reqMsg.CallbackHeight, reqMsg.ContractAddress, reqMsg.JobId)
+	require.NoError(t, err)
+
+	newUpdateMsg := func(callbackHeight, newCallbackHeight int64, fees sdk.Coin) *types.MsgUpdateCallback {
+		return &types.MsgUpdateCallback{
+			ContractAddress:   contractAddr.String(),
+			JobId:             1,
+			CallbackHeight:    callbackHeight,
+			NewCallbackHeight: newCallbackHeight,
+			Sender:            contractAdminAcc.String(),
+			Fees:              fees,
+		}
+	}
+
+	t.Run("FAIL: empty request", func(t *testing.T) {
+		_, err := msgServer.UpdateCallback(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("FAIL: callback does not exist", func(t *testing.T) {
+		_, err := msgServer.UpdateCallback(ctx, newUpdateMsg(131, 140, sdk.Coin{}))
+		require.ErrorIs(t, err, types.ErrCallbackNotFound)
+	})
+
+	t.Run("FAIL: sender is not authorized to update callback", func(t *testing.T) {
+		msg := newUpdateMsg(130, 120, sdk.Coin{})
+		msg.Sender = testutils.AccAddress().String()
+		_, err := msgServer.UpdateCallback(ctx, msg)
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("FAIL: callback is already registered at the new height", func(t *testing.T) {
+		_, err := msgServer.UpdateCallback(ctx, newUpdateMsg(130, 130, sdk.Coin{}))
+		require.Error(t, err)
+	})
+
+	t.Run("OK: moving the callback earlier refunds the reservation fees difference", func(t *testing.T) {
+		futureFee, blockFee, _, err := keeper.EstimateCallbackFees(ctx, 120, 0, 0)
+		require.NoError(t, err)
+
+		res, err := msgServer.UpdateCallback(ctx, newUpdateMsg(130, 120, sdk.Coin{}))
+		require.NoError(t, err)
+
+		expectedRefund := callback.FeeSplit.FutureReservationFees.Add(*callback.FeeSplit.BlockReservationFees).Sub(futureFee.Add(blockFee))
+		require.True(t, expectedRefund.IsPositive())
+		require.Equal(t, expectedRefund, res.Refund)
+
+		exists, err := keeper.ExistsCallback(ctx, 130, contractAddr.String(), 1)
+		require.NoError(t, err)
+		require.False(t, exists)
+		updated, err := keeper.GetCallback(ctx, 120, contractAddr.String(), 1)
+		require.NoError(t, err)
+		require.Equal(t, futureFee, *updated.FeeSplit.FutureReservationFees)
+		require.Equal(t, *callback.FeeSplit.TransactionFees, *updated.FeeSplit.TransactionFees)
+		require.Equal(t, *callback.FeeSplit.SurplusFees, *updated.FeeSplit.SurplusFees)
+		require.Equal(t, callback.MaxGasLimit, updated.MaxGasLimit)
+		callback = updated
+	})
+
+	t.Run("FAIL: moving the callback later without paying the reservation fees difference", func(t *testing.T) {
+		_, err := msgServer.UpdateCallback(ctx, newUpdateMsg(120, 200, sdk.Coin{}))
+		require.ErrorIs(t, err, types.ErrInsufficientFees)
+	})
+
+	t.Run("OK: moving the callback later charges the reservation fees difference", func(t *testing.T) {
+		futureFee, blockFee, _, err := keeper.EstimateCallbackFees(ctx, 200, 0, 0)
+		require.NoError(t, err)
+		feesDiff := futureFee.Add(blockFee).Sub(callback.FeeSplit.FutureReservationFees.Add(*callback.FeeSplit.BlockReservationFees))
+		extraFees := sdk.NewInt64Coin("stake", 10)
+
+		res, err := msgServer.UpdateCallback(ctx, newUpdateMsg(120, 200, feesDiff.Add(extraFees)))
+		require.NoError(t, err)
+		require.True(t, res.Refund.IsZero())
+
+		updated, err := keeper.GetCallback(ctx, 200, contractAddr.String(), 1)
+		require.NoError(t, err)
+		require.Equal(t, futureFee, *updated.FeeSplit.FutureReservationFees)
+		require.Equal(t, callback.FeeSplit.SurplusFees.Add(extraFees), *updated.FeeSplit.SurplusFees)
+	})
+
+	t.Run("OK: moving the callback to a block time", func(t *testing.T) {
+		newCallbackTime := ctx.BlockTime().Add(time.Second)
+		msg := newUpdateMsg(200, 0, sdk.Coin{})
+		msg.NewCallbackTime = &newCallbackTime
+		_, err := msgServer.UpdateCallback(ctx, msg)
+		require.NoError(t, err)
+
+		_, err = keeper.GetTimedCallback(ctx, newCallbackTime, contractAddr.String(), 1)
+		require.NoError(t, err)
+		exists, err := keeper.ExistsCallback(ctx, 200, contractAddr.String(), 1)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Run("OK: moving the callback within the same second does not count its own reservation", func(t *testing.T) {
+		params, err := keeper.GetParams(ctx)
+		require.NoError(t, err)
+		params.MaxBlockReservationLimit = 1
+		require.NoError(t, keeper.SetParams(ctx, params))
+
+		callbackTime := ctx.BlockTime().Add(time.Second)
+		newCallbackTime := callbackTime.Truncate(time.Second)
+		if newCallbackTime.Equal(callbackTime) {
+			newCallbackTime = newCallbackTime.Add(500 * time.Millisecond)
+		}
+		msg := newUpdateMsg(0, 0, sdk.Coin{})
+		msg.CallbackTime = &callbackTime
+		msg.NewCallbackTime = &newCallbackTime
+		_, err = msgServer.UpdateCallback(ctx, msg)
+		require.NoError(t, err)
+
+		_, err = keeper.GetTimedCallback(ctx, newCallbackTime, contractAddr.String(), 1)
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: the current admin of the contract updates a callback reserved by a former admin", func(t *testing.T) {
+		_, err := msgServer.RequestCallback(ctx, &types.MsgRequestCallback{
+			ContractAddress: contractAddr.String(),
+			JobId:           2,
+			CallbackHeight:  150,
+			Sender:          contractAdminAcc.String(),
+			Fees:            sdk.NewInt64Coin("stake", 3500000000),
+		})
+		require.NoError(t, err)
+
+		// the admin of the contract changes
+		newAdminAcc := testutils.AccAddress()
+		wasmKeeper.AddContractAdmin(contractAddr.String(), newAdminAcc.String())
+
+		msg := newUpdateMsg(150, 140, sdk.Coin{})
+		msg.JobId = 2
+		msg.Sender = newAdminAcc.String()
+		_, err = msgServer.UpdateCallback(ctx, msg)
+		require.NoError(t, err)
+
+		updated, err := keeper.GetCallback(ctx, 140, contractAddr.String(), 2)
+		require.NoError(t, err)
+		require.Equal(t, contractAdminAcc.String(), updated.ReservedBy)
+
+		// the former admin is no longer authorized
+		msg = newUpdateMsg(140, 145, sdk.Coin{})
+		msg.JobId = 2
+		_, err = msgServer.UpdateCallback(ctx, msg)
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+}
+
+func TestRequestCallbacks(t *testing.T) {
+	// Setting up chain and contract in mock wasm keeper
+	keeper, ctx := testutils.CallbackKeeper(t)
+	wasmKeeper := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(wasmKeeper)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := testutils.AccAddress()
+	wasmKeeper.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.String(),
+	)
+
+	msgServer := callbackKeeper.NewMsgServer(keeper)
+	fees := sdk.NewInt64Coin("stake", 100000000)
+	newRequest := func(jobID uint64, callbackHeight int64) types.CallbackRequest {
+		return types.CallbackRequest{
+			ContractAddress: contractAddr.String(),
+			JobId:           jobID,
+			CallbackHeight:  callbackHeight,
+			Fees:            fees,
+		}
+	}
+
+	t.Run("FAIL: empty request", func(t *testing.T) {
+		_, err := msgServer.RequestCallbacks(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("FAIL: batch is too large", func(t *testing.T) {
+		requests := make([]types.CallbackRequest, 0, types.MaxBatchSize+1)
+		for i := 0; i <= types.MaxBatchSize; i++ {
+			requests = append(requests, newRequest(uint64(i), 130))
+		}
+		err := types.NewMsgRequestCallbacks(contractAdminAcc, requests).ValidateBasic()
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		require.NoError(t, types.NewMsgRequestCallbacks(contractAdminAcc, requests[:types.MaxBatchSize]).ValidateBasic())
+	})
+
+	t.Run("FAIL: batch is not registered if any of the callbacks fails", func(t *testing.T) {
+		msg := types.NewMsgRequestCallbacks(contractAdminAcc, []types.CallbackRequest{
+			newRequest(1, 130),
+			newRequest(2, 130),
+			newRequest(1, 130),
+		})
+		// Executing in a cached context which is discarded on failure, as done for a transaction
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.RequestCallbacks(cacheCtx, msg)
+		require.ErrorContains(t, err, "callbacks[2]")
+
+		callbacks, err := keeper.GetCallbacksByHeight(ctx, 130)
+		require.NoError(t, err)
+		require.Empty(t, callbacks)
+	})
+
+	t.Run("FAIL: insufficient fees for a callback of the batch", func(t *testing.T) {
+		request := newRequest(2, 140)
+		request.Fees = sdk.NewInt64Coin("stake", 1)
+		msg := types.NewMsgRequestCallbacks(contractAdminAcc, []types.CallbackRequest{newRequest(1, 140), request})
+		_, err := msgServer.RequestCallbacks(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInsufficientFees)
+	})
+
+	t.Run("OK: block reservation fees increase as the batch fills the same height", func(t *testing.T) {
+		msg := types.NewMsgRequestCallbacks(contractAdminAcc, []types.CallbackRequest{
+			newRequest(1, 150),
+			newRequest(2, 150),
+			newRequest(3, 151),
+			newRequest(4, 150),
+		})
+		res, err := msgServer.RequestCallbacks(ctx, msg)
+		require.NoError(t, err)
+		require.Len(t, res.FeeSplits, 4)
+
+		require.True(t, res.FeeSplits[0].BlockReservationFees.IsZero())
+		require.Equal(t, int64(1), res.FeeSplits[1].BlockReservationFees.Amount.Int64())
+		require.True(t, res.FeeSplits[2].BlockReservationFees.IsZero())
+		require.Equal(t, int64(2), res.FeeSplits[3].BlockReservationFees.Amount.Int64())
+
+		for i, feeSplit := range res.FeeSplits {
+			total := feeSplit.TransactionFees.Add(*feeSplit.BlockReservationFees).Add(*feeSplit.FutureReservationFees).Add(*feeSplit.SurplusFees)
+			require.Equal(t, fees, total, "callbacks[%d]", i)
+		}
+
+		callbacks, err := keeper.GetCallbacksByHeight(ctx, 150)
+		require.NoError(t, err)
+		require.Len(t, callbacks, 3)
+	})
+
+	t.Run("FAIL: batch exceeds the block reservation limit", func(t *testing.T) {
+		msg := types.NewMsgRequestCallbacks(contractAdminAcc, []types.CallbackRequest{newRequest(5, 150)})
+		_, err := msgServer.RequestCallbacks(ctx, msg)
+		require.ErrorContains(t, err, "max reservation limit")
+	})
+}
+
+func TestCancelCallbacks(t *testing.T) {
+	// Setting up chain and contract in mock wasm keeper
+	keeper, ctx := testutils.CallbackKeeper(t)
+	wasmKeeper := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(wasmKeeper)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := testutils.AccAddress()
+	wasmKeeper.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.String(),
+	)
+
+	msgServer := callbackKeeper.NewMsgServer(keeper)
+	// Setting up existing callbacks to cancel
+	callbackTime := ctx.BlockTime().Add(time.Minute)
+	fees := sdk.NewInt64Coin("stake", 100000000)
+	_, err := msgServer.RequestCallbacks(ctx, types.NewMsgRequestCallbacks(contractAdminAcc, []types.CallbackRequest{
+		{ContractAddress: contractAddr.String(), JobId: 1, CallbackHeight: 130, Fees: fees},
+		{ContractAddress: contractAddr.String(), JobId: 2, CallbackHeight: 130, Fees: fees},
+		{ContractAddress: contractAddr.String(), JobId: 3, CallbackTime: &callbackTime, Fees: fees},
+	}))
+	require.NoError(t, err)
+
+	t.Run("FAIL: empty request", func(t *testing.T) {
+		_, err := msgServer.CancelCallbacks(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("FAIL: batch is too large", func(t *testing.T) {
+		cancellations := make([]types.CallbackCancellation, 0, types.MaxBatchSize+1)
+		for i := 0; i <= types.MaxBatchSize; i++ {
+			cancellations = append(cancellations, types.CallbackCancellation{ContractAddress: contractAddr.String(), JobId: uint64(i), CallbackHeight: 130})
+		}
+		err := types.NewMsgCancelCallbacks(contractAdminAcc, cancellations).ValidateBasic()
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+
+	t.Run("FAIL: callback of the batch does not exist", func(t *testing.T) {
+		msg := types.NewMsgCancelCallbacks(contractAdminAcc, []types.CallbackCancellation{
+			{ContractAddress: contractAddr.String(), JobId: 4, CallbackHeight: 130},
+		})
+		_, err := msgServer.CancelCallbacks(ctx, msg)
+		require.ErrorIs(t, err, types.ErrCallbackNotFound)
+	})
+
+	t.Run("FAIL: sender is not authorized to cancel the callbacks", func(t *testing.T) {
+		msg := types.NewMsgCancelCallbacks(testutils.AccAddress(), []types.CallbackCancellation{
+			{ContractAddress: contractAddr.String(), JobId: 1, CallbackHeight: 130},
+		})
+		_, err := msgServer.CancelCallbacks(ctx, msg)
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("OK: cancel the callbacks and refund their fees", func(t *testing.T) {
+		expectedRefunds := make([]sdk.Coin, 0, 3)
+		for _, callback := range []struct {
+			height int64
+			time   *time.Time
+			jobID  uint64
+		}{{130, nil, 1}, {130, nil, 2}, {0, &callbackTime, 3}} {
+			var registered types.Callback
+			if callback.time != nil {
+				registered, err = keeper.GetTimedCallback(ctx, *callback.time, contractAddr.String(), callback.jobID)
+			} else {
+				registered, err = keeper.GetCallback(ctx, callback.height, contractAddr.String(), callback.jobID)
+			}
+			require.NoError(t, err)
+			expectedRefunds = append(expectedRefunds, registered.FeeSplit.TransactionFees.Add(*registered.FeeSplit.SurplusFees))
+		}
+
+		msg := types.NewMsgCancelCallbacks(contractAdminAcc, []types.CallbackCancellation{
+			{ContractAddress: contractAddr.String(), JobId: 1, CallbackHeight: 130},
+			{ContractAddress: contractAddr.String(), JobId: 2, CallbackHeight: 130},
+			{ContractAddress: contractAddr.String(), JobId: 3, CallbackTime: &callbackTime},
+		})
+		res, err := msgServer.CancelCallbacks(ctx, msg)
+		require.NoError(t, err)
+		require.Equal(t, expectedRefunds, res.Refunds)
+		require.Equal(t, sdk.NewCoins().Add(expectedRefunds...), sdk.Coins(res.Refund))
+
+		callbacks, err := keeper.GetCallbacksByHeight(ctx, 130)
+		require.NoError(t, err)
+		require.Empty(t, callbacks)
+		_, err = keeper.GetTimedCallback(ctx, callbackTime, contractAddr.String(), 3)
+		require.Error(t, err)
+	})
+
+	t.Run("OK: cancel callbacks paid in different denoms", func(t *testing.T) {
+		// The callbacks could have been registered while the fees were paid in another denom
+		for jobID, denom := range map[uint64]string{4: "stake", 5: "uarch"} {
+			fee := sdk.NewInt64Coin(denom, 10)
+			err := keeper.SaveCallback(ctx, types.NewCallback(contractAdminAcc.String(), contractAddr.String(), 130, jobID, fee, fee, fee, fee))
+			require.NoError(t, err)
+		}
+
+		msg := types.NewMsgCancelCallbacks(contractAdminAcc, []types.CallbackCancellation{
+			{ContractAddress: contractAddr.String(), JobId: 4, CallbackHeight: 130},
+			{ContractAddress: contractAddr.String(), JobId: 5, CallbackHeight: 130},
+		})
+		res, err := msgServer.CancelCallbacks(ctx, msg)
+		require.NoError(t, err)
+		require.Equal(t, []sdk.Coin{sdk.NewInt64Coin("stake", 20), sdk.NewInt64Coin("uarch", 20)}, res.Refunds)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20), sdk.NewInt64Coin("uarch", 20)), sdk.Coins(res.Refund))
+	})
+}
+
+func TestRequestCallbackWithFeePayer(t *testing.T) {
+	// Setting up chain and contract in mock wasm keeper
+	keeper, ctx := testutils.CallbackKeeper(t)
+	wasmKeeper := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(wasmKeeper)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := testutils.AccAddress()
+	wasmKeeper.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.String(),
+	)
+
+	// Setting up granting contracts, one of which rejects every grant, and an account which granted an allowance to the contract admin
+	grantingContracts := e2eTesting.GenContractAddresses(3)
+	grantingContract, rejectingContract := grantingContracts[1], grantingContracts[2]
+	allowanceGranter := testutils.AccAddress()
+	var allowanceCharges []sdk.Coins
+	var grantRequests []*types.MsgRequestCallback
+	keeper.SetFeePayerKeepers(
+		testutils.MockCWFeesKeeper{
+			IsGrantingContractFn: func(_ context.Context, granter sdk.AccAddress) (bool, error) {
+				return granter.Equals(grantingContract) || granter.Equals(rejectingContract), nil
+			},
+			RequestGrantFn: func(_ context.Context, granter sdk.AccAddress, msgs []sdk.Msg, wantFees sdk.Coins, _ []sdk.AccAddress) (sdk.Coins, error) {
+				grantRequests = append(grantRequests, msgs[0].(*types.MsgRequestCallback))
+				if granter.Equals(rejectingContract) {
+					return nil, fmt.Errorf("grant rejected")
+				}
+				return wantFees, nil
+			},
+		},
+		testutils.MockFeeGrantKeeper{
+			GetAllowanceFn: func(_ context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+				if granter.Equals(allowanceGranter) && grantee.Equals(contractAdminAcc) {
+					return &feegrant.BasicAllowance{}, nil
+				}
+				return nil, fmt.Errorf("fee-grant not found")
+			},
+			UseGrantedFeesFn: func(_ context.Context, _, _ sdk.AccAddress, fee sdk.Coins, _ []sdk.Msg) error {
+				allowanceCharges = append(allowanceCharges, fee)
+				return nil
+			},
+		},
+	)
+
+	msgServer := callbackKeeper.NewMsgServer(keeper)
+	refundAcc := testutils.AccAddress()
+	newRequestMsg := func(jobID uint64, feePayer sdk.AccAddress) *types.MsgRequestCallback {
+		return types.NewMsgRequestCallback(contractAdminAcc, contractAddr, jobID, 130, sdk.Coin{}, 0, 0, nil, nil, 0, feePayer, refundAcc, nil)
+	}
+
+	t.Run("FAIL: fee payer is neither a granting contract nor an allowance granter", func(t *testing.T) {
+		_, err := msgServer.RequestCallback(ctx, newRequestMsg(1, testutils.AccAddress()))
+		require.ErrorIs(t, err, types.ErrFeePayerNotAllowed)
+	})
+
+	t.Run("FAIL: granting contract does not pay the reservation fees", func(t *testing.T) {
+		// The callback saved before the charge is reverted along with the transaction
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.RequestCallback(cacheCtx, newRequestMsg(1, rejectingContract))
+		require.ErrorIs(t, err, types.ErrFeePayerNotAllowed)
+	})
+
+	t.Run("FAIL: granting contract is not asked for a callback the sender is not authorized to reserve", func(t *testing.T) {
+		grantRequests = nil
+		msg := types.NewMsgRequestCallback(testutils.AccAddress(), contractAddr, 1, 130, sdk.Coin{}, 0, 0, nil, nil, 0, grantingContract, refundAcc, nil)
+		_, err := msgServer.RequestCallback(ctx, msg)
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+		require.Empty(t, grantRequests)
+	})
+
+	t.Run("OK: fee payer is a granting contract", func(t *testing.T) {
+		grantRequests = nil
+		_, err := msgServer.RequestCallback(ctx, newRequestMsg(1, grantingContract))
+		require.NoError(t, err)
+
+		// The granting contract is asked for the callback with the gas limit it is saved with
+		params, err := keeper.GetParams(ctx)
+		require.NoError(t, err)
+		require.Len(t, grantRequests, 1)
+		require.Equal(t, params.CallbackGasLimit, grantRequests[0].GasLimit)
+
+		callback, err := keeper.GetCallback(ctx, 130, contractAddr.String(), 1)
+		require.NoError(t, err)
+		require.Equal(t, grantingContract.String(), callback.FeePayer)
+		require.Equal(t, refundAcc.String(), callback.RefundAddress)
+		require.True(t, callback.FeeSplit.TransactionFees.IsPositive())
+		require.True(t, callback.FeeSplit.SurplusFees.IsZero())
+	})
+
+	t.Run("OK: fee payer granted an allowance to the sender", func(t *testing.T) {
+		_, err := msgServer.RequestCallback(ctx, newRequestMsg(2, allowanceGranter))
+		require.NoError(t, err)
+
+		// The reservation fees are charged from the allowance at registration
+		callback, err := keeper.GetCallback(ctx, 130, contractAddr.String(), 2)
+		require.NoError(t, err)
+		require.Len(t, allowanceCharges, 1)
+		require.Equal(t, sdk.NewCoins(callback.FeeSplit.BlockReservationFees.Add(*callback.FeeSplit.FutureReservationFees)), allowanceCharges[0])
+	})
+
+	t.Run("OK: cancelling a callback paid on execution refunds nothing", func(t *testing.T) {
+		res, err := msgServer.CancelCallback(ctx, types.NewMsgCancelCallback(contractAdminAcc, contractAddr, 1, 130, nil))
+		require.NoError(t, err)
+		require.True(t, res.Refund.IsZero())
+	})
+
+	t.Run("FAIL: fees sent to move a callback paid on execution", func(t *testing.T) {
+		msg := types.NewMsgUpdateCallback(contractAdminAcc, contractAddr, 2, 130, nil, 140, nil, sdk.NewInt64Coin("stake", 10))
+		_, err := msgServer.UpdateCallback(ctx, msg)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+
+	t.Run("OK: moving a callback paid on execution asks the fee payer", func(t *testing.T) {
+		msg := types.NewMsgUpdateCallback(contractAdminAcc, contractAddr, 2, 130, nil, 140, nil, sdk.Coin{})
+		_, err := msgServer.UpdateCallback(ctx, msg)
+		require.NoError(t, err)
+		require.Len(t, allowanceCharges, 2)
+	})
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/archway-network/archway/x/callback/types"
+)
+
+// SaveCallbackReceipt stores the execution receipt of a callback and queues it for deletion after
+// the ReceiptRetentionBlocks module param. If the param is set to zero, the receipt is not stored
+func (k Keeper) SaveCallbackReceipt(ctx sdk.Context, receipt types.CallbackReceipt) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.ReceiptRetentionBlocks == 0 {
+		return nil
+	}
+	contractAddress, err := sdk.AccAddressFromBech32(receipt.ContractAddress)
+	if err != nil {
+		return err
+	}
+
+	receiptID, err := k.ReceiptSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.ContractReceipts.Set(ctx, collections.Join(contractAddress.Bytes(), receiptID)); err != nil {
+		return err
+	}
+	deletionHeight := ctx.BlockHeight() + params.ReceiptRetentionBlocks
+	if err := k.ReceiptDeletionBlocks.Set(ctx, collections.Join(deletionHeight, receiptID)); err != nil {
+		return err
+	}
+	return k.Receipts.Set(ctx, receiptID, receipt)
+}
+
+// GetCallbackReceipts returns the execution receipts in the order the callbacks were executed.
+// If a contract address is given, only the receipts of the given contract are returned
+func (k Keeper) GetCallbackReceipts(ctx sdk.Context, contractAddr sdk.AccAddress, pageReq *query.PageRequest) ([]*types.CallbackReceipt, *query.PageResponse, error) {
+	if contractAddr.Empty() {
+		return query.CollectionPaginate(
+			ctx,
+			k.Receipts,
+			pageReq,
+			func(_ uint64, receipt types.CallbackReceipt) (*types.CallbackReceipt, error) {
+				return &receipt, nil
+			},
+		)
+	}
+	return query.CollectionPaginate(
+		ctx,
+		k.ContractReceipts,
+		pageReq,
+		func(key collections.Pair[[]byte, uint64], _ collections.NoValue) (*types.CallbackReceipt, error) {
+			receipt, err := k.Receipts.Get(ctx, key.K2())
+			if err != nil {
+				return nil, err
+			}
+			return &receipt, nil
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, uint64](contractAddr.Bytes()),
+	)
+}
+
+// PruneCallbackReceipts removes all the execution receipts which are queued to be deleted at the current block height
+func (k Keeper) PruneCallbackReceipts(ctx sdk.Context) error {
+	var receiptIDs []uint64
+	height := ctx.BlockHeight()
+	rng := collections.NewPrefixedPairRange[int64, uint64](height)
+	err := k.ReceiptDeletionBlocks.Walk(ctx, rng, func(key collections.Pair[int64, uint64]) (bool, error) {
+		receiptIDs = append(receiptIDs, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, receiptID := range receiptIDs {
+		receipt, err := k.Receipts.Get(ctx, receiptID)
+		if err != nil {
+			return err
+		}
+		// Removing the receipt data
+		if err := k.Receipts.Remove(ctx, receiptID); err != nil {
+			return err
+		}
+		// Removing the contract receipts
+		contractAddress := sdk.MustAccAddressFromBech32(receipt.ContractAddress)
+		if err := k.ContractReceipts.Remove(ctx, collections.Join(contractAddress.Bytes(), receiptID)); err != nil {
+			return err
+		}
+		// Removing the deletion block
+		if err := k.ReceiptDeletionBlocks.Remove(ctx, collections.Join(height, receiptID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/x/callback/types"
+)
+
+func (s *KeeperTestSuite) TestCallbackReceipts() {
+	ctx, keeper := s.chain.GetContext().WithBlockHeight(100), s.chain.GetApp().Keepers.CallbackKeeper
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.ReceiptRetentionBlocks = 10
+	s.Require().NoError(keeper.SetParams(ctx, params))
+
+	newReceipt := func(contractAddr sdk.AccAddress, jobID uint64) types.CallbackReceipt {
+		return types.CallbackReceipt{
+			ContractAddress: contractAddr.String(),
+			JobId:           jobID,
+			Height:          ctx.BlockHeight(),
+			GasUsed:         1000,
+			Success:         true,
+			RefundAmount:    sdk.NewInt64Coin("stake", 10),
+		}
+	}
+	s.Require().NoError(keeper.SaveCallbackReceipt(ctx, newReceipt(contractAddrs[0], 1)))
+	s.Require().NoError(keeper.SaveCallbackReceipt(ctx, newReceipt(contractAddrs[1], 1)))
+	s.Require().NoError(keeper.SaveCallbackReceipt(ctx, newReceipt(contractAddrs[0], 2)))
+
+	s.Run("OK: get all receipts", func() {
+		receipts, _, err := keeper.GetCallbackReceipts(ctx, nil, nil)
+		s.Require().NoError(err)
+		s.Require().Len(receipts, 3)
+	})
+
+	s.Run("OK: get receipts by contract", func() {
+		receipts, _, err := keeper.GetCallbackReceipts(ctx, contractAddrs[0], nil)
+		s.Require().NoError(err)
+		s.Require().Len(receipts, 2)
+		s.Assert().Equal(newReceipt(contractAddrs[0], 1), *receipts[0])
+		s.Assert().Equal(newReceipt(contractAddrs[0], 2), *receipts[1])
+	})
+
+	s.Run("OK: get receipts with pagination", func() {
+		receipts, pageResp, err := keeper.GetCallbackReceipts(ctx, nil, &query.PageRequest{Limit: 2})
+		s.Require().NoError(err)
+		s.Require().Len(receipts, 2)
+		s.Require().NotNil(pageResp.NextKey)
+	})
+
+	s.Run("OK: receipts are kept until the retention height", func() {
+		s.Require().NoError(keeper.PruneCallbackReceipts(ctx.WithBlockHeight(109)))
+		receipts, _, err := keeper.GetCallbackReceipts(ctx, nil, nil)
+		s.Require().NoError(err)
+		s.Require().Len(receipts, 3)
+	})
+
+	s.Run("OK: receipts are pruned at the retention height", func() {
+		s.Require().NoError(keeper.PruneCallbackReceipts(ctx.WithBlockHeight(110)))
+		receipts, _, err := keeper.GetCallbackReceipts(ctx, nil, nil)
+		s.Require().NoError(err)
+		s.Require().Empty(receipts)
+		receipts, _, err = keeper.GetCallbackReceipts(ctx, contractAddrs[0], nil)
+		s.Require().NoError(err)
+		s.Require().Empty(receipts)
+	})
+
+	s.Run("OK: receipts are not kept if the retention is disabled", func() {
+		params.ReceiptRetentionBlocks = 0
+		s.Require().NoError(keeper.SetParams(ctx, params))
+		s.Require().NoError(keeper.SaveCallbackReceipt(ctx, newReceipt(contractAddrs[0], 3)))
+		receipts, _, err := keeper.GetCallbackReceipts(ctx, nil, nil)
+		s.Require().NoError(err)
+		s.Require().Empty(receipts)
+	})
+}
@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // NewCallback creates a new Callback instance.
 func NewCallback(sender string, contractAddress string, height int64, jobID uint64, txFees sdk.Coin, blockReservationFees sdk.Coin, futureReservationFees sdk.Coin, surplusFees sdk.Coin) Callback {
@@ -26,9 +29,39 @@ func (c Callback) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(c.GetReservedBy()); err != nil {
 		return err
 	}
-	if c.GetCallbackHeight() <= 0 {
+	if c.GetFeePayer() != "" {
+		if _, err := sdk.AccAddressFromBech32(c.GetFeePayer()); err != nil {
+			return err
+		}
+	}
+	if c.GetRefundAddress() != "" {
+		if _, err := sdk.AccAddressFromBech32(c.GetRefundAddress()); err != nil {
+			return err
+		}
+	}
+	if c.IsTimed() {
+		if c.GetCallbackHeight() != 0 {
+			return errorsmod.Wrap(ErrCallbackHeightNotInFuture, "callback height set on a callback executed at a block time")
+		}
+		if c.IsRecurring() {
+			return errorsmod.Wrap(ErrInvalidRecurrence, "callbacks executed at a block time can not be recurring")
+		}
+	} else if c.GetCallbackHeight() <= 0 {
 		return ErrCallbackHeightNotInFuture
 	}
+	if !c.IsRecurring() && c.GetRemainingExecutions() != 0 {
+		return errorsmod.Wrap(ErrInvalidRecurrence, "remaining executions set on a one-off callback")
+	}
+	if c.RetryPolicy != nil {
+		if err := c.RetryPolicy.Validate(); err != nil {
+			return err
+		}
+		if c.GetFailedAttempts() >= c.RetryPolicy.MaxAttempts {
+			return errorsmod.Wrap(ErrInvalidRetryPolicy, "failed attempts exceed the max attempts")
+		}
+	} else if c.GetFailedAttempts() != 0 {
+		return errorsmod.Wrap(ErrInvalidRetryPolicy, "failed attempts set on a callback without a retry policy")
+	}
 	if err := c.GetFeeSplit().GetTransactionFees().Validate(); err != nil {
 		return err
 	}
@@ -43,3 +76,58 @@ func (c Callback) Validate() error {
 	}
 	return nil
 }
+
+// IsTimed returns true if the callback is executed at a block time instead of a block height.
+func (c Callback) IsTimed() bool {
+	return c.CallbackTime != nil
+}
+
+// IsRecurring returns true if the callback is rescheduled after its execution.
+func (c Callback) IsRecurring() bool {
+	return c.Interval > 0
+}
+
+// HasNextExecution returns true if the callback is to be rescheduled once the pending execution is done.
+func (c Callback) HasNextExecution() bool {
+	return c.IsRecurring() && c.RemainingExecutions != 1
+}
+
+// IsPaidOnExecution returns true if the fees of the callback are paid by its fee payer, the transaction fees being
+// charged when it is executed rather than upfront.
+func (c Callback) IsPaidOnExecution() bool {
+	return c.FeePayer != ""
+}
+
+// Attempt returns the number of the pending execution attempt, starting at 1.
+func (c Callback) Attempt() uint64 {
+	return c.FailedAttempts + 1
+}
+
+// CanRetry returns true if the pending execution is to be attempted again should it fail.
+func (c Callback) CanRetry() bool {
+	return c.RetryPolicy != nil && c.Attempt() < c.RetryPolicy.MaxAttempts
+}
+
+// RefundRecipient returns the address the unused fees of the callback are refunded to.
+// It is the refund address if set, or else the fee payer if set, or else the address which reserved the callback.
+func (c Callback) RefundRecipient() string {
+	switch {
+	case c.RefundAddress != "":
+		return c.RefundAddress
+	case c.FeePayer != "":
+		return c.FeePayer
+	default:
+		return c.ReservedBy
+	}
+}
+
+// Validate perform object fields validation.
+func (p RetryPolicy) Validate() error {
+	if p.MaxAttempts < 2 {
+		return errorsmod.Wrap(ErrInvalidRetryPolicy, "max attempts must be at least 2")
+	}
+	if p.BackoffBlocks == 0 {
+		return errorsmod.Wrap(ErrInvalidRetryPolicy, "backoff blocks must be positive")
+	}
+	return nil
+}
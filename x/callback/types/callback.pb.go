@@ -11,15 +11,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,13 +32,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BlockReservationPricing defines the pricing models of the block reservation fees of a callback.
+type BlockReservationPricing int32
+
+const (
+	// BLOCK_RESERVATION_PRICING_LINEAR charges the block_reservation_fee_multiplier for every callback already registered at the height.
+	BlockReservationPricing_BLOCK_RESERVATION_PRICING_LINEAR BlockReservationPricing = 0
+	// BLOCK_RESERVATION_PRICING_CONGESTION charges the congestion_base_fee when the target_block_reservations callbacks are registered at the height,
+	// compounded by the congestion_fee_change_rate for every callback above the target and discounted by it for every callback below.
+	BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION BlockReservationPricing = 1
+)
+
+var BlockReservationPricing_name = map[int32]string{
+	0: "BLOCK_RESERVATION_PRICING_LINEAR",
+	1: "BLOCK_RESERVATION_PRICING_CONGESTION",
+}
+
+var BlockReservationPricing_value = map[string]int32{
+	"BLOCK_RESERVATION_PRICING_LINEAR":     0,
+	"BLOCK_RESERVATION_PRICING_CONGESTION": 1,
+}
+
+func (x BlockReservationPricing) String() string {
+	return proto.EnumName(BlockReservationPricing_name, int32(x))
+}
+
+func (BlockReservationPricing) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_91c209d2fabf62aa, []int{0}
+}
+
 // Callback defines the callback structure.
 type Callback struct {
 	// contract_address is the address of the contract which is requesting the callback (bech32 encoded).
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	// job_id is an identifier the callback requestor can pass in to identify the callback when it happens.
 	JobId uint64 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	// callback_height is the height at which the callback is executed.
+	// callback_height is the height at which the callback is executed. Empty for callbacks executed at a block time.
 	CallbackHeight int64 `protobuf:"varint,3,opt,name=callback_height,json=callbackHeight,proto3" json:"callback_height,omitempty"`
 	// fee_split is the breakdown of the fees paid by the contract to reserve the callback
 	FeeSplit *CallbackFeesFeeSplit `protobuf:"bytes,4,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split,omitempty"`
@@ -41,6 +75,27 @@ type Callback struct {
 	ReservedBy string `protobuf:"bytes,5,opt,name=reserved_by,json=reservedBy,proto3" json:"reserved_by,omitempty"`
 	// callback_gas_limit is the maximum gas that can be consumed by this callback.
 	MaxGasLimit uint64 `protobuf:"varint,6,opt,name=max_gas_limit,json=maxGasLimit,proto3" json:"max_gas_limit,omitempty"`
+	// interval is the number of blocks after which a recurring callback is rescheduled once executed.
+	// A zero value denotes a one-off callback.
+	Interval uint64 `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	// remaining_executions is the number of executions left for a recurring callback, including the pending one.
+	// A zero value on a recurring callback denotes that it is rescheduled until cancelled or until its surplus fees run out.
+	RemainingExecutions uint64 `protobuf:"varint,8,opt,name=remaining_executions,json=remainingExecutions,proto3" json:"remaining_executions,omitempty"`
+	// payload is the opaque data passed back to the contract when the callback is executed.
+	Payload []byte `protobuf:"bytes,9,opt,name=payload,proto3" json:"payload,omitempty"`
+	// callback_time is the block time at or after which the callback is executed. Empty for callbacks executed at a height.
+	CallbackTime *time.Time `protobuf:"bytes,10,opt,name=callback_time,json=callbackTime,proto3,stdtime" json:"callback_time,omitempty"`
+	// fee_payer is the address which pays the fees of the callback (bech32 encoded): the reservation fees when it is
+	// registered and the transaction fees when it is executed.
+	// Empty for callbacks whose fees were prepaid by reserved_by at registration.
+	FeePayer string `protobuf:"bytes,11,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// refund_address is the address the unused fees of the callback are refunded to (bech32 encoded).
+	// Empty to refund the fee payer if set, or reserved_by otherwise.
+	RefundAddress string `protobuf:"bytes,12,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// retry_policy is the policy by which a failed execution of the callback is retried. Empty if failures are not retried.
+	RetryPolicy *RetryPolicy `protobuf:"bytes,13,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// failed_attempts is the number of failed attempts of the pending execution so far.
+	FailedAttempts uint64 `protobuf:"varint,14,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
 }
 
 func (m *Callback) Reset()         { *m = Callback{} }
@@ -118,6 +173,117 @@ func (m *Callback) GetMaxGasLimit() uint64 {
 	return 0
 }
 
+func (m *Callback) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Callback) GetRemainingExecutions() uint64 {
+	if m != nil {
+		return m.RemainingExecutions
+	}
+	return 0
+}
+
+func (m *Callback) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *Callback) GetCallbackTime() *time.Time {
+	if m != nil {
+		return m.CallbackTime
+	}
+	return nil
+}
+
+func (m *Callback) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *Callback) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *Callback) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *Callback) GetFailedAttempts() uint64 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
+// RetryPolicy defines how a callback whose execution failed is retried.
+type RetryPolicy struct {
+	// max_attempts is the maximum number of times an execution is attempted, including the first attempt.
+	MaxAttempts uint64 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// backoff_blocks is the number of blocks after which a failed execution is attempted again.
+	BackoffBlocks uint64 `protobuf:"varint,2,opt,name=backoff_blocks,json=backoffBlocks,proto3" json:"backoff_blocks,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91c209d2fabf62aa, []int{1}
+}
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetMaxAttempts() uint64 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetBackoffBlocks() uint64 {
+	if m != nil {
+		return m.BackoffBlocks
+	}
+	return 0
+}
+
 // CallbackFeesFeeSplit is the breakdown of all the fees that need to be paid by the contract to reserve a callback
 type CallbackFeesFeeSplit struct {
 	// transaction_fees is the transaction fees for the callback based on its gas consumption
@@ -134,7 +300,7 @@ func (m *CallbackFeesFeeSplit) Reset()         { *m = CallbackFeesFeeSplit{} }
 func (m *CallbackFeesFeeSplit) String() string { return proto.CompactTextString(m) }
 func (*CallbackFeesFeeSplit) ProtoMessage()    {}
 func (*CallbackFeesFeeSplit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91c209d2fabf62aa, []int{1}
+	return fileDescriptor_91c209d2fabf62aa, []int{2}
 }
 func (m *CallbackFeesFeeSplit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,7 +359,7 @@ func (m *CallbackFeesFeeSplit) GetSurplusFees() *types.Coin {
 
 // Params defines the module parameters.
 type Params struct {
-	// callback_gas_limit is the maximum gas that can be consumed by a callback.
+	// callback_gas_limit is the gas limit of a callback which does not request a gas limit at registration.
 	CallbackGasLimit uint64 `protobuf:"varint,1,opt,name=callback_gas_limit,json=callbackGasLimit,proto3" json:"callback_gas_limit,omitempty"`
 	// max_block_reservation_limit is the maximum number of callbacks which can be registered in a given block.
 	MaxBlockReservationLimit uint64 `protobuf:"varint,2,opt,name=max_block_reservation_limit,json=maxBlockReservationLimit,proto3" json:"max_block_reservation_limit,omitempty"`
@@ -203,13 +369,46 @@ type Params struct {
 	BlockReservationFeeMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=block_reservation_fee_multiplier,json=blockReservationFeeMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"block_reservation_fee_multiplier"`
 	// future_reservation_fee_multiplier is used to calculate a part of the reservation fees which will need to be paid while requesting the callback.
 	FutureReservationFeeMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=future_reservation_fee_multiplier,json=futureReservationFeeMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"future_reservation_fee_multiplier"`
+	// max_payload_size is the maximum size in bytes of the payload which can be attached to a callback.
+	MaxPayloadSize uint64 `protobuf:"varint,6,opt,name=max_payload_size,json=maxPayloadSize,proto3" json:"max_payload_size,omitempty"`
+	// payload_fee_multiplier is used to calculate the part of the block reservation fees which is charged per byte of the callback payload.
+	PayloadFeeMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=payload_fee_multiplier,json=payloadFeeMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"payload_fee_multiplier"`
+	// max_future_reservation_time is the maximum duration in the future that a contract can request a callback at a block time in.
+	MaxFutureReservationTime time.Duration `protobuf:"bytes,8,opt,name=max_future_reservation_time,json=maxFutureReservationTime,proto3,stdduration" json:"max_future_reservation_time"`
+	// future_reservation_time_fee_multiplier is used to calculate the future reservation fees per second for callbacks requested at a block time.
+	FutureReservationTimeFeeMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=future_reservation_time_fee_multiplier,json=futureReservationTimeFeeMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"future_reservation_time_fee_multiplier"`
+	// max_callback_gas_limit is the maximum gas limit a callback can request at registration.
+	MaxCallbackGasLimit uint64 `protobuf:"varint,10,opt,name=max_callback_gas_limit,json=maxCallbackGasLimit,proto3" json:"max_callback_gas_limit,omitempty"`
+	// block_callback_gas_limit is the maximum total gas which can be consumed by the callbacks executed in a block.
+	// Callbacks which do not fit are deferred to the next block.
+	BlockCallbackGasLimit uint64 `protobuf:"varint,11,opt,name=block_callback_gas_limit,json=blockCallbackGasLimit,proto3" json:"block_callback_gas_limit,omitempty"`
+	// receipt_retention_blocks is the number of blocks an execution receipt is kept in state after the callback is executed.
+	// A zero value disables the execution receipts.
+	ReceiptRetentionBlocks int64 `protobuf:"varint,12,opt,name=receipt_retention_blocks,json=receiptRetentionBlocks,proto3" json:"receipt_retention_blocks,omitempty"`
+	// max_event_subscriptions_per_contract is the maximum number of event subscriptions a contract can have at the same time.
+	MaxEventSubscriptionsPerContract uint64 `protobuf:"varint,13,opt,name=max_event_subscriptions_per_contract,json=maxEventSubscriptionsPerContract,proto3" json:"max_event_subscriptions_per_contract,omitempty"`
+	// max_events_per_delivery is the maximum number of events delivered to a subscriber in a block. Any further events are dropped.
+	MaxEventsPerDelivery uint64 `protobuf:"varint,14,opt,name=max_events_per_delivery,json=maxEventsPerDelivery,proto3" json:"max_events_per_delivery,omitempty"`
+	// block_reservation_pricing is the pricing model of the block reservation fees.
+	BlockReservationPricing BlockReservationPricing `protobuf:"varint,15,opt,name=block_reservation_pricing,json=blockReservationPricing,proto3,enum=archway.callback.v1.BlockReservationPricing" json:"block_reservation_pricing,omitempty"`
+	// target_block_reservations is the number of callbacks registered at a height at which the block reservation fees
+	// equal the congestion_base_fee, when the congestion pricing model is used.
+	TargetBlockReservations uint64 `protobuf:"varint,16,opt,name=target_block_reservations,json=targetBlockReservations,proto3" json:"target_block_reservations,omitempty"`
+	// congestion_base_fee is the block reservation fees at the target_block_reservations, when the congestion pricing model is used.
+	CongestionBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,17,opt,name=congestion_base_fee,json=congestionBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"congestion_base_fee"`
+	// congestion_fee_change_rate is the rate by which the block reservation fees change for every callback registered at a height
+	// above or below the target_block_reservations, when the congestion pricing model is used.
+	CongestionFeeChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,18,opt,name=congestion_fee_change_rate,json=congestionFeeChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"congestion_fee_change_rate"`
+	// max_event_subscriptions_per_type is the maximum number of event subscriptions which can be registered for the same event type,
+	// as every event emitted by a message is matched against all the subscriptions of its type at the expense of the transaction sender.
+	MaxEventSubscriptionsPerType uint64 `protobuf:"varint,19,opt,name=max_event_subscriptions_per_type,json=maxEventSubscriptionsPerType,proto3" json:"max_event_subscriptions_per_type,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91c209d2fabf62aa, []int{2}
+	return fileDescriptor_91c209d2fabf62aa, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,347 +458,2605 @@ func (m *Params) GetMaxFutureReservationLimit() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Callback)(nil), "archway.callback.v1.Callback")
-	proto.RegisterType((*CallbackFeesFeeSplit)(nil), "archway.callback.v1.CallbackFeesFeeSplit")
-	proto.RegisterType((*Params)(nil), "archway.callback.v1.Params")
+func (m *Params) GetMaxPayloadSize() uint64 {
+	if m != nil {
+		return m.MaxPayloadSize
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("archway/callback/v1/callback.proto", fileDescriptor_91c209d2fabf62aa)
+func (m *Params) GetMaxFutureReservationTime() time.Duration {
+	if m != nil {
+		return m.MaxFutureReservationTime
+	}
+	return 0
 }
 
-var fileDescriptor_91c209d2fabf62aa = []byte{
-	// 624 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xda, 0xad, 0xda, 0x5c, 0x60, 0x93, 0xd9, 0x20, 0xdd, 0x20, 0x2b, 0xbd, 0xd0, 0x49,
-	0x2c, 0x51, 0xb7, 0x2b, 0x08, 0xd1, 0x4d, 0x05, 0xa4, 0x4d, 0x40, 0xb8, 0x71, 0x89, 0x1c, 0xc7,
-	0x4d, 0xbd, 0x26, 0x71, 0x65, 0x3b, 0x5d, 0x83, 0x38, 0xf1, 0x0b, 0xf8, 0x29, 0x1c, 0x38, 0x73,
-	0xde, 0x71, 0xe2, 0x84, 0x38, 0x4c, 0x68, 0xfb, 0x23, 0x28, 0x71, 0xd2, 0x4e, 0x5b, 0xa4, 0x49,
-	0xdc, 0x5e, 0xbe, 0xf7, 0xbe, 0xef, 0x3d, 0x7f, 0xcf, 0x31, 0x68, 0x23, 0x8e, 0x87, 0x27, 0x28,
-	0xb1, 0x30, 0x0a, 0x02, 0x17, 0xe1, 0x91, 0x35, 0xe9, 0xce, 0x62, 0x73, 0xcc, 0x99, 0x64, 0xf0,
-	0x7e, 0x5e, 0x63, 0xce, 0xf0, 0x49, 0x77, 0xa3, 0xe9, 0x33, 0xe6, 0x07, 0xc4, 0xca, 0x4a, 0xdc,
-	0x78, 0x60, 0xa1, 0x28, 0x51, 0xf5, 0x1b, 0x6b, 0x3e, 0xf3, 0x59, 0x16, 0x5a, 0x69, 0x94, 0xa3,
-	0x06, 0x66, 0x22, 0x64, 0xc2, 0x72, 0x91, 0x20, 0xd6, 0xa4, 0xeb, 0x12, 0x89, 0xba, 0x16, 0x66,
-	0x34, 0xca, 0xf3, 0x4d, 0x95, 0x77, 0x14, 0x51, 0x7d, 0xa8, 0x54, 0xfb, 0x6b, 0x15, 0x2c, 0xed,
-	0xe7, 0xbd, 0xe1, 0x36, 0x58, 0xc5, 0x2c, 0x92, 0x1c, 0x61, 0xe9, 0x20, 0xcf, 0xe3, 0x44, 0x08,
-	0x5d, 0x6b, 0x69, 0x9d, 0x65, 0x7b, 0xa5, 0xc0, 0x5f, 0x29, 0x18, 0xae, 0x83, 0xfa, 0x31, 0x73,
-	0x1d, 0xea, 0xe9, 0xd5, 0x96, 0xd6, 0x59, 0xb0, 0x17, 0x8f, 0x99, 0xfb, 0xd6, 0x83, 0x4f, 0xc1,
-	0x4a, 0x71, 0x12, 0x67, 0x48, 0xa8, 0x3f, 0x94, 0x7a, 0xad, 0xa5, 0x75, 0x6a, 0xf6, 0xbd, 0x02,
-	0x7e, 0x93, 0xa1, 0xb0, 0x0f, 0x96, 0x07, 0x84, 0x38, 0x62, 0x1c, 0x50, 0xa9, 0x2f, 0xb4, 0xb4,
-	0x4e, 0x63, 0x77, 0xdb, 0x2c, 0x31, 0xc3, 0x2c, 0x86, 0xeb, 0x13, 0x22, 0xfa, 0x84, 0x7c, 0x4c,
-	0x09, 0xf6, 0xd2, 0x20, 0x8f, 0xe0, 0x16, 0x68, 0x70, 0x22, 0x08, 0x9f, 0x10, 0xcf, 0x71, 0x13,
-	0x7d, 0x31, 0x9b, 0x16, 0x14, 0x50, 0x2f, 0x81, 0x6d, 0x70, 0x37, 0x44, 0x53, 0xc7, 0x47, 0xc2,
-	0x09, 0x68, 0x48, 0xa5, 0x5e, 0xcf, 0xe6, 0x6d, 0x84, 0x68, 0xfa, 0x1a, 0x89, 0xc3, 0x14, 0x6a,
-	0xff, 0xac, 0x82, 0xb5, 0xb2, 0x3e, 0xf0, 0x00, 0xac, 0x4a, 0x8e, 0x22, 0x81, 0xb0, 0xa4, 0x2c,
-	0x72, 0x06, 0x84, 0x28, 0x43, 0x1a, 0xbb, 0x4d, 0x33, 0xb7, 0x31, 0xf5, 0xdc, 0xcc, 0x3d, 0x37,
-	0xf7, 0x19, 0x8d, 0xec, 0x95, 0x2b, 0x94, 0x54, 0x0d, 0xbe, 0x03, 0x0f, 0xdc, 0x80, 0xe1, 0x91,
-	0xa3, 0xc6, 0x42, 0x73, 0xad, 0xea, 0x6d, 0x5a, 0x6b, 0x19, 0xd1, 0x9e, 0xf3, 0x32, 0xc1, 0x0f,
-	0xe0, 0xe1, 0x20, 0x96, 0x31, 0x27, 0x37, 0x15, 0x6b, 0xb7, 0x29, 0xae, 0x2b, 0xe6, 0x75, 0xc9,
-	0xe7, 0xe0, 0x8e, 0x88, 0xf9, 0x38, 0x88, 0x85, 0xd2, 0x59, 0xb8, 0x4d, 0xa7, 0x91, 0x97, 0xa7,
-	0xec, 0xf6, 0xf7, 0x1a, 0xa8, 0xbf, 0x47, 0x1c, 0x85, 0x02, 0x3e, 0x03, 0x70, 0x76, 0x03, 0xe6,
-	0xa6, 0x6b, 0x99, 0xe9, 0xab, 0x45, 0xa6, 0x70, 0x1e, 0xbe, 0x00, 0x9b, 0xe9, 0x76, 0x6e, 0xda,
-	0xa3, 0x68, 0xea, 0x6e, 0xe9, 0x21, 0x9a, 0xf6, 0xae, 0xf9, 0xa0, 0xe8, 0x2f, 0xc1, 0xa3, 0x94,
-	0x5e, 0x62, 0x86, 0xe2, 0xd7, 0x32, 0x7e, 0x33, 0x44, 0xd3, 0xfe, 0xf5, 0x53, 0x2b, 0x81, 0xcf,
-	0xa0, 0x55, 0xba, 0x1a, 0x27, 0x8c, 0x03, 0x49, 0xc7, 0x01, 0x25, 0x3c, 0xb3, 0x62, 0xb9, 0xd7,
-	0x3d, 0x3d, 0xdf, 0xaa, 0xfc, 0x39, 0xdf, 0xda, 0x54, 0x8e, 0x08, 0x6f, 0x64, 0x52, 0x66, 0x85,
-	0x48, 0x0e, 0xcd, 0x43, 0xe2, 0x23, 0x9c, 0x1c, 0x10, 0xfc, 0xeb, 0xc7, 0x0e, 0xc8, 0x0d, 0x3b,
-	0x20, 0xd8, 0x7e, 0x5c, 0xb2, 0xbc, 0xa3, 0x99, 0x2e, 0xfc, 0x02, 0x9e, 0x94, 0x6f, 0xf1, 0x6a,
-	0xf3, 0xc5, 0xff, 0x6d, 0x6e, 0x94, 0xed, 0x79, 0xde, 0xbd, 0x77, 0x74, 0x7a, 0x61, 0x68, 0x67,
-	0x17, 0x86, 0xf6, 0xf7, 0xc2, 0xd0, 0xbe, 0x5d, 0x1a, 0x95, 0xb3, 0x4b, 0xa3, 0xf2, 0xfb, 0xd2,
-	0xa8, 0x7c, 0xda, 0xf3, 0xa9, 0x1c, 0xc6, 0xae, 0x89, 0x59, 0x68, 0xe5, 0x7f, 0xe4, 0x4e, 0x44,
-	0xe4, 0x09, 0xe3, 0xa3, 0xe2, 0xdb, 0x9a, 0xce, 0x1f, 0x35, 0x99, 0x8c, 0x89, 0x70, 0xeb, 0xd9,
-	0x73, 0xb2, 0xf7, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x71, 0xd4, 0xb7, 0x78, 0xf5, 0x04, 0x00, 0x00,
+func (m *Params) GetMaxCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.MaxCallbackGasLimit
+	}
+	return 0
 }
 
-func (m *Callback) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetBlockCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.BlockCallbackGasLimit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Callback) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetReceiptRetentionBlocks() int64 {
+	if m != nil {
+		return m.ReceiptRetentionBlocks
+	}
+	return 0
 }
 
-func (m *Callback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxGasLimit != 0 {
-		i = encodeVarintCallback(dAtA, i, uint64(m.MaxGasLimit))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.ReservedBy) > 0 {
-		i -= len(m.ReservedBy)
-		copy(dAtA[i:], m.ReservedBy)
-		i = encodeVarintCallback(dAtA, i, uint64(len(m.ReservedBy)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.FeeSplit != nil {
-		{
-			size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCallback(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.CallbackHeight != 0 {
-		i = encodeVarintCallback(dAtA, i, uint64(m.CallbackHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.JobId != 0 {
-		i = encodeVarintCallback(dAtA, i, uint64(m.JobId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintCallback(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *Params) GetMaxEventSubscriptionsPerContract() uint64 {
+	if m != nil {
+		return m.MaxEventSubscriptionsPerContract
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CallbackFeesFeeSplit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetMaxEventsPerDelivery() uint64 {
+	if m != nil {
+		return m.MaxEventsPerDelivery
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CallbackFeesFeeSplit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetBlockReservationPricing() BlockReservationPricing {
+	if m != nil {
+		return m.BlockReservationPricing
+	}
+	return BlockReservationPricing_BLOCK_RESERVATION_PRICING_LINEAR
 }
 
-func (m *CallbackFeesFeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SurplusFees != nil {
-		{
-			size, err := m.SurplusFees.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCallback(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.FutureReservationFees != nil {
-		{
-			size, err := m.FutureReservationFees.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCallback(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BlockReservationFees != nil {
-		{
-			size, err := m.BlockReservationFees.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCallback(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TransactionFees != nil {
-		{
-			size, err := m.TransactionFees.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCallback(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *Params) GetTargetBlockReservations() uint64 {
+	if m != nil {
+		return m.TargetBlockReservations
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetMaxEventSubscriptionsPerType() uint64 {
+	if m != nil {
+		return m.MaxEventSubscriptionsPerType
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CallbackReceipt is the record of a callback execution, kept in state for the receipt_retention_blocks module param.
+type CallbackReceipt struct {
+	// contract_address is the address of the contract which received the callback (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// job_id is an identifier of the callback.
+	JobId uint64 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// height is the height at which the callback was executed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// gas_used is the amount of gas consumed during the callback execution.
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// success is true if the callback was executed without errors.
+	Success bool `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// refund_amount is the amount of transaction fees refunded to the address which reserved the callback.
+	RefundAmount types.Coin `protobuf:"bytes,6,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.FutureReservationFeeMultiplier.Size()
-		i -= size
-		if _, err := m.FutureReservationFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *CallbackReceipt) Reset()         { *m = CallbackReceipt{} }
+func (m *CallbackReceipt) String() string { return proto.CompactTextString(m) }
+func (*CallbackReceipt) ProtoMessage()    {}
+func (*CallbackReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91c209d2fabf62aa, []int{4}
+}
+func (m *CallbackReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallbackReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintCallback(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.BlockReservationFeeMultiplier.Size()
-		i -= size
-		if _, err := m.BlockReservationFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *CallbackReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackReceipt.Merge(m, src)
+}
+func (m *CallbackReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallbackReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackReceipt proto.InternalMessageInfo
+
+func (m *CallbackReceipt) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *CallbackReceipt) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
+	}
+	return 0
+}
+
+func (m *CallbackReceipt) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CallbackReceipt) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *CallbackReceipt) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *CallbackReceipt) GetRefundAmount() types.Coin {
+	if m != nil {
+		return m.RefundAmount
+	}
+	return types.Coin{}
+}
+
+// EventSubscription defines a contract subscription to the events emitted by the transactions of a block.
+type EventSubscription struct {
+	// id is the unique identifier of the subscription.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// contract_address is the address of the contract which receives the matching events (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// event_type is the type of the events the contract is subscribed to, e.g. "wasm-liquidation" or "transfer".
+	EventType string `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// attribute_filters are the attributes an event needs to have to match the subscription.
+	// An attribute filter with an empty value matches any event which has an attribute with the given key.
+	AttributeFilters []EventAttribute `protobuf:"bytes,4,rep,name=attribute_filters,json=attributeFilters,proto3" json:"attribute_filters"`
+	// gas_limit is the maximum gas the contract can consume when the matching events are delivered in a block.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// reserved_by is the address which registered the subscription (bech32 encoded).
+	ReservedBy string `protobuf:"bytes,6,opt,name=reserved_by,json=reservedBy,proto3" json:"reserved_by,omitempty"`
+	// balance is the prepaid fees left to pay for the event deliveries.
+	Balance types.Coin `protobuf:"bytes,7,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *EventSubscription) Reset()         { *m = EventSubscription{} }
+func (m *EventSubscription) String() string { return proto.CompactTextString(m) }
+func (*EventSubscription) ProtoMessage()    {}
+func (*EventSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91c209d2fabf62aa, []int{5}
+}
+func (m *EventSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubscription.Merge(m, src)
+}
+func (m *EventSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSubscription proto.InternalMessageInfo
+
+func (m *EventSubscription) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventSubscription) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *EventSubscription) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *EventSubscription) GetAttributeFilters() []EventAttribute {
+	if m != nil {
+		return m.AttributeFilters
+	}
+	return nil
+}
+
+func (m *EventSubscription) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *EventSubscription) GetReservedBy() string {
+	if m != nil {
+		return m.ReservedBy
+	}
+	return ""
+}
+
+func (m *EventSubscription) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+// EventAttribute defines an event attribute key value pair.
+type EventAttribute struct {
+	// key is the attribute key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the attribute value.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventAttribute) Reset()         { *m = EventAttribute{} }
+func (m *EventAttribute) String() string { return proto.CompactTextString(m) }
+func (*EventAttribute) ProtoMessage()    {}
+func (*EventAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91c209d2fabf62aa, []int{6}
+}
+func (m *EventAttribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttribute.Merge(m, src)
+}
+func (m *EventAttribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttribute proto.InternalMessageInfo
+
+func (m *EventAttribute) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *EventAttribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// ContractEvent defines an event delivered to a subscribed contract.
+type ContractEvent struct {
+	// type is the event type.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// attributes are the event attributes.
+	Attributes []EventAttribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes"`
+}
+
+func (m *ContractEvent) Reset()         { *m = ContractEvent{} }
+func (m *ContractEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractEvent) ProtoMessage()    {}
+func (*ContractEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91c209d2fabf62aa, []int{7}
+}
+func (m *ContractEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEvent.Merge(m, src)
+}
+func (m *ContractEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEvent proto.InternalMessageInfo
+
+func (m *ContractEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ContractEvent) GetAttributes() []EventAttribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("archway.callback.v1.BlockReservationPricing", BlockReservationPricing_name, BlockReservationPricing_value)
+	proto.RegisterType((*Callback)(nil), "archway.callback.v1.Callback")
+	proto.RegisterType((*RetryPolicy)(nil), "archway.callback.v1.RetryPolicy")
+	proto.RegisterType((*CallbackFeesFeeSplit)(nil), "archway.callback.v1.CallbackFeesFeeSplit")
+	proto.RegisterType((*Params)(nil), "archway.callback.v1.Params")
+	proto.RegisterType((*CallbackReceipt)(nil), "archway.callback.v1.CallbackReceipt")
+	proto.RegisterType((*EventSubscription)(nil), "archway.callback.v1.EventSubscription")
+	proto.RegisterType((*EventAttribute)(nil), "archway.callback.v1.EventAttribute")
+	proto.RegisterType((*ContractEvent)(nil), "archway.callback.v1.ContractEvent")
+}
+
+func init() {
+	proto.RegisterFile("archway/callback/v1/callback.proto", fileDescriptor_91c209d2fabf62aa)
+}
+
+var fileDescriptor_91c209d2fabf62aa = []byte{
+	// 1486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x53, 0x1b, 0x47,
+	0x16, 0x47, 0x20, 0x40, 0x3c, 0x09, 0x21, 0x1a, 0x0c, 0x03, 0xb6, 0x85, 0xac, 0xf5, 0xee, 0xca,
+	0x5b, 0xb6, 0x54, 0xe0, 0xda, 0x5a, 0xef, 0xd6, 0x6e, 0x6d, 0x21, 0x01, 0x5e, 0x6a, 0x31, 0x90,
+	0xc1, 0x71, 0xaa, 0x72, 0x99, 0x6a, 0x8d, 0x5a, 0x43, 0x9b, 0xf9, 0xa3, 0xea, 0xee, 0x91, 0x25,
+	0x57, 0xae, 0xb9, 0xfb, 0x98, 0x5b, 0xbe, 0x44, 0xce, 0x39, 0xfb, 0xe8, 0xca, 0x29, 0x95, 0x83,
+	0x93, 0xb2, 0x53, 0xf9, 0x1c, 0xa9, 0xee, 0x9e, 0x19, 0x81, 0x18, 0xec, 0x84, 0xca, 0x6d, 0xfa,
+	0xfd, 0xf9, 0xf5, 0x7b, 0xbf, 0xf7, 0xfa, 0x75, 0x0f, 0x54, 0x31, 0xb3, 0x4f, 0x5f, 0xe0, 0x61,
+	0xc3, 0xc6, 0xae, 0xdb, 0xc6, 0xf6, 0x59, 0xa3, 0xbf, 0x99, 0x7c, 0xd7, 0x7b, 0x2c, 0x10, 0x01,
+	0x5a, 0x8a, 0x6c, 0xea, 0x89, 0xbc, 0xbf, 0xb9, 0xbe, 0xe6, 0x04, 0x81, 0xe3, 0x92, 0x86, 0x32,
+	0x69, 0x87, 0xdd, 0x06, 0xf6, 0x87, 0xda, 0x7e, 0xbd, 0x3c, 0xae, 0xea, 0x84, 0x0c, 0x0b, 0x1a,
+	0xf8, 0x91, 0x7e, 0x63, 0x5c, 0x2f, 0xa8, 0x47, 0xb8, 0xc0, 0x5e, 0x2f, 0x32, 0x58, 0x76, 0x02,
+	0x27, 0x50, 0x9f, 0x0d, 0xf9, 0x15, 0xc3, 0xda, 0x01, 0xf7, 0x02, 0xde, 0x68, 0x63, 0x4e, 0x1a,
+	0xfd, 0xcd, 0x36, 0x11, 0x78, 0xb3, 0x61, 0x07, 0x34, 0x86, 0x5d, 0xd3, 0x7a, 0x4b, 0x3b, 0xea,
+	0x85, 0x56, 0x55, 0x7f, 0xce, 0x42, 0xae, 0x15, 0x05, 0x8f, 0xee, 0x41, 0xc9, 0x0e, 0x7c, 0xc1,
+	0xb0, 0x2d, 0x2c, 0xdc, 0xe9, 0x30, 0xc2, 0xb9, 0x91, 0xa9, 0x64, 0x6a, 0x73, 0xe6, 0x42, 0x2c,
+	0xdf, 0xd6, 0x62, 0x74, 0x03, 0x66, 0x9e, 0x07, 0x6d, 0x8b, 0x76, 0x8c, 0xc9, 0x4a, 0xa6, 0x96,
+	0x35, 0xa7, 0x9f, 0x07, 0xed, 0xfd, 0x0e, 0xfa, 0x2b, 0x2c, 0xc4, 0x54, 0x58, 0xa7, 0x84, 0x3a,
+	0xa7, 0xc2, 0x98, 0xaa, 0x64, 0x6a, 0x53, 0x66, 0x31, 0x16, 0xff, 0x4f, 0x49, 0xd1, 0x1e, 0xcc,
+	0x75, 0x09, 0xb1, 0x78, 0xcf, 0xa5, 0xc2, 0xc8, 0x56, 0x32, 0xb5, 0xfc, 0xd6, 0xbd, 0x7a, 0x0a,
+	0x9b, 0xf5, 0x38, 0xb8, 0x3d, 0x42, 0xf8, 0x1e, 0x21, 0x27, 0xd2, 0xc1, 0xcc, 0x75, 0xa3, 0x2f,
+	0xb4, 0x01, 0x79, 0x46, 0x38, 0x61, 0x7d, 0xd2, 0xb1, 0xda, 0x43, 0x63, 0x5a, 0x45, 0x0b, 0xb1,
+	0xa8, 0x39, 0x44, 0x55, 0x98, 0xf7, 0xf0, 0xc0, 0x72, 0x30, 0xb7, 0x5c, 0xea, 0x51, 0x61, 0xcc,
+	0xa8, 0x78, 0xf3, 0x1e, 0x1e, 0x3c, 0xc6, 0xfc, 0x40, 0x8a, 0xd0, 0x3a, 0xe4, 0xa8, 0x2f, 0x08,
+	0xeb, 0x63, 0xd7, 0x98, 0x55, 0xea, 0x64, 0x8d, 0x36, 0x61, 0x99, 0x11, 0x0f, 0x53, 0x9f, 0xfa,
+	0x8e, 0x45, 0x06, 0xc4, 0x0e, 0x65, 0xbd, 0xb8, 0x91, 0x53, 0x76, 0x4b, 0x89, 0x6e, 0x37, 0x51,
+	0x21, 0x03, 0x66, 0x7b, 0x78, 0xe8, 0x06, 0xb8, 0x63, 0xcc, 0x55, 0x32, 0xb5, 0x82, 0x19, 0x2f,
+	0xd1, 0x2e, 0xcc, 0x27, 0xf4, 0xc8, 0xd2, 0x1a, 0xa0, 0x32, 0x5f, 0xaf, 0xeb, 0xba, 0xd7, 0xe3,
+	0xba, 0xd7, 0x9f, 0xc6, 0x75, 0x6f, 0x66, 0x5f, 0xfd, 0xb8, 0x91, 0x31, 0x0b, 0xb1, 0x9b, 0x54,
+	0xa0, 0x9b, 0x9a, 0xbc, 0x1e, 0x1e, 0x12, 0x66, 0xe4, 0x55, 0xca, 0x92, 0x91, 0x63, 0xb9, 0x46,
+	0x7f, 0x86, 0x22, 0x23, 0xdd, 0xd0, 0xef, 0x24, 0x25, 0x2c, 0x28, 0x8b, 0x79, 0x2d, 0x8d, 0x0b,
+	0xd8, 0x82, 0x02, 0x23, 0x82, 0x0d, 0xad, 0x5e, 0xe0, 0x52, 0x7b, 0x68, 0xcc, 0xab, 0x48, 0x2a,
+	0xa9, 0x35, 0x30, 0xa5, 0xe1, 0xb1, 0xb2, 0x33, 0xf3, 0x6c, 0xb4, 0x90, 0xe5, 0xee, 0x62, 0xea,
+	0x92, 0x8e, 0x85, 0x85, 0x20, 0x5e, 0x4f, 0x70, 0xa3, 0xa8, 0x78, 0x29, 0x6a, 0xf1, 0x76, 0x24,
+	0xad, 0x7e, 0x06, 0xf9, 0x73, 0x20, 0xe8, 0x0e, 0x14, 0x64, 0x51, 0x12, 0xa7, 0x4c, 0x52, 0x93,
+	0xd8, 0x43, 0xa6, 0x21, 0xb7, 0x0f, 0xba, 0x5d, 0xab, 0xed, 0x06, 0xf6, 0x19, 0x8f, 0x1a, 0x6d,
+	0x3e, 0x92, 0x36, 0x95, 0xb0, 0xfa, 0xed, 0x24, 0x2c, 0xa7, 0xb5, 0x08, 0xda, 0x81, 0x92, 0x60,
+	0xd8, 0xe7, 0xd8, 0x96, 0x45, 0xb1, 0xba, 0x84, 0xe8, 0x6d, 0xf2, 0x5b, 0x6b, 0xf5, 0xe8, 0x04,
+	0xc8, 0xe3, 0x52, 0x8f, 0x8e, 0x4b, 0xbd, 0x15, 0x50, 0xdf, 0x5c, 0x38, 0xe7, 0x22, 0xd1, 0xd0,
+	0x11, 0xac, 0xa8, 0xdd, 0x2d, 0xdd, 0x51, 0x78, 0x84, 0x35, 0xf9, 0x31, 0xac, 0x65, 0xe5, 0x68,
+	0x8e, 0xfc, 0x14, 0xe0, 0x27, 0xb0, 0xda, 0x0d, 0x45, 0xc8, 0xc8, 0x65, 0xc4, 0xa9, 0x8f, 0x21,
+	0xde, 0xd0, 0x9e, 0xe3, 0x90, 0xff, 0x86, 0x02, 0x0f, 0x59, 0xcf, 0x0d, 0xb9, 0xc6, 0xc9, 0x7e,
+	0x0c, 0x27, 0x1f, 0x99, 0x4b, 0xef, 0xea, 0xd7, 0x05, 0x98, 0x39, 0xc6, 0x0c, 0x7b, 0x1c, 0xdd,
+	0x07, 0x94, 0x74, 0xe7, 0xe8, 0xbc, 0xe8, 0xda, 0x94, 0x62, 0x4d, 0x72, 0x68, 0xfe, 0x03, 0x37,
+	0x65, 0x0d, 0x2f, 0xd3, 0xa3, 0xdd, 0x74, 0xb5, 0x0c, 0x0f, 0x0f, 0x9a, 0x63, 0x3c, 0x68, 0xf7,
+	0xff, 0xc2, 0x2d, 0xe9, 0x9e, 0x42, 0x86, 0xf6, 0x9f, 0x52, 0xfe, 0x6b, 0x1e, 0x1e, 0xec, 0x8d,
+	0x67, 0xad, 0x01, 0x5e, 0x42, 0x25, 0xb5, 0x34, 0x96, 0x17, 0xba, 0x82, 0xf6, 0x5c, 0x4a, 0x98,
+	0xa2, 0x62, 0xae, 0xb9, 0xf9, 0xfa, 0xed, 0xc6, 0xc4, 0x0f, 0x6f, 0x37, 0x6e, 0x6a, 0x46, 0x78,
+	0xe7, 0xac, 0x4e, 0x83, 0x86, 0x87, 0xc5, 0x69, 0xfd, 0x80, 0x38, 0xd8, 0x1e, 0xee, 0x10, 0xfb,
+	0xbb, 0x6f, 0x1e, 0x40, 0x44, 0xd8, 0x0e, 0xb1, 0xcd, 0xdb, 0x29, 0xc5, 0x7b, 0x92, 0xe0, 0xa2,
+	0x2f, 0xe0, 0x4e, 0x7a, 0x15, 0xcf, 0x6f, 0x3e, 0x7d, 0xdd, 0xcd, 0xcb, 0x69, 0x75, 0x3e, 0xb7,
+	0x7b, 0x0d, 0x4a, 0x92, 0xba, 0x68, 0xa8, 0x58, 0x9c, 0xbe, 0x24, 0xd1, 0x54, 0x2b, 0x7a, 0x78,
+	0x70, 0xac, 0xc5, 0x27, 0xf4, 0x25, 0x41, 0x0e, 0xac, 0xc4, 0x56, 0x63, 0xc1, 0xcd, 0x5e, 0x37,
+	0xb8, 0xe5, 0x08, 0xf0, 0x62, 0x48, 0x6d, 0xdd, 0x0c, 0x29, 0xa4, 0xa8, 0x31, 0x97, 0x8b, 0x5a,
+	0x72, 0x7c, 0xcc, 0xed, 0x44, 0xd7, 0x5f, 0x33, 0x27, 0x03, 0xf9, 0x4a, 0x4e, 0x3a, 0x23, 0xad,
+	0xe2, 0x6a, 0xea, 0x7d, 0x99, 0x81, 0xbf, 0x5c, 0xb1, 0xc1, 0x78, 0x76, 0x73, 0xd7, 0xcd, 0xae,
+	0xda, 0x4d, 0xdb, 0xfa, 0x62, 0xae, 0x0f, 0x61, 0x45, 0xe6, 0x9a, 0x72, 0x54, 0x40, 0xdf, 0x09,
+	0x1e, 0x1e, 0xb4, 0xc6, 0x4f, 0xcb, 0x3f, 0xc0, 0xd0, 0xdd, 0x9a, 0xe2, 0x96, 0x57, 0x6e, 0x37,
+	0x94, 0xfe, 0x92, 0xe3, 0x23, 0x30, 0x18, 0xb1, 0x09, 0xed, 0x09, 0x8b, 0x11, 0x41, 0x7c, 0x95,
+	0x73, 0x34, 0x11, 0x0b, 0xea, 0x6a, 0x5d, 0x89, 0xf4, 0x66, 0xac, 0xd6, 0xa3, 0x11, 0x1d, 0xc2,
+	0x5d, 0x19, 0x27, 0xe9, 0x13, 0x5f, 0x58, 0x3c, 0x6c, 0x73, 0x9b, 0xd1, 0x9e, 0xd4, 0x73, 0xab,
+	0x47, 0x98, 0x15, 0xdf, 0xe8, 0x6a, 0xf2, 0x67, 0xcd, 0x8a, 0x87, 0x07, 0xbb, 0xd2, 0xf4, 0xe4,
+	0xbc, 0xe5, 0x31, 0x61, 0xad, 0xc8, 0x0e, 0xfd, 0x1d, 0x56, 0x13, 0x3c, 0x0d, 0xd1, 0x21, 0x2e,
+	0xed, 0x13, 0x36, 0x8c, 0x86, 0xfe, 0x72, 0x0c, 0x21, 0xdd, 0x76, 0x22, 0x1d, 0x3a, 0x85, 0xb5,
+	0xcb, 0xe7, 0xb4, 0xc7, 0xa8, 0x4d, 0x7d, 0xc7, 0x58, 0xa8, 0x64, 0x6a, 0xc5, 0xad, 0xfb, 0xa9,
+	0xb7, 0xce, 0xf8, 0xdc, 0x38, 0xd6, 0x3e, 0xe6, 0x6a, 0x3b, 0x5d, 0x81, 0xfe, 0x05, 0x6b, 0x02,
+	0x33, 0x87, 0x88, 0xcb, 0x43, 0x89, 0x1b, 0x25, 0x15, 0xe2, 0xaa, 0x36, 0x18, 0x87, 0xe6, 0x08,
+	0xc3, 0x92, 0x1d, 0xf8, 0x0e, 0xe1, 0x9a, 0x5f, 0xcc, 0x55, 0x4f, 0x19, 0x8b, 0xd7, 0x6d, 0xa4,
+	0xc5, 0x11, 0x5a, 0x13, 0x73, 0xd9, 0x41, 0xc8, 0x87, 0xf5, 0x73, 0x5b, 0xc8, 0x8e, 0xb5, 0x4f,
+	0xb1, 0xef, 0x10, 0x8b, 0x61, 0x41, 0x0c, 0x74, 0xdd, 0x9d, 0x56, 0x47, 0xa0, 0x7b, 0x84, 0xb4,
+	0x14, 0xa4, 0x89, 0x05, 0x41, 0x7b, 0x50, 0xf9, 0x50, 0xfd, 0xc5, 0xb0, 0x47, 0x8c, 0x25, 0xc5,
+	0xca, 0xad, 0xab, 0x6a, 0xff, 0x74, 0xd8, 0x23, 0xd5, 0x5f, 0x32, 0xb0, 0x10, 0xb7, 0xa5, 0xa9,
+	0x5b, 0xed, 0x0f, 0x78, 0x29, 0xae, 0xc0, 0xcc, 0x85, 0x07, 0x62, 0xb4, 0x42, 0x6b, 0x90, 0x93,
+	0x27, 0x23, 0xe4, 0xa4, 0xa3, 0xc6, 0x77, 0xd6, 0x9c, 0x75, 0x30, 0xff, 0x94, 0x93, 0x8e, 0x7c,
+	0x57, 0xf1, 0xd0, 0xb6, 0xe5, 0x5e, 0x72, 0xb6, 0xe6, 0xcc, 0x78, 0x89, 0x76, 0x60, 0x3e, 0x7e,
+	0xf3, 0x78, 0x41, 0xe8, 0xeb, 0x47, 0xde, 0x87, 0xee, 0xc0, 0x66, 0x56, 0x12, 0x6d, 0x16, 0xa2,
+	0x37, 0x91, 0x72, 0x92, 0x6f, 0x89, 0xc5, 0x4b, 0x34, 0xa0, 0x22, 0x4c, 0xd2, 0x4e, 0x74, 0x0b,
+	0x4e, 0xd2, 0x4e, 0x6a, 0xea, 0x93, 0xe9, 0xa9, 0xdf, 0x06, 0xd0, 0xec, 0x2b, 0xae, 0xa7, 0x94,
+	0xd1, 0x9c, 0x92, 0x48, 0x62, 0xd1, 0x33, 0x58, 0xc4, 0x42, 0x30, 0xda, 0x0e, 0x05, 0xb1, 0xba,
+	0xd4, 0x15, 0x84, 0xc9, 0xdb, 0x7b, 0xaa, 0x96, 0xdf, 0xfa, 0x53, 0xea, 0x89, 0x50, 0xc1, 0x6d,
+	0xc7, 0x2e, 0x51, 0x0e, 0xa5, 0x04, 0x63, 0x4f, 0x43, 0xc8, 0xe7, 0xe1, 0x68, 0xb8, 0x4c, 0xeb,
+	0xf7, 0xac, 0x13, 0xcf, 0x93, 0xb1, 0x07, 0xf3, 0xcc, 0xa5, 0x07, 0xf3, 0x3f, 0x61, 0xb6, 0x8d,
+	0x5d, 0xec, 0xdb, 0xc4, 0x98, 0xfd, 0x6d, 0x2c, 0xc6, 0xf6, 0xd5, 0x47, 0x50, 0xbc, 0x18, 0x22,
+	0x2a, 0xc1, 0xd4, 0x19, 0x19, 0x46, 0xad, 0x21, 0x3f, 0xd1, 0x32, 0x4c, 0xf7, 0xb1, 0x1b, 0x92,
+	0x88, 0x33, 0xbd, 0xa8, 0xfa, 0x30, 0x1f, 0xcf, 0x19, 0x85, 0x80, 0x10, 0x64, 0x15, 0x69, 0xda,
+	0x53, 0x7d, 0xa3, 0x7d, 0x80, 0x24, 0x57, 0xc9, 0xf9, 0xef, 0x24, 0xea, 0x9c, 0xf3, 0xdf, 0x28,
+	0xac, 0x5e, 0x31, 0x5e, 0xd0, 0x5d, 0xa8, 0x34, 0x0f, 0x8e, 0x5a, 0xff, 0xb7, 0xcc, 0xdd, 0x93,
+	0x5d, 0xf3, 0xd9, 0xf6, 0xd3, 0xfd, 0xa3, 0x43, 0xeb, 0xd8, 0xdc, 0x6f, 0xed, 0x1f, 0x3e, 0xb6,
+	0x0e, 0xf6, 0x0f, 0x77, 0xb7, 0xcd, 0xd2, 0x04, 0xaa, 0xc1, 0xdd, 0xab, 0xad, 0x5a, 0x47, 0x87,
+	0x8f, 0x77, 0x4f, 0xa4, 0xa8, 0x94, 0x69, 0x3e, 0x79, 0xfd, 0xae, 0x9c, 0x79, 0xf3, 0xae, 0x9c,
+	0xf9, 0xe9, 0x5d, 0x39, 0xf3, 0xea, 0x7d, 0x79, 0xe2, 0xcd, 0xfb, 0xf2, 0xc4, 0xf7, 0xef, 0xcb,
+	0x13, 0x9f, 0x3f, 0x74, 0xa8, 0x38, 0x0d, 0xdb, 0x75, 0x3b, 0xf0, 0x1a, 0x51, 0x16, 0x0f, 0x7c,
+	0x22, 0x5e, 0x04, 0xec, 0x2c, 0x5e, 0x37, 0x06, 0xa3, 0xdf, 0x4f, 0xc9, 0x01, 0x6f, 0xcf, 0xa8,
+	0xcb, 0xf3, 0xe1, 0xaf, 0x03, 0x00, 0x22, 0xcf, 0xb0, 0xc1, 0x9f, 0x0e, 0x00, 0x00,
+}
+
+func (m *Callback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Callback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Callback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedAttempts != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.FailedAttempts))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCallback(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.CallbackTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CallbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintCallback(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RemainingExecutions != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.RemainingExecutions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Interval != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxGasLimit != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.MaxGasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ReservedBy) > 0 {
+		i -= len(m.ReservedBy)
+		copy(dAtA[i:], m.ReservedBy)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.ReservedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FeeSplit != nil {
+		{
+			size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCallback(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CallbackHeight != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.CallbackHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JobId != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BackoffBlocks != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.BackoffBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackFeesFeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackFeesFeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackFeesFeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SurplusFees != nil {
+		{
+			size, err := m.SurplusFees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCallback(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FutureReservationFees != nil {
+		{
+			size, err := m.FutureReservationFees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCallback(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockReservationFees != nil {
+		{
+			size, err := m.BlockReservationFees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCallback(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TransactionFees != nil {
+		{
+			size, err := m.TransactionFees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCallback(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEventSubscriptionsPerType != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.MaxEventSubscriptionsPerType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.CongestionFeeChangeRate.Size()
+		i -= size
+		if _, err := m.CongestionFeeChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCallback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.CongestionBaseFee.Size()
+		i -= size
+		if _, err := m.CongestionBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCallback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.TargetBlockReservations != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.TargetBlockReservations))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.BlockReservationPricing != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.BlockReservationPricing))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxEventsPerDelivery != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.MaxEventsPerDelivery))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxEventSubscriptionsPerContract != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.MaxEventSubscriptionsPerContract))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ReceiptRetentionBlocks != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.ReceiptRetentionBlocks))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BlockCallbackGasLimit != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.BlockCallbackGasLimit))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxCallbackGasLimit != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.MaxCallbackGasLimit))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.FutureReservationTimeFeeMultiplier.Size()
+		i -= size
+		if _, err := m.FutureReservationTimeFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCallback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxFutureReservationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxFutureReservationTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintCallback(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.PayloadFeeMultiplier.Size()
+		i -= size
+		if _, err := m.PayloadFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCallback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxPayloadSize != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.MaxPayloadSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.FutureReservationFeeMultiplier.Size()
+		i -= size
+		if _, err := m.FutureReservationFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCallback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BlockReservationFeeMultiplier.Size()
+		i -= size
+		if _, err := m.BlockReservationFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCallback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxFutureReservationLimit != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.MaxFutureReservationLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBlockReservationLimit != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.MaxBlockReservationLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CallbackGasLimit != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.CallbackGasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RefundAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCallback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JobId != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCallback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.ReservedBy) > 0 {
+		i -= len(m.ReservedBy)
+		copy(dAtA[i:], m.ReservedBy)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.ReservedBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AttributeFilters) > 0 {
+		for iNdEx := len(m.AttributeFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCallback(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCallback(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCallback(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCallback(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCallback(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Callback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovCallback(uint64(m.JobId))
+	}
+	if m.CallbackHeight != 0 {
+		n += 1 + sovCallback(uint64(m.CallbackHeight))
+	}
+	if m.FeeSplit != nil {
+		l = m.FeeSplit.Size()
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	l = len(m.ReservedBy)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	if m.MaxGasLimit != 0 {
+		n += 1 + sovCallback(uint64(m.MaxGasLimit))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovCallback(uint64(m.Interval))
+	}
+	if m.RemainingExecutions != 0 {
+		n += 1 + sovCallback(uint64(m.RemainingExecutions))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	if m.CallbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime)
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	if m.FailedAttempts != 0 {
+		n += 1 + sovCallback(uint64(m.FailedAttempts))
+	}
+	return n
+}
+
+func (m *RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovCallback(uint64(m.MaxAttempts))
+	}
+	if m.BackoffBlocks != 0 {
+		n += 1 + sovCallback(uint64(m.BackoffBlocks))
+	}
+	return n
+}
+
+func (m *CallbackFeesFeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransactionFees != nil {
+		l = m.TransactionFees.Size()
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	if m.BlockReservationFees != nil {
+		l = m.BlockReservationFees.Size()
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	if m.FutureReservationFees != nil {
+		l = m.FutureReservationFees.Size()
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	if m.SurplusFees != nil {
+		l = m.SurplusFees.Size()
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallbackGasLimit != 0 {
+		n += 1 + sovCallback(uint64(m.CallbackGasLimit))
+	}
+	if m.MaxBlockReservationLimit != 0 {
+		n += 1 + sovCallback(uint64(m.MaxBlockReservationLimit))
+	}
+	if m.MaxFutureReservationLimit != 0 {
+		n += 1 + sovCallback(uint64(m.MaxFutureReservationLimit))
+	}
+	l = m.BlockReservationFeeMultiplier.Size()
+	n += 1 + l + sovCallback(uint64(l))
+	l = m.FutureReservationFeeMultiplier.Size()
+	n += 1 + l + sovCallback(uint64(l))
+	if m.MaxPayloadSize != 0 {
+		n += 1 + sovCallback(uint64(m.MaxPayloadSize))
+	}
+	l = m.PayloadFeeMultiplier.Size()
+	n += 1 + l + sovCallback(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxFutureReservationTime)
+	n += 1 + l + sovCallback(uint64(l))
+	l = m.FutureReservationTimeFeeMultiplier.Size()
+	n += 1 + l + sovCallback(uint64(l))
+	if m.MaxCallbackGasLimit != 0 {
+		n += 1 + sovCallback(uint64(m.MaxCallbackGasLimit))
+	}
+	if m.BlockCallbackGasLimit != 0 {
+		n += 1 + sovCallback(uint64(m.BlockCallbackGasLimit))
+	}
+	if m.ReceiptRetentionBlocks != 0 {
+		n += 1 + sovCallback(uint64(m.ReceiptRetentionBlocks))
+	}
+	if m.MaxEventSubscriptionsPerContract != 0 {
+		n += 1 + sovCallback(uint64(m.MaxEventSubscriptionsPerContract))
+	}
+	if m.MaxEventsPerDelivery != 0 {
+		n += 1 + sovCallback(uint64(m.MaxEventsPerDelivery))
+	}
+	if m.BlockReservationPricing != 0 {
+		n += 1 + sovCallback(uint64(m.BlockReservationPricing))
+	}
+	if m.TargetBlockReservations != 0 {
+		n += 2 + sovCallback(uint64(m.TargetBlockReservations))
+	}
+	l = m.CongestionBaseFee.Size()
+	n += 2 + l + sovCallback(uint64(l))
+	l = m.CongestionFeeChangeRate.Size()
+	n += 2 + l + sovCallback(uint64(l))
+	if m.MaxEventSubscriptionsPerType != 0 {
+		n += 2 + sovCallback(uint64(m.MaxEventSubscriptionsPerType))
+	}
+	return n
+}
+
+func (m *CallbackReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovCallback(uint64(m.JobId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCallback(uint64(m.Height))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovCallback(uint64(m.GasUsed))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = m.RefundAmount.Size()
+	n += 1 + l + sovCallback(uint64(l))
+	return n
+}
+
+func (m *EventSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCallback(uint64(m.Id))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	if len(m.AttributeFilters) > 0 {
+		for _, e := range m.AttributeFilters {
+			l = e.Size()
+			n += 1 + l + sovCallback(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovCallback(uint64(m.GasLimit))
+	}
+	l = len(m.ReservedBy)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovCallback(uint64(l))
+	return n
+}
+
+func (m *EventAttribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovCallback(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCallback(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCallback(x uint64) (n int) {
+	return sovCallback(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Callback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Callback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Callback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			}
+			m.CallbackHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeSplit == nil {
+				m.FeeSplit = &CallbackFeesFeeSplit{}
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasLimit", wireType)
+			}
+			m.MaxGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingExecutions", wireType)
+			}
+			m.RemainingExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallbackTime == nil {
+				m.CallbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CallbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
+			}
+			m.FailedAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffBlocks", wireType)
+			}
+			m.BackoffBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallbackFeesFeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackFeesFeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackFeesFeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransactionFees == nil {
+				m.TransactionFees = &types.Coin{}
+			}
+			if err := m.TransactionFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockReservationFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockReservationFees == nil {
+				m.BlockReservationFees = &types.Coin{}
+			}
+			if err := m.BlockReservationFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureReservationFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FutureReservationFees == nil {
+				m.FutureReservationFees = &types.Coin{}
+			}
+			if err := m.FutureReservationFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SurplusFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SurplusFees == nil {
+				m.SurplusFees = &types.Coin{}
+			}
+			if err := m.SurplusFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimit", wireType)
+			}
+			m.CallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockReservationLimit", wireType)
+			}
+			m.MaxBlockReservationLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockReservationLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFutureReservationLimit", wireType)
+			}
+			m.MaxFutureReservationLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFutureReservationLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockReservationFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockReservationFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureReservationFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FutureReservationFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadSize", wireType)
+			}
+			m.MaxPayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PayloadFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFutureReservationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxFutureReservationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureReservationTimeFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FutureReservationTimeFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGasLimit", wireType)
+			}
+			m.MaxCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCallbackGasLimit", wireType)
+			}
+			m.BlockCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptRetentionBlocks", wireType)
+			}
+			m.ReceiptRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptRetentionBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEventSubscriptionsPerContract", wireType)
+			}
+			m.MaxEventSubscriptionsPerContract = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEventSubscriptionsPerContract |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEventsPerDelivery", wireType)
+			}
+			m.MaxEventsPerDelivery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEventsPerDelivery |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockReservationPricing", wireType)
+			}
+			m.BlockReservationPricing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockReservationPricing |= BlockReservationPricing(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockReservations", wireType)
+			}
+			m.TargetBlockReservations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockReservations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CongestionBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CongestionBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CongestionFeeChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CongestionFeeChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEventSubscriptionsPerType", wireType)
+			}
+			m.MaxEventSubscriptionsPerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEventSubscriptionsPerType |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i = encodeVarintCallback(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.MaxFutureReservationLimit != 0 {
-		i = encodeVarintCallback(dAtA, i, uint64(m.MaxFutureReservationLimit))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MaxBlockReservationLimit != 0 {
-		i = encodeVarintCallback(dAtA, i, uint64(m.MaxBlockReservationLimit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CallbackGasLimit != 0 {
-		i = encodeVarintCallback(dAtA, i, uint64(m.CallbackGasLimit))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintCallback(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCallback(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Callback) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovCallback(uint64(l))
-	}
-	if m.JobId != 0 {
-		n += 1 + sovCallback(uint64(m.JobId))
-	}
-	if m.CallbackHeight != 0 {
-		n += 1 + sovCallback(uint64(m.CallbackHeight))
-	}
-	if m.FeeSplit != nil {
-		l = m.FeeSplit.Size()
-		n += 1 + l + sovCallback(uint64(l))
-	}
-	l = len(m.ReservedBy)
-	if l > 0 {
-		n += 1 + l + sovCallback(uint64(l))
-	}
-	if m.MaxGasLimit != 0 {
-		n += 1 + sovCallback(uint64(m.MaxGasLimit))
-	}
-	return n
-}
-
-func (m *CallbackFeesFeeSplit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TransactionFees != nil {
-		l = m.TransactionFees.Size()
-		n += 1 + l + sovCallback(uint64(l))
-	}
-	if m.BlockReservationFees != nil {
-		l = m.BlockReservationFees.Size()
-		n += 1 + l + sovCallback(uint64(l))
-	}
-	if m.FutureReservationFees != nil {
-		l = m.FutureReservationFees.Size()
-		n += 1 + l + sovCallback(uint64(l))
-	}
-	if m.SurplusFees != nil {
-		l = m.SurplusFees.Size()
-		n += 1 + l + sovCallback(uint64(l))
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CallbackGasLimit != 0 {
-		n += 1 + sovCallback(uint64(m.CallbackGasLimit))
-	}
-	if m.MaxBlockReservationLimit != 0 {
-		n += 1 + sovCallback(uint64(m.MaxBlockReservationLimit))
-	}
-	if m.MaxFutureReservationLimit != 0 {
-		n += 1 + sovCallback(uint64(m.MaxFutureReservationLimit))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.BlockReservationFeeMultiplier.Size()
-	n += 1 + l + sovCallback(uint64(l))
-	l = m.FutureReservationFeeMultiplier.Size()
-	n += 1 + l + sovCallback(uint64(l))
-	return n
-}
-
-func sovCallback(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCallback(x uint64) (n int) {
-	return sovCallback(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Callback) Unmarshal(dAtA []byte) error {
+func (m *CallbackReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -622,10 +3079,10 @@ func (m *Callback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Callback: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallbackReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Callback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallbackReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -681,9 +3138,9 @@ func (m *Callback) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.CallbackHeight = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCallback
@@ -693,16 +3150,16 @@ func (m *Callback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CallbackHeight |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			var msglen int
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCallback
@@ -712,33 +3169,16 @@ func (m *Callback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCallback
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCallback
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FeeSplit == nil {
-				m.FeeSplit = &CallbackFeesFeeSplit{}
-			}
-			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservedBy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCallback
@@ -748,29 +3188,17 @@ func (m *Callback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCallback
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCallback
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReservedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
 			}
-			m.MaxGasLimit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCallback
@@ -780,11 +3208,25 @@ func (m *Callback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxGasLimit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCallback(dAtA[iNdEx:])
@@ -806,7 +3248,7 @@ func (m *Callback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CallbackFeesFeeSplit) Unmarshal(dAtA []byte) error {
+func (m *EventSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -829,17 +3271,36 @@ func (m *CallbackFeesFeeSplit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallbackFeesFeeSplit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallbackFeesFeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCallback
@@ -849,31 +3310,59 @@ func (m *CallbackFeesFeeSplit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCallback
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCallback
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TransactionFees == nil {
-				m.TransactionFees = &types.Coin{}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
 			}
-			if err := m.TransactionFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockReservationFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeFilters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -900,18 +3389,35 @@ func (m *CallbackFeesFeeSplit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockReservationFees == nil {
-				m.BlockReservationFees = &types.Coin{}
-			}
-			if err := m.BlockReservationFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AttributeFilters = append(m.AttributeFilters, EventAttribute{})
+			if err := m.AttributeFilters[len(m.AttributeFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureReservationFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedBy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCallback
@@ -921,31 +3427,27 @@ func (m *CallbackFeesFeeSplit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCallback
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCallback
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FutureReservationFees == nil {
-				m.FutureReservationFees = &types.Coin{}
-			}
-			if err := m.FutureReservationFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReservedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SurplusFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -972,10 +3474,7 @@ func (m *CallbackFeesFeeSplit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SurplusFees == nil {
-				m.SurplusFees = &types.Coin{}
-			}
-			if err := m.SurplusFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1000,7 +3499,7 @@ func (m *CallbackFeesFeeSplit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventAttribute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1023,17 +3522,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttribute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttribute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.CallbackGasLimit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCallback
@@ -1043,16 +3542,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CallbackGasLimit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockReservationLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.MaxBlockReservationLimit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCallback
@@ -1062,33 +3574,77 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBlockReservationLimit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxFutureReservationLimit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
 			}
-			m.MaxFutureReservationLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCallback
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxFutureReservationLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockReservationFeeMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1116,15 +3672,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockReservationFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureReservationFeeMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCallback
@@ -1134,23 +3688,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCallback
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCallback
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FutureReservationFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attributes = append(m.Attributes, EventAttribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -16,6 +17,7 @@ func TestCallbackValidate(t *testing.T) {
 	accAddr := accAddrs[0]
 	contractAddr := e2eTesting.GenContractAddresses(1)[0]
 	validCoin := sdk.NewInt64Coin("stake", 1)
+	callbackTime := time.Unix(1700000000, 0).UTC()
 
 	type testCase struct {
 		name        string
@@ -74,6 +76,152 @@ func TestCallbackValidate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "Fail: Remaining executions on a one-off callback",
+			callback: types.Callback{
+				ContractAddress:     contractAddr.String(),
+				ReservedBy:          accAddr.String(),
+				CallbackHeight:      1,
+				RemainingExecutions: 2,
+				FeeSplit: &types.CallbackFeesFeeSplit{
+					TransactionFees:       &validCoin,
+					BlockReservationFees:  &validCoin,
+					FutureReservationFees: &validCoin,
+					SurplusFees:           &validCoin,
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: Valid recurring callback",
+			callback: types.Callback{
+				ContractAddress:     contractAddr.String(),
+				ReservedBy:          accAddr.String(),
+				CallbackHeight:      1,
+				Interval:            5,
+				RemainingExecutions: 2,
+				FeeSplit: &types.CallbackFeesFeeSplit{
+					TransactionFees:       &validCoin,
+					BlockReservationFees:  &validCoin,
+					FutureReservationFees: &validCoin,
+					SurplusFees:           &validCoin,
+				},
+			},
+			errExpected: false,
+		},
+		{
+			name: "Fail: Retry policy with a single attempt",
+			callback: types.Callback{
+				ContractAddress: contractAddr.String(),
+				ReservedBy:      accAddr.String(),
+				CallbackHeight:  1,
+				RetryPolicy:     &types.RetryPolicy{MaxAttempts: 1, BackoffBlocks: 1},
+				FeeSplit: &types.CallbackFeesFeeSplit{
+					TransactionFees:       &validCoin,
+					BlockReservationFees:  &validCoin,
+					FutureReservationFees: &validCoin,
+					SurplusFees:           &validCoin,
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: Failed attempts reaching the max attempts",
+			callback: types.Callback{
+				ContractAddress: contractAddr.String(),
+				ReservedBy:      accAddr.String(),
+				CallbackHeight:  1,
+				RetryPolicy:     &types.RetryPolicy{MaxAttempts: 2, BackoffBlocks: 1},
+				FailedAttempts:  2,
+				FeeSplit: &types.CallbackFeesFeeSplit{
+					TransactionFees:       &validCoin,
+					BlockReservationFees:  &validCoin,
+					FutureReservationFees: &validCoin,
+					SurplusFees:           &validCoin,
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: Failed attempts without a retry policy",
+			callback: types.Callback{
+				ContractAddress: contractAddr.String(),
+				ReservedBy:      accAddr.String(),
+				CallbackHeight:  1,
+				FailedAttempts:  1,
+				FeeSplit: &types.CallbackFeesFeeSplit{
+					TransactionFees:       &validCoin,
+					BlockReservationFees:  &validCoin,
+					FutureReservationFees: &validCoin,
+					SurplusFees:           &validCoin,
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: Valid retried callback",
+			callback: types.Callback{
+				ContractAddress: contractAddr.String(),
+				ReservedBy:      accAddr.String(),
+				CallbackHeight:  1,
+				RetryPolicy:     &types.RetryPolicy{MaxAttempts: 3, BackoffBlocks: 2},
+				FailedAttempts:  1,
+				FeeSplit: &types.CallbackFeesFeeSplit{
+					TransactionFees:       &validCoin,
+					BlockReservationFees:  &validCoin,
+					FutureReservationFees: &validCoin,
+					SurplusFees:           &validCoin,
+				},
+			},
+			errExpected: false,
+		},
+		{
+			name: "Fail: Callback height set on a timed callback",
+			callback: types.Callback{
+				ContractAddress: contractAddr.String(),
+				ReservedBy:      accAddr.String(),
+				CallbackHeight:  1,
+				CallbackTime:    &callbackTime,
+				FeeSplit: &types.CallbackFeesFeeSplit{
+					TransactionFees:       &validCoin,
+					BlockReservationFees:  &validCoin,
+					FutureReservationFees: &validCoin,
+					SurplusFees:           &validCoin,
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: Recurring timed callback",
+			callback: types.Callback{
+				ContractAddress: contractAddr.String(),
+				ReservedBy:      accAddr.String(),
+				CallbackTime:    &callbackTime,
+				Interval:        5,
+				FeeSplit: &types.CallbackFeesFeeSplit{
+					TransactionFees:       &validCoin,
+					BlockReservationFees:  &validCoin,
+					FutureReservationFees: &validCoin,
+					SurplusFees:           &validCoin,
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: Valid timed callback",
+			callback: types.Callback{
+				ContractAddress: contractAddr.String(),
+				ReservedBy:      accAddr.String(),
+				CallbackTime:    &callbackTime,
+				FeeSplit: &types.CallbackFeesFeeSplit{
+					TransactionFees:       &validCoin,
+					BlockReservationFees:  &validCoin,
+					FutureReservationFees: &validCoin,
+					SurplusFees:           &validCoin,
+				},
+			},
+			errExpected: false,
+		},
 		{
 			name: "OK: Valid callback",
 			callback: types.Callback{
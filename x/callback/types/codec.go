@@ -15,6 +15,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "callback/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&MsgRequestCallback{}, "callback/MsgRequestCallback", nil)
 	cdc.RegisterConcrete(&MsgCancelCallback{}, "callback/MsgCancelCallback", nil)
+	cdc.RegisterConcrete(&MsgUpdateCallback{}, "callback/MsgUpdateCallback", nil)
+	cdc.RegisterConcrete(&MsgSubscribeToEvents{}, "callback/MsgSubscribeToEvents", nil)
+	cdc.RegisterConcrete(&MsgUnsubscribeFromEvents{}, "callback/MsgUnsubscribeFromEvents", nil)
+	cdc.RegisterConcrete(&MsgRequestCallbacks{}, "callback/MsgRequestCallbacks", nil)
+	cdc.RegisterConcrete(&MsgCancelCallbacks{}, "callback/MsgCancelCallbacks", nil)
 }
 
 // RegisterInterfaces registers interfaces types with the interface registry.
@@ -23,6 +28,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgRequestCallback{},
 		&MsgCancelCallback{},
+		&MsgUpdateCallback{},
+		&MsgSubscribeToEvents{},
+		&MsgUnsubscribeFromEvents{},
+		&MsgRequestCallbacks{},
+		&MsgCancelCallbacks{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -17,6 +17,16 @@ var (
 	ErrCallbackExists               = errorsmod.Register(DefaultCodespace, 8, "callback with given job id already exists for given height")
 	ErrCallbackHeightTooFarInFuture = errorsmod.Register(DefaultCodespace, 9, "callback request height is too far in the future")
 	ErrBlockFilled                  = errorsmod.Register(DefaultCodespace, 10, "block filled with max capacity of callbacks")
+	ErrInvalidRecurrence            = errorsmod.Register(DefaultCodespace, 11, "invalid callback recurrence")
+	ErrPayloadTooLarge              = errorsmod.Register(DefaultCodespace, 12, "callback payload exceeds the max payload size")
+	ErrCallbackTimeNotInFuture      = errorsmod.Register(DefaultCodespace, 13, "callback request time is not in the future")
+	ErrCallbackTimeTooFarInFuture   = errorsmod.Register(DefaultCodespace, 14, "callback request time is too far in the future")
+	ErrGasLimitTooHigh              = errorsmod.Register(DefaultCodespace, 15, "callback gas limit exceeds the max callback gas limit")
+	ErrEventSubscriptionNotFound    = errorsmod.Register(DefaultCodespace, 16, "event subscription with given id does not exist for given contract")
+	ErrTooManyEventSubscriptions    = errorsmod.Register(DefaultCodespace, 17, "max number of event subscriptions reached")
+	ErrFeePayerNotAllowed           = errorsmod.Register(DefaultCodespace, 18, "fee payer is not allowed to pay the fees of the callback")
+	ErrInvalidRetryPolicy           = errorsmod.Register(DefaultCodespace, 19, "invalid callback retry policy")
+	ErrBlockCallbackGasLimitReached = errorsmod.Register(DefaultCodespace, 20, "block callback gas limit reached")
 )
 
 // NewSudoError creates a new sudo error instance to pass on to the errors module
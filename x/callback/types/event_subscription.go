@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewContractEvent creates a new ContractEvent instance from an event emitted during the block.
+func NewContractEvent(event sdk.Event) ContractEvent {
+	attributes := make([]EventAttribute, 0, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attributes = append(attributes, EventAttribute{Key: attr.Key, Value: attr.Value})
+	}
+	return ContractEvent{
+		Type:       event.Type,
+		Attributes: attributes,
+	}
+}
+
+// MatchesEvent returns true if the event has the subscribed type and all the attributes of the subscription filters.
+// A filter with an empty value matches any event which has an attribute with the filter key.
+func (s EventSubscription) MatchesEvent(event sdk.Event) bool {
+	if event.Type != s.EventType {
+		return false
+	}
+	for _, filter := range s.AttributeFilters {
+		if !hasAttribute(event, filter) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasAttribute returns true if the event has an attribute matching the filter
+func hasAttribute(event sdk.Event, filter EventAttribute) bool {
+	for _, attr := range event.Attributes {
+		if attr.Key != filter.Key {
+			continue
+		}
+		if filter.Value == "" || attr.Value == filter.Value {
+			return true
+		}
+	}
+	return false
+}
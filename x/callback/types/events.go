@@ -2,15 +2,25 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// EventSubscriptionRemovedReasonUnsubscribed is the removal reason of a subscription cancelled on request
+	EventSubscriptionRemovedReasonUnsubscribed = "unsubscribed"
+	// EventSubscriptionRemovedReasonInsufficientBalance is the removal reason of a subscription
+	// whose prepaid balance is not enough to pay for another delivery
+	EventSubscriptionRemovedReasonInsufficientBalance = "insufficient balance"
+)
+
 func EmitCallbackRegisteredEvent(
 	ctx sdk.Context,
 	contractAddress string,
 	jobId uint64,
 	callbackHeight int64,
+	callbackTime *time.Time,
 	feeSplit *CallbackFeesFeeSplit,
 	reservedBy string,
 ) {
@@ -18,6 +28,7 @@ func EmitCallbackRegisteredEvent(
 		ContractAddress: contractAddress,
 		JobId:           jobId,
 		CallbackHeight:  callbackHeight,
+		CallbackTime:    callbackTime,
 		FeeSplit:        feeSplit,
 		ReservedBy:      reservedBy,
 	})
@@ -31,6 +42,7 @@ func EmitCallbackCancelledEvent(
 	contractAddress string,
 	jobId uint64,
 	callbackHeight int64,
+	callbackTime *time.Time,
 	cancelledBy string,
 	refundAmount sdk.Coin,
 ) {
@@ -38,6 +50,7 @@ func EmitCallbackCancelledEvent(
 		ContractAddress: contractAddress,
 		JobId:           jobId,
 		CallbackHeight:  callbackHeight,
+		CallbackTime:    callbackTime,
 		CancelledBy:     cancelledBy,
 		RefundAmount:    refundAmount,
 	})
@@ -52,12 +65,14 @@ func EmitCallbackExecutedSuccessEvent(
 	jobId uint64,
 	sudoMsg string,
 	gasUsed uint64,
+	attempt uint64,
 ) {
 	err := ctx.EventManager().EmitTypedEvent(&CallbackExecutedSuccessEvent{
 		ContractAddress: contractAddress,
 		JobId:           jobId,
 		SudoMsg:         sudoMsg,
 		GasUsed:         gasUsed,
+		Attempt:         attempt,
 	})
 	if err != nil {
 		panic(fmt.Errorf("sending CallbackExecutedSuccessEvent event: %w", err))
@@ -71,6 +86,7 @@ func EmitCallbackExecutedFailedEvent(
 	sudoMsg string,
 	gasUsed uint64,
 	errMsg string,
+	attempt uint64,
 ) {
 	err := ctx.EventManager().EmitTypedEvent(&CallbackExecutedFailedEvent{
 		Error:           errMsg,
@@ -78,8 +94,176 @@ func EmitCallbackExecutedFailedEvent(
 		JobId:           jobId,
 		SudoMsg:         sudoMsg,
 		GasUsed:         gasUsed,
+		Attempt:         attempt,
 	})
 	if err != nil {
 		panic(fmt.Errorf("sending CallbackExecutedFailedEvent event: %w", err))
 	}
 }
+
+func EmitCallbackRescheduledEvent(
+	ctx sdk.Context,
+	contractAddress string,
+	jobId uint64,
+	previousHeight int64,
+	callbackHeight int64,
+	feeSplit *CallbackFeesFeeSplit,
+	remainingExecutions uint64,
+) {
+	err := ctx.EventManager().EmitTypedEvent(&CallbackRescheduledEvent{
+		ContractAddress:     contractAddress,
+		JobId:               jobId,
+		PreviousHeight:      previousHeight,
+		CallbackHeight:      callbackHeight,
+		FeeSplit:            feeSplit,
+		RemainingExecutions: remainingExecutions,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending CallbackRescheduledEvent event: %w", err))
+	}
+}
+
+func EmitCallbackRescheduleFailedEvent(
+	ctx sdk.Context,
+	contractAddress string,
+	jobId uint64,
+	callbackHeight int64,
+	errMsg string,
+) {
+	err := ctx.EventManager().EmitTypedEvent(&CallbackRescheduleFailedEvent{
+		ContractAddress: contractAddress,
+		JobId:           jobId,
+		CallbackHeight:  callbackHeight,
+		Error:           errMsg,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending CallbackRescheduleFailedEvent event: %w", err))
+	}
+}
+
+func EmitCallbackRetryScheduledEvent(
+	ctx sdk.Context,
+	contractAddress string,
+	jobId uint64,
+	failedAttempts uint64,
+	callbackHeight int64,
+	feeSplit *CallbackFeesFeeSplit,
+	errMsg string,
+) {
+	err := ctx.EventManager().EmitTypedEvent(&CallbackRetryScheduledEvent{
+		ContractAddress: contractAddress,
+		JobId:           jobId,
+		FailedAttempts:  failedAttempts,
+		CallbackHeight:  callbackHeight,
+		FeeSplit:        feeSplit,
+		Error:           errMsg,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending CallbackRetryScheduledEvent event: %w", err))
+	}
+}
+
+func EmitCallbackDeferredEvent(
+	ctx sdk.Context,
+	contractAddress string,
+	jobId uint64,
+	callbackHeight int64,
+	callbackTime *time.Time,
+	blockGasConsumed uint64,
+) {
+	err := ctx.EventManager().EmitTypedEvent(&CallbackDeferredEvent{
+		ContractAddress:  contractAddress,
+		JobId:            jobId,
+		CallbackHeight:   callbackHeight,
+		CallbackTime:     callbackTime,
+		BlockGasConsumed: blockGasConsumed,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending CallbackDeferredEvent event: %w", err))
+	}
+}
+
+func EmitCallbackUpdatedEvent(
+	ctx sdk.Context,
+	contractAddress string,
+	jobId uint64,
+	previousHeight int64,
+	previousTime *time.Time,
+	callbackHeight int64,
+	callbackTime *time.Time,
+	feeSplit *CallbackFeesFeeSplit,
+	updatedBy string,
+	refundAmount sdk.Coin,
+) {
+	err := ctx.EventManager().EmitTypedEvent(&CallbackUpdatedEvent{
+		ContractAddress: contractAddress,
+		JobId:           jobId,
+		PreviousHeight:  previousHeight,
+		PreviousTime:    previousTime,
+		CallbackHeight:  callbackHeight,
+		CallbackTime:    callbackTime,
+		FeeSplit:        feeSplit,
+		UpdatedBy:       updatedBy,
+		RefundAmount:    refundAmount,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending CallbackUpdatedEvent event: %w", err))
+	}
+}
+
+func EmitEventSubscriptionRegisteredEvent(
+	ctx sdk.Context,
+	subscription EventSubscription,
+	fees sdk.Coin,
+) {
+	err := ctx.EventManager().EmitTypedEvent(&EventSubscriptionRegisteredEvent{
+		SubscriptionId:  subscription.Id,
+		ContractAddress: subscription.ContractAddress,
+		EventType:       subscription.EventType,
+		ReservedBy:      subscription.ReservedBy,
+		Fees:            fees,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending EventSubscriptionRegisteredEvent event: %w", err))
+	}
+}
+
+func EmitEventSubscriptionRemovedEvent(
+	ctx sdk.Context,
+	subscriptionID uint64,
+	contractAddress string,
+	refundAmount sdk.Coin,
+	reason string,
+) {
+	err := ctx.EventManager().EmitTypedEvent(&EventSubscriptionRemovedEvent{
+		SubscriptionId:  subscriptionID,
+		ContractAddress: contractAddress,
+		RefundAmount:    refundAmount,
+		Reason:          reason,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending EventSubscriptionRemovedEvent event: %w", err))
+	}
+}
+
+func EmitEventsDeliveredEvent(
+	ctx sdk.Context,
+	subscriptionID uint64,
+	contractAddress string,
+	eventsCount uint64,
+	gasUsed uint64,
+	feesConsumed sdk.Coin,
+	errMsg string,
+) {
+	err := ctx.EventManager().EmitTypedEvent(&EventsDeliveredEvent{
+		SubscriptionId:  subscriptionID,
+		ContractAddress: contractAddress,
+		EventsCount:     eventsCount,
+		GasUsed:         gasUsed,
+		FeesConsumed:    feesConsumed,
+		Error:           errMsg,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending EventsDeliveredEvent event: %w", err))
+	}
+}
@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,6 +41,8 @@ type CallbackRegisteredEvent struct {
 	FeeSplit *CallbackFeesFeeSplit `protobuf:"bytes,4,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split,omitempty"`
 	// reserved_by is the address which reserved the callback (bech32 encoded).
 	ReservedBy string `protobuf:"bytes,5,opt,name=reserved_by,json=reservedBy,proto3" json:"reserved_by,omitempty"`
+	// callback_time is the block time at or after which the callback is executed.
+	CallbackTime *time.Time `protobuf:"bytes,6,opt,name=callback_time,json=callbackTime,proto3,stdtime" json:"callback_time,omitempty"`
 }
 
 func (m *CallbackRegisteredEvent) Reset()         { *m = CallbackRegisteredEvent{} }
@@ -107,6 +113,13 @@ func (m *CallbackRegisteredEvent) GetReservedBy() string {
 	return ""
 }
 
+func (m *CallbackRegisteredEvent) GetCallbackTime() *time.Time {
+	if m != nil {
+		return m.CallbackTime
+	}
+	return nil
+}
+
 // CallbackCancelledEvent is emitted when a callback is cancelled.
 type CallbackCancelledEvent struct {
 	// cancelled_by is the address of the contract whose callback is being cancelled (bech32 encoded)
@@ -119,6 +132,8 @@ type CallbackCancelledEvent struct {
 	CallbackHeight int64 `protobuf:"varint,4,opt,name=callback_height,json=callbackHeight,proto3" json:"callback_height,omitempty"`
 	// refund_amount is the amount of fees which was refunded on cancellation
 	RefundAmount types.Coin `protobuf:"bytes,5,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount"`
+	// callback_time is the block time at which the callback requestor had registered the callback
+	CallbackTime *time.Time `protobuf:"bytes,6,opt,name=callback_time,json=callbackTime,proto3,stdtime" json:"callback_time,omitempty"`
 }
 
 func (m *CallbackCancelledEvent) Reset()         { *m = CallbackCancelledEvent{} }
@@ -189,6 +204,13 @@ func (m *CallbackCancelledEvent) GetRefundAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *CallbackCancelledEvent) GetCallbackTime() *time.Time {
+	if m != nil {
+		return m.CallbackTime
+	}
+	return nil
+}
+
 // CallbackExecutedSuccessEvent is emitted when a callback is executed successfully.
 type CallbackExecutedSuccessEvent struct {
 	// contract_address is the address of the contract for which callback is being executed (bech32 encoded).
@@ -199,6 +221,8 @@ type CallbackExecutedSuccessEvent struct {
 	SudoMsg string `protobuf:"bytes,3,opt,name=sudo_msg,json=sudoMsg,proto3" json:"sudo_msg,omitempty"`
 	// gas_used is the amount of gas consumed during the callback execution
 	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// attempt is the number of the execution attempt, starting at 1
+	Attempt uint64 `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`
 }
 
 func (m *CallbackExecutedSuccessEvent) Reset()         { *m = CallbackExecutedSuccessEvent{} }
@@ -262,6 +286,13 @@ func (m *CallbackExecutedSuccessEvent) GetGasUsed() uint64 {
 	return 0
 }
 
+func (m *CallbackExecutedSuccessEvent) GetAttempt() uint64 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
 // CallbackExecutedFailedEvent is emitted when a callback execution fails.
 type CallbackExecutedFailedEvent struct {
 	// contract_address is the address of the contract for which callback is being executed (bech32 encoded).
@@ -274,6 +305,8 @@ type CallbackExecutedFailedEvent struct {
 	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 	// error is the error returned during the callback execution
 	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// attempt is the number of the execution attempt, starting at 1
+	Attempt uint64 `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
 }
 
 func (m *CallbackExecutedFailedEvent) Reset()         { *m = CallbackExecutedFailedEvent{} }
@@ -344,389 +377,3549 @@ func (m *CallbackExecutedFailedEvent) GetError() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*CallbackRegisteredEvent)(nil), "archway.callback.v1.CallbackRegisteredEvent")
-	proto.RegisterType((*CallbackCancelledEvent)(nil), "archway.callback.v1.CallbackCancelledEvent")
-	proto.RegisterType((*CallbackExecutedSuccessEvent)(nil), "archway.callback.v1.CallbackExecutedSuccessEvent")
-	proto.RegisterType((*CallbackExecutedFailedEvent)(nil), "archway.callback.v1.CallbackExecutedFailedEvent")
+func (m *CallbackExecutedFailedEvent) GetAttempt() uint64 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("archway/callback/v1/events.proto", fileDescriptor_0196c63f44b94c06) }
-
-var fileDescriptor_0196c63f44b94c06 = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xce, 0x36, 0x49, 0xdb, 0x6c, 0x0a, 0x45, 0xa6, 0x40, 0x52, 0x90, 0x1b, 0x72, 0x21, 0x3d,
-	0x60, 0x2b, 0xed, 0x13, 0x34, 0xa1, 0x11, 0x1c, 0x7a, 0x71, 0xc5, 0x85, 0x8b, 0xb5, 0xde, 0x9d,
-	0x38, 0x6e, 0x1d, 0x6f, 0xb4, 0xb3, 0x4e, 0x9b, 0xb7, 0xe0, 0xc4, 0x4b, 0xf0, 0x22, 0x3d, 0xf6,
-	0xc8, 0x09, 0xa1, 0xe4, 0x0a, 0xef, 0x80, 0xfc, 0xb3, 0x45, 0x42, 0x91, 0x2a, 0x55, 0xbd, 0xcd,
-	0x7c, 0x33, 0x9f, 0x3d, 0xdf, 0x37, 0xb3, 0xb4, 0xc3, 0x14, 0x9f, 0x5c, 0xb1, 0x85, 0xcb, 0x59,
-	0x1c, 0x07, 0x8c, 0x5f, 0xba, 0xf3, 0xbe, 0x0b, 0x73, 0x48, 0x34, 0x3a, 0x33, 0x25, 0xb5, 0xb4,
-	0x9e, 0x97, 0x1d, 0x8e, 0xe9, 0x70, 0xe6, 0xfd, 0xfd, 0xee, 0x3a, 0xda, 0x5d, 0x43, 0x4e, 0xdc,
-	0xdf, 0x0b, 0x65, 0x28, 0xf3, 0xd0, 0xcd, 0xa2, 0x12, 0xb5, 0xb9, 0xc4, 0xa9, 0x44, 0x37, 0x60,
-	0x08, 0xee, 0xbc, 0x1f, 0x80, 0x66, 0x7d, 0x97, 0xcb, 0x28, 0x29, 0xeb, 0xed, 0xa2, 0xee, 0x17,
-	0xc4, 0x22, 0x29, 0x4a, 0xdd, 0x3f, 0x84, 0xbe, 0x1a, 0x96, 0xff, 0xf0, 0x20, 0x8c, 0x50, 0x83,
-	0x02, 0x71, 0x9a, 0x0d, 0x6b, 0x1d, 0xd2, 0x67, 0x5c, 0x26, 0x5a, 0x31, 0xae, 0x7d, 0x26, 0x84,
-	0x02, 0xc4, 0x16, 0xe9, 0x90, 0x5e, 0xc3, 0xdb, 0x35, 0xf8, 0x49, 0x01, 0x5b, 0x2f, 0xe8, 0xe6,
-	0x85, 0x0c, 0xfc, 0x48, 0xb4, 0x36, 0x3a, 0xa4, 0x57, 0xf3, 0xea, 0x17, 0x32, 0xf8, 0x24, 0xac,
-	0x77, 0x74, 0xd7, 0x08, 0xf0, 0x27, 0x10, 0x85, 0x13, 0xdd, 0xaa, 0x76, 0x48, 0xaf, 0xea, 0x3d,
-	0x35, 0xf0, 0xc7, 0x1c, 0xb5, 0x46, 0xb4, 0x31, 0x06, 0xf0, 0x71, 0x16, 0x47, 0xba, 0x55, 0xeb,
-	0x90, 0x5e, 0xf3, 0xe8, 0xd0, 0x59, 0x63, 0x92, 0x63, 0x66, 0x1d, 0x01, 0xe0, 0x08, 0xe0, 0x3c,
-	0x23, 0x78, 0xdb, 0xe3, 0x32, 0xb2, 0x0e, 0x68, 0x53, 0x01, 0x82, 0x9a, 0x83, 0xf0, 0x83, 0x45,
-	0xab, 0x9e, 0x4f, 0x4b, 0x0d, 0x34, 0x58, 0x74, 0x7f, 0x13, 0xfa, 0xd2, 0x7c, 0x63, 0xc8, 0x12,
-	0x0e, 0x71, 0x6c, 0xe4, 0xbe, 0xa5, 0x3b, 0xdc, 0x20, 0x19, 0xb9, 0x90, 0xda, 0xbc, 0xc3, 0x06,
-	0x8b, 0xb5, 0x8e, 0x6c, 0xdc, 0xe7, 0x48, 0xf5, 0x1e, 0x47, 0x6a, 0x6b, 0x1d, 0xf9, 0x40, 0x9f,
-	0x28, 0x18, 0xa7, 0x89, 0xf0, 0xd9, 0x54, 0xa6, 0x89, 0xce, 0xb5, 0x34, 0x8f, 0xda, 0x4e, 0xb9,
-	0xbe, 0x6c, 0xd7, 0x4e, 0xb9, 0x6b, 0x67, 0x28, 0xa3, 0x64, 0x50, 0xbb, 0xf9, 0x79, 0x50, 0xf1,
-	0x76, 0x0a, 0xd6, 0x49, 0x4e, 0xea, 0x7e, 0x23, 0xf4, 0x8d, 0x91, 0x7b, 0x7a, 0x0d, 0x3c, 0xd5,
-	0x20, 0xce, 0x53, 0xce, 0x01, 0xf1, 0xb1, 0x76, 0xdc, 0xa6, 0xdb, 0x98, 0x0a, 0xe9, 0x4f, 0x31,
-	0xcc, 0xa5, 0x36, 0xbc, 0xad, 0x2c, 0x3f, 0xc3, 0x30, 0x2b, 0x85, 0x0c, 0xfd, 0x14, 0x41, 0xe4,
-	0x2a, 0x6b, 0xde, 0x56, 0xc8, 0xf0, 0x33, 0x82, 0xe8, 0x7e, 0x27, 0xf4, 0xf5, 0xff, 0x83, 0x8d,
-	0x58, 0x14, 0x3f, 0xde, 0xed, 0x3d, 0x68, 0x2e, 0x6b, 0x8f, 0xd6, 0x41, 0x29, 0xa9, 0xca, 0xd3,
-	0x29, 0x92, 0xc1, 0xd9, 0xcd, 0xd2, 0x26, 0xb7, 0x4b, 0x9b, 0xfc, 0x5a, 0xda, 0xe4, 0xeb, 0xca,
-	0xae, 0xdc, 0xae, 0xec, 0xca, 0x8f, 0x95, 0x5d, 0xf9, 0x72, 0x1c, 0x46, 0x7a, 0x92, 0x06, 0x0e,
-	0x97, 0x53, 0xb7, 0xbc, 0xd7, 0xf7, 0x09, 0xe8, 0x2b, 0xa9, 0x2e, 0x4d, 0xee, 0x5e, 0xff, 0x7b,
-	0xd1, 0x7a, 0x31, 0x03, 0x0c, 0x36, 0xf3, 0xb7, 0x77, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x0d,
-	0x9a, 0x31, 0xb9, 0x29, 0x04, 0x00, 0x00,
+// CallbackRescheduledEvent is emitted when a recurring callback is rescheduled after its execution.
+type CallbackRescheduledEvent struct {
+	// contract_address is the address of the contract for which callback is being rescheduled (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// job_id is an identifier of the callback.
+	JobId uint64 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// previous_height is the height at which the callback was executed.
+	PreviousHeight int64 `protobuf:"varint,3,opt,name=previous_height,json=previousHeight,proto3" json:"previous_height,omitempty"`
+	// callback_height is the height at which the callback is executed next.
+	CallbackHeight int64 `protobuf:"varint,4,opt,name=callback_height,json=callbackHeight,proto3" json:"callback_height,omitempty"`
+	// fee_split is the breakdown of the fees charged from the surplus fees for the next execution
+	FeeSplit *CallbackFeesFeeSplit `protobuf:"bytes,5,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split,omitempty"`
+	// remaining_executions is the number of executions left for the callback, including the next one
+	RemainingExecutions uint64 `protobuf:"varint,6,opt,name=remaining_executions,json=remainingExecutions,proto3" json:"remaining_executions,omitempty"`
 }
 
-func (m *CallbackRegisteredEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CallbackRescheduledEvent) Reset()         { *m = CallbackRescheduledEvent{} }
+func (m *CallbackRescheduledEvent) String() string { return proto.CompactTextString(m) }
+func (*CallbackRescheduledEvent) ProtoMessage()    {}
+func (*CallbackRescheduledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0196c63f44b94c06, []int{4}
+}
+func (m *CallbackRescheduledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallbackRescheduledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackRescheduledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *CallbackRegisteredEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CallbackRescheduledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackRescheduledEvent.Merge(m, src)
+}
+func (m *CallbackRescheduledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallbackRescheduledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackRescheduledEvent.DiscardUnknown(m)
 }
 
-func (m *CallbackRegisteredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReservedBy) > 0 {
-		i -= len(m.ReservedBy)
-		copy(dAtA[i:], m.ReservedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReservedBy)))
-		i--
-		dAtA[i] = 0x2a
+var xxx_messageInfo_CallbackRescheduledEvent proto.InternalMessageInfo
+
+func (m *CallbackRescheduledEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	if m.FeeSplit != nil {
-		{
-			size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *CallbackRescheduledEvent) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
 	}
-	if m.CallbackHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.CallbackHeight))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *CallbackRescheduledEvent) GetPreviousHeight() int64 {
+	if m != nil {
+		return m.PreviousHeight
 	}
-	if m.JobId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.JobId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *CallbackRescheduledEvent) GetCallbackHeight() int64 {
+	if m != nil {
+		return m.CallbackHeight
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *CallbackRescheduledEvent) GetFeeSplit() *CallbackFeesFeeSplit {
+	if m != nil {
+		return m.FeeSplit
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CallbackCancelledEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CallbackRescheduledEvent) GetRemainingExecutions() uint64 {
+	if m != nil {
+		return m.RemainingExecutions
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CallbackCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CallbackRescheduleFailedEvent is emitted when a recurring callback could not be rescheduled after its execution.
+type CallbackRescheduleFailedEvent struct {
+	// contract_address is the address of the contract for which callback was being rescheduled (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// job_id is an identifier of the callback.
+	JobId uint64 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// callback_height is the height at which the callback would have been executed next.
+	CallbackHeight int64 `protobuf:"varint,3,opt,name=callback_height,json=callbackHeight,proto3" json:"callback_height,omitempty"`
+	// error is the reason the callback could not be rescheduled
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *CallbackCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.RefundAmount.MarshalToSizedBuffer(dAtA[:i])
+func (m *CallbackRescheduleFailedEvent) Reset()         { *m = CallbackRescheduleFailedEvent{} }
+func (m *CallbackRescheduleFailedEvent) String() string { return proto.CompactTextString(m) }
+func (*CallbackRescheduleFailedEvent) ProtoMessage()    {}
+func (*CallbackRescheduleFailedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0196c63f44b94c06, []int{5}
+}
+func (m *CallbackRescheduleFailedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallbackRescheduleFailedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackRescheduleFailedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.CallbackHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.CallbackHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.JobId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.JobId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CancelledBy) > 0 {
-		i -= len(m.CancelledBy)
-		copy(dAtA[i:], m.CancelledBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.CancelledBy)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CallbackRescheduleFailedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackRescheduleFailedEvent.Merge(m, src)
+}
+func (m *CallbackRescheduleFailedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallbackRescheduleFailedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackRescheduleFailedEvent.DiscardUnknown(m)
 }
 
-func (m *CallbackExecutedSuccessEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CallbackRescheduleFailedEvent proto.InternalMessageInfo
+
+func (m *CallbackRescheduleFailedEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CallbackExecutedSuccessEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CallbackRescheduleFailedEvent) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
+	}
+	return 0
 }
 
-func (m *CallbackExecutedSuccessEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GasUsed != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GasUsed))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.SudoMsg) > 0 {
-		i -= len(m.SudoMsg)
-		copy(dAtA[i:], m.SudoMsg)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SudoMsg)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.JobId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.JobId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *CallbackRescheduleFailedEvent) GetCallbackHeight() int64 {
+	if m != nil {
+		return m.CallbackHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CallbackExecutedFailedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CallbackRescheduleFailedEvent) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CallbackExecutedFailedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CallbackDeferredEvent is emitted when a callback is deferred to a later block as the block callback gas limit was reached.
+type CallbackDeferredEvent struct {
+	// contract_address is the address of the contract for which callback is being deferred (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// job_id is an identifier of the callback.
+	JobId uint64 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// callback_height is the height at which the callback was registered to be executed.
+	CallbackHeight int64 `protobuf:"varint,3,opt,name=callback_height,json=callbackHeight,proto3" json:"callback_height,omitempty"`
+	// callback_time is the block time at which the callback was registered to be executed.
+	CallbackTime *time.Time `protobuf:"bytes,4,opt,name=callback_time,json=callbackTime,proto3,stdtime" json:"callback_time,omitempty"`
+	// block_gas_consumed is the total gas consumed by the callbacks executed in the block before the deferral.
+	BlockGasConsumed uint64 `protobuf:"varint,5,opt,name=block_gas_consumed,json=blockGasConsumed,proto3" json:"block_gas_consumed,omitempty"`
 }
 
-func (m *CallbackExecutedFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x2a
+func (m *CallbackDeferredEvent) Reset()         { *m = CallbackDeferredEvent{} }
+func (m *CallbackDeferredEvent) String() string { return proto.CompactTextString(m) }
+func (*CallbackDeferredEvent) ProtoMessage()    {}
+func (*CallbackDeferredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0196c63f44b94c06, []int{6}
+}
+func (m *CallbackDeferredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallbackDeferredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackDeferredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.GasUsed != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GasUsed))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *CallbackDeferredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackDeferredEvent.Merge(m, src)
+}
+func (m *CallbackDeferredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallbackDeferredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackDeferredEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackDeferredEvent proto.InternalMessageInfo
+
+func (m *CallbackDeferredEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	if len(m.SudoMsg) > 0 {
-		i -= len(m.SudoMsg)
-		copy(dAtA[i:], m.SudoMsg)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SudoMsg)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *CallbackDeferredEvent) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
 	}
-	if m.JobId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.JobId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *CallbackDeferredEvent) GetCallbackHeight() int64 {
+	if m != nil {
+		return m.CallbackHeight
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *CallbackDeferredEvent) GetCallbackTime() *time.Time {
+	if m != nil {
+		return m.CallbackTime
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CallbackDeferredEvent) GetBlockGasConsumed() uint64 {
+	if m != nil {
+		return m.BlockGasConsumed
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *CallbackRegisteredEvent) Size() (n int) {
-	if m == nil {
-		return 0
+
+// CallbackUpdatedEvent is emitted when a callback is moved to a new height or block time.
+type CallbackUpdatedEvent struct {
+	// updated_by is the address which updated the callback (bech32 encoded).
+	UpdatedBy string `protobuf:"bytes,1,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// contract_address is the address of the contract (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// job_id is an identifier of the callback.
+	JobId uint64 `protobuf:"varint,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// previous_height is the height at which the callback was registered before the update.
+	PreviousHeight int64 `protobuf:"varint,4,opt,name=previous_height,json=previousHeight,proto3" json:"previous_height,omitempty"`
+	// previous_time is the block time at which the callback was registered before the update.
+	PreviousTime *time.Time `protobuf:"bytes,5,opt,name=previous_time,json=previousTime,proto3,stdtime" json:"previous_time,omitempty"`
+	// callback_height is the height at which the callback is executed.
+	CallbackHeight int64 `protobuf:"varint,6,opt,name=callback_height,json=callbackHeight,proto3" json:"callback_height,omitempty"`
+	// callback_time is the block time at or after which the callback is executed.
+	CallbackTime *time.Time `protobuf:"bytes,7,opt,name=callback_time,json=callbackTime,proto3,stdtime" json:"callback_time,omitempty"`
+	// fee_split is the recomputed breakdown of the fees paid to reserve the callback
+	FeeSplit *CallbackFeesFeeSplit `protobuf:"bytes,8,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split,omitempty"`
+	// refund_amount is the amount of reservation fees which was refunded on the update
+	RefundAmount types.Coin `protobuf:"bytes,9,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount"`
+}
+
+func (m *CallbackUpdatedEvent) Reset()         { *m = CallbackUpdatedEvent{} }
+func (m *CallbackUpdatedEvent) String() string { return proto.CompactTextString(m) }
+func (*CallbackUpdatedEvent) ProtoMessage()    {}
+func (*CallbackUpdatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0196c63f44b94c06, []int{7}
+}
+func (m *CallbackUpdatedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallbackUpdatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackUpdatedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *CallbackUpdatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackUpdatedEvent.Merge(m, src)
+}
+func (m *CallbackUpdatedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallbackUpdatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackUpdatedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackUpdatedEvent proto.InternalMessageInfo
+
+func (m *CallbackUpdatedEvent) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	if m.JobId != 0 {
-		n += 1 + sovEvents(uint64(m.JobId))
+	return ""
+}
+
+func (m *CallbackUpdatedEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	if m.CallbackHeight != 0 {
-		n += 1 + sovEvents(uint64(m.CallbackHeight))
+	return ""
+}
+
+func (m *CallbackUpdatedEvent) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
 	}
-	if m.FeeSplit != nil {
-		l = m.FeeSplit.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *CallbackUpdatedEvent) GetPreviousHeight() int64 {
+	if m != nil {
+		return m.PreviousHeight
 	}
-	l = len(m.ReservedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *CallbackUpdatedEvent) GetPreviousTime() *time.Time {
+	if m != nil {
+		return m.PreviousTime
 	}
-	return n
+	return nil
 }
 
-func (m *CallbackCancelledEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CallbackUpdatedEvent) GetCallbackHeight() int64 {
+	if m != nil {
+		return m.CallbackHeight
 	}
-	var l int
-	_ = l
-	l = len(m.CancelledBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *CallbackUpdatedEvent) GetCallbackTime() *time.Time {
+	if m != nil {
+		return m.CallbackTime
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+func (m *CallbackUpdatedEvent) GetFeeSplit() *CallbackFeesFeeSplit {
+	if m != nil {
+		return m.FeeSplit
 	}
-	if m.JobId != 0 {
-		n += 1 + sovEvents(uint64(m.JobId))
+	return nil
+}
+
+func (m *CallbackUpdatedEvent) GetRefundAmount() types.Coin {
+	if m != nil {
+		return m.RefundAmount
 	}
-	if m.CallbackHeight != 0 {
-		n += 1 + sovEvents(uint64(m.CallbackHeight))
+	return types.Coin{}
+}
+
+// EventSubscriptionRegisteredEvent is emitted when a contract subscribes to events.
+type EventSubscriptionRegisteredEvent struct {
+	// subscription_id is the identifier of the subscription.
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// contract_address is the address of the subscribed contract (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// event_type is the type of the events the contract is subscribed to.
+	EventType string `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// reserved_by is the address which registered the subscription (bech32 encoded).
+	ReservedBy string `protobuf:"bytes,4,opt,name=reserved_by,json=reservedBy,proto3" json:"reserved_by,omitempty"`
+	// fees is the amount of fees prepaid for the event deliveries.
+	Fees types.Coin `protobuf:"bytes,5,opt,name=fees,proto3" json:"fees"`
+}
+
+func (m *EventSubscriptionRegisteredEvent) Reset()         { *m = EventSubscriptionRegisteredEvent{} }
+func (m *EventSubscriptionRegisteredEvent) String() string { return proto.CompactTextString(m) }
+func (*EventSubscriptionRegisteredEvent) ProtoMessage()    {}
+func (*EventSubscriptionRegisteredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0196c63f44b94c06, []int{8}
+}
+func (m *EventSubscriptionRegisteredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSubscriptionRegisteredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSubscriptionRegisteredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.RefundAmount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+}
+func (m *EventSubscriptionRegisteredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubscriptionRegisteredEvent.Merge(m, src)
+}
+func (m *EventSubscriptionRegisteredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSubscriptionRegisteredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubscriptionRegisteredEvent.DiscardUnknown(m)
 }
 
-func (m *CallbackExecutedSuccessEvent) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventSubscriptionRegisteredEvent proto.InternalMessageInfo
+
+func (m *EventSubscriptionRegisteredEvent) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *EventSubscriptionRegisteredEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	if m.JobId != 0 {
-		n += 1 + sovEvents(uint64(m.JobId))
+	return ""
+}
+
+func (m *EventSubscriptionRegisteredEvent) GetEventType() string {
+	if m != nil {
+		return m.EventType
 	}
-	l = len(m.SudoMsg)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *EventSubscriptionRegisteredEvent) GetReservedBy() string {
+	if m != nil {
+		return m.ReservedBy
 	}
-	if m.GasUsed != 0 {
-		n += 1 + sovEvents(uint64(m.GasUsed))
+	return ""
+}
+
+func (m *EventSubscriptionRegisteredEvent) GetFees() types.Coin {
+	if m != nil {
+		return m.Fees
+	}
+	return types.Coin{}
+}
+
+// EventSubscriptionRemovedEvent is emitted when an event subscription is removed, either on request or
+// because its prepaid fees are not enough to pay for another delivery.
+type EventSubscriptionRemovedEvent struct {
+	// subscription_id is the identifier of the subscription.
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// contract_address is the address of the subscribed contract (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// refund_amount is the amount of prepaid fees which was refunded.
+	RefundAmount types.Coin `protobuf:"bytes,3,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount"`
+	// reason is the reason the subscription was removed.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventSubscriptionRemovedEvent) Reset()         { *m = EventSubscriptionRemovedEvent{} }
+func (m *EventSubscriptionRemovedEvent) String() string { return proto.CompactTextString(m) }
+func (*EventSubscriptionRemovedEvent) ProtoMessage()    {}
+func (*EventSubscriptionRemovedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0196c63f44b94c06, []int{9}
+}
+func (m *EventSubscriptionRemovedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSubscriptionRemovedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSubscriptionRemovedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSubscriptionRemovedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubscriptionRemovedEvent.Merge(m, src)
+}
+func (m *EventSubscriptionRemovedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSubscriptionRemovedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubscriptionRemovedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSubscriptionRemovedEvent proto.InternalMessageInfo
+
+func (m *EventSubscriptionRemovedEvent) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return 0
+}
+
+func (m *EventSubscriptionRemovedEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *EventSubscriptionRemovedEvent) GetRefundAmount() types.Coin {
+	if m != nil {
+		return m.RefundAmount
+	}
+	return types.Coin{}
+}
+
+func (m *EventSubscriptionRemovedEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventsDeliveredEvent is emitted when the matching events of a block are delivered to a subscribed contract.
+type EventsDeliveredEvent struct {
+	// subscription_id is the identifier of the subscription.
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// contract_address is the address of the subscribed contract (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// events_count is the number of events delivered.
+	EventsCount uint64 `protobuf:"varint,3,opt,name=events_count,json=eventsCount,proto3" json:"events_count,omitempty"`
+	// gas_used is the amount of gas consumed by the delivery.
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// fees_consumed is the amount of prepaid fees charged for the delivery.
+	FeesConsumed types.Coin `protobuf:"bytes,5,opt,name=fees_consumed,json=feesConsumed,proto3" json:"fees_consumed"`
+	// error is the error returned by the contract, or the reason the events were not delivered, if any.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventsDeliveredEvent) Reset()         { *m = EventsDeliveredEvent{} }
+func (m *EventsDeliveredEvent) String() string { return proto.CompactTextString(m) }
+func (*EventsDeliveredEvent) ProtoMessage()    {}
+func (*EventsDeliveredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0196c63f44b94c06, []int{10}
+}
+func (m *EventsDeliveredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsDeliveredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsDeliveredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventsDeliveredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsDeliveredEvent.Merge(m, src)
+}
+func (m *EventsDeliveredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventsDeliveredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsDeliveredEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsDeliveredEvent proto.InternalMessageInfo
+
+func (m *EventsDeliveredEvent) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return 0
+}
+
+func (m *EventsDeliveredEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *EventsDeliveredEvent) GetEventsCount() uint64 {
+	if m != nil {
+		return m.EventsCount
+	}
+	return 0
+}
+
+func (m *EventsDeliveredEvent) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EventsDeliveredEvent) GetFeesConsumed() types.Coin {
+	if m != nil {
+		return m.FeesConsumed
+	}
+	return types.Coin{}
+}
+
+func (m *EventsDeliveredEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// CallbackRetryScheduledEvent is emitted when a failed callback execution is scheduled to be attempted again.
+type CallbackRetryScheduledEvent struct {
+	// contract_address is the address of the contract for which callback is being retried (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// job_id is an identifier of the callback.
+	JobId uint64 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// failed_attempts is the number of failed attempts of the execution so far.
+	FailedAttempts uint64 `protobuf:"varint,3,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	// callback_height is the height at which the execution is attempted again.
+	CallbackHeight int64 `protobuf:"varint,4,opt,name=callback_height,json=callbackHeight,proto3" json:"callback_height,omitempty"`
+	// fee_split is the breakdown of the fees charged from the surplus fees for the next attempt
+	FeeSplit *CallbackFeesFeeSplit `protobuf:"bytes,5,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split,omitempty"`
+	// error is the error returned by the failed attempt
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *CallbackRetryScheduledEvent) Reset()         { *m = CallbackRetryScheduledEvent{} }
+func (m *CallbackRetryScheduledEvent) String() string { return proto.CompactTextString(m) }
+func (*CallbackRetryScheduledEvent) ProtoMessage()    {}
+func (*CallbackRetryScheduledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0196c63f44b94c06, []int{11}
+}
+func (m *CallbackRetryScheduledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallbackRetryScheduledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackRetryScheduledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallbackRetryScheduledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackRetryScheduledEvent.Merge(m, src)
+}
+func (m *CallbackRetryScheduledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallbackRetryScheduledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackRetryScheduledEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackRetryScheduledEvent proto.InternalMessageInfo
+
+func (m *CallbackRetryScheduledEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *CallbackRetryScheduledEvent) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
+	}
+	return 0
+}
+
+func (m *CallbackRetryScheduledEvent) GetFailedAttempts() uint64 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
+func (m *CallbackRetryScheduledEvent) GetCallbackHeight() int64 {
+	if m != nil {
+		return m.CallbackHeight
+	}
+	return 0
+}
+
+func (m *CallbackRetryScheduledEvent) GetFeeSplit() *CallbackFeesFeeSplit {
+	if m != nil {
+		return m.FeeSplit
+	}
+	return nil
+}
+
+func (m *CallbackRetryScheduledEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CallbackRegisteredEvent)(nil), "archway.callback.v1.CallbackRegisteredEvent")
+	proto.RegisterType((*CallbackCancelledEvent)(nil), "archway.callback.v1.CallbackCancelledEvent")
+	proto.RegisterType((*CallbackExecutedSuccessEvent)(nil), "archway.callback.v1.CallbackExecutedSuccessEvent")
+	proto.RegisterType((*CallbackExecutedFailedEvent)(nil), "archway.callback.v1.CallbackExecutedFailedEvent")
+	proto.RegisterType((*CallbackRescheduledEvent)(nil), "archway.callback.v1.CallbackRescheduledEvent")
+	proto.RegisterType((*CallbackRescheduleFailedEvent)(nil), "archway.callback.v1.CallbackRescheduleFailedEvent")
+	proto.RegisterType((*CallbackDeferredEvent)(nil), "archway.callback.v1.CallbackDeferredEvent")
+	proto.RegisterType((*CallbackUpdatedEvent)(nil), "archway.callback.v1.CallbackUpdatedEvent")
+	proto.RegisterType((*EventSubscriptionRegisteredEvent)(nil), "archway.callback.v1.EventSubscriptionRegisteredEvent")
+	proto.RegisterType((*EventSubscriptionRemovedEvent)(nil), "archway.callback.v1.EventSubscriptionRemovedEvent")
+	proto.RegisterType((*EventsDeliveredEvent)(nil), "archway.callback.v1.EventsDeliveredEvent")
+	proto.RegisterType((*CallbackRetryScheduledEvent)(nil), "archway.callback.v1.CallbackRetryScheduledEvent")
+}
+
+func init() { proto.RegisterFile("archway/callback/v1/events.proto", fileDescriptor_0196c63f44b94c06) }
+
+var fileDescriptor_0196c63f44b94c06 = []byte{
+	// 972 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x3f, 0x6f, 0xdb, 0x46,
+	0x14, 0x37, 0x65, 0x4a, 0xb6, 0x4e, 0xfe, 0x13, 0x30, 0x4a, 0x2a, 0xbb, 0xb5, 0xac, 0x70, 0x89,
+	0x03, 0xb4, 0x24, 0x14, 0x7f, 0x02, 0x4b, 0x96, 0xdb, 0x0c, 0x59, 0xe8, 0x64, 0xe9, 0x42, 0x1c,
+	0xc9, 0x27, 0x8a, 0x31, 0xc9, 0x23, 0x78, 0x47, 0x25, 0xda, 0xbb, 0x37, 0x1f, 0xa1, 0x6b, 0xb7,
+	0x02, 0xed, 0x57, 0x28, 0x90, 0x31, 0xdd, 0x3a, 0xb5, 0x85, 0x8d, 0x4e, 0xfd, 0x00, 0x5d, 0x0b,
+	0x1e, 0xef, 0x24, 0x59, 0x12, 0x1a, 0x55, 0x90, 0x9b, 0x8d, 0xef, 0xf7, 0xee, 0x41, 0xef, 0xfd,
+	0xde, 0x5f, 0xa1, 0x16, 0x4e, 0xdd, 0xc1, 0x6b, 0x3c, 0x32, 0x5d, 0x1c, 0x86, 0x0e, 0x76, 0xaf,
+	0xcc, 0x61, 0xdb, 0x84, 0x21, 0xc4, 0x8c, 0x1a, 0x49, 0x4a, 0x18, 0xd1, 0xee, 0x8b, 0x17, 0x86,
+	0x7c, 0x61, 0x0c, 0xdb, 0x87, 0xfa, 0x22, 0xb3, 0xf1, 0x03, 0x6e, 0x78, 0x58, 0xf7, 0x89, 0x4f,
+	0xf8, 0xa7, 0x99, 0x7f, 0x09, 0xb4, 0xe9, 0x12, 0x1a, 0x11, 0x6a, 0x3a, 0x98, 0x82, 0x39, 0x6c,
+	0x3b, 0xc0, 0x70, 0xdb, 0x74, 0x49, 0x10, 0x0b, 0xfd, 0x41, 0xa1, 0xb7, 0x0b, 0xc3, 0x42, 0x10,
+	0xaa, 0x63, 0x9f, 0x10, 0x3f, 0x04, 0x93, 0x4b, 0x4e, 0xd6, 0x37, 0x59, 0x10, 0x01, 0x65, 0x38,
+	0x4a, 0x8a, 0x07, 0xfa, 0x4f, 0x25, 0xf4, 0x49, 0x57, 0x38, 0x61, 0x81, 0x1f, 0x50, 0x06, 0x29,
+	0x78, 0xbd, 0x3c, 0x1a, 0xed, 0x09, 0xba, 0xe7, 0x92, 0x98, 0xa5, 0xd8, 0x65, 0x36, 0xf6, 0xbc,
+	0x14, 0x28, 0x6d, 0x28, 0x2d, 0xe5, 0xa4, 0x6a, 0xed, 0x4b, 0xfc, 0xac, 0x80, 0xb5, 0x07, 0xa8,
+	0xf2, 0x8a, 0x38, 0x76, 0xe0, 0x35, 0x4a, 0x2d, 0xe5, 0x44, 0xb5, 0xca, 0xaf, 0x88, 0xf3, 0xcc,
+	0xd3, 0x1e, 0xa3, 0x7d, 0x19, 0xa1, 0x3d, 0x80, 0xc0, 0x1f, 0xb0, 0xc6, 0x66, 0x4b, 0x39, 0xd9,
+	0xb4, 0xf6, 0x24, 0xfc, 0x15, 0x47, 0xb5, 0x0b, 0x54, 0xed, 0x03, 0xd8, 0x34, 0x09, 0x03, 0xd6,
+	0x50, 0x5b, 0xca, 0x49, 0xed, 0xe9, 0x13, 0x63, 0x01, 0x8b, 0x86, 0xf4, 0xf5, 0x02, 0x80, 0x5e,
+	0x00, 0x5c, 0xe6, 0x06, 0xd6, 0x76, 0x5f, 0x7c, 0x69, 0xc7, 0xa8, 0x96, 0x02, 0x85, 0x74, 0x08,
+	0x9e, 0xed, 0x8c, 0x1a, 0x65, 0xee, 0x2d, 0x92, 0x50, 0x67, 0xa4, 0xf5, 0xd0, 0xee, 0xd8, 0xa3,
+	0x9c, 0x8b, 0x46, 0x85, 0xff, 0xd8, 0xa1, 0x51, 0x10, 0x65, 0x48, 0xa2, 0x8c, 0x17, 0x92, 0xa8,
+	0x8e, 0xfa, 0xf6, 0xf7, 0x63, 0xc5, 0xda, 0x91, 0x66, 0xb9, 0x42, 0xff, 0xb1, 0x84, 0x1e, 0x4a,
+	0x57, 0xba, 0x38, 0x76, 0x21, 0x0c, 0x25, 0x6b, 0x8f, 0xd0, 0x8e, 0x2b, 0x91, 0xdc, 0x87, 0x82,
+	0xb1, 0xda, 0x18, 0xeb, 0x8c, 0x16, 0x12, 0x5b, 0xfa, 0x10, 0xb1, 0x9b, 0x1f, 0x20, 0x56, 0x5d,
+	0x48, 0xec, 0x39, 0xda, 0x4d, 0xa1, 0x9f, 0xc5, 0x9e, 0x8d, 0x23, 0x92, 0xc5, 0x8c, 0x53, 0x52,
+	0x7b, 0x7a, 0x60, 0x88, 0x32, 0xc9, 0x6b, 0xca, 0x10, 0x35, 0x65, 0x74, 0x49, 0x10, 0x77, 0xd4,
+	0x77, 0xbf, 0x1d, 0x6f, 0x58, 0x3b, 0x85, 0xd5, 0x19, 0x37, 0x5a, 0x17, 0x6b, 0x3f, 0x28, 0xe8,
+	0x33, 0xc9, 0x5a, 0xef, 0x0d, 0xb8, 0x19, 0x03, 0xef, 0x32, 0x73, 0x5d, 0xa0, 0x74, 0x5d, 0x15,
+	0x77, 0x80, 0xb6, 0x69, 0xe6, 0x11, 0x3b, 0xa2, 0x3e, 0x67, 0xac, 0x6a, 0x6d, 0xe5, 0xf2, 0x73,
+	0xea, 0xe7, 0x2a, 0x1f, 0x53, 0x3b, 0xa3, 0xe0, 0x71, 0xb2, 0x54, 0x6b, 0xcb, 0xc7, 0xf4, 0x25,
+	0x05, 0x4f, 0x6b, 0xa0, 0x2d, 0xcc, 0x18, 0x44, 0x49, 0xc1, 0x8f, 0x6a, 0x49, 0x51, 0xff, 0x59,
+	0x41, 0x9f, 0xce, 0xba, 0x7c, 0x81, 0x83, 0x10, 0xbc, 0x8f, 0xeb, 0x71, 0x1d, 0x95, 0x21, 0x4d,
+	0x49, 0x2a, 0x4a, 0xbc, 0x10, 0xa6, 0xe3, 0xa8, 0xdc, 0x8e, 0xe3, 0xfb, 0x12, 0x6a, 0x4c, 0xfa,
+	0x9c, 0xba, 0x03, 0xf0, 0xb2, 0x70, 0xad, 0x8d, 0x9e, 0xa4, 0x30, 0x0c, 0x48, 0x46, 0x67, 0x1a,
+	0x5d, 0xc2, 0xa2, 0x1e, 0x97, 0x2e, 0xdc, 0x5b, 0x13, 0xa1, 0xbc, 0xfa, 0x44, 0x68, 0xa3, 0x7a,
+	0x0a, 0x11, 0x0e, 0xe2, 0x20, 0xf6, 0x6d, 0xe0, 0x19, 0x0c, 0x48, 0x4c, 0x05, 0x3f, 0xf7, 0xc7,
+	0xba, 0xde, 0x58, 0xa5, 0x7f, 0xa7, 0xa0, 0xa3, 0x79, 0xae, 0xd6, 0x9b, 0xf5, 0xa5, 0x27, 0xe3,
+	0x38, 0xd1, 0xea, 0x54, 0xa2, 0xf5, 0xbf, 0x15, 0xf4, 0x40, 0xba, 0x78, 0x0e, 0x7d, 0x48, 0x3f,
+	0xc6, 0xd0, 0x9e, 0x9b, 0x0a, 0xea, 0x2a, 0x53, 0x41, 0xfb, 0x1c, 0x69, 0x4e, 0x48, 0xdc, 0x2b,
+	0x3b, 0xaf, 0x75, 0x97, 0xc4, 0x34, 0x8b, 0xc0, 0x13, 0x7d, 0x78, 0x8f, 0x6b, 0xbe, 0xc4, 0xb4,
+	0x2b, 0x70, 0xfd, 0xcf, 0x4d, 0x54, 0x97, 0x91, 0xbf, 0x4c, 0x3c, 0xcc, 0x64, 0xe0, 0x47, 0x08,
+	0x65, 0x85, 0x3c, 0x99, 0xba, 0x55, 0x81, 0xac, 0x6b, 0xe6, 0xce, 0xd6, 0xb8, 0xba, 0xb0, 0xc6,
+	0x7b, 0x68, 0x77, 0xfc, 0x90, 0xf3, 0x52, 0x5e, 0x96, 0x17, 0x69, 0xc6, 0x79, 0x59, 0x90, 0x87,
+	0xca, 0x72, 0x79, 0xd8, 0x5a, 0x29, 0x0f, 0xb7, 0x3a, 0x6e, 0x7b, 0xf5, 0x8e, 0x9b, 0x5b, 0x39,
+	0xd5, 0x15, 0x56, 0x8e, 0xfe, 0x97, 0x82, 0x5a, 0x3c, 0xb1, 0x97, 0x99, 0x43, 0xdd, 0x34, 0x48,
+	0xf2, 0xde, 0x9c, 0xbd, 0x50, 0x1e, 0xa3, 0x7d, 0x3a, 0xa5, 0xce, 0x53, 0xa6, 0xf0, 0x94, 0xed,
+	0x4d, 0xc3, 0xcf, 0xbc, 0xff, 0x92, 0xfd, 0x23, 0x84, 0xf8, 0x31, 0x67, 0xb3, 0x51, 0x02, 0x62,
+	0x22, 0x57, 0x39, 0xf2, 0x62, 0x94, 0xc0, 0xec, 0x85, 0xa1, 0xce, 0x5d, 0x18, 0xa7, 0x48, 0xed,
+	0x03, 0xd0, 0x65, 0x17, 0x2d, 0x7f, 0xac, 0xff, 0xa2, 0xa0, 0xa3, 0x05, 0xd1, 0x46, 0x64, 0x78,
+	0x97, 0xa1, 0xce, 0x65, 0x6a, 0x73, 0x95, 0xe3, 0xe0, 0x21, 0xaa, 0xa4, 0x80, 0x29, 0x89, 0x05,
+	0x19, 0x42, 0xd2, 0xbf, 0x29, 0xa1, 0x3a, 0xf7, 0x9d, 0x9e, 0x43, 0x18, 0x0c, 0xef, 0x36, 0x6b,
+	0x8f, 0xd0, 0x4e, 0x71, 0x82, 0xdb, 0xee, 0x38, 0x12, 0xd5, 0xaa, 0x15, 0x58, 0x97, 0xfb, 0xf9,
+	0x2f, 0xdb, 0xf4, 0x1c, 0xed, 0xe6, 0x69, 0xb8, 0x3d, 0x7d, 0x96, 0x21, 0x22, 0xb7, 0x92, 0xa3,
+	0x69, 0x32, 0xaa, 0x2b, 0xd3, 0xa3, 0xfa, 0xdb, 0xd2, 0xe4, 0x82, 0xb0, 0x80, 0xa5, 0xa3, 0xcb,
+	0x3b, 0x58, 0xbe, 0x7d, 0xbe, 0x9c, 0x6c, 0xb1, 0xed, 0xa9, 0x08, 0x7f, 0xaf, 0x80, 0xcf, 0x04,
+	0xfa, 0xff, 0x2f, 0xdf, 0x85, 0x8c, 0x74, 0x9e, 0xbf, 0xbb, 0x6e, 0x2a, 0xef, 0xaf, 0x9b, 0xca,
+	0x1f, 0xd7, 0x4d, 0xe5, 0xed, 0x4d, 0x73, 0xe3, 0xfd, 0x4d, 0x73, 0xe3, 0xd7, 0x9b, 0xe6, 0xc6,
+	0xd7, 0xa7, 0x7e, 0xc0, 0x06, 0x99, 0x63, 0xb8, 0x24, 0x32, 0xc5, 0xcf, 0x7d, 0x11, 0x03, 0x7b,
+	0x4d, 0xd2, 0x2b, 0x29, 0x9b, 0x6f, 0x26, 0x7f, 0xa0, 0xf2, 0x0e, 0xa5, 0x4e, 0x85, 0xcf, 0xb7,
+	0xd3, 0x7f, 0x06, 0x00, 0x50, 0x0a, 0x39, 0xdf, 0x98, 0x0d, 0x00, 0x00,
+}
+
+func (m *CallbackRegisteredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackRegisteredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackRegisteredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CallbackTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CallbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintEvents(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ReservedBy) > 0 {
+		i -= len(m.ReservedBy)
+		copy(dAtA[i:], m.ReservedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReservedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FeeSplit != nil {
+		{
+			size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CallbackHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CallbackHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JobId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackCancelledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CallbackTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CallbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintEvents(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.RefundAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.CallbackHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CallbackHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.JobId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CancelledBy) > 0 {
+		i -= len(m.CancelledBy)
+		copy(dAtA[i:], m.CancelledBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CancelledBy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackExecutedSuccessEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackExecutedSuccessEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackExecutedSuccessEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SudoMsg) > 0 {
+		i -= len(m.SudoMsg)
+		copy(dAtA[i:], m.SudoMsg)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SudoMsg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.JobId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackExecutedFailedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackExecutedFailedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackExecutedFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SudoMsg) > 0 {
+		i -= len(m.SudoMsg)
+		copy(dAtA[i:], m.SudoMsg)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SudoMsg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.JobId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackRescheduledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackRescheduledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackRescheduledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingExecutions != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RemainingExecutions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FeeSplit != nil {
+		{
+			size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CallbackHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CallbackHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JobId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackRescheduleFailedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackRescheduleFailedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackRescheduleFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CallbackHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CallbackHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JobId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackDeferredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackDeferredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackDeferredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGasConsumed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockGasConsumed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CallbackTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CallbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintEvents(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CallbackHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CallbackHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JobId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackUpdatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackUpdatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RefundAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.FeeSplit != nil {
+		{
+			size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CallbackTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CallbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintEvents(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CallbackHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CallbackHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PreviousTime != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PreviousTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreviousTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintEvents(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PreviousHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.JobId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSubscriptionRegisteredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubscriptionRegisteredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubscriptionRegisteredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ReservedBy) > 0 {
+		i -= len(m.ReservedBy)
+		copy(dAtA[i:], m.ReservedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReservedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubscriptionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSubscriptionRemovedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubscriptionRemovedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubscriptionRemovedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.RefundAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubscriptionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventsDeliveredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsDeliveredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsDeliveredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.FeesConsumed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.GasUsed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EventsCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EventsCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubscriptionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackRetryScheduledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackRetryScheduledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackRetryScheduledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FeeSplit != nil {
+		{
+			size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CallbackHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CallbackHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FailedAttempts != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FailedAttempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JobId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CallbackRegisteredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovEvents(uint64(m.JobId))
+	}
+	if m.CallbackHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CallbackHeight))
+	}
+	if m.FeeSplit != nil {
+		l = m.FeeSplit.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReservedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.CallbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime)
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *CallbackCancelledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CancelledBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovEvents(uint64(m.JobId))
+	}
+	if m.CallbackHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CallbackHeight))
+	}
+	l = m.RefundAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.CallbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime)
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *CallbackExecutedSuccessEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovEvents(uint64(m.JobId))
+	}
+	l = len(m.SudoMsg)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvents(uint64(m.GasUsed))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovEvents(uint64(m.Attempt))
+	}
+	return n
+}
+
+func (m *CallbackExecutedFailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovEvents(uint64(m.JobId))
+	}
+	l = len(m.SudoMsg)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvents(uint64(m.GasUsed))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovEvents(uint64(m.Attempt))
+	}
+	return n
+}
+
+func (m *CallbackRescheduledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovEvents(uint64(m.JobId))
+	}
+	if m.PreviousHeight != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousHeight))
+	}
+	if m.CallbackHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CallbackHeight))
+	}
+	if m.FeeSplit != nil {
+		l = m.FeeSplit.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.RemainingExecutions != 0 {
+		n += 1 + sovEvents(uint64(m.RemainingExecutions))
+	}
+	return n
+}
+
+func (m *CallbackRescheduleFailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovEvents(uint64(m.JobId))
+	}
+	if m.CallbackHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CallbackHeight))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *CallbackDeferredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovEvents(uint64(m.JobId))
+	}
+	if m.CallbackHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CallbackHeight))
+	}
+	if m.CallbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime)
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockGasConsumed != 0 {
+		n += 1 + sovEvents(uint64(m.BlockGasConsumed))
+	}
+	return n
+}
+
+func (m *CallbackUpdatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovEvents(uint64(m.JobId))
+	}
+	if m.PreviousHeight != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousHeight))
+	}
+	if m.PreviousTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreviousTime)
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.CallbackHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CallbackHeight))
+	}
+	if m.CallbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime)
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.FeeSplit != nil {
+		l = m.FeeSplit.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.RefundAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSubscriptionRegisteredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		n += 1 + sovEvents(uint64(m.SubscriptionId))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReservedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSubscriptionRemovedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		n += 1 + sovEvents(uint64(m.SubscriptionId))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.RefundAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventsDeliveredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		n += 1 + sovEvents(uint64(m.SubscriptionId))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.EventsCount != 0 {
+		n += 1 + sovEvents(uint64(m.EventsCount))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvents(uint64(m.GasUsed))
+	}
+	l = m.FeesConsumed.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *CallbackRetryScheduledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovEvents(uint64(m.JobId))
+	}
+	if m.FailedAttempts != 0 {
+		n += 1 + sovEvents(uint64(m.FailedAttempts))
+	}
+	if m.CallbackHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CallbackHeight))
+	}
+	if m.FeeSplit != nil {
+		l = m.FeeSplit.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CallbackRegisteredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackRegisteredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackRegisteredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			}
+			m.CallbackHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeSplit == nil {
+				m.FeeSplit = &CallbackFeesFeeSplit{}
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallbackTime == nil {
+				m.CallbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CallbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallbackCancelledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackCancelledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			}
+			m.CallbackHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallbackTime == nil {
+				m.CallbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CallbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallbackExecutedSuccessEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackExecutedSuccessEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackExecutedSuccessEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoMsg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallbackExecutedFailedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackExecutedFailedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackExecutedFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoMsg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallbackRescheduledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackRescheduledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackRescheduledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousHeight", wireType)
+			}
+			m.PreviousHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			}
+			m.CallbackHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeSplit == nil {
+				m.FeeSplit = &CallbackFeesFeeSplit{}
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingExecutions", wireType)
+			}
+			m.RemainingExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallbackRescheduleFailedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackRescheduleFailedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackRescheduleFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			}
+			m.CallbackHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallbackDeferredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackDeferredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackDeferredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			}
+			m.CallbackHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallbackTime == nil {
+				m.CallbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CallbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasConsumed", wireType)
+			}
+			m.BlockGasConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasConsumed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallbackUpdatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackUpdatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousHeight", wireType)
+			}
+			m.PreviousHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousTime == nil {
+				m.PreviousTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.PreviousTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			}
+			m.CallbackHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallbackTime == nil {
+				m.CallbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CallbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeSplit == nil {
+				m.FeeSplit = &CallbackFeesFeeSplit{}
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *CallbackExecutedFailedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.JobId != 0 {
-		n += 1 + sovEvents(uint64(m.JobId))
-	}
-	l = len(m.SudoMsg)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.GasUsed != 0 {
-		n += 1 + sovEvents(uint64(m.GasUsed))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *CallbackRegisteredEvent) Unmarshal(dAtA []byte) error {
+func (m *EventSubscriptionRegisteredEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -749,13 +3942,32 @@ func (m *CallbackRegisteredEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallbackRegisteredEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSubscriptionRegisteredEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallbackRegisteredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSubscriptionRegisteredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			m.SubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
@@ -787,30 +3999,11 @@ func (m *CallbackRegisteredEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
-			}
-			m.JobId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
 			}
-			m.CallbackHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -820,16 +4013,29 @@ func (m *CallbackRegisteredEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CallbackHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedBy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -839,33 +4045,29 @@ func (m *CallbackRegisteredEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeeSplit == nil {
-				m.FeeSplit = &CallbackFeesFeeSplit{}
-			}
-			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReservedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -875,23 +4077,24 @@ func (m *CallbackRegisteredEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReservedBy = string(dAtA[iNdEx:postIndex])
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -914,7 +4117,7 @@ func (m *CallbackRegisteredEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CallbackCancelledEvent) Unmarshal(dAtA []byte) error {
+func (m *EventSubscriptionRemovedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -937,17 +4140,17 @@ func (m *CallbackCancelledEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallbackCancelledEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSubscriptionRemovedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallbackCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSubscriptionRemovedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
 			}
-			var stringLen uint64
+			m.SubscriptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -957,24 +4160,11 @@ func (m *CallbackCancelledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SubscriptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CancelledBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
@@ -1008,10 +4198,10 @@ func (m *CallbackCancelledEvent) Unmarshal(dAtA []byte) error {
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
 			}
-			m.JobId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1021,35 +4211,30 @@ func (m *CallbackCancelledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JobId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.CallbackHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CallbackHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1059,24 +4244,23 @@ func (m *CallbackCancelledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RefundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1099,7 +4283,7 @@ func (m *CallbackCancelledEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CallbackExecutedSuccessEvent) Unmarshal(dAtA []byte) error {
+func (m *EventsDeliveredEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1122,13 +4306,32 @@ func (m *CallbackExecutedSuccessEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallbackExecutedSuccessEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventsDeliveredEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallbackExecutedSuccessEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventsDeliveredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			m.SubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
@@ -1160,11 +4363,11 @@ func (m *CallbackExecutedSuccessEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsCount", wireType)
 			}
-			m.JobId = 0
+			m.EventsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1174,16 +4377,35 @@ func (m *CallbackExecutedSuccessEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JobId |= uint64(b&0x7F) << shift
+				m.EventsCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SudoMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesConsumed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1193,29 +4415,30 @@ func (m *CallbackExecutedSuccessEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SudoMsg = string(dAtA[iNdEx:postIndex])
+			if err := m.FeesConsumed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.GasUsed = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1225,11 +4448,24 @@ func (m *CallbackExecutedSuccessEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1251,7 +4487,7 @@ func (m *CallbackExecutedSuccessEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CallbackExecutedFailedEvent) Unmarshal(dAtA []byte) error {
+func (m *CallbackRetryScheduledEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1274,10 +4510,10 @@ func (m *CallbackExecutedFailedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallbackExecutedFailedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallbackRetryScheduledEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallbackExecutedFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallbackRetryScheduledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1332,10 +4568,10 @@ func (m *CallbackExecutedFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SudoMsg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
 			}
-			var stringLen uint64
+			m.FailedAttempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1345,29 +4581,16 @@ func (m *CallbackExecutedFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FailedAttempts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SudoMsg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
 			}
-			m.GasUsed = 0
+			m.CallbackHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1377,12 +4600,48 @@ func (m *CallbackExecutedFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
+				m.CallbackHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeSplit == nil {
+				m.FeeSplit = &CallbackFeesFeeSplit{}
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
@@ -3,6 +3,7 @@ package types
 import (
 	context "context"
 
+	"cosmossdk.io/x/feegrant"
 	wasmdtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	cwerrortypes "github.com/archway-network/archway/x/cwerrors/types"
@@ -32,3 +33,13 @@ type BankKeeperExpected interface {
 type ErrorsKeeperExpected interface {
 	SetError(ctx sdk.Context, sudoErr cwerrortypes.SudoError) error
 }
+
+type CWFeesKeeperExpected interface {
+	IsGrantingContract(ctx context.Context, granter sdk.AccAddress) (bool, error)
+	RequestGrant(ctx context.Context, grantingContract sdk.AccAddress, txMsgs []sdk.Msg, wantFees sdk.Coins, signers []sdk.AccAddress) (sdk.Coins, error)
+}
+
+type FeeGrantKeeperExpected interface {
+	GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
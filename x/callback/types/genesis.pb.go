@@ -86,7 +86,7 @@ func init() {
 func init() { proto.RegisterFile("archway/callback/v1/genesis.proto", fileDescriptor_f5cf034641412b62) }
 
 var fileDescriptor_f5cf034641412b62 = []byte{
-	// 255 bytes of a gzipped FileDescriptorProto
+	// 252 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0x2c, 0x4a, 0xce,
 	0x28, 0x4f, 0xac, 0xd4, 0x4f, 0x4e, 0xcc, 0xc9, 0x49, 0x4a, 0x4c, 0xce, 0xd6, 0x2f, 0x33, 0xd4,
 	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
@@ -102,7 +102,7 @@ var fileDescriptor_f5cf034641412b62 = []byte{
 	0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xe3, 0xf4, 0xcc, 0x92, 0x8c,
 	0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0x69, 0xba, 0x79, 0xa9, 0x25, 0xe5, 0xf9, 0x45,
 	0xd9, 0x30, 0xbe, 0x7e, 0x05, 0xc2, 0x8f, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xef,
-	0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x12, 0x88, 0x41, 0x93, 0x72, 0x01, 0x00, 0x00,
+	0x19, 0x03, 0x06, 0x00, 0x12, 0x88, 0x41, 0x93, 0x72, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
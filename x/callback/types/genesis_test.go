@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/assert"
@@ -39,6 +40,20 @@ func TestGenesisValidate(t *testing.T) {
 					100,
 					math.LegacyMustNewDecFromStr("1.0"),
 					math.LegacyMustNewDecFromStr("1.0"),
+					1024,
+					math.LegacyMustNewDecFromStr("1.0"),
+					time.Hour,
+					math.LegacyMustNewDecFromStr("1.0"),
+					1000,
+					10000,
+					100,
+					5,
+					50,
+					types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+					10,
+					math.LegacyMustNewDecFromStr("1.0"),
+					math.LegacyMustNewDecFromStr("0.125"),
+					20,
 				),
 				Callbacks: []*types.Callback{
 					{
@@ -2,6 +2,7 @@ package types
 
 import (
 	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -11,9 +12,44 @@ const (
 	StoreKey = ModuleName
 	// QuerierRoute is the querier route for the module.
 	QuerierRoute = ModuleName
+	// TStoreKey defines the transient store key
+	TStoreKey = "t_" + ModuleName
 )
 
 var (
-	ParamsKeyPrefix   = collections.NewPrefix(1)
-	CallbackKeyPrefix = collections.NewPrefix(2)
+	ParamsKeyPrefix                       = collections.NewPrefix(1)
+	CallbackKeyPrefix                     = collections.NewPrefix(2)
+	TimedCallbackKeyPrefix                = collections.NewPrefix(3)
+	DeferredCallbackKeyPrefix             = collections.NewPrefix(4)
+	DeferredCallbackSequenceKeyPrefix     = collections.NewPrefix(5)
+	CallbacksByContractKeyPrefix          = collections.NewPrefix(6)
+	CallbacksByReserverKeyPrefix          = collections.NewPrefix(7)
+	ReceiptKeyPrefix                      = collections.NewPrefix(8)
+	ReceiptSequenceKeyPrefix              = collections.NewPrefix(9)
+	ContractReceiptsKeyPrefix             = collections.NewPrefix(10)
+	ReceiptDeletionBlocksKeyPrefix        = collections.NewPrefix(11)
+	EventSubscriptionKeyPrefix            = collections.NewPrefix(12)
+	EventSubscriptionSequenceKeyPrefix    = collections.NewPrefix(13)
+	EventSubscriptionsByTypeKeyPrefix     = collections.NewPrefix(14)
+	EventSubscriptionsByContractKeyPrefix = collections.NewPrefix(15)
+	TimedCallbacksByContractKeyPrefix     = collections.NewPrefix(16)
+	TimedCallbacksByReserverKeyPrefix     = collections.NewPrefix(17)
 )
+
+// Transient store key prefixes
+var (
+	// CapturedEventsKey is the prefix for the events of the block which match an event subscription
+	CapturedEventsKey = []byte{0x00}
+	// CapturedEventsCountKey is the key for the number of events captured in the block
+	CapturedEventsCountKey = []byte{0x01}
+)
+
+// GetCapturedEventsStoreKey returns the transient store key for a captured event of the given subscription
+func GetCapturedEventsStoreKey(subscriptionID uint64, eventSeq uint64) []byte {
+	return append(GetCapturedEventsPrefix(subscriptionID), sdk.Uint64ToBigEndian(eventSeq)...)
+}
+
+// GetCapturedEventsPrefix returns the transient store prefix for the captured events of the given subscription
+func GetCapturedEventsPrefix(subscriptionID uint64) []byte {
+	return append(append([]byte{}, CapturedEventsKey...), sdk.Uint64ToBigEndian(subscriptionID)...)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,9 +12,17 @@ import (
 var (
 	_ sdk.Msg = &MsgRequestCallback{}
 	_ sdk.Msg = &MsgCancelCallback{}
+	_ sdk.Msg = &MsgUpdateCallback{}
+	_ sdk.Msg = &MsgSubscribeToEvents{}
+	_ sdk.Msg = &MsgUnsubscribeFromEvents{}
+	_ sdk.Msg = &MsgRequestCallbacks{}
+	_ sdk.Msg = &MsgCancelCallbacks{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
+// MaxBatchSize is the maximum number of callbacks which can be requested or cancelled in a single batch
+const MaxBatchSize = 50
+
 // NewMsgRequestCallback creates a new MsgRequestCallback instance.
 func NewMsgRequestCallback(
 	senderAddr sdk.AccAddress,
@@ -21,6 +30,14 @@ func NewMsgRequestCallback(
 	jobId uint64,
 	callbackHeight int64,
 	fees sdk.Coin,
+	interval uint64,
+	maxExecutions uint64,
+	payload []byte,
+	callbackTime *time.Time,
+	gasLimit uint64,
+	feePayer sdk.AccAddress,
+	refundAddress sdk.AccAddress,
+	retryPolicy *RetryPolicy,
 ) *MsgRequestCallback {
 	msg := &MsgRequestCallback{
 		Sender:          senderAddr.String(),
@@ -28,6 +45,14 @@ func NewMsgRequestCallback(
 		JobId:           jobId,
 		CallbackHeight:  callbackHeight,
 		Fees:            fees,
+		Interval:        interval,
+		MaxExecutions:   maxExecutions,
+		Payload:         payload,
+		CallbackTime:    callbackTime,
+		GasLimit:        gasLimit,
+		FeePayer:        feePayer.String(),
+		RefundAddress:   refundAddress.String(),
+		RetryPolicy:     retryPolicy,
 	}
 
 	return msg
@@ -46,6 +71,33 @@ func (m MsgRequestCallback) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
 		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
 	}
+	if (m.CallbackHeight == 0) == (m.CallbackTime == nil) {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "exactly one of callback height or callback time must be set")
+	}
+	if m.CallbackTime != nil && m.Interval != 0 {
+		return errorsmod.Wrap(ErrInvalidRecurrence, "callbacks executed at a block time can not be recurring")
+	}
+	if m.Interval == 0 && m.MaxExecutions != 0 {
+		return errorsmod.Wrap(ErrInvalidRecurrence, "max executions can only be set along with an interval")
+	}
+	if m.FeePayer != "" {
+		if _, err := sdk.AccAddressFromBech32(m.FeePayer); err != nil {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid fee payer address: %v", err)
+		}
+		if !m.Fees.IsNil() && !m.Fees.IsZero() {
+			return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "fees can not be sent for a callback paid by a fee payer")
+		}
+	}
+	if m.RefundAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.RefundAddress); err != nil {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid refund address: %v", err)
+		}
+	}
+	if m.RetryPolicy != nil {
+		if err := m.RetryPolicy.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -56,12 +108,14 @@ func NewMsgCancelCallback(
 	contractAddr sdk.AccAddress,
 	jobId uint64,
 	callbackHeight int64,
+	callbackTime *time.Time,
 ) *MsgCancelCallback {
 	msg := &MsgCancelCallback{
 		Sender:          senderAddr.String(),
 		ContractAddress: contractAddr.String(),
 		JobId:           jobId,
 		CallbackHeight:  callbackHeight,
+		CallbackTime:    callbackTime,
 	}
 
 	return msg
@@ -84,6 +138,137 @@ func (m MsgCancelCallback) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgUpdateCallback creates a new MsgUpdateCallback instance.
+func NewMsgUpdateCallback(
+	senderAddr sdk.AccAddress,
+	contractAddr sdk.AccAddress,
+	jobId uint64,
+	callbackHeight int64,
+	callbackTime *time.Time,
+	newCallbackHeight int64,
+	newCallbackTime *time.Time,
+	fees sdk.Coin,
+) *MsgUpdateCallback {
+	msg := &MsgUpdateCallback{
+		Sender:            senderAddr.String(),
+		ContractAddress:   contractAddr.String(),
+		JobId:             jobId,
+		CallbackHeight:    callbackHeight,
+		CallbackTime:      callbackTime,
+		NewCallbackHeight: newCallbackHeight,
+		NewCallbackTime:   newCallbackTime,
+		Fees:              fees,
+	}
+
+	return msg
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgUpdateCallback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgUpdateCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+	if (m.NewCallbackHeight == 0) == (m.NewCallbackTime == nil) {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "exactly one of new callback height or new callback time must be set")
+	}
+	if !m.Fees.IsNil() {
+		if err := m.Fees.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidCoins, "invalid fees: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// NewMsgSubscribeToEvents creates a new MsgSubscribeToEvents instance.
+func NewMsgSubscribeToEvents(
+	senderAddr sdk.AccAddress,
+	contractAddr sdk.AccAddress,
+	eventType string,
+	attributeFilters []EventAttribute,
+	gasLimit uint64,
+	fees sdk.Coin,
+) *MsgSubscribeToEvents {
+	msg := &MsgSubscribeToEvents{
+		Sender:           senderAddr.String(),
+		ContractAddress:  contractAddr.String(),
+		EventType:        eventType,
+		AttributeFilters: attributeFilters,
+		GasLimit:         gasLimit,
+		Fees:             fees,
+	}
+
+	return msg
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgSubscribeToEvents) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgSubscribeToEvents) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+	if m.EventType == "" {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "event type must be set")
+	}
+	for _, attr := range m.AttributeFilters {
+		if attr.Key == "" {
+			return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "attribute filter key must be set")
+		}
+	}
+	if err := m.Fees.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidCoins, "invalid fees: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgUnsubscribeFromEvents creates a new MsgUnsubscribeFromEvents instance.
+func NewMsgUnsubscribeFromEvents(
+	senderAddr sdk.AccAddress,
+	contractAddr sdk.AccAddress,
+	subscriptionID uint64,
+) *MsgUnsubscribeFromEvents {
+	msg := &MsgUnsubscribeFromEvents{
+		Sender:          senderAddr.String(),
+		ContractAddress: contractAddr.String(),
+		SubscriptionId:  subscriptionID,
+	}
+
+	return msg
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgUnsubscribeFromEvents) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgUnsubscribeFromEvents) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+
+	return nil
+}
+
 // GetSigners implements the sdk.Msg interface.
 func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	senderAddr, err := sdk.AccAddressFromBech32(m.Authority)
@@ -102,3 +287,103 @@ func (m MsgUpdateParams) ValidateBasic() error {
 
 	return m.Params.Validate()
 }
+
+// NewMsgRequestCallbacks creates a new MsgRequestCallbacks instance.
+func NewMsgRequestCallbacks(
+	senderAddr sdk.AccAddress,
+	callbacks []CallbackRequest,
+) *MsgRequestCallbacks {
+	msg := &MsgRequestCallbacks{
+		Sender:    senderAddr.String(),
+		Callbacks: callbacks,
+	}
+
+	return msg
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgRequestCallbacks) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgRequestCallbacks) ValidateBasic() error {
+	if len(m.Callbacks) == 0 {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "at least one callback must be requested")
+	}
+	if len(m.Callbacks) > MaxBatchSize {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "at most %d callbacks can be requested at once", MaxBatchSize)
+	}
+	for i, callback := range m.Callbacks {
+		if err := callback.ToMsg(m.Sender).ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "callbacks[%d]", i)
+		}
+	}
+
+	return nil
+}
+
+// ToMsg returns the MsgRequestCallback equivalent to the batch entry.
+func (r CallbackRequest) ToMsg(sender string) MsgRequestCallback {
+	return MsgRequestCallback{
+		Sender:          sender,
+		ContractAddress: r.ContractAddress,
+		JobId:           r.JobId,
+		CallbackHeight:  r.CallbackHeight,
+		Fees:            r.Fees,
+		Interval:        r.Interval,
+		MaxExecutions:   r.MaxExecutions,
+		Payload:         r.Payload,
+		CallbackTime:    r.CallbackTime,
+		GasLimit:        r.GasLimit,
+		FeePayer:        r.FeePayer,
+		RefundAddress:   r.RefundAddress,
+		RetryPolicy:     r.RetryPolicy,
+	}
+}
+
+// NewMsgCancelCallbacks creates a new MsgCancelCallbacks instance.
+func NewMsgCancelCallbacks(
+	senderAddr sdk.AccAddress,
+	callbacks []CallbackCancellation,
+) *MsgCancelCallbacks {
+	msg := &MsgCancelCallbacks{
+		Sender:    senderAddr.String(),
+		Callbacks: callbacks,
+	}
+
+	return msg
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgCancelCallbacks) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgCancelCallbacks) ValidateBasic() error {
+	if len(m.Callbacks) == 0 {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "at least one callback must be cancelled")
+	}
+	if len(m.Callbacks) > MaxBatchSize {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "at most %d callbacks can be cancelled at once", MaxBatchSize)
+	}
+	for i, callback := range m.Callbacks {
+		if err := callback.ToMsg(m.Sender).ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "callbacks[%d]", i)
+		}
+	}
+
+	return nil
+}
+
+// ToMsg returns the MsgCancelCallback equivalent to the batch entry.
+func (c CallbackCancellation) ToMsg(sender string) MsgCancelCallback {
+	return MsgCancelCallback{
+		Sender:          sender,
+		ContractAddress: c.ContractAddress,
+		JobId:           c.JobId,
+		CallbackHeight:  c.CallbackHeight,
+		CallbackTime:    c.CallbackTime,
+	}
+}
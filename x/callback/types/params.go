@@ -2,16 +2,42 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
 	"cosmossdk.io/math"
 )
 
 var (
-	DefaultCallbackGasLimit               = uint64(1000000)
-	DefaultMaxBlockReservationLimit       = uint64(3)
-	DefaultMaxFutureReservationLimit      = uint64(10000)
-	DefaultBlockReservationFeeMultiplier  = math.LegacyMustNewDecFromStr("1.0")
-	DefaultFutureReservationFeeMultiplier = math.LegacyMustNewDecFromStr("1.0")
+	DefaultCallbackGasLimit                   = uint64(1000000)
+	DefaultMaxBlockReservationLimit           = uint64(3)
+	DefaultMaxFutureReservationLimit          = uint64(10000)
+	DefaultBlockReservationFeeMultiplier      = math.LegacyMustNewDecFromStr("1.0")
+	DefaultFutureReservationFeeMultiplier     = math.LegacyMustNewDecFromStr("1.0")
+	DefaultMaxPayloadSize                     = uint64(1024)
+	DefaultPayloadFeeMultiplier               = math.LegacyMustNewDecFromStr("1.0")
+	DefaultMaxFutureReservationTime           = 24 * time.Hour
+	DefaultFutureReservationTimeFeeMultiplier = math.LegacyMustNewDecFromStr("1.0")
+	DefaultMaxCallbackGasLimit                = uint64(10000000)
+	DefaultBlockCallbackGasLimit              = uint64(30000000)
+	DefaultReceiptRetentionBlocks             = int64(100800) // roughly 7 days
+	DefaultMaxEventSubscriptionsPerContract   = uint64(5)
+	DefaultMaxEventsPerDelivery               = uint64(50)
+	DefaultBlockReservationPricing            = BlockReservationPricing_BLOCK_RESERVATION_PRICING_LINEAR
+	DefaultTargetBlockReservations            = uint64(1)
+	DefaultCongestionBaseFee                  = math.LegacyMustNewDecFromStr("1.0")
+	DefaultCongestionFeeChangeRate            = math.LegacyMustNewDecFromStr("0.125")
+	DefaultMaxEventSubscriptionsPerType       = uint64(20)
+)
+
+var (
+	// MaxCongestionFee is the highest block reservation fee of the congestion pricing. The fee saturates at it
+	// instead of overflowing the decimal arithmetic, as the fees are also estimated in the end blocker
+	MaxCongestionFee = math.LegacyNewDecFromInt(math.NewIntWithDecimal(1, 27))
+	// MaxCongestionFeeChangeRate is the highest change rate of the congestion pricing, which doubles the fee for every callback
+	MaxCongestionFeeChangeRate = math.LegacyOneDec()
+	// MaxCongestionReservationsOverTarget is the highest number of callbacks which can be registered at a height over the
+	// target of the congestion pricing
+	MaxCongestionReservationsOverTarget = uint64(100)
 )
 
 // NewParams creates a new Params instance.
@@ -21,13 +47,41 @@ func NewParams(
 	maxFutureReservationLimit uint64,
 	blockReservationFeeMultiplier math.LegacyDec,
 	futureReservationFeeMultiplier math.LegacyDec,
+	maxPayloadSize uint64,
+	payloadFeeMultiplier math.LegacyDec,
+	maxFutureReservationTime time.Duration,
+	futureReservationTimeFeeMultiplier math.LegacyDec,
+	maxCallbackGasLimit uint64,
+	blockCallbackGasLimit uint64,
+	receiptRetentionBlocks int64,
+	maxEventSubscriptionsPerContract uint64,
+	maxEventsPerDelivery uint64,
+	blockReservationPricing BlockReservationPricing,
+	targetBlockReservations uint64,
+	congestionBaseFee math.LegacyDec,
+	congestionFeeChangeRate math.LegacyDec,
+	maxEventSubscriptionsPerType uint64,
 ) Params {
 	return Params{
-		CallbackGasLimit:               callbackGasLimit,
-		MaxBlockReservationLimit:       maxBlockReservationLimit,
-		MaxFutureReservationLimit:      maxFutureReservationLimit,
-		BlockReservationFeeMultiplier:  blockReservationFeeMultiplier,
-		FutureReservationFeeMultiplier: futureReservationFeeMultiplier,
+		CallbackGasLimit:                   callbackGasLimit,
+		MaxBlockReservationLimit:           maxBlockReservationLimit,
+		MaxFutureReservationLimit:          maxFutureReservationLimit,
+		BlockReservationFeeMultiplier:      blockReservationFeeMultiplier,
+		FutureReservationFeeMultiplier:     futureReservationFeeMultiplier,
+		MaxPayloadSize:                     maxPayloadSize,
+		PayloadFeeMultiplier:               payloadFeeMultiplier,
+		MaxFutureReservationTime:           maxFutureReservationTime,
+		FutureReservationTimeFeeMultiplier: futureReservationTimeFeeMultiplier,
+		MaxCallbackGasLimit:                maxCallbackGasLimit,
+		BlockCallbackGasLimit:              blockCallbackGasLimit,
+		ReceiptRetentionBlocks:             receiptRetentionBlocks,
+		MaxEventSubscriptionsPerContract:   maxEventSubscriptionsPerContract,
+		MaxEventsPerDelivery:               maxEventsPerDelivery,
+		BlockReservationPricing:            blockReservationPricing,
+		TargetBlockReservations:            targetBlockReservations,
+		CongestionBaseFee:                  congestionBaseFee,
+		CongestionFeeChangeRate:            congestionFeeChangeRate,
+		MaxEventSubscriptionsPerType:       maxEventSubscriptionsPerType,
 	}
 }
 
@@ -39,6 +93,20 @@ func DefaultParams() Params {
 		DefaultMaxFutureReservationLimit,
 		DefaultBlockReservationFeeMultiplier,
 		DefaultFutureReservationFeeMultiplier,
+		DefaultMaxPayloadSize,
+		DefaultPayloadFeeMultiplier,
+		DefaultMaxFutureReservationTime,
+		DefaultFutureReservationTimeFeeMultiplier,
+		DefaultMaxCallbackGasLimit,
+		DefaultBlockCallbackGasLimit,
+		DefaultReceiptRetentionBlocks,
+		DefaultMaxEventSubscriptionsPerContract,
+		DefaultMaxEventsPerDelivery,
+		DefaultBlockReservationPricing,
+		DefaultTargetBlockReservations,
+		DefaultCongestionBaseFee,
+		DefaultCongestionFeeChangeRate,
+		DefaultMaxEventSubscriptionsPerType,
 	)
 }
 
@@ -53,5 +121,82 @@ func (p Params) Validate() error {
 	if p.FutureReservationFeeMultiplier.IsNegative() {
 		return fmt.Errorf("FutureReservationFeeMultiplier must be greater than 0")
 	}
+	if p.PayloadFeeMultiplier.IsNil() || p.PayloadFeeMultiplier.IsNegative() {
+		return fmt.Errorf("PayloadFeeMultiplier must be greater than 0")
+	}
+	if p.MaxFutureReservationTime < 0 {
+		return fmt.Errorf("MaxFutureReservationTime must be greater than 0")
+	}
+	if p.FutureReservationTimeFeeMultiplier.IsNil() || p.FutureReservationTimeFeeMultiplier.IsNegative() {
+		return fmt.Errorf("FutureReservationTimeFeeMultiplier must be greater than 0")
+	}
+	if p.MaxCallbackGasLimit < p.CallbackGasLimit {
+		return fmt.Errorf("MaxCallbackGasLimit must be greater than or equal to CallbackGasLimit")
+	}
+	if p.BlockCallbackGasLimit < p.MaxCallbackGasLimit {
+		return fmt.Errorf("BlockCallbackGasLimit must be greater than or equal to MaxCallbackGasLimit")
+	}
+	if p.ReceiptRetentionBlocks < 0 {
+		return fmt.Errorf("ReceiptRetentionBlocks must not be negative. Current value: %d", p.ReceiptRetentionBlocks)
+	}
+	if p.MaxEventsPerDelivery == 0 {
+		return fmt.Errorf("MaxEventsPerDelivery must be greater than 0")
+	}
+	if _, ok := BlockReservationPricing_name[int32(p.BlockReservationPricing)]; !ok {
+		return fmt.Errorf("BlockReservationPricing is unknown: %d", p.BlockReservationPricing)
+	}
+	if p.TargetBlockReservations == 0 || p.TargetBlockReservations > p.MaxBlockReservationLimit {
+		return fmt.Errorf("TargetBlockReservations must be greater than 0 and less than or equal to MaxBlockReservationLimit")
+	}
+	if p.BlockReservationPricing == BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION &&
+		p.MaxBlockReservationLimit-p.TargetBlockReservations > MaxCongestionReservationsOverTarget {
+		return fmt.Errorf("MaxBlockReservationLimit must be at most %d over TargetBlockReservations", MaxCongestionReservationsOverTarget)
+	}
+	if p.CongestionBaseFee.IsNil() || p.CongestionBaseFee.IsNegative() {
+		return fmt.Errorf("CongestionBaseFee must be greater than 0")
+	}
+	if p.CongestionBaseFee.GT(MaxCongestionFee) {
+		return fmt.Errorf("CongestionBaseFee must be less than or equal to %s", MaxCongestionFee)
+	}
+	if p.CongestionFeeChangeRate.IsNil() || p.CongestionFeeChangeRate.IsNegative() {
+		return fmt.Errorf("CongestionFeeChangeRate must be greater than 0")
+	}
+	if p.CongestionFeeChangeRate.GT(MaxCongestionFeeChangeRate) {
+		return fmt.Errorf("CongestionFeeChangeRate must be less than or equal to %s", MaxCongestionFeeChangeRate)
+	}
+	if p.MaxEventSubscriptionsPerType == 0 {
+		return fmt.Errorf("MaxEventSubscriptionsPerType must be greater than 0")
+	}
 	return nil
 }
+
+// BlockReservationFee returns the block reservation fee amount of a callback registered at a height or within a second
+// at which the given number of callbacks are already registered, excluding the payload fees.
+func (p Params) BlockReservationFee(reservedCallbacks uint64) math.LegacyDec {
+	if p.BlockReservationPricing != BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION {
+		// blockReservationFeeMultiplier * totalCallbacksRegistered
+		return p.BlockReservationFeeMultiplier.MulInt64(int64(reservedCallbacks))
+	}
+
+	// congestionBaseFee * (1 + congestionFeeChangeRate) ^ (totalCallbacksRegistered - targetBlockReservations)
+	changeFactor := math.LegacyOneDec().Add(p.CongestionFeeChangeRate)
+	if reservedCallbacks >= p.TargetBlockReservations {
+		fee := p.CongestionBaseFee.Mul(saturatingPower(changeFactor, reservedCallbacks-p.TargetBlockReservations, MaxCongestionFee))
+		return math.LegacyMinDec(fee, MaxCongestionFee)
+	}
+	return p.CongestionBaseFee.Quo(saturatingPower(changeFactor, p.TargetBlockReservations-reservedCallbacks, MaxCongestionFee))
+}
+
+// saturatingPower returns base ^ power, or limit if it is lower. The base is expected to be at least 1, and the
+// limit low enough for its square not to overflow
+func saturatingPower(base math.LegacyDec, power uint64, limit math.LegacyDec) math.LegacyDec {
+	result := math.LegacyOneDec()
+	base = math.LegacyMinDec(base, limit)
+	for ; power > 0; power >>= 1 {
+		if power&1 == 1 {
+			result = math.LegacyMinDec(result.Mul(base), limit)
+		}
+		base = math.LegacyMinDec(base.Mul(base), limit)
+	}
+	return result
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/assert"
@@ -30,6 +31,20 @@ func TestParamsValidate(t *testing.T) {
 				100,
 				math.LegacyMustNewDecFromStr("1.0"),
 				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
 			),
 			errExpected: false,
 		},
@@ -41,6 +56,20 @@ func TestParamsValidate(t *testing.T) {
 				100,
 				math.LegacyMustNewDecFromStr("1.0"),
 				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
 			),
 			errExpected: true,
 		},
@@ -52,6 +81,20 @@ func TestParamsValidate(t *testing.T) {
 				100,
 				math.LegacyMustNewDecFromStr("-1.0"),
 				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
 			),
 			errExpected: true,
 		},
@@ -63,6 +106,395 @@ func TestParamsValidate(t *testing.T) {
 				100,
 				math.LegacyMustNewDecFromStr("1.0"),
 				math.LegacyMustNewDecFromStr("-1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: PayloadFeeMultiplier: negative",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("-1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: FutureReservationTimeFeeMultiplier: negative",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("-1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: MaxCallbackGasLimit: lower than CallbackGasLimit",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				99,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: BlockCallbackGasLimit: lower than MaxCallbackGasLimit",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				999,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: ReceiptRetentionBlocks: negative",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				-1,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: MaxEventsPerDelivery: zero",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				0,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: BlockReservationPricing: unknown",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing(5),
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: TargetBlockReservations: zero",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				0,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: TargetBlockReservations: higher than MaxBlockReservationLimit",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				101,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: CongestionBaseFee: negative",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("-1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: CongestionFeeChangeRate: negative",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("-0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: MaxBlockReservationLimit: too far over TargetBlockReservations",
+			params: types.NewParams(
+				100,
+				1000,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: CongestionBaseFee: too high",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				types.MaxCongestionFee.Add(math.LegacyOneDec()),
+				math.LegacyMustNewDecFromStr("0.125"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: CongestionFeeChangeRate: too high",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.5"),
+				20,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: MaxEventSubscriptionsPerType: zero",
+			params: types.NewParams(
+				100,
+				100,
+				100,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				1024,
+				math.LegacyMustNewDecFromStr("1.0"),
+				time.Hour,
+				math.LegacyMustNewDecFromStr("1.0"),
+				1000,
+				10000,
+				100,
+				5,
+				50,
+				types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION,
+				10,
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.125"),
+				0,
 			),
 			errExpected: true,
 		},
@@ -79,3 +511,31 @@ func TestParamsValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsBlockReservationFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxBlockReservationLimit = 10
+	params.BlockReservationFeeMultiplier = math.LegacyMustNewDecFromStr("2.0")
+
+	// Linear pricing charges the multiplier for every callback already registered
+	assert.Equal(t, math.LegacyZeroDec().String(), params.BlockReservationFee(0).String())
+	assert.Equal(t, math.LegacyMustNewDecFromStr("6.0").String(), params.BlockReservationFee(3).String())
+
+	// Congestion pricing charges the base fee at the target, compounded by the change rate for every callback above or below it
+	params.BlockReservationPricing = types.BlockReservationPricing_BLOCK_RESERVATION_PRICING_CONGESTION
+	params.TargetBlockReservations = 2
+	params.CongestionBaseFee = math.LegacyMustNewDecFromStr("100.0")
+	params.CongestionFeeChangeRate = math.LegacyMustNewDecFromStr("0.5")
+	assert.Equal(t, math.LegacyMustNewDecFromStr("44.444444444444444444").String(), params.BlockReservationFee(0).String())
+	assert.Equal(t, math.LegacyMustNewDecFromStr("66.666666666666666667").String(), params.BlockReservationFee(1).String())
+	assert.Equal(t, math.LegacyMustNewDecFromStr("100.0").String(), params.BlockReservationFee(2).String())
+	assert.Equal(t, math.LegacyMustNewDecFromStr("337.5").String(), params.BlockReservationFee(5).String())
+
+	// The congestion fee saturates instead of overflowing
+	params.CongestionFeeChangeRate = math.LegacyOneDec()
+	params.CongestionBaseFee = types.MaxCongestionFee
+	assert.Equal(t, types.MaxCongestionFee.String(), params.BlockReservationFee(1000).String())
+	assert.Equal(t, types.MaxCongestionFee.String(), params.BlockReservationFee(^uint64(0)).String())
+	params.TargetBlockReservations = ^uint64(0)
+	assert.True(t, params.BlockReservationFee(0).LTE(math.LegacyOneDec()))
+}
@@ -7,22 +7,27 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -117,6 +122,12 @@ func (m *QueryParamsResponse) GetParams() Params {
 type QueryEstimateCallbackFeesRequest struct {
 	// block_height is the height at which to estimate the callback fees
 	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// payload_size is the size in bytes of the payload attached to the callback
+	PayloadSize uint64 `protobuf:"varint,2,opt,name=payload_size,json=payloadSize,proto3" json:"payload_size,omitempty"`
+	// callback_time is the block time at which to estimate the callback fees. Takes precedence over block_height when set.
+	CallbackTime *time.Time `protobuf:"bytes,3,opt,name=callback_time,json=callbackTime,proto3,stdtime" json:"callback_time,omitempty"`
+	// gas_limit is the gas limit requested for the callback. Leave empty to use the callback_gas_limit module param.
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
 func (m *QueryEstimateCallbackFeesRequest) Reset()         { *m = QueryEstimateCallbackFeesRequest{} }
@@ -159,6 +170,27 @@ func (m *QueryEstimateCallbackFeesRequest) GetBlockHeight() int64 {
 	return 0
 }
 
+func (m *QueryEstimateCallbackFeesRequest) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
+	}
+	return 0
+}
+
+func (m *QueryEstimateCallbackFeesRequest) GetCallbackTime() *time.Time {
+	if m != nil {
+		return m.CallbackTime
+	}
+	return nil
+}
+
+func (m *QueryEstimateCallbackFeesRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 // QueryEstimateCallbackFeesResponse is the response for Query.EstimateCallbackFees.
 type QueryEstimateCallbackFeesResponse struct {
 	// total_fees is the total fees that needs to be paid by the contract to reserve a callback
@@ -306,503 +338,3861 @@ func (m *QueryCallbacksResponse) GetCallbacks() []*Callback {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "archway.callback.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "archway.callback.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryEstimateCallbackFeesRequest)(nil), "archway.callback.v1.QueryEstimateCallbackFeesRequest")
-	proto.RegisterType((*QueryEstimateCallbackFeesResponse)(nil), "archway.callback.v1.QueryEstimateCallbackFeesResponse")
-	proto.RegisterType((*QueryCallbacksRequest)(nil), "archway.callback.v1.QueryCallbacksRequest")
-	proto.RegisterType((*QueryCallbacksResponse)(nil), "archway.callback.v1.QueryCallbacksResponse")
+// QueryDeferredCallbacksRequest is the request for Query.DeferredCallbacks.
+type QueryDeferredCallbacksRequest struct {
+	// pagination is an optional pagination options for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("archway/callback/v1/query.proto", fileDescriptor_0c34fd4ae1f0e6aa) }
-
-var fileDescriptor_0c34fd4ae1f0e6aa = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x06, 0x22, 0xb2, 0xe1, 0xb4, 0x0d, 0xa8, 0xb8, 0xd4, 0x4d, 0x8d, 0x04, 0x81,
-	0xaa, 0xbb, 0x4a, 0x22, 0x10, 0x7f, 0x6e, 0xad, 0x1a, 0x71, 0x41, 0x2a, 0x46, 0x5c, 0xb8, 0x44,
-	0x6b, 0x6b, 0xe2, 0x58, 0x71, 0xbc, 0xae, 0x77, 0x93, 0x92, 0x1b, 0xe2, 0xcc, 0x01, 0x89, 0x67,
-	0xe0, 0x25, 0x78, 0x82, 0x1e, 0x2b, 0x21, 0x21, 0x4e, 0x08, 0x25, 0x3c, 0x08, 0xf2, 0x7a, 0x9d,
-	0x42, 0x71, 0x43, 0x7b, 0x5b, 0xcd, 0x7e, 0xdf, 0xcc, 0x6f, 0x76, 0xc6, 0x46, 0x9b, 0x2c, 0xf1,
-	0x06, 0x47, 0x6c, 0x4a, 0x3d, 0x16, 0x86, 0x2e, 0xf3, 0x86, 0x74, 0xd2, 0xa2, 0x87, 0x63, 0x48,
-	0xa6, 0x24, 0x4e, 0xb8, 0xe4, 0x78, 0x55, 0x0b, 0x48, 0x2e, 0x20, 0x93, 0x96, 0x59, 0xf7, 0xb9,
-	0xcf, 0xd5, 0x3d, 0x4d, 0x4f, 0x99, 0xd4, 0xbc, 0xed, 0x73, 0xee, 0x87, 0x40, 0x59, 0x1c, 0x50,
-	0x16, 0x45, 0x5c, 0x32, 0x19, 0xf0, 0x48, 0xe8, 0x5b, 0xcb, 0xe3, 0x62, 0xc4, 0x05, 0x75, 0x99,
-	0x00, 0x3a, 0x69, 0xb9, 0x20, 0x59, 0x8b, 0x7a, 0x3c, 0x88, 0xf4, 0xbd, 0x5d, 0x44, 0xb2, 0x28,
-	0xaa, 0x34, 0x76, 0x1d, 0xe1, 0x97, 0x29, 0xdb, 0x01, 0x4b, 0xd8, 0x48, 0x38, 0x70, 0x38, 0x06,
-	0x21, 0xed, 0x03, 0xb4, 0xfa, 0x57, 0x54, 0xc4, 0x3c, 0x12, 0x80, 0x9f, 0xa0, 0x4a, 0xac, 0x22,
-	0x6b, 0x46, 0xc3, 0x68, 0xd6, 0xda, 0xeb, 0xa4, 0xa0, 0x15, 0x92, 0x99, 0x76, 0xaf, 0x1c, 0xff,
-	0xd8, 0x2c, 0x39, 0xda, 0x60, 0xef, 0xa3, 0x86, 0xca, 0xb8, 0x2f, 0x64, 0x30, 0x62, 0x12, 0xf6,
-	0xb4, 0xa1, 0x0b, 0x90, 0x57, 0xc5, 0x5b, 0xe8, 0xba, 0x1b, 0x72, 0x6f, 0xd8, 0x1b, 0x40, 0xe0,
-	0x0f, 0xa4, 0x2a, 0x52, 0x76, 0x6a, 0x2a, 0xf6, 0x5c, 0x85, 0xec, 0xcf, 0x06, 0xda, 0x5a, 0x92,
-	0x47, 0x73, 0x3e, 0x46, 0x48, 0x72, 0xc9, 0xc2, 0x5e, 0x1f, 0x20, 0x67, 0xbd, 0x45, 0xb2, 0xd7,
-	0x22, 0xe9, 0x6b, 0x11, 0xfd, 0x5a, 0x64, 0x8f, 0x07, 0x91, 0x53, 0x55, 0xe2, 0x34, 0x03, 0xee,
-	0xa2, 0x6a, 0x1f, 0xa0, 0x27, 0xe2, 0x30, 0x90, 0x6b, 0x2b, 0xca, 0x78, 0xbf, 0xb0, 0xc9, 0x3f,
-	0xeb, 0x76, 0x01, 0x5e, 0xa5, 0x06, 0xe7, 0x5a, 0x5f, 0x9f, 0xec, 0xa7, 0xe8, 0x86, 0xc2, 0xcc,
-	0x65, 0x97, 0xe9, 0xf1, 0x35, 0xba, 0x79, 0xd6, 0xab, 0xfb, 0x7a, 0x86, 0xaa, 0x39, 0x43, 0xda,
-	0x56, 0xb9, 0x59, 0x6b, 0x6f, 0x2c, 0xa5, 0x73, 0x4e, 0xf5, 0xed, 0x6f, 0x65, 0x74, 0x55, 0xe5,
-	0xc5, 0xef, 0x0c, 0x54, 0xc9, 0x86, 0x84, 0xef, 0x15, 0xda, 0xff, 0xdd, 0x08, 0xb3, 0xf9, 0x7f,
-	0x61, 0x06, 0x69, 0xdf, 0x79, 0xff, 0xf5, 0xd7, 0xa7, 0x95, 0x0d, 0xbc, 0x4e, 0x8b, 0xd6, 0x2f,
-	0x5b, 0x07, 0xfc, 0xc5, 0x40, 0xf5, 0xa2, 0x11, 0xe2, 0x87, 0xe7, 0xd7, 0x59, 0xb2, 0x3a, 0xe6,
-	0xa3, 0xcb, 0xda, 0x34, 0x6c, 0x47, 0xc1, 0xee, 0xe0, 0xed, 0x42, 0x58, 0xd0, 0xd6, 0x5e, 0x1e,
-	0x54, 0x0b, 0x85, 0x3f, 0x18, 0xa8, 0xba, 0x18, 0x0e, 0x7e, 0x70, 0x7e, 0xe9, 0xb3, 0xd3, 0x37,
-	0xb7, 0x2f, 0xa4, 0xd5, 0x6c, 0x77, 0x15, 0x5b, 0x03, 0x5b, 0x74, 0xd9, 0x77, 0x2c, 0x76, 0x5f,
-	0x1c, 0xcf, 0x2c, 0xe3, 0x64, 0x66, 0x19, 0x3f, 0x67, 0x96, 0xf1, 0x71, 0x6e, 0x95, 0x4e, 0xe6,
-	0x56, 0xe9, 0xfb, 0xdc, 0x2a, 0xbd, 0xe9, 0xf8, 0x81, 0x1c, 0x8c, 0x5d, 0xe2, 0xf1, 0x51, 0x9e,
-	0x63, 0x27, 0x02, 0x79, 0xc4, 0x93, 0xe1, 0x22, 0xe7, 0xdb, 0xd3, 0xac, 0x72, 0x1a, 0x83, 0x70,
-	0x2b, 0xea, 0xc7, 0xd0, 0xf9, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x70, 0xbf, 0x4b, 0x6a, 0xc8, 0x04,
-	0x00, 0x00,
+func (m *QueryDeferredCallbacksRequest) Reset()         { *m = QueryDeferredCallbacksRequest{} }
+func (m *QueryDeferredCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeferredCallbacksRequest) ProtoMessage()    {}
+func (*QueryDeferredCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c34fd4ae1f0e6aa, []int{6}
+}
+func (m *QueryDeferredCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeferredCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeferredCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeferredCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeferredCallbacksRequest.Merge(m, src)
+}
+func (m *QueryDeferredCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeferredCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeferredCallbacksRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryDeferredCallbacksRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns module parameters
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// EstimateCallbackFees returns the total amount of callback fees a contract needs to pay to register the callback
-	EstimateCallbackFees(ctx context.Context, in *QueryEstimateCallbackFeesRequest, opts ...grpc.CallOption) (*QueryEstimateCallbackFeesResponse, error)
-	// Callbacks returns all the callbacks registered at a given height
-	Callbacks(ctx context.Context, in *QueryCallbacksRequest, opts ...grpc.CallOption) (*QueryCallbacksResponse, error)
+func (m *QueryDeferredCallbacksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryDeferredCallbacksResponse is the response for Query.DeferredCallbacks.
+type QueryDeferredCallbacksResponse struct {
+	// callbacks is the list of deferred callbacks in the order they are executed
+	Callbacks []*Callback `protobuf:"bytes,1,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+	// pagination is the pagination details in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryDeferredCallbacksResponse) Reset()         { *m = QueryDeferredCallbacksResponse{} }
+func (m *QueryDeferredCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeferredCallbacksResponse) ProtoMessage()    {}
+func (*QueryDeferredCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c34fd4ae1f0e6aa, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/archway.callback.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDeferredCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeferredCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeferredCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDeferredCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeferredCallbacksResponse.Merge(m, src)
+}
+func (m *QueryDeferredCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeferredCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeferredCallbacksResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) EstimateCallbackFees(ctx context.Context, in *QueryEstimateCallbackFeesRequest, opts ...grpc.CallOption) (*QueryEstimateCallbackFeesResponse, error) {
-	out := new(QueryEstimateCallbackFeesResponse)
-	err := c.cc.Invoke(ctx, "/archway.callback.v1.Query/EstimateCallbackFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeferredCallbacksResponse proto.InternalMessageInfo
+
+func (m *QueryDeferredCallbacksResponse) GetCallbacks() []*Callback {
+	if m != nil {
+		return m.Callbacks
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Callbacks(ctx context.Context, in *QueryCallbacksRequest, opts ...grpc.CallOption) (*QueryCallbacksResponse, error) {
-	out := new(QueryCallbacksResponse)
-	err := c.cc.Invoke(ctx, "/archway.callback.v1.Query/Callbacks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDeferredCallbacksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns module parameters
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// EstimateCallbackFees returns the total amount of callback fees a contract needs to pay to register the callback
-	EstimateCallbackFees(context.Context, *QueryEstimateCallbackFeesRequest) (*QueryEstimateCallbackFeesResponse, error)
-	// Callbacks returns all the callbacks registered at a given height
-	Callbacks(context.Context, *QueryCallbacksRequest) (*QueryCallbacksResponse, error)
+// QueryCallbacksByContractRequest is the request for Query.CallbacksByContract.
+type QueryCallbacksByContractRequest struct {
+	// contract_address is the address of the contract to query the callbacks for (bech32 encoded)
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// pagination is an optional pagination options for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryCallbacksByContractRequest) Reset()         { *m = QueryCallbacksByContractRequest{} }
+func (m *QueryCallbacksByContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbacksByContractRequest) ProtoMessage()    {}
+func (*QueryCallbacksByContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c34fd4ae1f0e6aa, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryCallbacksByContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EstimateCallbackFees(ctx context.Context, req *QueryEstimateCallbackFeesRequest) (*QueryEstimateCallbackFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateCallbackFees not implemented")
+func (m *QueryCallbacksByContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbacksByContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Callbacks(ctx context.Context, req *QueryCallbacksRequest) (*QueryCallbacksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Callbacks not implemented")
+func (m *QueryCallbacksByContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbacksByContractRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryCallbacksByContractRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.callback.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryCallbacksByContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbacksByContractRequest.DiscardUnknown(m)
 }
 
-func _Query_EstimateCallbackFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEstimateCallbackFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateCallbackFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.callback.v1.Query/EstimateCallbackFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateCallbackFees(ctx, req.(*QueryEstimateCallbackFeesRequest))
+var xxx_messageInfo_QueryCallbacksByContractRequest proto.InternalMessageInfo
+
+func (m *QueryCallbacksByContractRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Callbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCallbacksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Callbacks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.callback.v1.Query/Callbacks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Callbacks(ctx, req.(*QueryCallbacksRequest))
+func (m *QueryCallbacksByContractRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "archway.callback.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "EstimateCallbackFees",
-			Handler:    _Query_EstimateCallbackFees_Handler,
-		},
-		{
-			MethodName: "Callbacks",
-			Handler:    _Query_Callbacks_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "archway/callback/v1/query.proto",
+// QueryCallbacksByContractResponse is the response for Query.CallbacksByContract.
+type QueryCallbacksByContractResponse struct {
+	// callbacks is the list of callbacks registered for the given contract
+	Callbacks []*Callback `protobuf:"bytes,1,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+	// pagination is the pagination details in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCallbacksByContractResponse) Reset()         { *m = QueryCallbacksByContractResponse{} }
+func (m *QueryCallbacksByContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbacksByContractResponse) ProtoMessage()    {}
+func (*QueryCallbacksByContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c34fd4ae1f0e6aa, []int{9}
+}
+func (m *QueryCallbacksByContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCallbacksByContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbacksByContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCallbacksByContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbacksByContractResponse.Merge(m, src)
+}
+func (m *QueryCallbacksByContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCallbacksByContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbacksByContractResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryCallbacksByContractResponse proto.InternalMessageInfo
+
+func (m *QueryCallbacksByContractResponse) GetCallbacks() []*Callback {
+	if m != nil {
+		return m.Callbacks
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCallbacksByContractResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCallbacksByReserverRequest is the request for Query.CallbacksByReserver.
+type QueryCallbacksByReserverRequest struct {
+	// reserved_by is the address which reserved the callbacks (bech32 encoded)
+	ReservedBy string `protobuf:"bytes,1,opt,name=reserved_by,json=reservedBy,proto3" json:"reserved_by,omitempty"`
+	// pagination is an optional pagination options for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCallbacksByReserverRequest) Reset()         { *m = QueryCallbacksByReserverRequest{} }
+func (m *QueryCallbacksByReserverRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbacksByReserverRequest) ProtoMessage()    {}
+func (*QueryCallbacksByReserverRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c34fd4ae1f0e6aa, []int{10}
+}
+func (m *QueryCallbacksByReserverRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCallbacksByReserverRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbacksByReserverRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryEstimateCallbackFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCallbacksByReserverRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbacksByReserverRequest.Merge(m, src)
 }
-
-func (m *QueryEstimateCallbackFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCallbacksByReserverRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCallbacksByReserverRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbacksByReserverRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEstimateCallbackFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryCallbacksByReserverRequest proto.InternalMessageInfo
+
+func (m *QueryCallbacksByReserverRequest) GetReservedBy() string {
+	if m != nil {
+		return m.ReservedBy
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryEstimateCallbackFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCallbacksByReserverRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEstimateCallbackFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCallbacksByReserverResponse is the response for Query.CallbacksByReserver.
+type QueryCallbacksByReserverResponse struct {
+	// callbacks is the list of callbacks reserved by the given address
+	Callbacks []*Callback `protobuf:"bytes,1,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+	// pagination is the pagination details in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEstimateCallbackFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FeeSplit != nil {
-		{
-			size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TotalFees != nil {
-		{
-			size, err := m.TotalFees.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCallbacksByReserverResponse) Reset()         { *m = QueryCallbacksByReserverResponse{} }
+func (m *QueryCallbacksByReserverResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbacksByReserverResponse) ProtoMessage()    {}
+func (*QueryCallbacksByReserverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c34fd4ae1f0e6aa, []int{11}
+}
+func (m *QueryCallbacksByReserverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCallbacksByReserverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbacksByReserverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryCallbacksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCallbacksByReserverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbacksByReserverResponse.Merge(m, src)
 }
-
-func (m *QueryCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCallbacksByReserverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCallbacksByReserverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbacksByReserverResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryCallbacksByReserverResponse proto.InternalMessageInfo
+
+func (m *QueryCallbacksByReserverResponse) GetCallbacks() []*Callback {
+	if m != nil {
+		return m.Callbacks
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryCallbacksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCallbacksByReserverResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCallbackReceiptsRequest is the request for Query.CallbackReceipts.
+type QueryCallbackReceiptsRequest struct {
+	// contract_address is the optional address of the contract to query the receipts for (bech32 encoded)
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// pagination is an optional pagination options for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Callbacks) > 0 {
-		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCallbackReceiptsRequest) Reset()         { *m = QueryCallbackReceiptsRequest{} }
+func (m *QueryCallbackReceiptsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbackReceiptsRequest) ProtoMessage()    {}
+func (*QueryCallbackReceiptsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c34fd4ae1f0e6aa, []int{12}
+}
+func (m *QueryCallbackReceiptsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCallbackReceiptsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbackReceiptsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCallbackReceiptsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbackReceiptsRequest.Merge(m, src)
+}
+func (m *QueryCallbackReceiptsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCallbackReceiptsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbackReceiptsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryCallbackReceiptsRequest proto.InternalMessageInfo
+
+func (m *QueryCallbackReceiptsRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryCallbackReceiptsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryCallbackReceiptsResponse is the response for Query.CallbackReceipts.
+type QueryCallbackReceiptsResponse struct {
+	// receipts is the list of callback execution receipts, ordered by execution
+	Receipts []*CallbackReceipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	// pagination is the pagination details in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEstimateCallbackFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+func (m *QueryCallbackReceiptsResponse) Reset()         { *m = QueryCallbackReceiptsResponse{} }
+func (m *QueryCallbackReceiptsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbackReceiptsResponse) ProtoMessage()    {}
+func (*QueryCallbackReceiptsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c34fd4ae1f0e6aa, []int{13}
+}
+func (m *QueryCallbackReceiptsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCallbackReceiptsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbackReceiptsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCallbackReceiptsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbackReceiptsResponse.Merge(m, src)
+}
+func (m *QueryCallbackReceiptsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCallbackReceiptsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbackReceiptsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEstimateCallbackFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TotalFees != nil {
-		l = m.TotalFees.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.FeeSplit != nil {
-		l = m.FeeSplit.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryCallbackReceiptsResponse proto.InternalMessageInfo
+
+func (m *QueryCallbackReceiptsResponse) GetReceipts() []*CallbackReceipt {
+	if m != nil {
+		return m.Receipts
 	}
-	return n
+	return nil
 }
 
-func (m *QueryCallbacksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+func (m *QueryCallbackReceiptsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryCallbacksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryEventSubscriptionsRequest is the request for Query.EventSubscriptions.
+type QueryEventSubscriptionsRequest struct {
+	// contract_address is the optional address of the contract to query the subscriptions for (bech32 encoded)
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// pagination is an optional pagination options for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEventSubscriptionsRequest) Reset()         { *m = QueryEventSubscriptionsRequest{} }
+func (m *QueryEventSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEventSubscriptionsRequest) ProtoMessage()    {}
+func (*QueryEventSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c34fd4ae1f0e6aa, []int{14}
+}
+func (m *QueryEventSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEventSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEventSubscriptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEventSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEventSubscriptionsRequest.Merge(m, src)
+}
+func (m *QueryEventSubscriptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEventSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEventSubscriptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEventSubscriptionsRequest proto.InternalMessageInfo
+
+func (m *QueryEventSubscriptionsRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *QueryEventSubscriptionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEventSubscriptionsResponse is the response for Query.EventSubscriptions.
+type QueryEventSubscriptionsResponse struct {
+	// subscriptions is the list of event subscriptions
+	Subscriptions []*EventSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// pagination is the pagination details in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEventSubscriptionsResponse) Reset()         { *m = QueryEventSubscriptionsResponse{} }
+func (m *QueryEventSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEventSubscriptionsResponse) ProtoMessage()    {}
+func (*QueryEventSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c34fd4ae1f0e6aa, []int{15}
+}
+func (m *QueryEventSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEventSubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEventSubscriptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEventSubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEventSubscriptionsResponse.Merge(m, src)
+}
+func (m *QueryEventSubscriptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEventSubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEventSubscriptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEventSubscriptionsResponse proto.InternalMessageInfo
+
+func (m *QueryEventSubscriptionsResponse) GetSubscriptions() []*EventSubscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+func (m *QueryEventSubscriptionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlockReservationPricesRequest is the request for Query.BlockReservationPrices.
+type QueryBlockReservationPricesRequest struct {
+	// start_height is the first height of the range (inclusive)
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height of the range (inclusive)
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// payload_size is the size in bytes of the payload to be attached to the callback
+	PayloadSize uint64 `protobuf:"varint,3,opt,name=payload_size,json=payloadSize,proto3" json:"payload_size,omitempty"`
+	// gas_limit is the gas limit requested for the callback. If not set, the callback_gas_limit module param is used
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *QueryBlockReservationPricesRequest) Reset()         { *m = QueryBlockReservationPricesRequest{} }
+func (m *QueryBlockReservationPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockReservationPricesRequest) ProtoMessage()    {}
+func (*QueryBlockReservationPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c34fd4ae1f0e6aa, []int{16}
+}
+func (m *QueryBlockReservationPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockReservationPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockReservationPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockReservationPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockReservationPricesRequest.Merge(m, src)
+}
+func (m *QueryBlockReservationPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockReservationPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockReservationPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockReservationPricesRequest proto.InternalMessageInfo
+
+func (m *QueryBlockReservationPricesRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryBlockReservationPricesRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryBlockReservationPricesRequest) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
+	}
+	return 0
+}
+
+func (m *QueryBlockReservationPricesRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// QueryBlockReservationPricesResponse is the response for Query.BlockReservationPrices.
+type QueryBlockReservationPricesResponse struct {
+	// prices is the list of callback fees for every height of the range
+	Prices []BlockReservationPrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+}
+
+func (m *QueryBlockReservationPricesResponse) Reset()         { *m = QueryBlockReservationPricesResponse{} }
+func (m *QueryBlockReservationPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockReservationPricesResponse) ProtoMessage()    {}
+func (*QueryBlockReservationPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c34fd4ae1f0e6aa, []int{17}
+}
+func (m *QueryBlockReservationPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockReservationPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockReservationPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockReservationPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockReservationPricesResponse.Merge(m, src)
+}
+func (m *QueryBlockReservationPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockReservationPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockReservationPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockReservationPricesResponse proto.InternalMessageInfo
+
+func (m *QueryBlockReservationPricesResponse) GetPrices() []BlockReservationPrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+// BlockReservationPrice is the callback fees for a given height.
+type BlockReservationPrice struct {
+	// block_height is the height the fees are for
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// reserved_callbacks is the number of callbacks already registered at the height
+	ReservedCallbacks uint64 `protobuf:"varint,2,opt,name=reserved_callbacks,json=reservedCallbacks,proto3" json:"reserved_callbacks,omitempty"`
+	// filled is true if the max_block_reservation_limit module param is reached at the height, in which case no fees are returned
+	Filled bool `protobuf:"varint,3,opt,name=filled,proto3" json:"filled,omitempty"`
+	// fee_split is the breakdown of the fees
+	FeeSplit *CallbackFeesFeeSplit `protobuf:"bytes,4,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split,omitempty"`
+	// total_fees is the total fees to register a callback at the height
+	TotalFees *types.Coin `protobuf:"bytes,5,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+}
+
+func (m *BlockReservationPrice) Reset()         { *m = BlockReservationPrice{} }
+func (m *BlockReservationPrice) String() string { return proto.CompactTextString(m) }
+func (*BlockReservationPrice) ProtoMessage()    {}
+func (*BlockReservationPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c34fd4ae1f0e6aa, []int{18}
+}
+func (m *BlockReservationPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockReservationPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockReservationPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockReservationPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockReservationPrice.Merge(m, src)
+}
+func (m *BlockReservationPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockReservationPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockReservationPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockReservationPrice proto.InternalMessageInfo
+
+func (m *BlockReservationPrice) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *BlockReservationPrice) GetReservedCallbacks() uint64 {
+	if m != nil {
+		return m.ReservedCallbacks
+	}
+	return 0
+}
+
+func (m *BlockReservationPrice) GetFilled() bool {
+	if m != nil {
+		return m.Filled
+	}
+	return false
+}
+
+func (m *BlockReservationPrice) GetFeeSplit() *CallbackFeesFeeSplit {
+	if m != nil {
+		return m.FeeSplit
+	}
+	return nil
+}
+
+func (m *BlockReservationPrice) GetTotalFees() *types.Coin {
+	if m != nil {
+		return m.TotalFees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "archway.callback.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "archway.callback.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryEstimateCallbackFeesRequest)(nil), "archway.callback.v1.QueryEstimateCallbackFeesRequest")
+	proto.RegisterType((*QueryEstimateCallbackFeesResponse)(nil), "archway.callback.v1.QueryEstimateCallbackFeesResponse")
+	proto.RegisterType((*QueryCallbacksRequest)(nil), "archway.callback.v1.QueryCallbacksRequest")
+	proto.RegisterType((*QueryCallbacksResponse)(nil), "archway.callback.v1.QueryCallbacksResponse")
+	proto.RegisterType((*QueryDeferredCallbacksRequest)(nil), "archway.callback.v1.QueryDeferredCallbacksRequest")
+	proto.RegisterType((*QueryDeferredCallbacksResponse)(nil), "archway.callback.v1.QueryDeferredCallbacksResponse")
+	proto.RegisterType((*QueryCallbacksByContractRequest)(nil), "archway.callback.v1.QueryCallbacksByContractRequest")
+	proto.RegisterType((*QueryCallbacksByContractResponse)(nil), "archway.callback.v1.QueryCallbacksByContractResponse")
+	proto.RegisterType((*QueryCallbacksByReserverRequest)(nil), "archway.callback.v1.QueryCallbacksByReserverRequest")
+	proto.RegisterType((*QueryCallbacksByReserverResponse)(nil), "archway.callback.v1.QueryCallbacksByReserverResponse")
+	proto.RegisterType((*QueryCallbackReceiptsRequest)(nil), "archway.callback.v1.QueryCallbackReceiptsRequest")
+	proto.RegisterType((*QueryCallbackReceiptsResponse)(nil), "archway.callback.v1.QueryCallbackReceiptsResponse")
+	proto.RegisterType((*QueryEventSubscriptionsRequest)(nil), "archway.callback.v1.QueryEventSubscriptionsRequest")
+	proto.RegisterType((*QueryEventSubscriptionsResponse)(nil), "archway.callback.v1.QueryEventSubscriptionsResponse")
+	proto.RegisterType((*QueryBlockReservationPricesRequest)(nil), "archway.callback.v1.QueryBlockReservationPricesRequest")
+	proto.RegisterType((*QueryBlockReservationPricesResponse)(nil), "archway.callback.v1.QueryBlockReservationPricesResponse")
+	proto.RegisterType((*BlockReservationPrice)(nil), "archway.callback.v1.BlockReservationPrice")
+}
+
+func init() { proto.RegisterFile("archway/callback/v1/query.proto", fileDescriptor_0c34fd4ae1f0e6aa) }
+
+var fileDescriptor_0c34fd4ae1f0e6aa = []byte{
+	// 1191 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xc7, 0x33, 0x49, 0x1a, 0xc5, 0xcf, 0xad, 0x7e, 0xed, 0x24, 0x8d, 0xf2, 0xdb, 0x34, 0x76,
+	0xba, 0x45, 0x89, 0x93, 0x92, 0x5d, 0xe2, 0x34, 0x50, 0xe0, 0x02, 0x0e, 0x09, 0x3d, 0x14, 0x29,
+	0x6c, 0xe0, 0xc2, 0x65, 0x35, 0x5e, 0x8f, 0x37, 0xab, 0xd8, 0xbb, 0xdb, 0x9d, 0x49, 0x8a, 0x7b,
+	0x42, 0x70, 0x43, 0x1c, 0x8a, 0xca, 0x95, 0x23, 0x15, 0x20, 0x71, 0xe0, 0x82, 0x10, 0x47, 0x4e,
+	0x3d, 0x70, 0xa8, 0xc4, 0x85, 0x13, 0xa0, 0x84, 0x3f, 0x04, 0xed, 0xec, 0x8c, 0x1d, 0xdb, 0xeb,
+	0x4d, 0x5c, 0x05, 0xb5, 0x37, 0xef, 0x9b, 0xf7, 0xe6, 0x7d, 0xe6, 0x3b, 0xbb, 0x33, 0xdf, 0x04,
+	0x8a, 0x24, 0x72, 0xf6, 0xee, 0x93, 0x96, 0xe9, 0x90, 0x46, 0xa3, 0x4a, 0x9c, 0x7d, 0xf3, 0x70,
+	0xcd, 0xbc, 0x77, 0x40, 0xa3, 0x96, 0x11, 0x46, 0x01, 0x0f, 0xf0, 0x94, 0x4c, 0x30, 0x54, 0x82,
+	0x71, 0xb8, 0xa6, 0x4d, 0xbb, 0x81, 0x1b, 0x88, 0x71, 0x33, 0xfe, 0x95, 0xa4, 0x6a, 0xd7, 0xdc,
+	0x20, 0x70, 0x1b, 0xd4, 0x24, 0xa1, 0x67, 0x12, 0xdf, 0x0f, 0x38, 0xe1, 0x5e, 0xe0, 0x33, 0x39,
+	0x5a, 0x94, 0xa3, 0xe2, 0xa9, 0x7a, 0x50, 0x37, 0xb9, 0xd7, 0xa4, 0x8c, 0x93, 0x66, 0x28, 0x13,
+	0x0a, 0x4e, 0xc0, 0x9a, 0x01, 0x33, 0xab, 0x84, 0x51, 0xf3, 0x70, 0xad, 0x4a, 0x39, 0x59, 0x33,
+	0x9d, 0xc0, 0xf3, 0xe5, 0xf8, 0xca, 0xc9, 0x71, 0x81, 0xd8, 0xce, 0x0a, 0x89, 0xeb, 0xf9, 0xa2,
+	0x9b, 0xcc, 0xd5, 0xd3, 0x96, 0xd5, 0x5e, 0x81, 0xc8, 0xd1, 0xa7, 0x01, 0xbf, 0x1f, 0xcf, 0xb2,
+	0x43, 0x22, 0xd2, 0x64, 0x16, 0xbd, 0x77, 0x40, 0x19, 0xd7, 0x77, 0x60, 0xaa, 0x2b, 0xca, 0xc2,
+	0xc0, 0x67, 0x14, 0xbf, 0x0e, 0x13, 0xa1, 0x88, 0xcc, 0xa2, 0x05, 0x54, 0xca, 0x97, 0xe7, 0x8c,
+	0x14, 0x5d, 0x8c, 0xa4, 0xa8, 0x32, 0xfe, 0xe4, 0xcf, 0xe2, 0x88, 0x25, 0x0b, 0xf4, 0xdf, 0x10,
+	0x2c, 0x88, 0x29, 0xb7, 0x18, 0xf7, 0x9a, 0x84, 0xd3, 0x4d, 0x59, 0xb1, 0x4d, 0xa9, 0x6a, 0x8b,
+	0xaf, 0xc3, 0xc5, 0x6a, 0x23, 0x70, 0xf6, 0xed, 0x3d, 0xea, 0xb9, 0x7b, 0x5c, 0x74, 0x19, 0xb3,
+	0xf2, 0x22, 0x76, 0x47, 0x84, 0xe2, 0x94, 0x90, 0xb4, 0x1a, 0x01, 0xa9, 0xd9, 0xcc, 0x7b, 0x40,
+	0x67, 0x47, 0x17, 0x50, 0x69, 0xdc, 0xca, 0xcb, 0xd8, 0xae, 0xf7, 0x80, 0xe2, 0x2d, 0xb8, 0xa4,
+	0x70, 0xec, 0x58, 0xde, 0xd9, 0x31, 0x01, 0xab, 0x19, 0x89, 0xf6, 0x86, 0xd2, 0xde, 0xf8, 0x40,
+	0x69, 0x5f, 0x19, 0x7f, 0xf8, 0x57, 0x11, 0x59, 0x17, 0x55, 0x59, 0x3c, 0x80, 0xe7, 0x20, 0xe7,
+	0x12, 0x66, 0x37, 0xbc, 0xa6, 0xc7, 0x67, 0xc7, 0x45, 0x9b, 0x49, 0x97, 0xb0, 0xbb, 0xf1, 0xb3,
+	0xfe, 0x0d, 0x82, 0xeb, 0x19, 0xcb, 0x91, 0x7a, 0xdd, 0x06, 0xe0, 0x01, 0x27, 0x0d, 0xbb, 0x4e,
+	0xa9, 0xd2, 0xec, 0xff, 0x46, 0xb2, 0x83, 0x46, 0xbc, 0x83, 0x86, 0xdc, 0x3b, 0x63, 0x33, 0xf0,
+	0x7c, 0x2b, 0x27, 0x92, 0xe3, 0x19, 0xf0, 0x36, 0xe4, 0xea, 0x94, 0xda, 0x2c, 0x6c, 0x78, 0x5c,
+	0xac, 0x31, 0x5f, 0x5e, 0x4e, 0x15, 0xfb, 0x64, 0xdf, 0x6d, 0x4a, 0x77, 0xe3, 0x02, 0x6b, 0xb2,
+	0x2e, 0x7f, 0xe9, 0x6f, 0xc0, 0x55, 0x81, 0xa9, 0xd2, 0x86, 0x90, 0x5a, 0xff, 0x10, 0x66, 0x7a,
+	0x6b, 0xe5, 0xba, 0xde, 0x84, 0x9c, 0x62, 0x88, 0x97, 0x35, 0x56, 0xca, 0x97, 0xe7, 0x33, 0xe9,
+	0xac, 0x4e, 0xbe, 0xee, 0xc2, 0xbc, 0x98, 0xf6, 0x1d, 0x5a, 0xa7, 0x51, 0x44, 0x6b, 0x7d, 0x68,
+	0xdb, 0x00, 0x9d, 0x57, 0x59, 0xaa, 0xb6, 0xd8, 0xa5, 0x5a, 0xf2, 0x69, 0x2a, 0xed, 0x76, 0x88,
+	0x4b, 0x65, 0xad, 0x75, 0xa2, 0x52, 0x7f, 0x8c, 0xa0, 0x30, 0xa8, 0xd3, 0x39, 0x2c, 0x04, 0xbf,
+	0xdb, 0xc5, 0x99, 0x6c, 0xd2, 0xd2, 0xa9, 0x9c, 0x49, 0xe7, 0x2e, 0xd0, 0xaf, 0x10, 0x14, 0xbb,
+	0x95, 0xae, 0xb4, 0x36, 0x03, 0x9f, 0x47, 0xc4, 0xe1, 0x4a, 0x94, 0x65, 0xb8, 0xec, 0xc8, 0x90,
+	0x4d, 0x6a, 0xb5, 0x88, 0xb2, 0xe4, 0x85, 0xca, 0x59, 0xff, 0x53, 0xf1, 0xb7, 0x93, 0x30, 0xde,
+	0x4e, 0xe1, 0x7a, 0x16, 0xfd, 0xbe, 0x55, 0x9f, 0x6c, 0x2a, 0xd6, 0x0b, 0xa5, 0xe0, 0xe7, 0x29,
+	0x0a, 0x5a, 0x94, 0xd1, 0xe8, 0x90, 0x46, 0x4a, 0xc1, 0x22, 0xe4, 0xa3, 0x24, 0x54, 0xb3, 0xab,
+	0x2d, 0x29, 0x1e, 0xa8, 0x50, 0xa5, 0xf5, 0x9f, 0xea, 0xd6, 0x81, 0x79, 0xa1, 0x74, 0xfb, 0x12,
+	0xc1, 0xb5, 0x2e, 0x54, 0x8b, 0x3a, 0xd4, 0x0b, 0x39, 0x7b, 0x8e, 0xaf, 0xdd, 0xf7, 0x08, 0xe6,
+	0x07, 0x30, 0x49, 0xed, 0xde, 0x82, 0xc9, 0x48, 0xc6, 0xa4, 0x74, 0x2f, 0x65, 0x4b, 0x97, 0x24,
+	0x5b, 0xed, 0xaa, 0xf3, 0x13, 0xf0, 0x91, 0x3a, 0x63, 0xb6, 0x0e, 0xa9, 0xcf, 0x77, 0x0f, 0xaa,
+	0xcc, 0x89, 0xbc, 0x30, 0x1e, 0x7a, 0x9e, 0x12, 0xfe, 0xac, 0x3e, 0x87, 0x34, 0x2a, 0x29, 0xe2,
+	0x5d, 0xb8, 0xc4, 0x4e, 0x0e, 0x48, 0x25, 0x17, 0x53, 0x95, 0xec, 0x9b, 0xc7, 0xea, 0x2e, 0x3e,
+	0x3f, 0x41, 0x1f, 0x23, 0xd0, 0x05, 0x7a, 0x25, 0xbe, 0x89, 0x92, 0xcf, 0x46, 0x8c, 0xec, 0x44,
+	0x9e, 0xd3, 0xe5, 0x14, 0x18, 0x27, 0x11, 0xef, 0xb9, 0xbe, 0x44, 0x4c, 0x3a, 0x85, 0x79, 0x00,
+	0xea, 0xd7, 0x54, 0xc2, 0xa8, 0x48, 0xc8, 0x51, 0xbf, 0x36, 0xc0, 0x48, 0x8c, 0xf5, 0x1b, 0x89,
+	0x4c, 0x07, 0x10, 0xc0, 0x8d, 0x4c, 0x4e, 0x29, 0xf3, 0x1d, 0x98, 0x08, 0x45, 0x44, 0xea, 0xbb,
+	0x92, 0xaa, 0x6f, 0xea, 0x24, 0x6d, 0x07, 0x25, 0xea, 0xf5, 0xcf, 0x46, 0xe1, 0x6a, 0x6a, 0xde,
+	0x59, 0x6c, 0xd3, 0x2a, 0xe0, 0xf6, 0xe1, 0xd7, 0x39, 0x77, 0x12, 0xf3, 0x74, 0x45, 0x8d, 0xb4,
+	0xcf, 0x2b, 0x3c, 0x03, 0x13, 0x75, 0xaf, 0xd1, 0xa0, 0x35, 0x21, 0xcb, 0xa4, 0x25, 0x9f, 0xba,
+	0x6d, 0xc9, 0xf8, 0x33, 0xdb, 0x92, 0x1e, 0x63, 0x74, 0xe1, 0xec, 0xc6, 0xa8, 0xfc, 0xf5, 0x45,
+	0xb8, 0x20, 0x74, 0xc7, 0x9f, 0x20, 0x98, 0x48, 0xac, 0x26, 0x5e, 0x4a, 0x65, 0xe8, 0xf7, 0xb5,
+	0x5a, 0xe9, 0xf4, 0xc4, 0x64, 0xdf, 0xf4, 0x1b, 0x9f, 0xfe, 0xfe, 0xcf, 0xa3, 0xd1, 0x79, 0x3c,
+	0x67, 0xa6, 0x99, 0xe8, 0xc4, 0xd4, 0xe2, 0x5f, 0x10, 0x4c, 0xa7, 0x19, 0x40, 0xbc, 0x31, 0xb8,
+	0x4f, 0x86, 0xff, 0xd5, 0x5e, 0x1d, 0xb6, 0x4c, 0xc2, 0xae, 0x0b, 0xd8, 0x55, 0x7c, 0x33, 0x15,
+	0x96, 0xca, 0xd2, 0xf6, 0xc6, 0x0b, 0xd5, 0xf1, 0x17, 0x08, 0x72, 0x9d, 0x1d, 0x5f, 0x19, 0xdc,
+	0xba, 0xd7, 0xa0, 0x69, 0x37, 0xcf, 0x94, 0x2b, 0xd9, 0x16, 0x05, 0xdb, 0x02, 0x2e, 0x98, 0x59,
+	0x7f, 0x8d, 0x30, 0xfc, 0x03, 0x82, 0x2b, 0x7d, 0x46, 0x0d, 0x97, 0x07, 0xb7, 0x1a, 0xe4, 0x1f,
+	0xb5, 0xf5, 0xa1, 0x6a, 0x24, 0xa6, 0x29, 0x30, 0x97, 0xf1, 0x52, 0x2a, 0x66, 0x4d, 0xd6, 0x75,
+	0xbe, 0x1d, 0xfc, 0x13, 0x82, 0xa9, 0x14, 0x63, 0x84, 0x6f, 0x9d, 0x41, 0x9c, 0x3e, 0x7b, 0xa7,
+	0x6d, 0x0c, 0x59, 0x25, 0xa9, 0xcb, 0x82, 0xfa, 0x65, 0xbc, 0x92, 0x2d, 0xae, 0x5d, 0x6d, 0xd9,
+	0xea, 0xae, 0xe9, 0x05, 0x57, 0xce, 0xe4, 0x8c, 0xe0, 0x3d, 0xae, 0x4a, 0xdb, 0x18, 0xb2, 0x6a,
+	0x78, 0xf0, 0x48, 0x01, 0x7e, 0x87, 0xe0, 0x72, 0xaf, 0x27, 0xc0, 0x6b, 0xa7, 0xf7, 0xef, 0xf1,
+	0x34, 0x5a, 0x79, 0x98, 0x12, 0xc9, 0x6b, 0x08, 0xde, 0x12, 0x5e, 0xcc, 0xe4, 0xb5, 0xdb, 0x06,
+	0xe3, 0x47, 0x04, 0xb8, 0xff, 0xf2, 0xc5, 0x19, 0xaf, 0xe6, 0x40, 0x03, 0xa1, 0xdd, 0x1a, 0xae,
+	0x48, 0x12, 0xbf, 0x22, 0x88, 0x57, 0x70, 0x29, 0xfd, 0x4c, 0x88, 0x0b, 0xed, 0xee, 0x3b, 0xfc,
+	0x57, 0x04, 0x33, 0xe9, 0xb7, 0x19, 0x7e, 0x6d, 0x30, 0x42, 0xe6, 0x3d, 0xad, 0xdd, 0x1e, 0xbe,
+	0x50, 0xf2, 0x6f, 0x08, 0x7e, 0x13, 0xaf, 0xa6, 0xf2, 0x27, 0xf7, 0x5d, 0xd4, 0xa9, 0xb6, 0x93,
+	0x5b, 0xb2, 0xf2, 0xde, 0x93, 0xa3, 0x02, 0x7a, 0x7a, 0x54, 0x40, 0x7f, 0x1f, 0x15, 0xd0, 0xc3,
+	0xe3, 0xc2, 0xc8, 0xd3, 0xe3, 0xc2, 0xc8, 0x1f, 0xc7, 0x85, 0x91, 0x8f, 0xd6, 0x5d, 0x8f, 0xef,
+	0x1d, 0x54, 0x0d, 0x27, 0x68, 0xaa, 0x29, 0x57, 0x7d, 0xca, 0xef, 0x07, 0xd1, 0x7e, 0xbb, 0xc5,
+	0xc7, 0x9d, 0x26, 0xbc, 0x15, 0x52, 0x56, 0x9d, 0x10, 0xff, 0x2c, 0x58, 0xff, 0x77, 0x00, 0xef,
+	0xdb, 0xbe, 0x39, 0x22, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns module parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EstimateCallbackFees returns the total amount of callback fees a contract needs to pay to register the callback
+	EstimateCallbackFees(ctx context.Context, in *QueryEstimateCallbackFeesRequest, opts ...grpc.CallOption) (*QueryEstimateCallbackFeesResponse, error)
+	// Callbacks returns all the callbacks registered at a given height
+	Callbacks(ctx context.Context, in *QueryCallbacksRequest, opts ...grpc.CallOption) (*QueryCallbacksResponse, error)
+	// DeferredCallbacks returns all the callbacks which were deferred to a later block as the block callback gas limit was reached
+	DeferredCallbacks(ctx context.Context, in *QueryDeferredCallbacksRequest, opts ...grpc.CallOption) (*QueryDeferredCallbacksResponse, error)
+	// CallbacksByContract returns all the callbacks registered for a given contract
+	CallbacksByContract(ctx context.Context, in *QueryCallbacksByContractRequest, opts ...grpc.CallOption) (*QueryCallbacksByContractResponse, error)
+	// CallbacksByReserver returns all the callbacks reserved by a given address
+	CallbacksByReserver(ctx context.Context, in *QueryCallbacksByReserverRequest, opts ...grpc.CallOption) (*QueryCallbacksByReserverResponse, error)
+	// CallbackReceipts returns the execution receipts of the callbacks executed in the last receipt_retention_blocks blocks
+	CallbackReceipts(ctx context.Context, in *QueryCallbackReceiptsRequest, opts ...grpc.CallOption) (*QueryCallbackReceiptsResponse, error)
+	// EventSubscriptions returns the event subscriptions, optionally filtered by contract
+	EventSubscriptions(ctx context.Context, in *QueryEventSubscriptionsRequest, opts ...grpc.CallOption) (*QueryEventSubscriptionsResponse, error)
+	// BlockReservationPrices returns the callback fees for every height of a range of future heights
+	BlockReservationPrices(ctx context.Context, in *QueryBlockReservationPricesRequest, opts ...grpc.CallOption) (*QueryBlockReservationPricesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateCallbackFees(ctx context.Context, in *QueryEstimateCallbackFeesRequest, opts ...grpc.CallOption) (*QueryEstimateCallbackFeesResponse, error) {
+	out := new(QueryEstimateCallbackFeesResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Query/EstimateCallbackFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Callbacks(ctx context.Context, in *QueryCallbacksRequest, opts ...grpc.CallOption) (*QueryCallbacksResponse, error) {
+	out := new(QueryCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Query/Callbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeferredCallbacks(ctx context.Context, in *QueryDeferredCallbacksRequest, opts ...grpc.CallOption) (*QueryDeferredCallbacksResponse, error) {
+	out := new(QueryDeferredCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Query/DeferredCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CallbacksByContract(ctx context.Context, in *QueryCallbacksByContractRequest, opts ...grpc.CallOption) (*QueryCallbacksByContractResponse, error) {
+	out := new(QueryCallbacksByContractResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Query/CallbacksByContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CallbacksByReserver(ctx context.Context, in *QueryCallbacksByReserverRequest, opts ...grpc.CallOption) (*QueryCallbacksByReserverResponse, error) {
+	out := new(QueryCallbacksByReserverResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Query/CallbacksByReserver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CallbackReceipts(ctx context.Context, in *QueryCallbackReceiptsRequest, opts ...grpc.CallOption) (*QueryCallbackReceiptsResponse, error) {
+	out := new(QueryCallbackReceiptsResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Query/CallbackReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EventSubscriptions(ctx context.Context, in *QueryEventSubscriptionsRequest, opts ...grpc.CallOption) (*QueryEventSubscriptionsResponse, error) {
+	out := new(QueryEventSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Query/EventSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockReservationPrices(ctx context.Context, in *QueryBlockReservationPricesRequest, opts ...grpc.CallOption) (*QueryBlockReservationPricesResponse, error) {
+	out := new(QueryBlockReservationPricesResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Query/BlockReservationPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns module parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EstimateCallbackFees returns the total amount of callback fees a contract needs to pay to register the callback
+	EstimateCallbackFees(context.Context, *QueryEstimateCallbackFeesRequest) (*QueryEstimateCallbackFeesResponse, error)
+	// Callbacks returns all the callbacks registered at a given height
+	Callbacks(context.Context, *QueryCallbacksRequest) (*QueryCallbacksResponse, error)
+	// DeferredCallbacks returns all the callbacks which were deferred to a later block as the block callback gas limit was reached
+	DeferredCallbacks(context.Context, *QueryDeferredCallbacksRequest) (*QueryDeferredCallbacksResponse, error)
+	// CallbacksByContract returns all the callbacks registered for a given contract
+	CallbacksByContract(context.Context, *QueryCallbacksByContractRequest) (*QueryCallbacksByContractResponse, error)
+	// CallbacksByReserver returns all the callbacks reserved by a given address
+	CallbacksByReserver(context.Context, *QueryCallbacksByReserverRequest) (*QueryCallbacksByReserverResponse, error)
+	// CallbackReceipts returns the execution receipts of the callbacks executed in the last receipt_retention_blocks blocks
+	CallbackReceipts(context.Context, *QueryCallbackReceiptsRequest) (*QueryCallbackReceiptsResponse, error)
+	// EventSubscriptions returns the event subscriptions, optionally filtered by contract
+	EventSubscriptions(context.Context, *QueryEventSubscriptionsRequest) (*QueryEventSubscriptionsResponse, error)
+	// BlockReservationPrices returns the callback fees for every height of a range of future heights
+	BlockReservationPrices(context.Context, *QueryBlockReservationPricesRequest) (*QueryBlockReservationPricesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) EstimateCallbackFees(ctx context.Context, req *QueryEstimateCallbackFeesRequest) (*QueryEstimateCallbackFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateCallbackFees not implemented")
+}
+func (*UnimplementedQueryServer) Callbacks(ctx context.Context, req *QueryCallbacksRequest) (*QueryCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Callbacks not implemented")
+}
+func (*UnimplementedQueryServer) DeferredCallbacks(ctx context.Context, req *QueryDeferredCallbacksRequest) (*QueryDeferredCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeferredCallbacks not implemented")
+}
+func (*UnimplementedQueryServer) CallbacksByContract(ctx context.Context, req *QueryCallbacksByContractRequest) (*QueryCallbacksByContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallbacksByContract not implemented")
+}
+func (*UnimplementedQueryServer) CallbacksByReserver(ctx context.Context, req *QueryCallbacksByReserverRequest) (*QueryCallbacksByReserverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallbacksByReserver not implemented")
+}
+func (*UnimplementedQueryServer) CallbackReceipts(ctx context.Context, req *QueryCallbackReceiptsRequest) (*QueryCallbackReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallbackReceipts not implemented")
+}
+func (*UnimplementedQueryServer) EventSubscriptions(ctx context.Context, req *QueryEventSubscriptionsRequest) (*QueryEventSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventSubscriptions not implemented")
+}
+func (*UnimplementedQueryServer) BlockReservationPrices(ctx context.Context, req *QueryBlockReservationPricesRequest) (*QueryBlockReservationPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockReservationPrices not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateCallbackFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateCallbackFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateCallbackFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Query/EstimateCallbackFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateCallbackFees(ctx, req.(*QueryEstimateCallbackFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Callbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Callbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Query/Callbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Callbacks(ctx, req.(*QueryCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeferredCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeferredCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeferredCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Query/DeferredCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeferredCallbacks(ctx, req.(*QueryDeferredCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CallbacksByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCallbacksByContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CallbacksByContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Query/CallbacksByContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CallbacksByContract(ctx, req.(*QueryCallbacksByContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CallbacksByReserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCallbacksByReserverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CallbacksByReserver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Query/CallbacksByReserver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CallbacksByReserver(ctx, req.(*QueryCallbacksByReserverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CallbackReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCallbackReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CallbackReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Query/CallbackReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CallbackReceipts(ctx, req.(*QueryCallbackReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EventSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEventSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EventSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Query/EventSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EventSubscriptions(ctx, req.(*QueryEventSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockReservationPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockReservationPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockReservationPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Query/BlockReservationPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockReservationPrices(ctx, req.(*QueryBlockReservationPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "archway.callback.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EstimateCallbackFees",
+			Handler:    _Query_EstimateCallbackFees_Handler,
+		},
+		{
+			MethodName: "Callbacks",
+			Handler:    _Query_Callbacks_Handler,
+		},
+		{
+			MethodName: "DeferredCallbacks",
+			Handler:    _Query_DeferredCallbacks_Handler,
+		},
+		{
+			MethodName: "CallbacksByContract",
+			Handler:    _Query_CallbacksByContract_Handler,
+		},
+		{
+			MethodName: "CallbacksByReserver",
+			Handler:    _Query_CallbacksByReserver_Handler,
+		},
+		{
+			MethodName: "CallbackReceipts",
+			Handler:    _Query_CallbackReceipts_Handler,
+		},
+		{
+			MethodName: "EventSubscriptions",
+			Handler:    _Query_EventSubscriptions_Handler,
+		},
+		{
+			MethodName: "BlockReservationPrices",
+			Handler:    _Query_BlockReservationPrices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "archway/callback/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateCallbackFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateCallbackFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateCallbackFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CallbackTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CallbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateCallbackFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateCallbackFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateCallbackFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeSplit != nil {
+		{
+			size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TotalFees != nil {
+		{
+			size, err := m.TotalFees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeferredCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeferredCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeferredCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeferredCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeferredCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeferredCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbacksByContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbacksByContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbacksByContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbacksByContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbacksByContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbacksByContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbacksByReserverRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbacksByReserverRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbacksByReserverRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReservedBy) > 0 {
+		i -= len(m.ReservedBy)
+		copy(dAtA[i:], m.ReservedBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReservedBy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbacksByReserverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbacksByReserverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbacksByReserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbackReceiptsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbackReceiptsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbackReceiptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbackReceiptsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbackReceiptsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbackReceiptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEventSubscriptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEventSubscriptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEventSubscriptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEventSubscriptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEventSubscriptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEventSubscriptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockReservationPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockReservationPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockReservationPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockReservationPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockReservationPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockReservationPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockReservationPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockReservationPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockReservationPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalFees != nil {
+		{
+			size, err := m.TotalFees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FeeSplit != nil {
+		{
+			size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Filled {
+		i--
+		if m.Filled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReservedCallbacks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReservedCallbacks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateCallbackFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovQuery(uint64(m.PayloadSize))
+	}
+	if m.CallbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *QueryEstimateCallbackFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalFees != nil {
+		l = m.TotalFees.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FeeSplit != nil {
+		l = m.FeeSplit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDeferredCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeferredCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCallbacksByContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCallbacksByContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCallbacksByReserverRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReservedBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCallbacksByReserverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCallbackReceiptsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCallbackReceiptsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEventSubscriptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEventSubscriptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockReservationPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovQuery(uint64(m.PayloadSize))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *QueryBlockReservationPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockReservationPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.ReservedCallbacks != 0 {
+		n += 1 + sovQuery(uint64(m.ReservedCallbacks))
+	}
+	if m.Filled {
+		n += 2
+	}
+	if m.FeeSplit != nil {
+		l = m.FeeSplit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TotalFees != nil {
+		l = m.TotalFees.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateCallbackFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateCallbackFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateCallbackFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallbackTime == nil {
+				m.CallbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CallbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateCallbackFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateCallbackFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateCallbackFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalFees == nil {
+				m.TotalFees = &types.Coin{}
+			}
+			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeSplit == nil {
+				m.FeeSplit = &CallbackFeesFeeSplit{}
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCallbacksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbacksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCallbacksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbacksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, &Callback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeferredCallbacksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeferredCallbacksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeferredCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeferredCallbacksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeferredCallbacksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeferredCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, &Callback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCallbacksByContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbacksByContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbacksByContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCallbacksByContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbacksByContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbacksByContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, &Callback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Callbacks) > 0 {
-		for _, e := range m.Callbacks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCallbacksByReserverRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbacksByReserverRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbacksByReserverRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCallbacksByReserverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbacksByReserverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbacksByReserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, &Callback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCallbackReceiptsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbackReceiptsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbackReceiptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryCallbackReceiptsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbackReceiptsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbackReceiptsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, &CallbackReceipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEventSubscriptionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -825,12 +4215,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEventSubscriptionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEventSubscriptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -852,7 +4310,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEventSubscriptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -875,15 +4333,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEventSubscriptionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEventSubscriptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -910,7 +4368,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Subscriptions = append(m.Subscriptions, &EventSubscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -935,7 +4430,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEstimateCallbackFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockReservationPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -958,17 +4453,17 @@ func (m *QueryEstimateCallbackFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimateCallbackFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockReservationPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimateCallbackFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockReservationPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.BlockHeight = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -978,7 +4473,64 @@ func (m *QueryEstimateCallbackFeesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1004,7 +4556,7 @@ func (m *QueryEstimateCallbackFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEstimateCallbackFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockReservationPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1027,51 +4579,15 @@ func (m *QueryEstimateCallbackFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimateCallbackFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockReservationPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimateCallbackFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockReservationPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TotalFees == nil {
-				m.TotalFees = &types.Coin{}
-			}
-			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1098,10 +4614,8 @@ func (m *QueryEstimateCallbackFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeeSplit == nil {
-				m.FeeSplit = &CallbackFeesFeeSplit{}
-			}
-			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, BlockReservationPrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1126,7 +4640,7 @@ func (m *QueryEstimateCallbackFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCallbacksRequest) Unmarshal(dAtA []byte) error {
+func (m *BlockReservationPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1149,10 +4663,10 @@ func (m *QueryCallbacksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCallbacksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockReservationPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockReservationPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1174,59 +4688,84 @@ func (m *QueryCallbacksRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedCallbacks", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.ReservedCallbacks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservedCallbacks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCallbacksResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			m.Filled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.FeeSplit == nil {
+				m.FeeSplit = &CallbackFeesFeeSplit{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCallbacksResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1253,8 +4792,10 @@ func (m *QueryCallbacksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Callbacks = append(m.Callbacks, &Callback{})
-			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TotalFees == nil {
+				m.TotalFees = &types.Coin{}
+			}
+			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7,19 +7,66 @@ import "encoding/json"
 type SudoMsg struct {
 	// Callback is the endpoint name at the contract which is called
 	Callback *CallbackMsg `json:"callback,omitempty"`
+	// Events is the endpoint name at the contract which is called when subscribed events are delivered
+	Events *EventsMsg `json:"events,omitempty"`
 }
 
 // CallbackMsg is the callback message sent to a contract.
 type CallbackMsg struct {
 	// JobID is the user specified job id
 	JobID uint64 `json:"job_id"`
+	// Payload is the user specified data attached to the callback (base64 encoded)
+	Payload []byte `json:"payload,omitempty"`
+}
+
+// EventsMsg is the message sent to a contract with the events of a block which match its subscription.
+type EventsMsg struct {
+	// SubscriptionID is the id of the matched subscription
+	SubscriptionID uint64 `json:"subscription_id"`
+	// Events are the matching events in the order they were emitted
+	Events []EventMsg `json:"events"`
+}
+
+// EventMsg is an event delivered to a contract.
+type EventMsg struct {
+	// Type is the event type
+	Type string `json:"type"`
+	// Attributes are the event attributes
+	Attributes []EventAttributeMsg `json:"attributes"`
+}
+
+// EventAttributeMsg is an event attribute delivered to a contract.
+type EventAttributeMsg struct {
+	// Key is the attribute key
+	Key string `json:"key"`
+	// Value is the attribute value
+	Value string `json:"value"`
 }
 
 // NewCallback creates a new Callback instance.
-func NewCallbackMsg(jobID uint64) SudoMsg {
+func NewCallbackMsg(jobID uint64, payload []byte) SudoMsg {
 	return SudoMsg{
 		Callback: &CallbackMsg{
-			JobID: jobID,
+			JobID:   jobID,
+			Payload: payload,
+		},
+	}
+}
+
+// NewEventsMsg creates a new SudoMsg instance delivering the given events.
+func NewEventsMsg(subscriptionID uint64, events []ContractEvent) SudoMsg {
+	eventMsgs := make([]EventMsg, 0, len(events))
+	for _, event := range events {
+		attributes := make([]EventAttributeMsg, 0, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attributes = append(attributes, EventAttributeMsg{Key: attr.Key, Value: attr.Value})
+		}
+		eventMsgs = append(eventMsgs, EventMsg{Type: event.Type, Attributes: attributes})
+	}
+	return SudoMsg{
+		Events: &EventsMsg{
+			SubscriptionID: subscriptionID,
+			Events:         eventMsgs,
 		},
 	}
 }
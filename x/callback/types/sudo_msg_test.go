@@ -16,9 +16,14 @@ func TestSudoMsgString(t *testing.T) {
 	}{
 		{
 			"ok: callback job_id is 1",
-			types.NewCallbackMsg(1),
+			types.NewCallbackMsg(1, nil),
 			`{"callback":{"job_id":1}}`,
 		},
+		{
+			"ok: callback job_id is 1 with payload",
+			types.NewCallbackMsg(1, []byte(`{"action":"settle"}`)),
+			`{"callback":{"job_id":1,"payload":"eyJhY3Rpb24iOiJzZXR0bGUifQ=="}}`,
+		},
 	}
 
 	for _, tc := range testCases {
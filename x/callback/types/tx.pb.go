@@ -11,18 +11,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -132,10 +136,33 @@ type MsgRequestCallback struct {
 	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	// job_id is an identifier the callback requestor can pass in to identify the callback when it happens
 	JobId uint64 `protobuf:"varint,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	// callback_height is the height at which the callback is executed.
+	// callback_height is the height at which the callback is executed. Leave empty when callback_time is set.
 	CallbackHeight int64 `protobuf:"varint,4,opt,name=callback_height,json=callbackHeight,proto3" json:"callback_height,omitempty"`
 	// fees is the amount of fees being paid to register the contract
 	Fees types.Coin `protobuf:"bytes,5,opt,name=fees,proto3" json:"fees"`
+	// interval is the number of blocks between executions of a recurring callback.
+	// Leave empty to register a one-off callback.
+	Interval uint64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	// max_executions is the total number of times a recurring callback is executed.
+	// Leave empty for a recurring callback to be rescheduled until cancelled or until its surplus fees run out.
+	MaxExecutions uint64 `protobuf:"varint,7,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+	// payload is the optional opaque data passed back to the contract when the callback is executed.
+	// The size is limited by the max_payload_size module param and charged for as part of the block reservation fees.
+	Payload []byte `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+	// callback_time is the block time at or after which the callback is executed. Leave empty when callback_height is set.
+	CallbackTime *time.Time `protobuf:"bytes,9,opt,name=callback_time,json=callbackTime,proto3,stdtime" json:"callback_time,omitempty"`
+	// gas_limit is the maximum gas the callback can consume when executed. The transaction fees are priced from it.
+	// Leave empty to use the callback_gas_limit module param. Can not be higher than the max_callback_gas_limit module param.
+	GasLimit uint64 `protobuf:"varint,10,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// fee_payer is the optional address which pays the fees of the callback instead of the sender prepaying them: the
+	// reservation fees at registration and the transaction fees when it is executed. It is either a x/cwfees granting contract or an account which granted a x/feegrant allowance to the sender.
+	// The fees must be left empty when it is set.
+	FeePayer string `protobuf:"bytes,11,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// refund_address is the optional address the unused fees of the callback are refunded to.
+	// Leave empty to refund the fee payer if set, or the sender otherwise.
+	RefundAddress string `protobuf:"bytes,12,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// retry_policy is the optional policy by which a failed execution is retried. Every retry is charged from the surplus fees.
+	RetryPolicy *RetryPolicy `protobuf:"bytes,13,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
 }
 
 func (m *MsgRequestCallback) Reset()         { *m = MsgRequestCallback{} }
@@ -206,6 +233,62 @@ func (m *MsgRequestCallback) GetFees() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgRequestCallback) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MsgRequestCallback) GetMaxExecutions() uint64 {
+	if m != nil {
+		return m.MaxExecutions
+	}
+	return 0
+}
+
+func (m *MsgRequestCallback) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *MsgRequestCallback) GetCallbackTime() *time.Time {
+	if m != nil {
+		return m.CallbackTime
+	}
+	return nil
+}
+
+func (m *MsgRequestCallback) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *MsgRequestCallback) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *MsgRequestCallback) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *MsgRequestCallback) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
 // MsgRequestCallbackResponse defines the response structure for executing a MsgRequestCallback message.
 type MsgRequestCallbackResponse struct {
 }
@@ -253,6 +336,8 @@ type MsgCancelCallback struct {
 	JobId uint64 `protobuf:"varint,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	// callback_height is the height at which the callback requestor had registered the callback
 	CallbackHeight int64 `protobuf:"varint,4,opt,name=callback_height,json=callbackHeight,proto3" json:"callback_height,omitempty"`
+	// callback_time is the block time at which the callback requestor had registered the callback
+	CallbackTime *time.Time `protobuf:"bytes,5,opt,name=callback_time,json=callbackTime,proto3,stdtime" json:"callback_time,omitempty"`
 }
 
 func (m *MsgCancelCallback) Reset()         { *m = MsgCancelCallback{} }
@@ -316,6 +401,13 @@ func (m *MsgCancelCallback) GetCallbackHeight() int64 {
 	return 0
 }
 
+func (m *MsgCancelCallback) GetCallbackTime() *time.Time {
+	if m != nil {
+		return m.CallbackTime
+	}
+	return nil
+}
+
 // MsgCancelCallbackResponse defines the response structure for executing a MsgCancelCallback message.
 type MsgCancelCallbackResponse struct {
 	// refund is the amount of fees being refunded due to the cancellation of the callback
@@ -362,549 +454,4513 @@ func (m *MsgCancelCallbackResponse) GetRefund() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "archway.callback.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "archway.callback.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgRequestCallback)(nil), "archway.callback.v1.MsgRequestCallback")
-	proto.RegisterType((*MsgRequestCallbackResponse)(nil), "archway.callback.v1.MsgRequestCallbackResponse")
-	proto.RegisterType((*MsgCancelCallback)(nil), "archway.callback.v1.MsgCancelCallback")
-	proto.RegisterType((*MsgCancelCallbackResponse)(nil), "archway.callback.v1.MsgCancelCallbackResponse")
+// MsgUpdateCallback is the Msg/UpdateCallback request type.
+type MsgUpdateCallback struct {
+	// sender is the address which is updating the callback (bech32 encoded)
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract_address is the address of the contract (bech32 encoded)
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// job_id is an identifier the callback requestor had passed during registration of the callback
+	JobId uint64 `protobuf:"varint,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// callback_height is the height at which the callback is currently registered
+	CallbackHeight int64 `protobuf:"varint,4,opt,name=callback_height,json=callbackHeight,proto3" json:"callback_height,omitempty"`
+	// callback_time is the block time at which the callback is currently registered
+	CallbackTime *time.Time `protobuf:"bytes,5,opt,name=callback_time,json=callbackTime,proto3,stdtime" json:"callback_time,omitempty"`
+	// new_callback_height is the height the callback is moved to. Leave empty when new_callback_time is set.
+	NewCallbackHeight int64 `protobuf:"varint,6,opt,name=new_callback_height,json=newCallbackHeight,proto3" json:"new_callback_height,omitempty"`
+	// new_callback_time is the block time the callback is moved to. Leave empty when new_callback_height is set.
+	NewCallbackTime *time.Time `protobuf:"bytes,7,opt,name=new_callback_time,json=newCallbackTime,proto3,stdtime" json:"new_callback_time,omitempty"`
+	// fees is the amount of fees being paid if the reservation fees at the new height or block time are higher.
+	// Any extra fees paid is kept as surplus fees.
+	Fees types.Coin `protobuf:"bytes,8,opt,name=fees,proto3" json:"fees"`
 }
 
-func init() { proto.RegisterFile("archway/callback/v1/tx.proto", fileDescriptor_d9a16d5bd27202f4) }
-
-var fileDescriptor_d9a16d5bd27202f4 = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x4d, 0x6e, 0xda, 0x40,
-	0x18, 0x65, 0x12, 0x40, 0x62, 0x52, 0x41, 0x3a, 0xfd, 0x89, 0x71, 0x91, 0x83, 0x50, 0xd5, 0xd0,
-	0x28, 0x1d, 0x8b, 0xb0, 0xa8, 0x94, 0x5d, 0x61, 0xd3, 0x2e, 0x2c, 0x55, 0x56, 0xbb, 0xe9, 0x06,
-	0x8d, 0xed, 0x89, 0xed, 0x80, 0x3d, 0xae, 0x67, 0x20, 0x61, 0x57, 0xf5, 0x00, 0x55, 0xef, 0xd0,
-	0x0b, 0xe4, 0x16, 0xcd, 0x32, 0xcb, 0x76, 0x13, 0x55, 0xb0, 0x88, 0xd4, 0x53, 0x54, 0xd8, 0x63,
-	0x10, 0x84, 0x48, 0x2c, 0xbb, 0xf3, 0x7c, 0xef, 0xf9, 0x7d, 0xef, 0xcd, 0xf7, 0x69, 0x60, 0x8d,
-	0xc4, 0xb6, 0x77, 0x4e, 0xc6, 0xba, 0x4d, 0x06, 0x03, 0x8b, 0xd8, 0x7d, 0x7d, 0xd4, 0xd2, 0xc5,
-	0x05, 0x8e, 0x62, 0x26, 0x18, 0x7a, 0x24, 0x51, 0x9c, 0xa1, 0x78, 0xd4, 0x52, 0x1f, 0xbb, 0xcc,
-	0x65, 0x09, 0xae, 0xcf, 0xbe, 0x52, 0xaa, 0xaa, 0xd9, 0x8c, 0x07, 0x8c, 0xeb, 0x16, 0xe1, 0x54,
-	0x1f, 0xb5, 0x2c, 0x2a, 0x48, 0x4b, 0xb7, 0x99, 0x1f, 0x4a, 0x7c, 0x4f, 0xe2, 0x01, 0x77, 0x67,
-	0x2d, 0x02, 0xee, 0x4a, 0xa0, 0xb1, 0xce, 0xc1, 0xbc, 0x5f, 0xc2, 0x69, 0x7c, 0x03, 0xb0, 0x62,
-	0x70, 0xf7, 0x63, 0xe4, 0x10, 0x41, 0xdf, 0x93, 0x98, 0x04, 0x1c, 0xd5, 0x60, 0x89, 0x0c, 0x85,
-	0xc7, 0x62, 0x5f, 0x8c, 0x15, 0x50, 0x07, 0xcd, 0x92, 0xb9, 0x28, 0x20, 0x03, 0x16, 0xa3, 0x84,
-	0xa7, 0x6c, 0xd5, 0x41, 0x73, 0xe7, 0xf8, 0x19, 0x5e, 0x13, 0x05, 0xa7, 0x52, 0x1d, 0xe5, 0xea,
-	0x66, 0x3f, 0xf7, 0xf7, 0x66, 0x7f, 0x37, 0xfd, 0xe5, 0x88, 0x05, 0xbe, 0xa0, 0x41, 0x24, 0xc6,
-	0xa6, 0x14, 0x39, 0x29, 0x7f, 0xbd, 0xbd, 0x3c, 0x5c, 0xc8, 0x37, 0xaa, 0x70, 0x6f, 0xc5, 0x8f,
-	0x49, 0x79, 0xc4, 0x42, 0x4e, 0x1b, 0xbf, 0x01, 0x44, 0x06, 0x77, 0x4d, 0xfa, 0x79, 0x48, 0xb9,
-	0xe8, 0xca, 0x6e, 0xe8, 0x29, 0x2c, 0x72, 0x1a, 0x3a, 0x34, 0x96, 0x5e, 0xe5, 0x09, 0xbd, 0x84,
-	0xbb, 0x36, 0x0b, 0x45, 0x4c, 0x6c, 0xd1, 0x23, 0x8e, 0x13, 0x53, 0x9e, 0x5a, 0x2e, 0x99, 0x95,
-	0xac, 0xfe, 0x26, 0x2d, 0xa3, 0x27, 0xb0, 0x78, 0xc6, 0xac, 0x9e, 0xef, 0x28, 0xdb, 0x75, 0xd0,
-	0xcc, 0x9b, 0x85, 0x33, 0x66, 0xbd, 0x73, 0xd0, 0x01, 0xac, 0x64, 0x99, 0x7a, 0x1e, 0xf5, 0x5d,
-	0x4f, 0x28, 0xf9, 0x3a, 0x68, 0x6e, 0x9b, 0xe5, 0xac, 0xfc, 0x36, 0xa9, 0xa2, 0x36, 0xcc, 0x9f,
-	0x52, 0xca, 0x95, 0x42, 0x72, 0x23, 0x55, 0x9c, 0x4e, 0x04, 0xcf, 0x26, 0x86, 0xe5, 0xc4, 0x70,
-	0x97, 0xf9, 0x61, 0x27, 0x3f, 0xbb, 0x0f, 0x33, 0x21, 0x9f, 0xec, 0xcc, 0x92, 0x4b, 0xb3, 0x8d,
-	0x1a, 0x54, 0xef, 0x46, 0x9b, 0x27, 0xff, 0x01, 0xe0, 0x43, 0x83, 0xbb, 0x5d, 0x12, 0xda, 0x74,
-	0xf0, 0x1f, 0x05, 0x5f, 0xce, 0xf0, 0x01, 0x56, 0xef, 0x98, 0xcc, 0x22, 0xa0, 0xd7, 0xb0, 0x18,
-	0xd3, 0xd3, 0x61, 0xe8, 0x24, 0x66, 0x37, 0xb8, 0x24, 0x49, 0x3f, 0xfe, 0xb9, 0x05, 0xb7, 0x0d,
-	0xee, 0x22, 0x0b, 0x3e, 0x58, 0xda, 0xd2, 0xe7, 0x6b, 0xf7, 0x6e, 0x65, 0x77, 0xd4, 0xa3, 0x4d,
-	0x58, 0x73, 0x93, 0x7d, 0x58, 0x59, 0xdd, 0xae, 0x83, 0xfb, 0x04, 0x56, 0x88, 0xaa, 0xbe, 0x21,
-	0x71, 0xde, 0xcc, 0x83, 0xe5, 0x95, 0x81, 0xbe, 0xb8, 0x4f, 0x62, 0x99, 0xa7, 0xe2, 0xcd, 0x78,
-	0x59, 0x27, 0xb5, 0xf0, 0xe5, 0xf6, 0xf2, 0x10, 0x74, 0x8c, 0xab, 0x89, 0x06, 0xae, 0x27, 0x1a,
-	0xf8, 0x33, 0xd1, 0xc0, 0xf7, 0xa9, 0x96, 0xbb, 0x9e, 0x6a, 0xb9, 0x5f, 0x53, 0x2d, 0xf7, 0xa9,
-	0xed, 0xfa, 0xc2, 0x1b, 0x5a, 0xd8, 0x66, 0x81, 0x2e, 0xa5, 0x5f, 0x85, 0x54, 0x9c, 0xb3, 0xb8,
-	0x9f, 0x9d, 0xf5, 0x8b, 0xc5, 0x33, 0x22, 0xc6, 0x11, 0xe5, 0x56, 0x31, 0x79, 0x41, 0xda, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x69, 0x78, 0x60, 0x7c, 0xe9, 0x04, 0x00, 0x00,
+func (m *MsgUpdateCallback) Reset()         { *m = MsgUpdateCallback{} }
+func (m *MsgUpdateCallback) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCallback) ProtoMessage()    {}
+func (*MsgUpdateCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a16d5bd27202f4, []int{6}
+}
+func (m *MsgUpdateCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCallback.Merge(m, src)
+}
+func (m *MsgUpdateCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCallback.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgUpdateCallback proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgUpdateCallback) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a governance operation for updating the x/callback
-	// module parameters. The authority is defined in the keeper.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// RequestCallback defines a message for registering a callback at a specific height by a given contract
-	RequestCallback(ctx context.Context, in *MsgRequestCallback, opts ...grpc.CallOption) (*MsgRequestCallbackResponse, error)
-	// CancelCallback defines a message for cancelling an existing callback
-	CancelCallback(ctx context.Context, in *MsgCancelCallback, opts ...grpc.CallOption) (*MsgCancelCallbackResponse, error)
+func (m *MsgUpdateCallback) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateCallback) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateCallback) GetCallbackHeight() int64 {
+	if m != nil {
+		return m.CallbackHeight
+	}
+	return 0
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/archway.callback.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCallback) GetCallbackTime() *time.Time {
+	if m != nil {
+		return m.CallbackTime
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RequestCallback(ctx context.Context, in *MsgRequestCallback, opts ...grpc.CallOption) (*MsgRequestCallbackResponse, error) {
-	out := new(MsgRequestCallbackResponse)
-	err := c.cc.Invoke(ctx, "/archway.callback.v1.Msg/RequestCallback", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCallback) GetNewCallbackHeight() int64 {
+	if m != nil {
+		return m.NewCallbackHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CancelCallback(ctx context.Context, in *MsgCancelCallback, opts ...grpc.CallOption) (*MsgCancelCallbackResponse, error) {
-	out := new(MsgCancelCallbackResponse)
-	err := c.cc.Invoke(ctx, "/archway.callback.v1.Msg/CancelCallback", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCallback) GetNewCallbackTime() *time.Time {
+	if m != nil {
+		return m.NewCallbackTime
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a governance operation for updating the x/callback
-	// module parameters. The authority is defined in the keeper.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// RequestCallback defines a message for registering a callback at a specific height by a given contract
-	RequestCallback(context.Context, *MsgRequestCallback) (*MsgRequestCallbackResponse, error)
-	// CancelCallback defines a message for cancelling an existing callback
-	CancelCallback(context.Context, *MsgCancelCallback) (*MsgCancelCallbackResponse, error)
+func (m *MsgUpdateCallback) GetFees() types.Coin {
+	if m != nil {
+		return m.Fees
+	}
+	return types.Coin{}
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgUpdateCallbackResponse defines the response structure for executing a MsgUpdateCallback message.
+type MsgUpdateCallbackResponse struct {
+	// refund is the amount of reservation fees being refunded as the reservation fees at the new height or block time are lower
+	Refund types.Coin `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund"`
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgUpdateCallbackResponse) Reset()         { *m = MsgUpdateCallbackResponse{} }
+func (m *MsgUpdateCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCallbackResponse) ProtoMessage()    {}
+func (*MsgUpdateCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a16d5bd27202f4, []int{7}
 }
-func (*UnimplementedMsgServer) RequestCallback(ctx context.Context, req *MsgRequestCallback) (*MsgRequestCallbackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestCallback not implemented")
+func (m *MsgUpdateCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CancelCallback(ctx context.Context, req *MsgCancelCallback) (*MsgCancelCallbackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelCallback not implemented")
+func (m *MsgUpdateCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgUpdateCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCallbackResponse.Merge(m, src)
+}
+func (m *MsgUpdateCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCallbackResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+var xxx_messageInfo_MsgUpdateCallbackResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateCallbackResponse) GetRefund() types.Coin {
+	if m != nil {
+		return m.Refund
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.callback.v1.Msg/UpdateParams",
+	return types.Coin{}
+}
+
+// MsgSubscribeToEvents is the Msg/SubscribeToEvents request type.
+type MsgSubscribeToEvents struct {
+	// sender is the address which is registering the subscription (bech32 encoded)
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract_address is the address of the contract which receives the matching events (bech32 encoded)
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// event_type is the type of the events to subscribe to
+	EventType string `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// attribute_filters are the attributes an event needs to have to match the subscription
+	AttributeFilters []EventAttribute `protobuf:"bytes,4,rep,name=attribute_filters,json=attributeFilters,proto3" json:"attribute_filters"`
+	// gas_limit is the maximum gas the contract can consume when the matching events are delivered in a block.
+	// Leave empty to use the callback_gas_limit module param. Can not be higher than the max_callback_gas_limit module param.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// fees is the amount of fees prepaid for the event deliveries
+	Fees types.Coin `protobuf:"bytes,6,opt,name=fees,proto3" json:"fees"`
+}
+
+func (m *MsgSubscribeToEvents) Reset()         { *m = MsgSubscribeToEvents{} }
+func (m *MsgSubscribeToEvents) String() string { return proto.CompactTextString(m) }
+func (*MsgSubscribeToEvents) ProtoMessage()    {}
+func (*MsgSubscribeToEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a16d5bd27202f4, []int{8}
+}
+func (m *MsgSubscribeToEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubscribeToEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubscribeToEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MsgSubscribeToEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubscribeToEvents.Merge(m, src)
+}
+func (m *MsgSubscribeToEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubscribeToEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubscribeToEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubscribeToEvents proto.InternalMessageInfo
+
+func (m *MsgSubscribeToEvents) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RequestCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestCallback)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSubscribeToEvents) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestCallback(ctx, in)
+	return ""
+}
+
+func (m *MsgSubscribeToEvents) GetEventType() string {
+	if m != nil {
+		return m.EventType
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.callback.v1.Msg/RequestCallback",
+	return ""
+}
+
+func (m *MsgSubscribeToEvents) GetAttributeFilters() []EventAttribute {
+	if m != nil {
+		return m.AttributeFilters
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestCallback(ctx, req.(*MsgRequestCallback))
+	return nil
+}
+
+func (m *MsgSubscribeToEvents) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CancelCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelCallback)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSubscribeToEvents) GetFees() types.Coin {
+	if m != nil {
+		return m.Fees
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelCallback(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.callback.v1.Msg/CancelCallback",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelCallback(ctx, req.(*MsgCancelCallback))
-	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "archway.callback.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RequestCallback",
-			Handler:    _Msg_RequestCallback_Handler,
-		},
-		{
-			MethodName: "CancelCallback",
-			Handler:    _Msg_CancelCallback_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "archway/callback/v1/tx.proto",
+// MsgSubscribeToEventsResponse defines the response structure for executing a MsgSubscribeToEvents message.
+type MsgSubscribeToEventsResponse struct {
+	// subscription_id is the identifier of the registered subscription
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubscribeToEventsResponse) Reset()         { *m = MsgSubscribeToEventsResponse{} }
+func (m *MsgSubscribeToEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubscribeToEventsResponse) ProtoMessage()    {}
+func (*MsgSubscribeToEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a16d5bd27202f4, []int{9}
+}
+func (m *MsgSubscribeToEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubscribeToEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubscribeToEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSubscribeToEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubscribeToEventsResponse.Merge(m, src)
+}
+func (m *MsgSubscribeToEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubscribeToEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubscribeToEventsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSubscribeToEventsResponse proto.InternalMessageInfo
+
+func (m *MsgSubscribeToEventsResponse) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// MsgUnsubscribeFromEvents is the Msg/UnsubscribeFromEvents request type.
+type MsgUnsubscribeFromEvents struct {
+	// sender is the address which is removing the subscription (bech32 encoded)
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract_address is the address of the subscribed contract (bech32 encoded)
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// subscription_id is the identifier of the subscription
+	SubscriptionId uint64 `protobuf:"varint,3,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+}
+
+func (m *MsgUnsubscribeFromEvents) Reset()         { *m = MsgUnsubscribeFromEvents{} }
+func (m *MsgUnsubscribeFromEvents) String() string { return proto.CompactTextString(m) }
+func (*MsgUnsubscribeFromEvents) ProtoMessage()    {}
+func (*MsgUnsubscribeFromEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a16d5bd27202f4, []int{10}
+}
+func (m *MsgUnsubscribeFromEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnsubscribeFromEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnsubscribeFromEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUnsubscribeFromEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnsubscribeFromEvents.Merge(m, src)
+}
+func (m *MsgUnsubscribeFromEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnsubscribeFromEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnsubscribeFromEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnsubscribeFromEvents proto.InternalMessageInfo
+
+func (m *MsgUnsubscribeFromEvents) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnsubscribeFromEvents) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnsubscribeFromEvents) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUnsubscribeFromEventsResponse defines the response structure for executing a MsgUnsubscribeFromEvents message.
+type MsgUnsubscribeFromEventsResponse struct {
+	// refund is the prepaid fees left which are refunded on removal of the subscription
+	Refund types.Coin `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund"`
 }
 
-func (m *MsgRequestCallback) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnsubscribeFromEventsResponse) Reset()         { *m = MsgUnsubscribeFromEventsResponse{} }
+func (m *MsgUnsubscribeFromEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnsubscribeFromEventsResponse) ProtoMessage()    {}
+func (*MsgUnsubscribeFromEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a16d5bd27202f4, []int{11}
+}
+func (m *MsgUnsubscribeFromEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnsubscribeFromEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnsubscribeFromEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUnsubscribeFromEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnsubscribeFromEventsResponse.Merge(m, src)
+}
+func (m *MsgUnsubscribeFromEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnsubscribeFromEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnsubscribeFromEventsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRequestCallback) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUnsubscribeFromEventsResponse proto.InternalMessageInfo
+
+func (m *MsgUnsubscribeFromEventsResponse) GetRefund() types.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return types.Coin{}
 }
 
-func (m *MsgRequestCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+// MsgRequestCallbacks is the Msg/RequestCallbacks request type.
+type MsgRequestCallbacks struct {
+	// sender is the address who is requesting the callbacks (bech32 encoded)
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// callbacks are the callbacks being requested. Either all of them are registered, or none are.
+	Callbacks []CallbackRequest `protobuf:"bytes,2,rep,name=callbacks,proto3" json:"callbacks"`
+}
+
+func (m *MsgRequestCallbacks) Reset()         { *m = MsgRequestCallbacks{} }
+func (m *MsgRequestCallbacks) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestCallbacks) ProtoMessage()    {}
+func (*MsgRequestCallbacks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a16d5bd27202f4, []int{12}
+}
+func (m *MsgRequestCallbacks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestCallbacks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestCallbacks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.CallbackHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CallbackHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.JobId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.JobId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRequestCallbacks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestCallbacks.Merge(m, src)
+}
+func (m *MsgRequestCallbacks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestCallbacks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestCallbacks.DiscardUnknown(m)
 }
 
-func (m *MsgRequestCallbackResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRequestCallbacks proto.InternalMessageInfo
+
+func (m *MsgRequestCallbacks) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRequestCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRequestCallbacks) GetCallbacks() []CallbackRequest {
+	if m != nil {
+		return m.Callbacks
+	}
+	return nil
 }
 
-func (m *MsgRequestCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// CallbackRequest is a callback requested as part of a MsgRequestCallbacks batch.
+// The fields are the same as the ones of MsgRequestCallback.
+type CallbackRequest struct {
+	// contract_address is the address of the contract which is requesting the callback (bech32 encoded)
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// job_id is an identifier the callback requestor can pass in to identify the callback when it happens
+	JobId uint64 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// callback_height is the height at which the callback is executed. Leave empty when callback_time is set.
+	CallbackHeight int64 `protobuf:"varint,3,opt,name=callback_height,json=callbackHeight,proto3" json:"callback_height,omitempty"`
+	// fees is the amount of fees being paid to register the callback
+	Fees types.Coin `protobuf:"bytes,4,opt,name=fees,proto3" json:"fees"`
+	// interval is the number of blocks between executions of a recurring callback.
+	Interval uint64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// max_executions is the total number of times a recurring callback is executed.
+	MaxExecutions uint64 `protobuf:"varint,6,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+	// payload is the optional opaque data passed back to the contract when the callback is executed.
+	Payload []byte `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	// callback_time is the block time at or after which the callback is executed. Leave empty when callback_height is set.
+	CallbackTime *time.Time `protobuf:"bytes,8,opt,name=callback_time,json=callbackTime,proto3,stdtime" json:"callback_time,omitempty"`
+	// gas_limit is the maximum gas the callback can consume when executed.
+	GasLimit uint64 `protobuf:"varint,9,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// fee_payer is the optional address which pays the fees of the callback instead of the sender.
+	FeePayer string `protobuf:"bytes,10,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// refund_address is the optional address the unused fees of the callback are refunded to.
+	RefundAddress string `protobuf:"bytes,11,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// retry_policy is the optional policy by which a failed execution is retried.
+	RetryPolicy *RetryPolicy `protobuf:"bytes,12,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
 }
 
-func (m *MsgCancelCallback) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CallbackRequest) Reset()         { *m = CallbackRequest{} }
+func (m *CallbackRequest) String() string { return proto.CompactTextString(m) }
+func (*CallbackRequest) ProtoMessage()    {}
+func (*CallbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a16d5bd27202f4, []int{13}
+}
+func (m *CallbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCancelCallback) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CallbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackRequest.Merge(m, src)
+}
+func (m *CallbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackRequest.DiscardUnknown(m)
 }
 
-func (m *MsgCancelCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CallbackHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CallbackHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.JobId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.JobId))
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_CallbackRequest proto.InternalMessageInfo
+
+func (m *CallbackRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *CallbackRequest) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *CallbackRequest) GetCallbackHeight() int64 {
+	if m != nil {
+		return m.CallbackHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCancelCallbackResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CallbackRequest) GetFees() types.Coin {
+	if m != nil {
+		return m.Fees
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgCancelCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CallbackRequest) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
 }
 
-func (m *MsgCancelCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *CallbackRequest) GetMaxExecutions() uint64 {
+	if m != nil {
+		return m.MaxExecutions
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CallbackRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CallbackRequest) GetCallbackTime() *time.Time {
+	if m != nil {
+		return m.CallbackTime
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *CallbackRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CallbackRequest) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgRequestCallback) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CallbackRequest) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *CallbackRequest) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgRequestCallbacksResponse defines the response structure for executing a MsgRequestCallbacks message.
+type MsgRequestCallbacksResponse struct {
+	// fee_splits are the breakdowns of the fees charged for each of the requested callbacks, in the request order
+	FeeSplits []CallbackFeesFeeSplit `protobuf:"bytes,1,rep,name=fee_splits,json=feeSplits,proto3" json:"fee_splits"`
+}
+
+func (m *MsgRequestCallbacksResponse) Reset()         { *m = MsgRequestCallbacksResponse{} }
+func (m *MsgRequestCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestCallbacksResponse) ProtoMessage()    {}
+func (*MsgRequestCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a16d5bd27202f4, []int{14}
+}
+func (m *MsgRequestCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.JobId != 0 {
-		n += 1 + sovTx(uint64(m.JobId))
+}
+func (m *MsgRequestCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestCallbacksResponse.Merge(m, src)
+}
+func (m *MsgRequestCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestCallbacksResponse proto.InternalMessageInfo
+
+func (m *MsgRequestCallbacksResponse) GetFeeSplits() []CallbackFeesFeeSplit {
+	if m != nil {
+		return m.FeeSplits
 	}
-	if m.CallbackHeight != 0 {
-		n += 1 + sovTx(uint64(m.CallbackHeight))
+	return nil
+}
+
+// MsgCancelCallbacks is the Msg/CancelCallbacks request type.
+type MsgCancelCallbacks struct {
+	// sender is the address which is cancelling the callbacks (bech32 encoded)
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// callbacks are the callbacks being cancelled. Either all of them are cancelled, or none are.
+	Callbacks []CallbackCancellation `protobuf:"bytes,2,rep,name=callbacks,proto3" json:"callbacks"`
+}
+
+func (m *MsgCancelCallbacks) Reset()         { *m = MsgCancelCallbacks{} }
+func (m *MsgCancelCallbacks) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelCallbacks) ProtoMessage()    {}
+func (*MsgCancelCallbacks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a16d5bd27202f4, []int{15}
+}
+func (m *MsgCancelCallbacks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelCallbacks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelCallbacks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Fees.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgCancelCallbacks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelCallbacks.Merge(m, src)
+}
+func (m *MsgCancelCallbacks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelCallbacks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelCallbacks.DiscardUnknown(m)
 }
 
-func (m *MsgRequestCallbackResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgCancelCallbacks proto.InternalMessageInfo
+
+func (m *MsgCancelCallbacks) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgCancelCallback) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelCallbacks) GetCallbacks() []CallbackCancellation {
+	if m != nil {
+		return m.Callbacks
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// CallbackCancellation is a callback cancelled as part of a MsgCancelCallbacks batch.
+// The fields are the same as the ones of MsgCancelCallback.
+type CallbackCancellation struct {
+	// contract_address is the address of the contract (bech32 encoded)
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// job_id is an identifier the callback requestor had passed during registration of the callback
+	JobId uint64 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// callback_height is the height at which the callback requestor had registered the callback
+	CallbackHeight int64 `protobuf:"varint,3,opt,name=callback_height,json=callbackHeight,proto3" json:"callback_height,omitempty"`
+	// callback_time is the block time at which the callback requestor had registered the callback
+	CallbackTime *time.Time `protobuf:"bytes,4,opt,name=callback_time,json=callbackTime,proto3,stdtime" json:"callback_time,omitempty"`
+}
+
+func (m *CallbackCancellation) Reset()         { *m = CallbackCancellation{} }
+func (m *CallbackCancellation) String() string { return proto.CompactTextString(m) }
+func (*CallbackCancellation) ProtoMessage()    {}
+func (*CallbackCancellation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a16d5bd27202f4, []int{16}
+}
+func (m *CallbackCancellation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallbackCancellation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackCancellation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *CallbackCancellation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackCancellation.Merge(m, src)
+}
+func (m *CallbackCancellation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallbackCancellation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackCancellation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackCancellation proto.InternalMessageInfo
+
+func (m *CallbackCancellation) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	if m.JobId != 0 {
-		n += 1 + sovTx(uint64(m.JobId))
+	return ""
+}
+
+func (m *CallbackCancellation) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
 	}
-	if m.CallbackHeight != 0 {
-		n += 1 + sovTx(uint64(m.CallbackHeight))
+	return 0
+}
+
+func (m *CallbackCancellation) GetCallbackHeight() int64 {
+	if m != nil {
+		return m.CallbackHeight
 	}
-	return n
+	return 0
 }
 
-func (m *MsgCancelCallbackResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CallbackCancellation) GetCallbackTime() *time.Time {
+	if m != nil {
+		return m.CallbackTime
 	}
-	var l int
-	_ = l
-	l = m.Refund.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
 
-func sovTx(x uint64) (n int) {
+// MsgCancelCallbacksResponse defines the response structure for executing a MsgCancelCallbacks message.
+type MsgCancelCallbacksResponse struct {
+	// refunds are the amounts of fees refunded for each of the cancelled callbacks, in the request order
+	Refunds []types.Coin `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds"`
+	// refund is the total amount of fees refunded, per denom as the callbacks could have been paid in different denoms
+	Refund []types.Coin `protobuf:"bytes,2,rep,name=refund,proto3" json:"refund"`
+}
+
+func (m *MsgCancelCallbacksResponse) Reset()         { *m = MsgCancelCallbacksResponse{} }
+func (m *MsgCancelCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelCallbacksResponse) ProtoMessage()    {}
+func (*MsgCancelCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a16d5bd27202f4, []int{17}
+}
+func (m *MsgCancelCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelCallbacksResponse.Merge(m, src)
+}
+func (m *MsgCancelCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelCallbacksResponse proto.InternalMessageInfo
+
+func (m *MsgCancelCallbacksResponse) GetRefunds() []types.Coin {
+	if m != nil {
+		return m.Refunds
+	}
+	return nil
+}
+
+func (m *MsgCancelCallbacksResponse) GetRefund() []types.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "archway.callback.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "archway.callback.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRequestCallback)(nil), "archway.callback.v1.MsgRequestCallback")
+	proto.RegisterType((*MsgRequestCallbackResponse)(nil), "archway.callback.v1.MsgRequestCallbackResponse")
+	proto.RegisterType((*MsgCancelCallback)(nil), "archway.callback.v1.MsgCancelCallback")
+	proto.RegisterType((*MsgCancelCallbackResponse)(nil), "archway.callback.v1.MsgCancelCallbackResponse")
+	proto.RegisterType((*MsgUpdateCallback)(nil), "archway.callback.v1.MsgUpdateCallback")
+	proto.RegisterType((*MsgUpdateCallbackResponse)(nil), "archway.callback.v1.MsgUpdateCallbackResponse")
+	proto.RegisterType((*MsgSubscribeToEvents)(nil), "archway.callback.v1.MsgSubscribeToEvents")
+	proto.RegisterType((*MsgSubscribeToEventsResponse)(nil), "archway.callback.v1.MsgSubscribeToEventsResponse")
+	proto.RegisterType((*MsgUnsubscribeFromEvents)(nil), "archway.callback.v1.MsgUnsubscribeFromEvents")
+	proto.RegisterType((*MsgUnsubscribeFromEventsResponse)(nil), "archway.callback.v1.MsgUnsubscribeFromEventsResponse")
+	proto.RegisterType((*MsgRequestCallbacks)(nil), "archway.callback.v1.MsgRequestCallbacks")
+	proto.RegisterType((*CallbackRequest)(nil), "archway.callback.v1.CallbackRequest")
+	proto.RegisterType((*MsgRequestCallbacksResponse)(nil), "archway.callback.v1.MsgRequestCallbacksResponse")
+	proto.RegisterType((*MsgCancelCallbacks)(nil), "archway.callback.v1.MsgCancelCallbacks")
+	proto.RegisterType((*CallbackCancellation)(nil), "archway.callback.v1.CallbackCancellation")
+	proto.RegisterType((*MsgCancelCallbacksResponse)(nil), "archway.callback.v1.MsgCancelCallbacksResponse")
+}
+
+func init() { proto.RegisterFile("archway/callback/v1/tx.proto", fileDescriptor_d9a16d5bd27202f4) }
+
+var fileDescriptor_d9a16d5bd27202f4 = []byte{
+	// 1232 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x6f, 0x13, 0x47,
+	0x18, 0xce, 0xc6, 0x5f, 0xf1, 0x6b, 0x27, 0x8e, 0x17, 0x28, 0xcb, 0x92, 0x3a, 0x96, 0xdb, 0x12,
+	0x83, 0x60, 0xb7, 0x01, 0x55, 0x55, 0xb9, 0x91, 0x88, 0x14, 0x24, 0x5c, 0xa1, 0x25, 0xed, 0xa1,
+	0x3d, 0x58, 0xb3, 0xeb, 0xf1, 0x7a, 0xc1, 0xfb, 0xc1, 0xce, 0x38, 0x89, 0x0f, 0x95, 0x50, 0xd5,
+	0x43, 0x4f, 0x15, 0x52, 0xcf, 0x3d, 0xf5, 0x07, 0x94, 0x9f, 0xc1, 0x91, 0x63, 0x4f, 0xb4, 0x4a,
+	0x0e, 0x48, 0xfc, 0x85, 0x5e, 0xaa, 0x99, 0xfd, 0x70, 0x6c, 0xaf, 0xe3, 0x25, 0x41, 0x55, 0xd5,
+	0x9b, 0x67, 0xe6, 0x99, 0xf7, 0xeb, 0x79, 0xf6, 0xdd, 0x77, 0x0d, 0x6b, 0xc8, 0x37, 0x7a, 0xfb,
+	0x68, 0xa8, 0x1a, 0xa8, 0xdf, 0xd7, 0x91, 0xf1, 0x44, 0xdd, 0xdb, 0x54, 0xe9, 0x81, 0xe2, 0xf9,
+	0x2e, 0x75, 0xc5, 0x73, 0xe1, 0xa9, 0x12, 0x9d, 0x2a, 0x7b, 0x9b, 0xf2, 0x79, 0xd3, 0x35, 0x5d,
+	0x7e, 0xae, 0xb2, 0x5f, 0x01, 0x54, 0x5e, 0x37, 0x5d, 0xd7, 0xec, 0x63, 0x95, 0xaf, 0xf4, 0x41,
+	0x57, 0xa5, 0x96, 0x8d, 0x09, 0x45, 0xb6, 0x17, 0x02, 0x6a, 0x86, 0x4b, 0x6c, 0x97, 0xa8, 0x3a,
+	0x22, 0x58, 0xdd, 0xdb, 0xd4, 0x31, 0x45, 0x9b, 0xaa, 0xe1, 0x5a, 0x4e, 0x78, 0x7e, 0x31, 0x3c,
+	0xb7, 0x89, 0xc9, 0x62, 0xb0, 0x89, 0x19, 0x1e, 0x34, 0x92, 0x42, 0x8c, 0x03, 0xe2, 0x98, 0xc6,
+	0xcf, 0x02, 0x54, 0x5a, 0xc4, 0xfc, 0xda, 0xeb, 0x20, 0x8a, 0x1f, 0x22, 0x1f, 0xd9, 0x44, 0x5c,
+	0x83, 0x22, 0x1a, 0xd0, 0x9e, 0xeb, 0x5b, 0x74, 0x28, 0x09, 0x75, 0xa1, 0x59, 0xd4, 0x46, 0x1b,
+	0x62, 0x0b, 0xf2, 0x1e, 0xc7, 0x49, 0x8b, 0x75, 0xa1, 0x59, 0xba, 0x79, 0x59, 0x49, 0xc8, 0x55,
+	0x09, 0x4c, 0x6d, 0x49, 0x2f, 0x5f, 0xaf, 0x2f, 0xbc, 0x7d, 0xbd, 0xbe, 0x1a, 0x5c, 0xb9, 0xee,
+	0xda, 0x16, 0xc5, 0xb6, 0x47, 0x87, 0x5a, 0x68, 0xe4, 0xf6, 0xca, 0x0f, 0x6f, 0x5e, 0x5c, 0x1b,
+	0x99, 0x6f, 0x5c, 0x82, 0x8b, 0x13, 0xf1, 0x68, 0x98, 0x78, 0xae, 0x43, 0x70, 0xe3, 0xb7, 0x2c,
+	0x88, 0x2d, 0x62, 0x6a, 0xf8, 0xe9, 0x00, 0x13, 0xba, 0x1d, 0x7a, 0x13, 0x3f, 0x80, 0x3c, 0xc1,
+	0x4e, 0x07, 0xfb, 0x61, 0xac, 0xe1, 0x4a, 0xbc, 0x0a, 0xab, 0x86, 0xeb, 0x50, 0x1f, 0x19, 0xb4,
+	0x8d, 0x3a, 0x1d, 0x1f, 0x93, 0x20, 0xe4, 0xa2, 0x56, 0x89, 0xf6, 0xef, 0x04, 0xdb, 0xe2, 0x05,
+	0xc8, 0x3f, 0x76, 0xf5, 0xb6, 0xd5, 0x91, 0x32, 0x75, 0xa1, 0x99, 0xd5, 0x72, 0x8f, 0x5d, 0xfd,
+	0x7e, 0x47, 0xdc, 0x80, 0x4a, 0x94, 0x53, 0xbb, 0x87, 0x2d, 0xb3, 0x47, 0xa5, 0x6c, 0x5d, 0x68,
+	0x66, 0xb4, 0x95, 0x68, 0xfb, 0x1e, 0xdf, 0x15, 0x6f, 0x41, 0xb6, 0x8b, 0x31, 0x91, 0x72, 0xbc,
+	0x22, 0x97, 0x94, 0x80, 0x11, 0x85, 0x31, 0xa6, 0x84, 0x8c, 0x29, 0xdb, 0xae, 0xe5, 0x6c, 0x65,
+	0x59, 0x3d, 0x34, 0x0e, 0x16, 0x65, 0x58, 0xb2, 0x1c, 0x8a, 0xfd, 0x3d, 0xd4, 0x97, 0xf2, 0xdc,
+	0x6d, 0xbc, 0x16, 0x3f, 0x81, 0x15, 0x1b, 0x1d, 0xb4, 0xf1, 0x01, 0x36, 0x06, 0xd4, 0x72, 0x1d,
+	0x22, 0x15, 0x38, 0x62, 0xd9, 0x46, 0x07, 0x77, 0xe3, 0x4d, 0x51, 0x82, 0x82, 0x87, 0x86, 0x7d,
+	0x17, 0x75, 0xa4, 0xa5, 0xba, 0xd0, 0x2c, 0x6b, 0xd1, 0x52, 0xbc, 0x0b, 0xcb, 0x71, 0xe8, 0x4c,
+	0x50, 0x52, 0x91, 0x87, 0x26, 0x2b, 0x81, 0xda, 0x94, 0x48, 0x6d, 0xca, 0x6e, 0xa4, 0xb6, 0xad,
+	0xec, 0xf3, 0x3f, 0xd7, 0x05, 0xad, 0x1c, 0x5d, 0x63, 0x07, 0xe2, 0x65, 0x28, 0x9a, 0x88, 0xb4,
+	0xfb, 0x96, 0x6d, 0x51, 0x09, 0x82, 0x20, 0x4d, 0x44, 0x1e, 0xb0, 0x35, 0x3b, 0xec, 0x62, 0xdc,
+	0xf6, 0xd0, 0x10, 0xfb, 0x52, 0x89, 0x57, 0x76, 0xa9, 0x8b, 0xf1, 0x43, 0xb6, 0x66, 0x19, 0xf8,
+	0xb8, 0x3b, 0x70, 0x3a, 0x71, 0xed, 0xcb, 0x1c, 0xb1, 0x1c, 0xec, 0x46, 0x95, 0xdf, 0x86, 0xb2,
+	0x8f, 0xa9, 0x3f, 0x6c, 0x7b, 0x6e, 0xdf, 0x32, 0x86, 0xd2, 0x32, 0x0f, 0xb3, 0x9e, 0xa8, 0x29,
+	0x8d, 0x01, 0x1f, 0x72, 0x9c, 0x56, 0xf2, 0x47, 0x8b, 0xdb, 0x25, 0xa6, 0xa1, 0x90, 0xf6, 0xc6,
+	0x1a, 0xc8, 0xd3, 0x22, 0x89, 0x35, 0xf4, 0x56, 0x80, 0x6a, 0x8b, 0x98, 0xdb, 0xc8, 0x31, 0x70,
+	0xff, 0xbf, 0x24, 0xa1, 0x29, 0xc2, 0x72, 0xa7, 0x21, 0x6c, 0xbc, 0x14, 0xbb, 0x70, 0x69, 0x2a,
+	0xd7, 0xa8, 0x12, 0xe2, 0xe7, 0x90, 0x0f, 0xa8, 0x90, 0x84, 0x74, 0xaa, 0x0d, 0xe1, 0x8d, 0x5f,
+	0x33, 0x50, 0x8d, 0x1f, 0xd1, 0xff, 0x5f, 0x09, 0x45, 0x05, 0xce, 0x39, 0x78, 0xbf, 0x3d, 0xe9,
+	0x33, 0xcf, 0x7d, 0x56, 0x1d, 0xbc, 0xbf, 0x3d, 0xee, 0xf6, 0x01, 0x54, 0xc7, 0xf0, 0xdc, 0x75,
+	0x21, 0xa5, 0xeb, 0xca, 0x31, 0x7b, 0xdc, 0x7b, 0xd4, 0x4a, 0x96, 0xde, 0xa1, 0x95, 0x24, 0xb1,
+	0x3e, 0x4e, 0xcf, 0xd9, 0x59, 0xff, 0x7d, 0x11, 0xce, 0xb7, 0x88, 0xf9, 0x68, 0xa0, 0x13, 0xc3,
+	0xb7, 0x74, 0xbc, 0xeb, 0xde, 0xdd, 0xc3, 0x0e, 0x25, 0xef, 0x83, 0xf8, 0x0f, 0x01, 0x30, 0x33,
+	0xd6, 0xa6, 0x43, 0x0f, 0x73, 0xf2, 0x8b, 0x5a, 0x91, 0xef, 0xec, 0x0e, 0x3d, 0x2c, 0x7e, 0x03,
+	0x55, 0x44, 0xa9, 0x6f, 0xe9, 0x03, 0x8a, 0xdb, 0x5d, 0xab, 0x4f, 0xb1, 0x4f, 0xa4, 0x6c, 0x3d,
+	0xd3, 0x2c, 0xdd, 0xfc, 0x28, 0xb1, 0x51, 0xf0, 0xc8, 0xee, 0x44, 0x57, 0xc2, 0x44, 0x56, 0x63,
+	0x1b, 0x3b, 0x81, 0x89, 0xf1, 0xe6, 0x96, 0x9b, 0x68, 0x6e, 0x11, 0x0f, 0xf9, 0x53, 0xf3, 0xf0,
+	0x25, 0xac, 0x25, 0x15, 0x2c, 0xa6, 0x62, 0x03, 0x2a, 0x24, 0x38, 0xf4, 0x58, 0x37, 0x67, 0xba,
+	0x17, 0x78, 0x10, 0x2b, 0xc7, 0xb7, 0xef, 0x77, 0x1a, 0xbf, 0x08, 0x20, 0x31, 0x46, 0x1d, 0x12,
+	0xd9, 0xda, 0xf1, 0x5d, 0xfb, 0xfd, 0x95, 0x3f, 0x21, 0x90, 0x4c, 0x52, 0x20, 0xe3, 0xe9, 0x7d,
+	0x07, 0xf5, 0x59, 0x41, 0x9d, 0x5d, 0x6d, 0x3f, 0x0a, 0x70, 0x6e, 0xba, 0x8b, 0xcf, 0xce, 0xf6,
+	0x1e, 0x14, 0x23, 0x01, 0xb0, 0x34, 0x99, 0x34, 0x3e, 0x4e, 0x94, 0xc6, 0xe8, 0x81, 0xe0, 0x96,
+	0x43, 0xb7, 0xa3, 0xcb, 0xe3, 0x39, 0xfe, 0x9d, 0x81, 0xca, 0xc4, 0x8d, 0xc4, 0xc2, 0x0a, 0xf3,
+	0x1a, 0xda, 0xe2, 0x9c, 0x86, 0x96, 0x39, 0x71, 0xac, 0xc8, 0x9e, 0x76, 0xac, 0xc8, 0xcd, 0x1d,
+	0x2b, 0xf2, 0x73, 0xc6, 0x8a, 0xc2, 0x9c, 0xb1, 0x62, 0xe9, 0xec, 0x63, 0x45, 0xf1, 0xa4, 0xb1,
+	0x02, 0xe6, 0x8e, 0x15, 0xa5, 0x34, 0x63, 0x45, 0xf9, 0x14, 0x63, 0x45, 0xc3, 0x86, 0xcb, 0x09,
+	0x1a, 0x8c, 0xc5, 0xfd, 0x15, 0x00, 0x8b, 0x93, 0x78, 0x7d, 0x8b, 0x32, 0x09, 0x30, 0xd1, 0x5d,
+	0x3d, 0x51, 0x74, 0x3b, 0x18, 0x93, 0x1d, 0x8c, 0x1f, 0xb1, 0x1b, 0x91, 0xf2, 0xba, 0xe1, 0x9a,
+	0x34, 0x7e, 0x12, 0x40, 0x9c, 0x7a, 0x5d, 0xcf, 0x96, 0x7c, 0x6b, 0x5a, 0xf2, 0x27, 0x7b, 0x0f,
+	0x0c, 0xf7, 0x11, 0xe3, 0x78, 0x8e, 0xee, 0x5f, 0x0a, 0x70, 0x3e, 0xe9, 0xda, 0xbf, 0x29, 0xfe,
+	0x29, 0xa9, 0x65, 0x4f, 0x23, 0xb5, 0xc6, 0x73, 0x81, 0xcf, 0x83, 0x13, 0x55, 0x8d, 0x49, 0xfc,
+	0x02, 0x0a, 0x81, 0x72, 0x22, 0x06, 0xe7, 0x3e, 0x65, 0x11, 0xfe, 0x58, 0x73, 0x5b, 0x4c, 0x77,
+	0x33, 0x84, 0xdf, 0x7c, 0x56, 0x80, 0x4c, 0x8b, 0x98, 0xa2, 0x0e, 0xe5, 0xb1, 0xef, 0xae, 0xe4,
+	0x8e, 0x35, 0xf1, 0x35, 0x24, 0x5f, 0x4f, 0x83, 0x8a, 0xf3, 0x7b, 0x02, 0x95, 0xc9, 0xef, 0xa5,
+	0x8d, 0x59, 0x06, 0x26, 0x80, 0xb2, 0x9a, 0x12, 0x18, 0x3b, 0xeb, 0xc1, 0xca, 0xc4, 0x60, 0x7d,
+	0x65, 0x96, 0x89, 0x71, 0x9c, 0xac, 0xa4, 0xc3, 0x1d, 0xf7, 0x34, 0x31, 0x7f, 0x5e, 0x39, 0xb9,
+	0x2c, 0xf3, 0x3d, 0xcd, 0x18, 0x98, 0x9e, 0x42, 0x75, 0x7a, 0xe6, 0xb9, 0x3a, 0xcb, 0xc8, 0x14,
+	0x54, 0xde, 0x4c, 0x0d, 0x8d, 0x5d, 0x7e, 0x0f, 0x17, 0x92, 0xdf, 0xf5, 0x37, 0x66, 0xc6, 0x9e,
+	0x04, 0x97, 0x3f, 0x7b, 0x27, 0x78, 0xec, 0xde, 0x81, 0xd5, 0xa9, 0xf7, 0x6e, 0x33, 0xa5, 0x14,
+	0x88, 0xfc, 0x69, 0x5a, 0xe4, 0x71, 0x89, 0x4e, 0xf6, 0xbc, 0x8d, 0x74, 0x72, 0x20, 0xb2, 0x9a,
+	0x12, 0x18, 0x39, 0x93, 0x73, 0xcf, 0xde, 0xbc, 0xb8, 0x26, 0x6c, 0xb5, 0x5e, 0x1e, 0xd6, 0x84,
+	0x57, 0x87, 0x35, 0xe1, 0xaf, 0xc3, 0x9a, 0xf0, 0xfc, 0xa8, 0xb6, 0xf0, 0xea, 0xa8, 0xb6, 0xf0,
+	0xc7, 0x51, 0x6d, 0xe1, 0xdb, 0x5b, 0xa6, 0x45, 0x7b, 0x03, 0x5d, 0x31, 0x5c, 0x5b, 0x0d, 0x6d,
+	0xdf, 0x70, 0x30, 0xdd, 0x77, 0xfd, 0x27, 0xd1, 0x5a, 0x3d, 0x18, 0xfd, 0xa3, 0xc2, 0x26, 0x56,
+	0xa2, 0xe7, 0x79, 0x33, 0xba, 0xf5, 0xcf, 0x00, 0xfd, 0x80, 0x84, 0x76, 0x15, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the x/callback
+	// module parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RequestCallback defines a message for registering a callback at a specific height by a given contract
+	RequestCallback(ctx context.Context, in *MsgRequestCallback, opts ...grpc.CallOption) (*MsgRequestCallbackResponse, error)
+	// CancelCallback defines a message for cancelling an existing callback
+	CancelCallback(ctx context.Context, in *MsgCancelCallback, opts ...grpc.CallOption) (*MsgCancelCallbackResponse, error)
+	// UpdateCallback defines a message for moving an existing callback to a new height or block time
+	UpdateCallback(ctx context.Context, in *MsgUpdateCallback, opts ...grpc.CallOption) (*MsgUpdateCallbackResponse, error)
+	// SubscribeToEvents defines a message for subscribing a contract to the events emitted in a block
+	SubscribeToEvents(ctx context.Context, in *MsgSubscribeToEvents, opts ...grpc.CallOption) (*MsgSubscribeToEventsResponse, error)
+	// UnsubscribeFromEvents defines a message for removing an existing event subscription
+	UnsubscribeFromEvents(ctx context.Context, in *MsgUnsubscribeFromEvents, opts ...grpc.CallOption) (*MsgUnsubscribeFromEventsResponse, error)
+	// RequestCallbacks defines a message for registering a batch of callbacks at once
+	RequestCallbacks(ctx context.Context, in *MsgRequestCallbacks, opts ...grpc.CallOption) (*MsgRequestCallbacksResponse, error)
+	// CancelCallbacks defines a message for cancelling a batch of existing callbacks at once
+	CancelCallbacks(ctx context.Context, in *MsgCancelCallbacks, opts ...grpc.CallOption) (*MsgCancelCallbacksResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestCallback(ctx context.Context, in *MsgRequestCallback, opts ...grpc.CallOption) (*MsgRequestCallbackResponse, error) {
+	out := new(MsgRequestCallbackResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Msg/RequestCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelCallback(ctx context.Context, in *MsgCancelCallback, opts ...grpc.CallOption) (*MsgCancelCallbackResponse, error) {
+	out := new(MsgCancelCallbackResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Msg/CancelCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCallback(ctx context.Context, in *MsgUpdateCallback, opts ...grpc.CallOption) (*MsgUpdateCallbackResponse, error) {
+	out := new(MsgUpdateCallbackResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Msg/UpdateCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubscribeToEvents(ctx context.Context, in *MsgSubscribeToEvents, opts ...grpc.CallOption) (*MsgSubscribeToEventsResponse, error) {
+	out := new(MsgSubscribeToEventsResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Msg/SubscribeToEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnsubscribeFromEvents(ctx context.Context, in *MsgUnsubscribeFromEvents, opts ...grpc.CallOption) (*MsgUnsubscribeFromEventsResponse, error) {
+	out := new(MsgUnsubscribeFromEventsResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Msg/UnsubscribeFromEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestCallbacks(ctx context.Context, in *MsgRequestCallbacks, opts ...grpc.CallOption) (*MsgRequestCallbacksResponse, error) {
+	out := new(MsgRequestCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Msg/RequestCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelCallbacks(ctx context.Context, in *MsgCancelCallbacks, opts ...grpc.CallOption) (*MsgCancelCallbacksResponse, error) {
+	out := new(MsgCancelCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/archway.callback.v1.Msg/CancelCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the x/callback
+	// module parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RequestCallback defines a message for registering a callback at a specific height by a given contract
+	RequestCallback(context.Context, *MsgRequestCallback) (*MsgRequestCallbackResponse, error)
+	// CancelCallback defines a message for cancelling an existing callback
+	CancelCallback(context.Context, *MsgCancelCallback) (*MsgCancelCallbackResponse, error)
+	// UpdateCallback defines a message for moving an existing callback to a new height or block time
+	UpdateCallback(context.Context, *MsgUpdateCallback) (*MsgUpdateCallbackResponse, error)
+	// SubscribeToEvents defines a message for subscribing a contract to the events emitted in a block
+	SubscribeToEvents(context.Context, *MsgSubscribeToEvents) (*MsgSubscribeToEventsResponse, error)
+	// UnsubscribeFromEvents defines a message for removing an existing event subscription
+	UnsubscribeFromEvents(context.Context, *MsgUnsubscribeFromEvents) (*MsgUnsubscribeFromEventsResponse, error)
+	// RequestCallbacks defines a message for registering a batch of callbacks at once
+	RequestCallbacks(context.Context, *MsgRequestCallbacks) (*MsgRequestCallbacksResponse, error)
+	// CancelCallbacks defines a message for cancelling a batch of existing callbacks at once
+	CancelCallbacks(context.Context, *MsgCancelCallbacks) (*MsgCancelCallbacksResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RequestCallback(ctx context.Context, req *MsgRequestCallback) (*MsgRequestCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCallback not implemented")
+}
+func (*UnimplementedMsgServer) CancelCallback(ctx context.Context, req *MsgCancelCallback) (*MsgCancelCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCallback not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCallback(ctx context.Context, req *MsgUpdateCallback) (*MsgUpdateCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCallback not implemented")
+}
+func (*UnimplementedMsgServer) SubscribeToEvents(ctx context.Context, req *MsgSubscribeToEvents) (*MsgSubscribeToEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeToEvents not implemented")
+}
+func (*UnimplementedMsgServer) UnsubscribeFromEvents(ctx context.Context, req *MsgUnsubscribeFromEvents) (*MsgUnsubscribeFromEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeFromEvents not implemented")
+}
+func (*UnimplementedMsgServer) RequestCallbacks(ctx context.Context, req *MsgRequestCallbacks) (*MsgRequestCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCallbacks not implemented")
+}
+func (*UnimplementedMsgServer) CancelCallbacks(ctx context.Context, req *MsgCancelCallbacks) (*MsgCancelCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCallbacks not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Msg/RequestCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestCallback(ctx, req.(*MsgRequestCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Msg/CancelCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelCallback(ctx, req.(*MsgCancelCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Msg/UpdateCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCallback(ctx, req.(*MsgUpdateCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubscribeToEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubscribeToEvents)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubscribeToEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Msg/SubscribeToEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubscribeToEvents(ctx, req.(*MsgSubscribeToEvents))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnsubscribeFromEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnsubscribeFromEvents)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnsubscribeFromEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Msg/UnsubscribeFromEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnsubscribeFromEvents(ctx, req.(*MsgUnsubscribeFromEvents))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestCallbacks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Msg/RequestCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestCallbacks(ctx, req.(*MsgRequestCallbacks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelCallbacks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.callback.v1.Msg/CancelCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelCallbacks(ctx, req.(*MsgCancelCallbacks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "archway.callback.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RequestCallback",
+			Handler:    _Msg_RequestCallback_Handler,
+		},
+		{
+			MethodName: "CancelCallback",
+			Handler:    _Msg_CancelCallback_Handler,
+		},
+		{
+			MethodName: "UpdateCallback",
+			Handler:    _Msg_UpdateCallback_Handler,
+		},
+		{
+			MethodName: "SubscribeToEvents",
+			Handler:    _Msg_SubscribeToEvents_Handler,
+		},
+		{
+			MethodName: "UnsubscribeFromEvents",
+			Handler:    _Msg_UnsubscribeFromEvents_Handler,
+		},
+		{
+			MethodName: "RequestCallbacks",
+			Handler:    _Msg_RequestCallbacks_Handler,
+		},
+		{
+			MethodName: "CancelCallbacks",
+			Handler:    _Msg_CancelCallbacks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "archway/callback/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CallbackTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CallbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxExecutions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.CallbackHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CallbackHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.JobId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CallbackTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CallbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CallbackHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CallbackHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.JobId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.NewCallbackTime != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NewCallbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NewCallbackTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTx(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NewCallbackHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewCallbackHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CallbackTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CallbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintTx(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CallbackHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CallbackHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.JobId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubscribeToEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubscribeToEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubscribeToEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AttributeFilters) > 0 {
+		for iNdEx := len(m.AttributeFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubscribeToEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubscribeToEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubscribeToEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnsubscribeFromEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnsubscribeFromEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnsubscribeFromEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnsubscribeFromEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnsubscribeFromEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnsubscribeFromEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestCallbacks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestCallbacks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestCallbacks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CallbackTime != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CallbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintTx(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxExecutions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CallbackHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CallbackHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JobId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeSplits) > 0 {
+		for iNdEx := len(m.FeeSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelCallbacks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelCallbacks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelCallbacks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackCancellation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackCancellation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackCancellation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CallbackTime != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CallbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintTx(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CallbackHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CallbackHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JobId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Refunds) > 0 {
+		for iNdEx := len(m.Refunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovTx(uint64(m.JobId))
+	}
+	if m.CallbackHeight != 0 {
+		n += 1 + sovTx(uint64(m.CallbackHeight))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovTx(uint64(m.MaxExecutions))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CallbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovTx(uint64(m.JobId))
+	}
+	if m.CallbackHeight != 0 {
+		n += 1 + sovTx(uint64(m.CallbackHeight))
+	}
+	if m.CallbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Refund.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovTx(uint64(m.JobId))
+	}
+	if m.CallbackHeight != 0 {
+		n += 1 + sovTx(uint64(m.CallbackHeight))
+	}
+	if m.CallbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewCallbackHeight != 0 {
+		n += 1 + sovTx(uint64(m.NewCallbackHeight))
+	}
+	if m.NewCallbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NewCallbackTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Refund.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubscribeToEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AttributeFilters) > 0 {
+		for _, e := range m.AttributeFilters {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubscribeToEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	return n
+}
+
+func (m *MsgUnsubscribeFromEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	return n
+}
+
+func (m *MsgUnsubscribeFromEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Refund.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRequestCallbacks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CallbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovTx(uint64(m.JobId))
+	}
+	if m.CallbackHeight != 0 {
+		n += 1 + sovTx(uint64(m.CallbackHeight))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovTx(uint64(m.MaxExecutions))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CallbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeSplits) > 0 {
+		for _, e := range m.FeeSplits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelCallbacks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CallbackCancellation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovTx(uint64(m.JobId))
+	}
+	if m.CallbackHeight != 0 {
+		n += 1 + sovTx(uint64(m.CallbackHeight))
+	}
+	if m.CallbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CallbackTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refunds) > 0 {
+		for _, e := range m.Refunds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			}
+			m.CallbackHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallbackTime == nil {
+				m.CallbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CallbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestCallbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestCallbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			}
+			m.CallbackHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallbackTime == nil {
+				m.CallbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CallbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelCallbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelCallbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			}
+			m.CallbackHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallbackTime == nil {
+				m.CallbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CallbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCallbackHeight", wireType)
+			}
+			m.NewCallbackHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewCallbackHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCallbackTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewCallbackTime == nil {
+				m.NewCallbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NewCallbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCallbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCallbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubscribeToEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubscribeToEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubscribeToEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeFilters = append(m.AttributeFilters, EventAttribute{})
+			if err := m.AttributeFilters[len(m.AttributeFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubscribeToEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubscribeToEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubscribeToEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			m.SubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnsubscribeFromEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnsubscribeFromEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnsubscribeFromEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			m.SubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnsubscribeFromEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnsubscribeFromEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnsubscribeFromEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestCallbacks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestCallbacks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestCallbacks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, CallbackRequest{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *CallbackRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -927,15 +4983,277 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallbackRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			}
+			m.CallbackHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallbackTime == nil {
+				m.CallbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CallbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -963,11 +5281,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -994,7 +5312,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1019,7 +5340,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestCallbacksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1042,12 +5363,46 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestCallbacksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSplits = append(m.FeeSplits, CallbackFeesFeeSplit{})
+			if err := m.FeeSplits[len(m.FeeSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1069,7 +5424,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestCallback) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelCallbacks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1092,10 +5447,10 @@ func (m *MsgRequestCallback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestCallback: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelCallbacks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelCallbacks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1132,77 +5487,7 @@ func (m *MsgRequestCallback) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
-			}
-			m.JobId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
-			}
-			m.CallbackHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CallbackHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1229,7 +5514,8 @@ func (m *MsgRequestCallback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Callbacks = append(m.Callbacks, CallbackCancellation{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1254,57 +5540,7 @@ func (m *MsgRequestCallback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestCallbackResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestCallbackResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelCallback) Unmarshal(dAtA []byte) error {
+func (m *CallbackCancellation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1327,15 +5563,15 @@ func (m *MsgCancelCallback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelCallback: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallbackCancellation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallbackCancellation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1363,13 +5599,13 @@ func (m *MsgCancelCallback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			var stringLen uint64
+			m.JobId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1379,29 +5615,16 @@ func (m *MsgCancelCallback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.JobId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
 			}
-			m.JobId = 0
+			m.CallbackHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1411,16 +5634,16 @@ func (m *MsgCancelCallback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JobId |= uint64(b&0x7F) << shift
+				m.CallbackHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackTime", wireType)
 			}
-			m.CallbackHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1430,11 +5653,28 @@ func (m *MsgCancelCallback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CallbackHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallbackTime == nil {
+				m.CallbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CallbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1456,7 +5696,7 @@ func (m *MsgCancelCallback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelCallbackResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelCallbacksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1479,13 +5719,47 @@ func (m *MsgCancelCallbackResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelCallbackResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelCallbacksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunds = append(m.Refunds, types.Coin{})
+			if err := m.Refunds[len(m.Refunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
 			}
@@ -1514,7 +5788,8 @@ func (m *MsgCancelCallbackResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Refund = append(m.Refund, types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9,12 +9,14 @@ import (
 	"github.com/archway-network/archway/x/cwerrors/types"
 )
 
-const ErrorCallbackGasLimit = 150_000
-
 // EndBlocker is called every block, and prunes errors that are older than the current block height.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper, wk types.WasmKeeperExpected) ([]abci.ValidatorUpdate, error) {
 	// Iterate over all errors (with callback subscription) and execute the error callback for each error
 	k.IterateSudoErrorCallbacks(ctx, sudoErrorCallbackExec(ctx, k, wk))
+	// Notify the contracts whose subscriptions are about to expire
+	if err := subscriptionExpiryNoticeExec(ctx, k, wk); err != nil {
+		return nil, err
+	}
 	// Prune any error callback subscripitons that have expired in the current block height
 	if err := k.PruneSubscriptionsEndBlock(ctx); err != nil {
 		return nil, err
@@ -30,8 +32,13 @@ func sudoErrorCallbackExec(ctx sdk.Context, k keeper.Keeper, wk types.WasmKeeper
 	return func(sudoError types.SudoError) bool {
 		contractAddr := sdk.MustAccAddressFromBech32(sudoError.ContractAddress)
 
+		gasLimit, err := k.GetSubscriptionGasLimit(ctx, contractAddr)
+		if err != nil {
+			panic(err)
+		}
+
 		sudoMsg := types.NewSudoMsg(sudoError)
-		_, err := pkg.ExecuteWithGasLimit(ctx, ErrorCallbackGasLimit, func(ctx sdk.Context) error {
+		_, err = pkg.ExecuteWithGasLimit(ctx, gasLimit, func(ctx sdk.Context) error {
 			_, err := wk.Sudo(ctx, contractAddr, sudoMsg.Bytes())
 			return err
 		})
@@ -57,3 +64,44 @@ func sudoErrorCallbackExec(ctx sdk.Context, k keeper.Keeper, wk types.WasmKeeper
 		return false
 	}
 }
+
+func subscriptionExpiryNoticeExec(ctx sdk.Context, k keeper.Keeper, wk types.WasmKeeperExpected) error {
+	contractAddrs, endHeight, err := k.GetExpiringSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, contractAddr := range contractAddrs {
+		renewal, err := k.GetSubscriptionRenewal(ctx, contractAddr)
+		if err != nil {
+			return err
+		}
+		types.EmitSubscriptionExpiringEvent(ctx, contractAddr.String(), endHeight, renewal.AutoRenew)
+		if !renewal.ExpiryNotice {
+			continue
+		}
+
+		gasLimit, err := k.GetSubscriptionGasLimit(ctx, contractAddr)
+		if err != nil {
+			return err
+		}
+		sudoMsg := types.NewSubscriptionExpiringSudoMsg(contractAddr.String(), endHeight, renewal.AutoRenew)
+		_, err = pkg.ExecuteWithGasLimit(ctx, gasLimit, func(ctx sdk.Context) error {
+			_, err := wk.Sudo(ctx, contractAddr, sudoMsg.Bytes())
+			return err
+		})
+		if err != nil {
+			// In case Sudo error, such as out of gas, store the error in state so that the contract can still find out
+			newSudoErr := types.SudoError{
+				ModuleName:      types.ModuleName,
+				ContractAddress: contractAddr.String(),
+				ErrorCode:       int32(types.ModuleErrors_ERR_EXPIRY_NOTICE_FAILED),
+				InputPayload:    sudoMsg.String(),
+				ErrorMessage:    err.Error(),
+			}
+			if err := k.StoreErrorInState(ctx, contractAddr, newSudoErr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
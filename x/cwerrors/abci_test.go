@@ -1,7 +1,9 @@
 package cwerrors_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -70,10 +72,10 @@ func TestEndBlocker(t *testing.T) {
 	require.NoError(t, err)
 
 	// Check number of errors match
-	sudoErrs, err := keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes())
+	sudoErrs, _, err := keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes(), types.ErrorsFilter{}, nil)
 	require.NoError(t, err)
 	require.Len(t, sudoErrs, 3)
-	sudoErrs, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes())
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes(), types.ErrorsFilter{}, nil)
 	require.NoError(t, err)
 	require.Len(t, sudoErrs, 3)
 
@@ -83,10 +85,10 @@ func TestEndBlocker(t *testing.T) {
 	require.NoError(t, err)
 
 	// Check number of errors match
-	sudoErrs, err = keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes())
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes(), types.ErrorsFilter{}, nil)
 	require.NoError(t, err)
 	require.Len(t, sudoErrs, 1)
-	sudoErrs, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes())
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes(), types.ErrorsFilter{}, nil)
 	require.NoError(t, err)
 	require.Len(t, sudoErrs, 2)
 
@@ -96,15 +98,15 @@ func TestEndBlocker(t *testing.T) {
 	require.NoError(t, err)
 
 	// Check number of errors match
-	sudoErrs, err = keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes())
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes(), types.ErrorsFilter{}, nil)
 	require.NoError(t, err)
 	require.Len(t, sudoErrs, 0)
-	sudoErrs, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes())
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes(), types.ErrorsFilter{}, nil)
 	require.NoError(t, err)
 	require.Len(t, sudoErrs, 0)
 
 	// Setup subscription
-	expiryTime, err := keeper.SetSubscription(ctx, contractAdminAcc, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0))
+	expiryTime, err := keeper.SetSubscription(ctx, contractAdminAcc, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), nil, 0, types.SubscriptionRenewal{})
 	require.NoError(t, err)
 	require.Equal(t, ctx.BlockHeight()+params.SubscriptionPeriod, expiryTime)
 
@@ -119,11 +121,11 @@ func TestEndBlocker(t *testing.T) {
 	require.NoError(t, err)
 
 	// Should be empty as the is stored for error callback
-	sudoErrs, err = keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes())
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes(), types.ErrorsFilter{}, nil)
 	require.NoError(t, err)
 	require.Len(t, sudoErrs, 0)
 	// Second error should still be stored in state
-	sudoErrs, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes())
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes(), types.ErrorsFilter{}, nil)
 	require.NoError(t, err)
 	require.Len(t, sudoErrs, 1)
 
@@ -132,7 +134,108 @@ func TestEndBlocker(t *testing.T) {
 	require.Len(t, sudoErrs, 1)
 
 	// Ensure old errors in state persist and are not purged
-	sudoErrs, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes())
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes(), types.ErrorsFilter{}, nil)
 	require.NoError(t, err)
 	require.Len(t, sudoErrs, 1)
 }
+
+func TestEndBlockerSubscriptionExpiryNotice(t *testing.T) {
+	keeper, ctx := testutils.CWErrorsKeeper(t)
+	wasmKeeper := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(wasmKeeper)
+
+	contractAddresses := e2eTesting.GenContractAddresses(2)
+	contractAddr := contractAddresses[0]
+	contractAddr2 := contractAddresses[1]
+	contractAdminAcc := testutils.AccAddress()
+	wasmKeeper.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.String(),
+	)
+	wasmKeeper.AddContractAdmin(
+		contractAddr2.String(),
+		contractAdminAcc.String(),
+	)
+	params := types.DefaultParams()
+	params.SubscriptionPeriod = 10
+	params.SubscriptionExpiryNoticePeriod = 3
+	err := keeper.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	// Subscribe a contract with the expiry notice and a contract without it
+	endHeight, err := keeper.SetSubscription(ctx, contractAdminAcc, contractAddr, params.SubscriptionFee, nil, 0, types.SubscriptionRenewal{ExpiryNotice: true})
+	require.NoError(t, err)
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc, contractAddr2, params.SubscriptionFee, nil, 0, types.SubscriptionRenewal{})
+	require.NoError(t, err)
+
+	// Make the sudo notice fail so that it is stored in state
+	wasmKeeper.SetReturnSudoError(errors.New("notice failed"))
+
+	// Nothing happens before the notice height
+	ctx = ctx.WithBlockHeight(endHeight - params.SubscriptionExpiryNoticePeriod - 1)
+	_, err = cwerrors.EndBlocker(ctx, keeper, wasmKeeper)
+	require.NoError(t, err)
+	sudoErrs, _, err := keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes(), types.ErrorsFilter{}, nil)
+	require.NoError(t, err)
+	require.Len(t, sudoErrs, 0)
+
+	// The notice is sent at the notice height, only to the contract which opted in
+	ctx = ctx.WithBlockHeight(endHeight - params.SubscriptionExpiryNoticePeriod)
+	_, err = cwerrors.EndBlocker(ctx, keeper, wasmKeeper)
+	require.NoError(t, err)
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes(), types.ErrorsFilter{}, nil)
+	require.NoError(t, err)
+	require.Len(t, sudoErrs, 1)
+	require.Equal(t, types.ModuleName, sudoErrs[0].ModuleName)
+	require.Equal(t, int32(types.ModuleErrors_ERR_EXPIRY_NOTICE_FAILED), sudoErrs[0].ErrorCode)
+	require.Equal(t, types.NewSubscriptionExpiringSudoMsg(contractAddr.String(), endHeight, false).String(), sudoErrs[0].InputPayload)
+	require.Equal(t, ctx.BlockHeight(), sudoErrs[0].BlockHeight)
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes(), types.ErrorsFilter{}, nil)
+	require.NoError(t, err)
+	require.Len(t, sudoErrs, 0)
+
+	// The subscriptions expire at the end height
+	ctx = ctx.WithBlockHeight(endHeight)
+	_, err = cwerrors.EndBlocker(ctx, keeper, wasmKeeper)
+	require.NoError(t, err)
+	require.False(t, keeper.HasSubscription(ctx, contractAddr))
+	require.False(t, keeper.HasSubscription(ctx, contractAddr2))
+}
+
+func TestEndBlockerErrorCallbackFailed(t *testing.T) {
+	keeper, ctx := testutils.CWErrorsKeeper(t)
+	wasmKeeper := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(wasmKeeper)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := testutils.AccAddress()
+	wasmKeeper.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.String(),
+	)
+	params := types.DefaultParams()
+	err := keeper.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	// Subscribe the contract so that its errors are sent as error callbacks
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc, contractAddr, params.SubscriptionFee, nil, 0, types.SubscriptionRenewal{})
+	require.NoError(t, err)
+
+	// Make the error callback fail so that it is stored in state
+	wasmKeeper.SetReturnSudoError(errors.New("callback failed"))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	err = keeper.SetError(ctx, types.SudoError{ContractAddress: contractAddr.String(), ModuleName: "test"})
+	require.NoError(t, err)
+	_, err = cwerrors.EndBlocker(ctx, keeper, wasmKeeper)
+	require.NoError(t, err)
+
+	// The failure is stored with the block it happened at
+	sudoErrs, _, err := keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes(), types.ErrorsFilter{}, nil)
+	require.NoError(t, err)
+	require.Len(t, sudoErrs, 1)
+	require.Equal(t, int32(types.ModuleErrors_ERR_CALLBACK_EXECUTION_FAILED), sudoErrs[0].ErrorCode)
+	require.Equal(t, ctx.BlockHeight(), sudoErrs[0].BlockHeight)
+	require.True(t, ctx.BlockTime().Equal(sudoErrs[0].BlockTime))
+	require.Equal(t, ctx.BlockHeight()+params.ErrorStoredTime, sudoErrs[0].DeletionHeight)
+}
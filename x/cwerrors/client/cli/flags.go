@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/archway-network/archway/x/cwerrors/types"
+)
+
+const (
+	flagModuleName    = "module-name"
+	flagErrorCodes    = "error-codes"
+	flagMinHeight     = "min-height"
+	flagMaxHeight     = "max-height"
+	flagFilter        = "filter"
+	flagGasLimit      = "callback-gas-limit"
+	flagAutoRenew     = "auto-renew"
+	flagRenewBySender = "renew-by-sender"
+	flagExpiryNotice  = "expiry-notice"
+)
+
+// getSubscriptionFiltersFlag parses the subscription filters flag values.
+// A filter is formatted as [module_name][:error_code,error_code...], e.g. "cwica", "callback:2,3" or ":2".
+func getSubscriptionFiltersFlag(cmd *cobra.Command) ([]types.SubscriptionFilter, error) {
+	values, err := cmd.Flags().GetStringArray(flagFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]types.SubscriptionFilter, 0, len(values))
+	for _, value := range values {
+		moduleName, codes, _ := strings.Cut(value, ":")
+		filter := types.SubscriptionFilter{ModuleName: moduleName}
+		if codes != "" {
+			for _, code := range strings.Split(codes, ",") {
+				errorCode, err := strconv.ParseInt(strings.TrimSpace(code), 10, 32)
+				if err != nil {
+					return nil, fmt.Errorf("parsing %s flag error code (%s): %w", flagFilter, code, err)
+				}
+				filter.ErrorCodes = append(filter.ErrorCodes, int32(errorCode))
+			}
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
@@ -20,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		getQueryErrorsCmd(),
 		getQueryIsSubscribedCmd(),
+		getQueryErrorStatsCmd(),
 		getQueryParamsCmd(),
 	)
 	return cmd
@@ -30,7 +31,7 @@ func getQueryErrorsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "errors [contract_address]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query errors for a contract address",
+		Short: "Query errors for a contract address with pagination and filters",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -38,8 +39,35 @@ func getQueryErrorsCmd() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			moduleName, err := cmd.Flags().GetString(flagModuleName)
+			if err != nil {
+				return err
+			}
+			errorCodes, err := cmd.Flags().GetInt32Slice(flagErrorCodes)
+			if err != nil {
+				return err
+			}
+			minHeight, err := cmd.Flags().GetInt64(flagMinHeight)
+			if err != nil {
+				return err
+			}
+			maxHeight, err := cmd.Flags().GetInt64(flagMaxHeight)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.Errors(cmd.Context(), &types.QueryErrorsRequest{
 				ContractAddress: args[0],
+				Pagination:      pageReq,
+				ModuleName:      moduleName,
+				ErrorCodes:      errorCodes,
+				MinHeight:       minHeight,
+				MaxHeight:       maxHeight,
 			})
 			if err != nil {
 				return err
@@ -48,7 +76,12 @@ func getQueryErrorsCmd() *cobra.Command {
 			return clientCtx.PrintProto(res)
 		},
 	}
+	cmd.Flags().String(flagModuleName, "", "Only return the errors thrown by the given module")
+	cmd.Flags().Int32Slice(flagErrorCodes, nil, "Only return the errors with one of the given module level error codes")
+	cmd.Flags().Int64(flagMinHeight, 0, "Only return the errors which happened at or after the given block height")
+	cmd.Flags().Int64(flagMaxHeight, 0, "Only return the errors which happened at or before the given block height")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "errors")
 	return cmd
 }
 
@@ -79,6 +112,33 @@ func getQueryIsSubscribedCmd() *cobra.Command {
 	return cmd
 }
 
+// getQueryErrorStatsCmd returns the command to query the number of stored and evicted errors of a contract address.
+func getQueryErrorStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "error-stats [contract_address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the number of errors stored in state and evicted from state for a contract address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ErrorStats(cmd.Context(), &types.QueryErrorStatsRequest{
+				ContractAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // getQueryParamsCmd returns the command to query module parameters.
 func getQueryParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -21,6 +23,8 @@ func GetTxCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		getTxSubscribeToErrorCmd(),
+		getTxAcknowledgeErrorsCmd(),
+		getTxCancelSubscriptionCmd(),
 	)
 
 	return cmd
@@ -46,10 +50,114 @@ func getTxSubscribeToErrorCmd() *cobra.Command {
 				return err
 			}
 
+			filters, err := getSubscriptionFiltersFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			callbackGasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return err
+			}
+
+			autoRenew, err := cmd.Flags().GetBool(flagAutoRenew)
+			if err != nil {
+				return err
+			}
+			renewBySender, err := cmd.Flags().GetBool(flagRenewBySender)
+			if err != nil {
+				return err
+			}
+			renewalPayer := ""
+			if renewBySender {
+				renewalPayer = senderAddr.String()
+			}
+
+			expiryNotice, err := cmd.Flags().GetBool(flagExpiryNotice)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgSubscribeToError{
+				Sender:           senderAddr.String(),
+				ContractAddress:  args[0],
+				Fee:              fees,
+				Filters:          filters,
+				CallbackGasLimit: callbackGasLimit,
+				AutoRenew:        autoRenew,
+				RenewalPayer:     renewalPayer,
+				ExpiryNotice:     expiryNotice,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringArray(flagFilter, nil, "Only receive the errors matching the filter as callbacks, formatted as [module_name][:error_code,...] (can be repeated)")
+	cmd.Flags().Uint64(flagGasLimit, 0, "Gas limit of the error callbacks, the fee scales with it (defaults to the error_callback_gas_limit param)")
+	cmd.Flags().Bool(flagAutoRenew, false, "Renew the subscription when it expires, by charging the contract")
+	cmd.Flags().Bool(flagRenewBySender, false, "Charge the sender instead of the contract for the auto renewals")
+	cmd.Flags().Bool(flagExpiryNotice, false, "Send a sudo notice to the contract before the subscription expires")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getTxAcknowledgeErrorsCmd returns the command to acknowledge and delete the errors of a contract address.
+func getTxAcknowledgeErrorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "acknowledge-errors [contract-address] [error-ids]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Acknowledge and delete the errors of a contract address, given as comma separated error ids",
+		Aliases: []string{"ack"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			var errorIDs []uint64
+			for _, arg := range strings.Split(args[1], ",") {
+				errorID, err := pkg.ParseUint64Arg("error-ids", strings.TrimSpace(arg))
+				if err != nil {
+					return err
+				}
+				errorIDs = append(errorIDs, errorID)
+			}
+
+			msg := types.MsgAcknowledgeErrors{
+				Sender:          senderAddr.String(),
+				ContractAddress: args[0],
+				ErrorIds:        errorIDs,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getTxCancelSubscriptionCmd returns the command to cancel the error callback subscription of a contract address.
+func getTxCancelSubscriptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-subscription [contract-address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Cancel the error callback subscription of a contract address and refund the unused subscription period",
+		Aliases: []string{"unsubscribe"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			msg := types.MsgCancelSubscription{
 				Sender:          senderAddr.String(),
 				ContractAddress: args[0],
-				Fee:             fees,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
@@ -35,13 +35,24 @@ func (qs *QueryServer) Errors(c context.Context, request *types.QueryErrorsReque
 		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %s", err.Error())
 	}
 
-	errors, err := qs.keeper.GetErrorsByContractAddress(sdk.UnwrapSDKContext(c), contractAddr)
+	filter := types.ErrorsFilter{
+		ModuleName: request.ModuleName,
+		ErrorCodes: request.ErrorCodes,
+		MinHeight:  request.MinHeight,
+		MaxHeight:  request.MaxHeight,
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	errors, pageResp, err := qs.keeper.GetErrorsByContractAddress(sdk.UnwrapSDKContext(c), contractAddr, filter, request.Pagination)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "could not fetch the errors: %s", err.Error())
 	}
 
 	return &types.QueryErrorsResponse{
-		Errors: errors,
+		Errors:     errors,
+		Pagination: pageResp,
 	}, nil
 }
 
@@ -56,10 +67,49 @@ func (qs *QueryServer) IsSubscribed(c context.Context, request *types.QueryIsSub
 		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %s", err.Error())
 	}
 
-	hasSub, validtill := qs.keeper.GetSubscription(sdk.UnwrapSDKContext(c), contractAddr)
+	ctx := sdk.UnwrapSDKContext(c)
+	hasSub, validtill := qs.keeper.GetSubscription(ctx, contractAddr)
+	filters, err := qs.keeper.GetSubscriptionFilters(ctx, contractAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "could not fetch the subscription filters: %s", err.Error())
+	}
+	callbackGasLimit, err := qs.keeper.GetSubscriptionGasLimit(ctx, contractAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "could not fetch the subscription callback gas limit: %s", err.Error())
+	}
+	renewal, err := qs.keeper.GetSubscriptionRenewal(ctx, contractAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "could not fetch the subscription renewal: %s", err.Error())
+	}
 	return &types.QueryIsSubscribedResponse{
 		Subscribed:            hasSub,
 		SubscriptionValidTill: validtill,
+		Filters:               filters,
+		CallbackGasLimit:      callbackGasLimit,
+		AutoRenew:             renewal.AutoRenew,
+		RenewalPayer:          renewal.RenewalPayer,
+		ExpiryNotice:          renewal.ExpiryNotice,
+	}, nil
+}
+
+// ErrorStats implements types.QueryServer.
+func (qs *QueryServer) ErrorStats(c context.Context, request *types.QueryErrorStatsRequest) (*types.QueryErrorStatsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %s", err.Error())
+	}
+
+	stored, dropped, err := qs.keeper.GetErrorStats(sdk.UnwrapSDKContext(c), contractAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "could not fetch the error stats: %s", err.Error())
+	}
+	return &types.QueryErrorStatsResponse{
+		StoredErrors:  stored,
+		DroppedErrors: dropped,
 	}, nil
 }
 
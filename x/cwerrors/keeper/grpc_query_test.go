@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
 	"github.com/archway-network/archway/pkg/testutils"
@@ -76,6 +77,54 @@ func (s *KeeperTestSuite) TestErrors() {
 	res, err = queryServer.Errors(ctx, &types.QueryErrorsRequest{ContractAddress: contractAddr2.String()})
 	s.Require().NoError(err)
 	s.Require().Len(res.Errors, 2)
+
+	// Set an error thrown by another module for contract1 in block 2
+	err = keeper.SetError(ctx, types.SudoError{
+		ContractAddress: contractAddr.String(),
+		ModuleName:      "other",
+		ErrorCode:       2,
+	})
+	s.Require().NoError(err)
+
+	// Paginate the errors of contract1
+	res, err = queryServer.Errors(ctx, &types.QueryErrorsRequest{
+		ContractAddress: contractAddr.String(),
+		Pagination:      &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Errors, 3)
+	s.Require().EqualValues(4, res.Pagination.Total)
+	res, err = queryServer.Errors(ctx, &types.QueryErrorsRequest{
+		ContractAddress: contractAddr.String(),
+		Pagination:      &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Errors, 1)
+	s.Require().Equal("other", res.Errors[0].ModuleName)
+
+	// Filter by module name
+	res, err = queryServer.Errors(ctx, &types.QueryErrorsRequest{ContractAddress: contractAddr.String(), ModuleName: "test"})
+	s.Require().NoError(err)
+	s.Require().Len(res.Errors, 3)
+
+	// Filter by error code
+	res, err = queryServer.Errors(ctx, &types.QueryErrorsRequest{ContractAddress: contractAddr.String(), ErrorCodes: []int32{2}})
+	s.Require().NoError(err)
+	s.Require().Len(res.Errors, 1)
+	s.Require().EqualValues(2, res.Errors[0].ErrorCode)
+
+	// Filter by height range
+	res, err = queryServer.Errors(ctx, &types.QueryErrorsRequest{ContractAddress: contractAddr.String(), MaxHeight: ctx.BlockHeight() - 1})
+	s.Require().NoError(err)
+	s.Require().Len(res.Errors, 2)
+	res, err = queryServer.Errors(ctx, &types.QueryErrorsRequest{ContractAddress: contractAddr.String(), MinHeight: ctx.BlockHeight(), ModuleName: "test"})
+	s.Require().NoError(err)
+	s.Require().Len(res.Errors, 1)
+	s.Require().Equal(ctx.BlockHeight(), res.Errors[0].BlockHeight)
+
+	// Invalid height range
+	_, err = queryServer.Errors(ctx, &types.QueryErrorsRequest{ContractAddress: contractAddr.String(), MinHeight: 10, MaxHeight: 5})
+	s.Require().Error(err)
 }
 
 func (s *KeeperTestSuite) TestIsSubscribed() {
@@ -104,12 +153,63 @@ func (s *KeeperTestSuite) TestIsSubscribed() {
 	s.Require().False(res.Subscribed)
 
 	// TEST CASE 4: subscription found
-	expectedEndHeight, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0))
+	expectedEndHeight, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), nil, 0, types.SubscriptionRenewal{})
 	s.Require().NoError(err)
 	res, err = queryServer.IsSubscribed(ctx, &types.QueryIsSubscribedRequest{ContractAddress: contractAddr.String()})
 	s.Require().NoError(err)
 	s.Require().True(res.Subscribed)
 	s.Require().Equal(expectedEndHeight, res.SubscriptionValidTill)
+	s.Require().Equal(types.DefaultErrorCallbackGasLimit, res.CallbackGasLimit)
+
+	// TEST CASE 5: subscription found with a purchased callback gas limit
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), nil, 300_000, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+	res, err = queryServer.IsSubscribed(ctx, &types.QueryIsSubscribedRequest{ContractAddress: contractAddr.String()})
+	s.Require().NoError(err)
+	s.Require().True(res.Subscribed)
+	s.Require().Equal(uint64(300_000), res.CallbackGasLimit)
+}
+
+func (s *KeeperTestSuite) TestErrorStats() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().Keepers.CWErrorsKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := s.chain.GetAccount(0)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+	params := types.DefaultParams()
+	params.MaxErrorsPerContract = 1
+	err := keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+	queryServer := cwerrorsKeeper.NewQueryServer(keeper)
+
+	// TEST CASE 1: empty request
+	_, err = queryServer.ErrorStats(ctx, nil)
+	s.Require().Error(err)
+
+	// TEST CASE 2: invalid contract address
+	_, err = queryServer.ErrorStats(ctx, &types.QueryErrorStatsRequest{ContractAddress: "👻"})
+	s.Require().Error(err)
+
+	// TEST CASE 3: contract without errors
+	res, err := queryServer.ErrorStats(ctx, &types.QueryErrorStatsRequest{ContractAddress: contractAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), res.StoredErrors)
+	s.Require().Equal(uint64(0), res.DroppedErrors)
+
+	// TEST CASE 4: contract with stored and evicted errors
+	for i := 0; i < 3; i++ {
+		err = keeper.SetError(ctx, types.SudoError{ContractAddress: contractAddr.String(), ModuleName: "test"})
+		s.Require().NoError(err)
+	}
+	res, err = queryServer.ErrorStats(ctx, &types.QueryErrorStatsRequest{ContractAddress: contractAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.StoredErrors)
+	s.Require().Equal(uint64(2), res.DroppedErrors)
 }
 
 func (s *KeeperTestSuite) TestParams() {
@@ -122,9 +222,14 @@ func (s *KeeperTestSuite) TestParams() {
 
 	// Set params
 	params := types.Params{
-		ErrorStoredTime:    100,
-		SubscriptionFee:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 2),
-		SubscriptionPeriod: 100,
+		ErrorStoredTime:                100,
+		SubscriptionFee:                sdk.NewInt64Coin(sdk.DefaultBondDenom, 2),
+		SubscriptionPeriod:             100,
+		SubscriptionFilterFee:          sdk.NewInt64Coin(sdk.DefaultBondDenom, 1),
+		ErrorCallbackGasLimit:          100_000,
+		MaxErrorCallbackGasLimit:       200_000,
+		SubscriptionExpiryNoticePeriod: 10,
+		MaxErrorsPerContract:           100,
 	}
 	err = keeper.SetParams(ctx, params)
 	s.Require().NoError(err)
@@ -35,6 +35,16 @@ type Keeper struct {
 	ContractSubscriptions collections.Map[[]byte, int64]
 	// SubscriptionEndBlock key: SubscriptionEndBlockKeyPrefix + BlockHeight + contractAddress | value: nil
 	SubscriptionEndBlock collections.Map[collections.Pair[int64, []byte], []byte]
+	// SubscriptionFilters key: SubscriptionFiltersKeyPrefix + contractAddress + filterIndex | value: SubscriptionFilter
+	SubscriptionFilters collections.Map[collections.Pair[[]byte, uint64], types.SubscriptionFilter]
+	// SubscriptionGasLimits key: SubscriptionGasLimitsKeyPrefix + contractAddress | value: callbackGasLimit
+	SubscriptionGasLimits collections.Map[[]byte, uint64]
+	// SubscriptionRenewals key: SubscriptionRenewalsKeyPrefix + contractAddress | value: SubscriptionRenewal
+	SubscriptionRenewals collections.Map[[]byte, types.SubscriptionRenewal]
+	// ContractErrorsCount key: ContractErrorsCountKeyPrefix + contractAddress | value: number of errors in state
+	ContractErrorsCount collections.Map[[]byte, uint64]
+	// DroppedErrorsCount key: DroppedErrorsCountKeyPrefix + contractAddress | value: number of evicted errors
+	DroppedErrorsCount collections.Map[[]byte, uint64]
 }
 
 // NewKeeper creates a new Keeper instance.
@@ -97,6 +107,41 @@ func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, tStoreKey storetyp
 			collections.PairKeyCodec(collections.Int64Key, collections.BytesKey),
 			collections.BytesValue,
 		),
+		SubscriptionFilters: collections.NewMap(
+			sb,
+			types.SubscriptionFiltersKeyPrefix,
+			"subscriptionFilters",
+			collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key),
+			collcompat.ProtoValue[types.SubscriptionFilter](cdc),
+		),
+		SubscriptionGasLimits: collections.NewMap(
+			sb,
+			types.SubscriptionGasLimitsKeyPrefix,
+			"subscriptionGasLimits",
+			collections.BytesKey,
+			collections.Uint64Value,
+		),
+		SubscriptionRenewals: collections.NewMap(
+			sb,
+			types.SubscriptionRenewalsKeyPrefix,
+			"subscriptionRenewals",
+			collections.BytesKey,
+			collcompat.ProtoValue[types.SubscriptionRenewal](cdc),
+		),
+		ContractErrorsCount: collections.NewMap(
+			sb,
+			types.ContractErrorsCountKeyPrefix,
+			"contractErrorsCount",
+			collections.BytesKey,
+			collections.Uint64Value,
+		),
+		DroppedErrorsCount: collections.NewMap(
+			sb,
+			types.DroppedErrorsCountKeyPrefix,
+			"droppedErrorsCount",
+			collections.BytesKey,
+			collections.Uint64Value,
+		),
 	}
 	schema, err := sb.Build()
 	if err != nil {
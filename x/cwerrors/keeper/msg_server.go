@@ -43,7 +43,15 @@ func (s *MsgServer) SubscribeToError(c context.Context, request *types.MsgSubscr
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	subscriptionEndHeight, err := s.keeper.SetSubscription(ctx, sender, contractAddr, request.Fee)
+	subscriptionEndHeight, err := s.keeper.SetSubscription(ctx, sender, contractAddr, request.Fee, request.Filters, request.CallbackGasLimit, types.SubscriptionRenewal{
+		AutoRenew:    request.AutoRenew,
+		RenewalPayer: request.RenewalPayer,
+		ExpiryNotice: request.ExpiryNotice,
+	})
+	if err != nil {
+		return nil, err
+	}
+	callbackGasLimit, err := s.keeper.GetSubscriptionGasLimit(ctx, contractAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -54,12 +62,77 @@ func (s *MsgServer) SubscribeToError(c context.Context, request *types.MsgSubscr
 		request.ContractAddress,
 		request.Fee,
 		subscriptionEndHeight,
+		request.Filters,
+		callbackGasLimit,
 	)
 	return &types.MsgSubscribeToErrorResponse{
 		SubscriptionValidTill: subscriptionEndHeight,
 	}, nil
 }
 
+// CancelSubscription implements types.MsgServer.
+func (s *MsgServer) CancelSubscription(c context.Context, request *types.MsgCancelSubscription) (*types.MsgCancelSubscriptionResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(request.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	refund, err := s.keeper.CancelSubscription(ctx, sender, contractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	types.EmitSubscriptionCancelledEvent(
+		ctx,
+		request.Sender,
+		request.ContractAddress,
+		refund,
+	)
+	return &types.MsgCancelSubscriptionResponse{
+		Refund: refund,
+	}, nil
+}
+
+// AcknowledgeErrors implements types.MsgServer.
+func (s *MsgServer) AcknowledgeErrors(c context.Context, request *types.MsgAcknowledgeErrors) (*types.MsgAcknowledgeErrorsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(request.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := s.keeper.AcknowledgeErrors(ctx, sender, contractAddr, request.ErrorIds); err != nil {
+		return nil, err
+	}
+
+	types.EmitErrorsAcknowledgedEvent(
+		ctx,
+		request.Sender,
+		request.ContractAddress,
+		request.ErrorIds,
+	)
+	return &types.MsgAcknowledgeErrorsResponse{}, nil
+}
+
 // UpdateParams implements types.MsgServer.
 func (s MsgServer) UpdateParams(c context.Context, request *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if request == nil {
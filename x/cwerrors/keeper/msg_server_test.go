@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"google.golang.org/grpc/codes"
@@ -126,6 +127,300 @@ func (s *KeeperTestSuite) TestSubscribeToError() {
 	}
 }
 
+func (s *KeeperTestSuite) TestAcknowledgeErrors() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().Keepers.CWErrorsKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	contractAddresses := e2eTesting.GenContractAddresses(3)
+	contractAddr := contractAddresses[0]
+	contractAddr2 := contractAddresses[1]
+	contractAddr3 := contractAddresses[2]
+	contractAdminAcc := s.chain.GetAccount(2)
+	contractNotAdminAcc := s.chain.GetAccount(3)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+	contractViewer.AddContractAdmin(
+		contractAddr2.String(),
+		contractAdminAcc.Address.String(),
+	)
+
+	// Store 3 errors for contract1 and 1 error for contract2
+	for _, contract := range []string{contractAddr.String(), contractAddr.String(), contractAddr.String(), contractAddr2.String()} {
+		err := keeper.SetError(ctx, types.SudoError{ContractAddress: contract, ModuleName: "test"})
+		s.Require().NoError(err)
+	}
+	contract1Errs, _, err := keeper.GetErrorsByContractAddress(ctx, contractAddr, types.ErrorsFilter{}, nil)
+	s.Require().NoError(err)
+	s.Require().Len(contract1Errs, 3)
+	contract2Errs, _, err := keeper.GetErrorsByContractAddress(ctx, contractAddr2, types.ErrorsFilter{}, nil)
+	s.Require().NoError(err)
+	s.Require().Len(contract2Errs, 1)
+
+	msgServer := cwerrorsKeeper.NewMsgServer(keeper)
+
+	testCases := []struct {
+		testCase    string
+		input       func() *types.MsgAcknowledgeErrors
+		expectError bool
+		errorType   error
+	}{
+		{
+			testCase: "FAIL: empty request",
+			input: func() *types.MsgAcknowledgeErrors {
+				return nil
+			},
+			expectError: true,
+			errorType:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			testCase: "FAIL: invalid sender address",
+			input: func() *types.MsgAcknowledgeErrors {
+				return &types.MsgAcknowledgeErrors{
+					Sender:          "👻",
+					ContractAddress: contractAddr.String(),
+					ErrorIds:        []uint64{contract1Errs[0].ErrorId},
+				}
+			},
+			expectError: true,
+			errorType:   errors.New("decoding bech32 failed: invalid bech32 string length 4"),
+		},
+		{
+			testCase: "FAIL: contract not found",
+			input: func() *types.MsgAcknowledgeErrors {
+				return &types.MsgAcknowledgeErrors{
+					Sender:          contractAdminAcc.Address.String(),
+					ContractAddress: contractAddr3.String(),
+					ErrorIds:        []uint64{contract1Errs[0].ErrorId},
+				}
+			},
+			expectError: true,
+			errorType:   types.ErrContractNotFound,
+		},
+		{
+			testCase: "FAIL: sender unauthorized",
+			input: func() *types.MsgAcknowledgeErrors {
+				return &types.MsgAcknowledgeErrors{
+					Sender:          contractNotAdminAcc.Address.String(),
+					ContractAddress: contractAddr.String(),
+					ErrorIds:        []uint64{contract1Errs[0].ErrorId},
+				}
+			},
+			expectError: true,
+			errorType:   types.ErrUnauthorized,
+		},
+		{
+			testCase: "FAIL: error of another contract",
+			input: func() *types.MsgAcknowledgeErrors {
+				return &types.MsgAcknowledgeErrors{
+					Sender:          contractAdminAcc.Address.String(),
+					ContractAddress: contractAddr.String(),
+					ErrorIds:        []uint64{contract1Errs[0].ErrorId, contract2Errs[0].ErrorId},
+				}
+			},
+			expectError: true,
+			errorType:   types.ErrErrorNotFound,
+		},
+		{
+			testCase: "OK: acknowledged by the contract admin",
+			input: func() *types.MsgAcknowledgeErrors {
+				return &types.MsgAcknowledgeErrors{
+					Sender:          contractAdminAcc.Address.String(),
+					ContractAddress: contractAddr.String(),
+					ErrorIds:        []uint64{contract1Errs[0].ErrorId, contract1Errs[2].ErrorId},
+				}
+			},
+			expectError: false,
+		},
+		{
+			testCase: "OK: acknowledged by the contract itself",
+			input: func() *types.MsgAcknowledgeErrors {
+				return &types.MsgAcknowledgeErrors{
+					Sender:          contractAddr2.String(),
+					ContractAddress: contractAddr2.String(),
+					ErrorIds:        []uint64{contract2Errs[0].ErrorId},
+				}
+			},
+			expectError: false,
+		},
+		{
+			testCase: "FAIL: error already acknowledged",
+			input: func() *types.MsgAcknowledgeErrors {
+				return &types.MsgAcknowledgeErrors{
+					Sender:          contractAdminAcc.Address.String(),
+					ContractAddress: contractAddr.String(),
+					ErrorIds:        []uint64{contract1Errs[0].ErrorId},
+				}
+			},
+			expectError: true,
+			errorType:   types.ErrErrorNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case: %s", tc.testCase), func() {
+			req := tc.input()
+			_, err := msgServer.AcknowledgeErrors(ctx, req)
+			if tc.expectError {
+				s.Require().Error(err)
+				s.Assert().ErrorContains(err, tc.errorType.Error())
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	// Check only the unacknowledged error of contract1 remains
+	remainingErrs, _, err := keeper.GetErrorsByContractAddress(ctx, contractAddr, types.ErrorsFilter{}, nil)
+	s.Require().NoError(err)
+	s.Require().Len(remainingErrs, 1)
+	s.Require().Equal(contract1Errs[1].ErrorId, remainingErrs[0].ErrorId)
+	remainingErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2, types.ErrorsFilter{}, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(remainingErrs)
+
+	// Check the deletion blocks of the acknowledged errors are removed
+	found, err := keeper.DeletionBlocks.Has(ctx, collections.Join(contract1Errs[0].DeletionHeight, contract1Errs[0].ErrorId))
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	// Check the remaining error is still pruned
+	err = keeper.PruneErrorsCurrentBlock(ctx.WithBlockHeight(contract1Errs[1].DeletionHeight))
+	s.Require().NoError(err)
+	remainingErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr, types.ErrorsFilter{}, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(remainingErrs)
+}
+
+func (s *KeeperTestSuite) TestCancelSubscription() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().Keepers.CWErrorsKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	contractAddresses := e2eTesting.GenContractAddresses(3)
+	contractAddr := contractAddresses[0]
+	contractAddr2 := contractAddresses[1]
+	contractAddr3 := contractAddresses[2]
+	contractAdminAcc := s.chain.GetAccount(2)
+	contractNotAdminAcc := s.chain.GetAccount(3)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+	contractViewer.AddContractAdmin(
+		contractAddr3.String(),
+		contractAdminAcc.Address.String(),
+	)
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.SubscriptionFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	err = keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, params.SubscriptionFee, nil, 0, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+
+	msgServer := cwerrorsKeeper.NewMsgServer(keeper)
+
+	testCases := []struct {
+		testCase       string
+		input          func() *types.MsgCancelSubscription
+		expectError    bool
+		errorType      error
+		expectedRefund sdk.Coin
+	}{
+		{
+			testCase: "FAIL: empty request",
+			input: func() *types.MsgCancelSubscription {
+				return nil
+			},
+			expectError: true,
+			errorType:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			testCase: "FAIL: invalid sender address",
+			input: func() *types.MsgCancelSubscription {
+				return &types.MsgCancelSubscription{
+					Sender:          "👻",
+					ContractAddress: contractAddr.String(),
+				}
+			},
+			expectError: true,
+			errorType:   errors.New("invalid bech32 string length 4"),
+		},
+		{
+			testCase: "FAIL: invalid contract address",
+			input: func() *types.MsgCancelSubscription {
+				return &types.MsgCancelSubscription{
+					Sender:          contractAdminAcc.Address.String(),
+					ContractAddress: "👻",
+				}
+			},
+			expectError: true,
+			errorType:   errors.New("invalid bech32 string length 4"),
+		},
+		{
+			testCase: "FAIL: contract not found",
+			input: func() *types.MsgCancelSubscription {
+				return &types.MsgCancelSubscription{
+					Sender:          contractAdminAcc.Address.String(),
+					ContractAddress: contractAddr2.String(),
+				}
+			},
+			expectError: true,
+			errorType:   types.ErrContractNotFound,
+		},
+		{
+			testCase: "FAIL: sender unauthorized",
+			input: func() *types.MsgCancelSubscription {
+				return &types.MsgCancelSubscription{
+					Sender:          contractNotAdminAcc.Address.String(),
+					ContractAddress: contractAddr.String(),
+				}
+			},
+			expectError: true,
+			errorType:   types.ErrUnauthorized,
+		},
+		{
+			testCase: "FAIL: subscription not found",
+			input: func() *types.MsgCancelSubscription {
+				return &types.MsgCancelSubscription{
+					Sender:          contractAdminAcc.Address.String(),
+					ContractAddress: contractAddr3.String(),
+				}
+			},
+			expectError: true,
+			errorType:   types.ErrSubscriptionNotFound,
+		},
+		{
+			testCase: "OK: valid request",
+			input: func() *types.MsgCancelSubscription {
+				return &types.MsgCancelSubscription{
+					Sender:          contractAdminAcc.Address.String(),
+					ContractAddress: contractAddr.String(),
+				}
+			},
+			expectError:    false,
+			expectedRefund: params.SubscriptionFee,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case: %s", tc.testCase), func() {
+			req := tc.input()
+			res, err := msgServer.CancelSubscription(ctx, req)
+			if tc.expectError {
+				s.Require().Error(err)
+				s.Assert().ErrorContains(err, tc.errorType.Error())
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedRefund, res.Refund)
+				s.Require().False(keeper.HasSubscription(ctx, contractAddr))
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestUpdateParams() {
 	// Setting up chain and contract in mock wasm keeper
 	ctx, keeper := s.chain.GetContext().WithBlockHeight(101), s.chain.GetApp().Keepers.CWErrorsKeeper
@@ -186,6 +481,11 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 						0,
 						sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
 						100,
+						sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+						150_000,
+						1_000_000,
+						10,
+						100,
 					),
 				}
 			},
@@ -1,23 +1,33 @@
 package keeper
 
 import (
+	"errors"
 	"strings"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/archway-network/archway/x/cwerrors/types"
 )
 
-// SetSubscription sets a subscription for a contract so the contract can receive error callbacks
-func (k Keeper) SetSubscription(ctx sdk.Context, sender, contractAddress sdk.AccAddress, fee sdk.Coin) (int64, error) {
+// SetSubscription sets a subscription for a contract so the contract can receive error callbacks.
+// If filters are given, only the errors matching one of them are delivered as callbacks. The filters replace the
+// filters of an existing subscription. A non-zero callbackGasLimit sets the gas limit of the error callbacks of the
+// contract, otherwise the ErrorCallbackGasLimit param is used.
+// The AutoRenew, RenewalPayer and ExpiryNotice settings of the given renewal replace the settings of an existing
+// subscription. The fee is held in escrow by the module, and released to the fee collector as the subscription is used
+func (k Keeper) SetSubscription(ctx sdk.Context, sender, contractAddress sdk.AccAddress, fee sdk.Coin, filters []types.SubscriptionFilter, callbackGasLimit uint64, renewal types.SubscriptionRenewal) (int64, error) {
 	if !k.wasmKeeper.HasContractInfo(ctx, contractAddress) {
 		return -1, types.ErrContractNotFound
 	}
 	if !isAuthorizedToSubscribe(ctx, k, contractAddress, sender.String()) {
 		return -1, types.ErrUnauthorized
 	}
+	if renewal.RenewalPayer != "" && (!renewal.AutoRenew || renewal.RenewalPayer != sender.String()) {
+		return -1, types.ErrInvalidRenewalPayer
+	}
 
 	existingSubFound, existingEndHeight := k.GetSubscription(ctx, contractAddress)
 	if existingSubFound {
@@ -30,21 +40,157 @@ func (k Keeper) SetSubscription(ctx sdk.Context, sender, contractAddress sdk.Acc
 		return -1, err
 	}
 
-	if !fee.IsEqual(params.SubscriptionFee) {
+	if err := params.ValidateCallbackGasLimit(callbackGasLimit); err != nil {
+		return -1, err
+	}
+	if !fee.IsEqual(params.SubscriptionFeeFor(len(filters), callbackGasLimit)) {
 		return -1, types.ErrIncorrectSubscriptionFee
 	}
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, authtypes.FeeCollectorName, sdk.NewCoins(fee))
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(fee))
 	if err != nil {
 		return -1, err
 	}
 
+	// Release the fees consumed so far by an existing subscription, and escrow the new fee along with the unused fees
+	existingRenewal, err := k.GetSubscriptionRenewal(ctx, contractAddress)
+	if err != nil {
+		return -1, err
+	}
+	unusedFees := existingRenewal.Refund(existingEndHeight, ctx.BlockHeight())
+	if err = k.sendToFeeCollector(ctx, existingRenewal.FeesEscrowed.Sub(unusedFees)); err != nil {
+		return -1, err
+	}
+	if unusedFees.Denom != fee.Denom { // the subscription fee denom was changed by governance, the unused fees are not refundable anymore
+		if err = k.sendToFeeCollector(ctx, unusedFees); err != nil {
+			return -1, err
+		}
+		unusedFees = sdk.NewCoin(fee.Denom, math.ZeroInt())
+	}
+	// The escrow has a single payer, so the unused fees paid by another address are refunded to it rather than carried over
+	if previousPayer := existingRenewal.PayerAddress(sender); !previousPayer.Equals(sender) {
+		if err = k.refundFromModule(ctx, previousPayer, unusedFees); err != nil {
+			return -1, err
+		}
+		unusedFees = sdk.NewCoin(fee.Denom, math.ZeroInt())
+	}
+	renewal.FeesEscrowed = unusedFees.Add(fee)
+	renewal.Payer = sender.String()
+	renewal.EscrowStartHeight = ctx.BlockHeight()
+	if err = k.SubscriptionRenewals.Set(ctx, contractAddress, renewal); err != nil {
+		return -1, err
+	}
+
 	subscriptionEndHeight := max(ctx.BlockHeight(), existingEndHeight) + params.SubscriptionPeriod
 	if err = k.SubscriptionEndBlock.Set(ctx, collections.Join(subscriptionEndHeight, contractAddress.Bytes()), nil); err != nil {
 		return -1, err
 	}
+	if err = k.setSubscriptionFilters(ctx, contractAddress, filters); err != nil {
+		return -1, err
+	}
+	if err = k.setSubscriptionGasLimit(ctx, contractAddress, callbackGasLimit); err != nil {
+		return -1, err
+	}
 	return subscriptionEndHeight, k.ContractSubscriptions.Set(ctx, contractAddress, subscriptionEndHeight)
 }
 
+// GetSubscriptionFilters returns the filters of the errors delivered to a subscribed contract.
+// All the errors are delivered if no filters are returned
+func (k Keeper) GetSubscriptionFilters(ctx sdk.Context, contractAddress sdk.AccAddress) ([]types.SubscriptionFilter, error) {
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](contractAddress.Bytes())
+	iter, err := k.SubscriptionFilters.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// setSubscriptionFilters replaces the filters of the contract subscription
+func (k Keeper) setSubscriptionFilters(ctx sdk.Context, contractAddress sdk.AccAddress, filters []types.SubscriptionFilter) error {
+	if err := k.SubscriptionFilters.Clear(ctx, collections.NewPrefixedPairRange[[]byte, uint64](contractAddress.Bytes())); err != nil {
+		return err
+	}
+	for i, filter := range filters {
+		if err := k.SubscriptionFilters.Set(ctx, collections.Join(contractAddress.Bytes(), uint64(i)), filter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CancelSubscription cancels the subscription of a contract. The escrowed fees of the unused subscription period are
+// refunded to the address which paid them, and the consumed fees are released to the fee collector
+func (k Keeper) CancelSubscription(ctx sdk.Context, sender, contractAddress sdk.AccAddress) (sdk.Coin, error) {
+	if !k.wasmKeeper.HasContractInfo(ctx, contractAddress) {
+		return sdk.Coin{}, types.ErrContractNotFound
+	}
+	if !isAuthorizedToSubscribe(ctx, k, contractAddress, sender.String()) {
+		return sdk.Coin{}, types.ErrUnauthorized
+	}
+	found, endHeight := k.GetSubscription(ctx, contractAddress)
+	if !found {
+		return sdk.Coin{}, types.ErrSubscriptionNotFound
+	}
+
+	renewal, err := k.GetSubscriptionRenewal(ctx, contractAddress)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	refund := renewal.Refund(endHeight, ctx.BlockHeight())
+	if err := k.sendToFeeCollector(ctx, renewal.FeesEscrowed.Sub(refund)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.refundFromModule(ctx, renewal.PayerAddress(sender), refund); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.SubscriptionEndBlock.Remove(ctx, collections.Join(endHeight, contractAddress.Bytes())); err != nil {
+		return sdk.Coin{}, err
+	}
+	return refund, k.removeSubscription(ctx, contractAddress)
+}
+
+// GetSubscriptionRenewal returns the renewal settings and the escrowed fees of the subscription of a contract.
+// The subscriptions created before the renewals were introduced have no renewal stored, in which case a renewal
+// without escrowed fees is returned
+func (k Keeper) GetSubscriptionRenewal(ctx sdk.Context, contractAddress sdk.AccAddress) (types.SubscriptionRenewal, error) {
+	renewal, err := k.SubscriptionRenewals.Get(ctx, contractAddress)
+	if err == nil {
+		return renewal, nil
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return types.SubscriptionRenewal{}, err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.SubscriptionRenewal{}, err
+	}
+	return types.SubscriptionRenewal{
+		FeesEscrowed: sdk.NewCoin(params.SubscriptionFee.Denom, math.ZeroInt()),
+	}, nil
+}
+
+// GetSubscriptionGasLimit returns the gas limit of the error callbacks of a subscribed contract.
+// The ErrorCallbackGasLimit param is returned if the subscription did not purchase a higher limit
+func (k Keeper) GetSubscriptionGasLimit(ctx sdk.Context, contractAddress sdk.AccAddress) (uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	gasLimit, err := k.SubscriptionGasLimits.Get(ctx, contractAddress)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	return max(gasLimit, params.ErrorCallbackGasLimit), nil
+}
+
+// setSubscriptionGasLimit replaces the error callback gas limit of the contract subscription. Zero removes it
+func (k Keeper) setSubscriptionGasLimit(ctx sdk.Context, contractAddress sdk.AccAddress, callbackGasLimit uint64) error {
+	if callbackGasLimit == 0 {
+		return k.SubscriptionGasLimits.Remove(ctx, contractAddress)
+	}
+	return k.SubscriptionGasLimits.Set(ctx, contractAddress, callbackGasLimit)
+}
+
 // HasSubscription checks if a contract has a subscription
 func (k Keeper) HasSubscription(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
 	has, err := k.ContractSubscriptions.Has(ctx, contractAddress)
@@ -63,20 +209,154 @@ func (k Keeper) GetSubscription(ctx sdk.Context, contractAddress sdk.AccAddress)
 	return true, has
 }
 
-// PruneSubscriptionsEndBlock prunes subscriptions that have ended at the given block. This is executed at the module endblocker
+// PruneSubscriptionsEndBlock prunes subscriptions that have ended at the given block. This is executed at the module endblocker.
+// The subscriptions with auto renew are renewed instead, unless the renewal payer cannot pay the subscription fee
 func (k Keeper) PruneSubscriptionsEndBlock(ctx sdk.Context) (err error) {
 	height := ctx.BlockHeight()
 	rng := collections.NewPrefixedPairRange[int64, []byte](height)
-	err = k.SubscriptionEndBlock.Walk(ctx, rng, func(key collections.Pair[int64, []byte], _ []byte) (bool, error) {
-		if err := k.ContractSubscriptions.Remove(ctx, key.K2()); err != nil {
-			return true, err
+	iter, err := k.SubscriptionEndBlock.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	if err = k.SubscriptionEndBlock.Clear(ctx, rng); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		contractAddress := sdk.AccAddress(key.K2())
+		renewal, err := k.GetSubscriptionRenewal(ctx, contractAddress)
+		if err != nil {
+			return err
+		}
+		// The subscription period is over, so all the escrowed fees are consumed
+		if err := k.sendToFeeCollector(ctx, renewal.FeesEscrowed); err != nil {
+			return err
+		}
+		if renewal.AutoRenew {
+			renewed, err := k.renewSubscription(ctx, contractAddress, renewal)
+			if err != nil {
+				return err
+			}
+			if renewed {
+				continue
+			}
 		}
+		if err := k.removeSubscription(ctx, contractAddress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renewSubscription renews the expiring subscription of a contract for another subscription period by charging the
+// renewal payer. The subscription filters and callback gas limit are kept. Returns false if the renewal payer cannot
+// pay the subscription fee
+func (k Keeper) renewSubscription(ctx sdk.Context, contractAddress sdk.AccAddress, renewal types.SubscriptionRenewal) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+	filters, err := k.GetSubscriptionFilters(ctx, contractAddress)
+	if err != nil {
+		return false, err
+	}
+	callbackGasLimit, err := k.SubscriptionGasLimits.Get(ctx, contractAddress)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, err
+	}
+
+	payer := contractAddress
+	if renewal.RenewalPayer != "" {
+		payer = sdk.MustAccAddressFromBech32(renewal.RenewalPayer)
+	}
+	if err := params.ValidateCallbackGasLimit(callbackGasLimit); err != nil {
+		types.EmitSubscriptionRenewalFailedEvent(ctx, contractAddress.String(), payer.String(), err.Error())
+		return false, nil
+	}
+	fee := params.SubscriptionFeeFor(len(filters), callbackGasLimit)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+		types.EmitSubscriptionRenewalFailedEvent(ctx, contractAddress.String(), payer.String(), err.Error())
 		return false, nil
-	})
+	}
+
+	renewal.FeesEscrowed = fee
+	renewal.EscrowStartHeight = ctx.BlockHeight()
+	renewal.Payer = payer.String()
+	if err := k.SubscriptionRenewals.Set(ctx, contractAddress, renewal); err != nil {
+		return false, err
+	}
+	subscriptionEndHeight := ctx.BlockHeight() + params.SubscriptionPeriod
+	if err := k.SubscriptionEndBlock.Set(ctx, collections.Join(subscriptionEndHeight, contractAddress.Bytes()), nil); err != nil {
+		return false, err
+	}
+	if err := k.ContractSubscriptions.Set(ctx, contractAddress, subscriptionEndHeight); err != nil {
+		return false, err
+	}
+	types.EmitSubscriptionRenewedEvent(ctx, contractAddress.String(), payer.String(), fee, subscriptionEndHeight)
+	return true, nil
+}
+
+// GetExpiringSubscriptions returns the addresses of the contracts whose subscription expires in
+// SubscriptionExpiryNoticePeriod blocks along with the height they expire at. Nothing is returned if the notice is disabled
+func (k Keeper) GetExpiringSubscriptions(ctx sdk.Context) ([]sdk.AccAddress, int64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if params.SubscriptionExpiryNoticePeriod == 0 {
+		return nil, 0, nil
+	}
+	endHeight := ctx.BlockHeight() + params.SubscriptionExpiryNoticePeriod
+	iter, err := k.SubscriptionEndBlock.Iterate(ctx, collections.NewPrefixedPairRange[int64, []byte](endHeight))
 	if err != nil {
+		return nil, 0, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, 0, err
+	}
+	contractAddresses := make([]sdk.AccAddress, 0, len(keys))
+	for _, key := range keys {
+		contractAddresses = append(contractAddresses, key.K2())
+	}
+	return contractAddresses, endHeight, nil
+}
+
+// removeSubscription removes the subscription of a contract along with its filters, callback gas limit and renewal
+func (k Keeper) removeSubscription(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	if err := k.ContractSubscriptions.Remove(ctx, contractAddress); err != nil {
+		return err
+	}
+	if err := k.setSubscriptionFilters(ctx, contractAddress, nil); err != nil {
+		return err
+	}
+	if err := k.setSubscriptionGasLimit(ctx, contractAddress, 0); err != nil {
 		return err
 	}
-	return k.SubscriptionEndBlock.Clear(ctx, rng)
+	return k.SubscriptionRenewals.Remove(ctx, contractAddress)
+}
+
+// sendToFeeCollector sends the escrowed subscription fees from the x/cwerrors module account to the fee collector
+func (k Keeper) sendToFeeCollector(ctx sdk.Context, amount sdk.Coin) error {
+	if amount.IsNil() || amount.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(amount))
+}
+
+// refundFromModule sends the escrowed subscription fees from the x/cwerrors module account to the recipient
+func (k Keeper) refundFromModule(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coin) error {
+	if amount.IsNil() || amount.IsZero() {
+		return nil
+	}
+	if k.bankKeeper.BlockedAddr(recipient) { // blocked accounts cant receive funds. so in that case we send to fee collector
+		return k.sendToFeeCollector(ctx, amount)
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(amount))
 }
 
 // isAuthorizedToSubscribe checks if the sender is authorized to subscribe to the contract
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
@@ -25,11 +26,11 @@ func (s *KeeperTestSuite) TestSetSubscription() {
 	fees := sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
 
 	// TEST CASE 1: Contract does not exist
-	_, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr2, fees)
+	_, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr2, fees, nil, 0, types.SubscriptionRenewal{})
 	s.Require().ErrorIs(err, types.ErrContractNotFound)
 
 	// TEST CASE 2: Sender unauthorized to set subscription
-	_, err = keeper.SetSubscription(ctx, contractNotAdminAcc.Address, contractAddr, fees)
+	_, err = keeper.SetSubscription(ctx, contractNotAdminAcc.Address, contractAddr, fees, nil, 0, types.SubscriptionRenewal{})
 	s.Require().ErrorIs(err, types.ErrUnauthorized)
 
 	// TEST CASE 3: Subscription fee is less than the minimum subscription fee
@@ -41,32 +42,298 @@ func (s *KeeperTestSuite) TestSetSubscription() {
 		SubscriptionPeriod: params.SubscriptionPeriod,
 	})
 	s.Require().NoError(err)
-	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees)
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees, nil, 0, types.SubscriptionRenewal{})
 	s.Require().ErrorIs(err, types.ErrIncorrectSubscriptionFee)
 	err = keeper.SetParams(ctx, types.DefaultParams())
 	s.Require().NoError(err)
 
 	// TEST CASE 4: Successful subscription
-	subscriptionEndHeight, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees)
+	subscriptionEndHeight, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees, nil, 0, types.SubscriptionRenewal{})
 	s.Require().NoError(err)
 	expectedEndDate := ctx.BlockHeight() + types.DefaultParams().SubscriptionPeriod
 	s.Require().Equal(subscriptionEndHeight, expectedEndDate)
 
 	// TEST CASE 5: Subscription already exists - subscription end height gets updated to new height
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
-	subscriptionEndHeight, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees)
+	subscriptionEndHeight, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees, nil, 0, types.SubscriptionRenewal{})
 	s.Require().NoError(err)
 	expectedEndDate = expectedEndDate + params.SubscriptionPeriod // existing subscription gets extended
 	s.Require().Equal(subscriptionEndHeight, expectedEndDate)
 
 	// TEST CASE 6: Subscription being updated by the contract itself (instead of admin)
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
-	subscriptionEndHeight, err = keeper.SetSubscription(ctx, contractAddr, contractAddr, fees)
+	subscriptionEndHeight, err = keeper.SetSubscription(ctx, contractAddr, contractAddr, fees, nil, 0, types.SubscriptionRenewal{})
 	s.Require().NoError(err)
 	expectedEndDate = expectedEndDate + params.SubscriptionPeriod // existing subscription gets extended
 	s.Require().Equal(subscriptionEndHeight, expectedEndDate)
 }
 
+func (s *KeeperTestSuite) TestSetSubscriptionWithFilters() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().Keepers.CWErrorsKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := s.chain.GetAccount(0)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+	params := types.DefaultParams()
+	params.SubscriptionFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	params.SubscriptionFilterFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	err := keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	filters := []types.SubscriptionFilter{
+		{ModuleName: "cwica"},
+		{ModuleName: "callback", ErrorCodes: []int32{1, 2}},
+	}
+
+	// TEST CASE 1: Subscription fee does not cover the filters
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), filters, 0, types.SubscriptionRenewal{})
+	s.Require().ErrorIs(err, types.ErrIncorrectSubscriptionFee)
+
+	// TEST CASE 2: Successful subscription with filters
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 120), filters, 0, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+	storedFilters, err := keeper.GetSubscriptionFilters(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(filters, storedFilters)
+
+	// TEST CASE 3: Extending the subscription replaces the filters
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 110), filters[1:], 0, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+	storedFilters, err = keeper.GetSubscriptionFilters(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(filters[1:], storedFilters)
+
+	// TEST CASE 4: Extending the subscription without filters delivers all the errors
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), nil, 0, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+	storedFilters, err = keeper.GetSubscriptionFilters(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Empty(storedFilters)
+
+	// TEST CASE 5: Filters are pruned along with the subscription
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 120), filters, 0, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+	_, endHeight := keeper.GetSubscription(ctx, contractAddr)
+	err = keeper.PruneSubscriptionsEndBlock(ctx.WithBlockHeight(endHeight))
+	s.Require().NoError(err)
+	storedFilters, err = keeper.GetSubscriptionFilters(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Empty(storedFilters)
+}
+
+func (s *KeeperTestSuite) TestSetSubscriptionWithGasLimit() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().Keepers.CWErrorsKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := s.chain.GetAccount(0)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+	params := types.DefaultParams()
+	params.SubscriptionFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	params.ErrorCallbackGasLimit = 100_000
+	params.MaxErrorCallbackGasLimit = 500_000
+	err := keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	// TEST CASE 1: Callback gas limit above the max
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 600), nil, 600_000, types.SubscriptionRenewal{})
+	s.Require().ErrorIs(err, types.ErrInvalidCallbackGasLimit)
+
+	// TEST CASE 2: Callback gas limit below the default
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), nil, 50_000, types.SubscriptionRenewal{})
+	s.Require().ErrorIs(err, types.ErrInvalidCallbackGasLimit)
+
+	// TEST CASE 3: Subscription fee does not scale with the callback gas limit
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), nil, 300_000, types.SubscriptionRenewal{})
+	s.Require().ErrorIs(err, types.ErrIncorrectSubscriptionFee)
+
+	// TEST CASE 4: Successful subscription with a higher callback gas limit
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300), nil, 300_000, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+	gasLimit, err := keeper.GetSubscriptionGasLimit(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(300_000), gasLimit)
+
+	// TEST CASE 5: Extending the subscription without a callback gas limit resets it to the default
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), nil, 0, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+	gasLimit, err = keeper.GetSubscriptionGasLimit(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(100_000), gasLimit)
+
+	// TEST CASE 6: Callback gas limit is pruned along with the subscription
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 500), nil, 500_000, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+	_, endHeight := keeper.GetSubscription(ctx, contractAddr)
+	err = keeper.PruneSubscriptionsEndBlock(ctx.WithBlockHeight(endHeight))
+	s.Require().NoError(err)
+	has, err := keeper.SubscriptionGasLimits.Has(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestCancelSubscriptionRefund() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().Keepers.CWErrorsKeeper
+	bankKeeper := s.chain.GetApp().Keepers.BankKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	contractAddresses := e2eTesting.GenContractAddresses(2)
+	contractAddr := contractAddresses[0]
+	contractAddr2 := contractAddresses[1]
+	contractAdminAcc := s.chain.GetAccount(0)
+	contractNotAdminAcc := s.chain.GetAccount(1)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+	contractViewer.AddContractAdmin(
+		contractAddr2.String(),
+		contractAdminAcc.Address.String(),
+	)
+	params := types.DefaultParams()
+	params.SubscriptionFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	params.SubscriptionPeriod = 100
+	params.SubscriptionExpiryNoticePeriod = 10
+	err := keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	// TEST CASE 1: Subscription does not exist
+	_, err = keeper.CancelSubscription(ctx, contractAdminAcc.Address, contractAddr)
+	s.Require().ErrorIs(err, types.ErrSubscriptionNotFound)
+
+	// TEST CASE 2: Sender is not authorized
+	endHeight, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, params.SubscriptionFee, nil, 0, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+	_, err = keeper.CancelSubscription(ctx, contractNotAdminAcc.Address, contractAddr)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// TEST CASE 3: The unused subscription period is refunded
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 25)
+	balanceBefore := bankKeeper.GetBalance(ctx, contractAdminAcc.Address, sdk.DefaultBondDenom)
+	refund, err := keeper.CancelSubscription(ctx, contractAdminAcc.Address, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 750), refund)
+	balanceAfter := bankKeeper.GetBalance(ctx, contractAdminAcc.Address, sdk.DefaultBondDenom)
+	s.Require().Equal(balanceBefore.Add(refund), balanceAfter)
+	s.Require().False(keeper.HasSubscription(ctx, contractAddr))
+	has, err := keeper.SubscriptionEndBlock.Has(ctx, collections.Join(endHeight, contractAddr.Bytes()))
+	s.Require().NoError(err)
+	s.Require().False(has)
+	has, err = keeper.SubscriptionRenewals.Has(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// TEST CASE 4: Extending a subscription escrows the unused fees along with the new fee
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr2, params.SubscriptionFee, nil, 0, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 50)
+	endHeight, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr2, params.SubscriptionFee, nil, 0, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+	renewal, err := keeper.GetSubscriptionRenewal(ctx, contractAddr2)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1500), renewal.FeesEscrowed)
+	s.Require().Equal(ctx.BlockHeight(), renewal.EscrowStartHeight)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 75)
+	refund, err = keeper.CancelSubscription(ctx, contractAdminAcc.Address, contractAddr2)
+	s.Require().NoError(err)
+	s.Require().Equal(endHeight-ctx.BlockHeight(), int64(75))
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 750), refund)
+
+	// TEST CASE 5: The refund goes to the payer of the escrowed fees, not to the sender cancelling the subscription
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, params.SubscriptionFee, nil, 0, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+	renewal, err = keeper.GetSubscriptionRenewal(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(contractAdminAcc.Address.String(), renewal.Payer)
+	balanceBefore = bankKeeper.GetBalance(ctx, contractAdminAcc.Address, sdk.DefaultBondDenom)
+	refund, err = keeper.CancelSubscription(ctx, contractAddr, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(params.SubscriptionFee, refund)
+	s.Require().Equal(balanceBefore.Add(refund), bankKeeper.GetBalance(ctx, contractAdminAcc.Address, sdk.DefaultBondDenom))
+	s.Require().True(bankKeeper.GetBalance(ctx, contractAddr, sdk.DefaultBondDenom).IsZero())
+}
+
+func (s *KeeperTestSuite) TestPruneSubscriptionsEndBlockAutoRenew() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().Keepers.CWErrorsKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	contractAddresses := e2eTesting.GenContractAddresses(3)
+	contractAddr := contractAddresses[0]
+	contractAddr2 := contractAddresses[1]
+	contractAddr3 := contractAddresses[2]
+	contractAdminAcc := s.chain.GetAccount(0)
+	for _, addr := range contractAddresses {
+		contractViewer.AddContractAdmin(
+			addr.String(),
+			contractAdminAcc.Address.String(),
+		)
+	}
+	params := types.DefaultParams()
+	params.SubscriptionFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	params.SubscriptionPeriod = 100
+	params.SubscriptionExpiryNoticePeriod = 10
+	err := keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+	filters := []types.SubscriptionFilter{{ModuleName: "callback"}}
+
+	// contractAddr renews by charging the sender
+	endHeight, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, params.SubscriptionFee, filters, 0, types.SubscriptionRenewal{
+		AutoRenew:    true,
+		RenewalPayer: contractAdminAcc.Address.String(),
+	})
+	s.Require().NoError(err)
+	// contractAddr2 renews by charging the contract, which has no funds
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr2, params.SubscriptionFee, nil, 0, types.SubscriptionRenewal{
+		AutoRenew: true,
+	})
+	s.Require().NoError(err)
+	// contractAddr3 does not renew
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr3, params.SubscriptionFee, nil, 0, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+
+	// TEST CASE 1: Renewal payer must be the sender
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr3, params.SubscriptionFee, nil, 0, types.SubscriptionRenewal{
+		AutoRenew:    true,
+		RenewalPayer: contractAddr.String(),
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidRenewalPayer)
+
+	// TEST CASE 2: The expiring subscriptions are listed before expiry
+	expiring, expiringHeight, err := keeper.GetExpiringSubscriptions(ctx.WithBlockHeight(endHeight - params.SubscriptionExpiryNoticePeriod))
+	s.Require().NoError(err)
+	s.Require().Equal(endHeight, expiringHeight)
+	s.Require().ElementsMatch([]sdk.AccAddress{contractAddr, contractAddr2, contractAddr3}, expiring)
+
+	// TEST CASE 3: Only the subscription with a funded renewal payer is renewed
+	ctx = ctx.WithBlockHeight(endHeight)
+	err = keeper.PruneSubscriptionsEndBlock(ctx)
+	s.Require().NoError(err)
+	found, newEndHeight := keeper.GetSubscription(ctx, contractAddr)
+	s.Require().True(found)
+	s.Require().Equal(endHeight+params.SubscriptionPeriod, newEndHeight)
+	storedFilters, err := keeper.GetSubscriptionFilters(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(filters, storedFilters)
+	renewal, err := keeper.GetSubscriptionRenewal(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), renewal.FeesEscrowed)
+	s.Require().Equal(endHeight, renewal.EscrowStartHeight)
+	s.Require().True(renewal.AutoRenew)
+	s.Require().False(keeper.HasSubscription(ctx, contractAddr2))
+	s.Require().False(keeper.HasSubscription(ctx, contractAddr3))
+}
+
 func (s *KeeperTestSuite) TestHasSubscription() {
 	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().Keepers.CWErrorsKeeper
 	contractViewer := testutils.NewMockContractViewer()
@@ -85,7 +352,7 @@ func (s *KeeperTestSuite) TestHasSubscription() {
 	s.Require().False(hasSub)
 
 	// TEST CASE 2: Subscription exists
-	_, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees)
+	_, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees, nil, 0, types.SubscriptionRenewal{})
 	s.Require().NoError(err)
 	hasSub = keeper.HasSubscription(ctx, contractAddr)
 	s.Require().True(hasSub)
@@ -109,7 +376,7 @@ func (s *KeeperTestSuite) TestGetSubscription() {
 	s.Require().Equal(endHeight, int64(0))
 
 	// TEST CASE 2: Subscription exists
-	endHeight, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees)
+	endHeight, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees, nil, 0, types.SubscriptionRenewal{})
 	s.Require().NoError(err)
 	found, foundEndHeight := keeper.GetSubscription(ctx, contractAddr)
 	s.Require().True(found)
@@ -145,7 +412,7 @@ func (s *KeeperTestSuite) TestPruneSubscriptionsEndBlock() {
 	s.Require().NoError(err)
 
 	// TEST CASE 2: Set subscription. Go to expire time. Prune subscriptions
-	endHeight, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees)
+	endHeight, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees, nil, 0, types.SubscriptionRenewal{})
 	s.Require().NoError(err)
 	ctx = ctx.WithBlockHeight(endHeight)
 	err = keeper.PruneSubscriptionsEndBlock(ctx)
@@ -154,16 +421,16 @@ func (s *KeeperTestSuite) TestPruneSubscriptionsEndBlock() {
 	s.Require().False(hasSub)
 
 	// TEST CASE 3: Prune subscriptions when many contracts have subscriptions
-	endHeight, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees)
+	endHeight, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, fees, nil, 0, types.SubscriptionRenewal{})
 	s.Require().NoError(err)
-	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr2, fees)
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr2, fees, nil, 0, types.SubscriptionRenewal{})
 	s.Require().NoError(err)
-	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr3, fees)
+	_, err = keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr3, fees, nil, 0, types.SubscriptionRenewal{})
 	s.Require().NoError(err)
 
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 	// extend the subscription for contractAddr3
-	newEndHeight, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr3, fees)
+	newEndHeight, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr3, fees, nil, 0, types.SubscriptionRenewal{})
 	s.Require().NoError(err)
 
 	ctx = ctx.WithBlockHeight(endHeight)
@@ -1,15 +1,24 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/archway-network/archway/x/cwerrors/types"
 )
 
-// SetError stores a sudo error and queues it for deletion after a certain block height
+// SetError stores a sudo error and queues it for deletion after a certain block height.
+// The error is stamped with the current block height, block time and transaction hash
 func (k Keeper) SetError(ctx sdk.Context, sudoErr types.SudoError) error {
+	sudoErr = stampError(ctx, sudoErr)
+
 	// Ensure error is valid
 	if err := sudoErr.Validate(); err != nil {
 		return err
@@ -21,16 +30,35 @@ func (k Keeper) SetError(ctx sdk.Context, sudoErr types.SudoError) error {
 	}
 
 	if k.HasSubscription(ctx, contractAddr) {
-		// If contract has subscription, store the error in the transient store to be executed as error callback
-		return k.storeErrorCallback(ctx, sudoErr)
-	} else {
-		// for contracts which dont have an error subscription, store the error in state to be deleted after a set height
-		return k.StoreErrorInState(ctx, contractAddr, sudoErr)
+		filters, err := k.GetSubscriptionFilters(ctx, contractAddr)
+		if err != nil {
+			return err
+		}
+		if types.MatchesSubscriptionFilters(filters, sudoErr) {
+			// If contract has subscription matching the error, store the error in the transient store to be executed as error callback
+			return k.storeErrorCallback(ctx, sudoErr)
+		}
+	}
+	// for contracts which dont have an error subscription matching the error, store the error in state to be deleted after a set height
+	return k.StoreErrorInState(ctx, contractAddr, sudoErr)
+}
+
+// stampError sets the block height, block time and transaction hash the error happened at
+func stampError(ctx sdk.Context, sudoErr types.SudoError) types.SudoError {
+	sudoErr.BlockHeight = ctx.BlockHeight()
+	sudoErr.BlockTime = ctx.BlockTime()
+	sudoErr.TxHash = ""
+	if txBytes := ctx.TxBytes(); len(txBytes) != 0 {
+		sudoErr.TxHash = fmt.Sprintf("%X", tmhash.Sum(txBytes))
 	}
+	return sudoErr
 }
 
-// StoreErrorInState stores the error in the state and queues it for deletion after a certain block height
+// StoreErrorInState stores the error in the state and queues it for deletion after a certain block height.
+// The error is stamped with the current block, as it is also stored directly by the module when an error callback fails
 func (k Keeper) StoreErrorInState(ctx sdk.Context, contractAddr sdk.AccAddress, sudoErr types.SudoError) error {
+	sudoErr = stampError(ctx, sudoErr)
+
 	// just a unique identifier for the error
 	errorID, err := k.ErrorID.Next(ctx)
 	if err != nil {
@@ -53,6 +81,8 @@ func (k Keeper) StoreErrorInState(ctx sdk.Context, contractAddr sdk.AccAddress,
 	}
 
 	// Store the actual sudo error
+	sudoErr.ErrorId = errorID
+	sudoErr.DeletionHeight = deletionHeight
 	err = k.Errors.Set(ctx, errorID, sudoErr)
 	if err != nil {
 		return err
@@ -63,34 +93,110 @@ func (k Keeper) StoreErrorInState(ctx sdk.Context, contractAddr sdk.AccAddress,
 		sudoErr,
 		deletionHeight,
 	)
+
+	count, err := k.getErrorsCount(ctx, k.ContractErrorsCount, contractAddr)
+	if err != nil {
+		return err
+	}
+	if err = k.ContractErrorsCount.Set(ctx, contractAddr, count+1); err != nil {
+		return err
+	}
+	return k.evictErrors(ctx, contractAddr, params.MaxErrorsPerContract)
+}
+
+// evictErrors removes the oldest errors of the contract until no more than maxErrors are stored in state
+func (k Keeper) evictErrors(ctx sdk.Context, contractAddr sdk.AccAddress, maxErrors uint64) error {
+	if maxErrors == 0 {
+		return nil
+	}
+	count, err := k.getErrorsCount(ctx, k.ContractErrorsCount, contractAddr)
+	if err != nil {
+		return err
+	}
+	for ; count > maxErrors; count-- {
+		// The error ids are incremental, so the first error id of the contract is its oldest error
+		iter, err := k.ContractErrors.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, uint64](contractAddr.Bytes()))
+		if err != nil {
+			return err
+		}
+		key, err := iter.Key()
+		iter.Close()
+		if err != nil {
+			return err
+		}
+		sudoErr, err := k.Errors.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if err = k.removeError(ctx, contractAddr, key.K2(), sudoErr.DeletionHeight); err != nil {
+			return err
+		}
+
+		dropped, err := k.getErrorsCount(ctx, k.DroppedErrorsCount, contractAddr)
+		if err != nil {
+			return err
+		}
+		if err = k.DroppedErrorsCount.Set(ctx, contractAddr, dropped+1); err != nil {
+			return err
+		}
+		types.EmitErrorEvictedEvent(ctx, contractAddr.String(), key.K2(), dropped+1)
+	}
 	return nil
 }
 
+// GetErrorStats returns the number of errors of the contract stored in state and the number of its errors evicted
+// from state
+func (k Keeper) GetErrorStats(ctx sdk.Context, contractAddr sdk.AccAddress) (stored uint64, dropped uint64, err error) {
+	stored, err = k.getErrorsCount(ctx, k.ContractErrorsCount, contractAddr)
+	if err != nil {
+		return 0, 0, err
+	}
+	dropped, err = k.getErrorsCount(ctx, k.DroppedErrorsCount, contractAddr)
+	if err != nil {
+		return 0, 0, err
+	}
+	return stored, dropped, nil
+}
+
+// getErrorsCount returns the count of the contract in the given counter collection, zero if not set
+func (k Keeper) getErrorsCount(ctx sdk.Context, counter collections.Map[[]byte, uint64], contractAddr sdk.AccAddress) (uint64, error) {
+	count, err := counter.Get(ctx, contractAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (k Keeper) storeErrorCallback(ctx sdk.Context, sudoErr types.SudoError) error {
 	errorID, err := k.ErrorID.Next(ctx)
 	if err != nil {
 		return err
 	}
 
+	sudoErr.ErrorId = errorID
 	k.SetSudoErrorCallback(ctx, errorID, sudoErr)
 	return nil
 }
 
-// GetErrosByContractAddress returns all errors (in state) for a given contract address
-func (k Keeper) GetErrorsByContractAddress(ctx sdk.Context, contractAddress []byte) (sudoErrs []types.SudoError, err error) {
-	rng := collections.NewPrefixedPairRange[[]byte, uint64](contractAddress)
-	err = k.ContractErrors.Walk(ctx, rng, func(key collections.Pair[[]byte, uint64], _ []byte) (bool, error) {
-		sudoErr, err := k.Errors.Get(ctx, key.K2())
-		if err != nil {
-			return true, err
-		}
-		sudoErrs = append(sudoErrs, sudoErr)
-		return false, nil
-	})
-	if err != nil {
-		return nil, err
-	}
-	return sudoErrs, nil
+// GetErrorsByContractAddress returns the errors (in state) for a given contract address matching the filter.
+// Only the error ids of the contract are iterated, and the errors are paginated
+func (k Keeper) GetErrorsByContractAddress(ctx sdk.Context, contractAddress []byte, filter types.ErrorsFilter, pageReq *query.PageRequest) ([]types.SudoError, *query.PageResponse, error) {
+	return query.CollectionFilteredPaginate(
+		ctx,
+		k.ContractErrors,
+		pageReq,
+		func(key collections.Pair[[]byte, uint64], _ []byte) (bool, error) {
+			sudoErr, err := k.Errors.Get(ctx, key.K2())
+			if err != nil {
+				return false, err
+			}
+			return filter.Matches(sudoErr), nil
+		},
+		func(key collections.Pair[[]byte, uint64], _ []byte) (types.SudoError, error) {
+			return k.Errors.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, uint64](contractAddress),
+	)
 }
 
 // ExportErrors returns all errors in state. Used for genesis export
@@ -123,17 +229,116 @@ func (k Keeper) PruneErrorsCurrentBlock(ctx sdk.Context) (err error) {
 		if err != nil {
 			return err
 		}
-		// Removing the error data
-		if err := k.Errors.Remove(ctx, errorID); err != nil {
+		contractAddress := sdk.MustAccAddressFromBech32(sudoErr.ContractAddress)
+		if err := k.removeError(ctx, contractAddress, errorID, height); err != nil {
 			return err
 		}
-		// Removing the contract errors
-		contractAddress := sdk.MustAccAddressFromBech32(sudoErr.ContractAddress)
-		if err := k.ContractErrors.Remove(ctx, collections.Join(contractAddress.Bytes(), errorID)); err != nil {
+	}
+	return nil
+}
+
+// AcknowledgeErrors deletes the given errors of a contract from the state, so they are no longer returned once handled.
+// The sender must be the contract itself, its admin or its owner
+func (k Keeper) AcknowledgeErrors(ctx sdk.Context, sender, contractAddress sdk.AccAddress, errorIDs []uint64) error {
+	if !k.wasmKeeper.HasContractInfo(ctx, contractAddress) {
+		return types.ErrContractNotFound
+	}
+	if !isAuthorizedToSubscribe(ctx, k, contractAddress, sender.String()) {
+		return types.ErrUnauthorized
+	}
+
+	// Ensure all the errors belong to the contract before deleting any of them
+	sudoErrs := make([]types.SudoError, 0, len(errorIDs))
+	for _, errorID := range errorIDs {
+		found, err := k.ContractErrors.Has(ctx, collections.Join(contractAddress.Bytes(), errorID))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errorsmod.Wrapf(types.ErrErrorNotFound, "error id: %d", errorID)
+		}
+		sudoErr, err := k.Errors.Get(ctx, errorID)
+		if err != nil {
+			return err
+		}
+		sudoErrs = append(sudoErrs, sudoErr)
+	}
+
+	for i, sudoErr := range sudoErrs {
+		if err := k.removeError(ctx, contractAddress, errorIDs[i], sudoErr.DeletionHeight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetErrorsMetadata sets the error id and the deletion height of all the errors in state.
+// Used to migrate the errors stored before these fields were recorded
+func (k Keeper) SetErrorsMetadata(ctx sdk.Context) error {
+	iter, err := k.DeletionBlocks.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		sudoErr, err := k.Errors.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		sudoErr.ErrorId = key.K2()
+		sudoErr.DeletionHeight = key.K1()
+		if err := k.Errors.Set(ctx, key.K2(), sudoErr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeError removes the error data, its association with the contract and its deletion block
+func (k Keeper) removeError(ctx sdk.Context, contractAddress sdk.AccAddress, errorID uint64, deletionHeight int64) error {
+	if err := k.Errors.Remove(ctx, errorID); err != nil {
+		return err
+	}
+	if err := k.ContractErrors.Remove(ctx, collections.Join(contractAddress.Bytes(), errorID)); err != nil {
+		return err
+	}
+	if err := k.DeletionBlocks.Remove(ctx, collections.Join(deletionHeight, errorID)); err != nil {
+		return err
+	}
+
+	count, err := k.getErrorsCount(ctx, k.ContractErrorsCount, contractAddress)
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return k.ContractErrorsCount.Remove(ctx, contractAddress)
+	}
+	return k.ContractErrorsCount.Set(ctx, contractAddress, count-1)
+}
+
+// SetContractErrorsCount counts the errors in state of each contract.
+// Used to migrate the errors stored before the counts were recorded
+func (k Keeper) SetContractErrorsCount(ctx sdk.Context) error {
+	iter, err := k.ContractErrors.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	if err := k.ContractErrorsCount.Clear(ctx, nil); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		count, err := k.getErrorsCount(ctx, k.ContractErrorsCount, key.K1())
+		if err != nil {
 			return err
 		}
-		// Removing the deletion block
-		if err := k.DeletionBlocks.Remove(ctx, collections.Join(height, errorID)); err != nil {
+		if err := k.ContractErrorsCount.Set(ctx, key.K1(), count+1); err != nil {
 			return err
 		}
 	}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
@@ -86,10 +87,15 @@ func (s *KeeperTestSuite) TestSetError() {
 			} else {
 				s.Require().NoError(err)
 
-				getErrors, err := keeper.GetErrorsByContractAddress(ctx, sdk.MustAccAddressFromBech32(tc.sudoError.ContractAddress))
+				getErrors, _, err := keeper.GetErrorsByContractAddress(ctx, sdk.MustAccAddressFromBech32(tc.sudoError.ContractAddress), types.ErrorsFilter{}, nil)
 				s.Require().NoError(err)
 				s.Require().Len(getErrors, 1)
-				s.Require().Equal(tc.sudoError, getErrors[0])
+				expectedErr := tc.sudoError
+				expectedErr.BlockHeight = ctx.BlockHeight()
+				expectedErr.BlockTime = ctx.BlockTime()
+				expectedErr.ErrorId = getErrors[0].ErrorId
+				expectedErr.DeletionHeight = ctx.BlockHeight() + types.DefaultParams().ErrorStoredTime
+				s.Require().Equal(expectedErr, getErrors[0])
 			}
 		})
 	}
@@ -132,10 +138,10 @@ func (s *KeeperTestSuite) TestGetErrorsByContractAddress() {
 	s.Require().NoError(err)
 
 	// Check number of errors match
-	sudoErrs, err := keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes())
+	sudoErrs, _, err := keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes(), types.ErrorsFilter{}, nil)
 	s.Require().NoError(err)
 	s.Require().Len(sudoErrs, 2)
-	sudoErrs, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes())
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes(), types.ErrorsFilter{}, nil)
 	s.Require().NoError(err)
 	s.Require().Len(sudoErrs, 1)
 
@@ -151,12 +157,25 @@ func (s *KeeperTestSuite) TestGetErrorsByContractAddress() {
 	s.Require().NoError(err)
 
 	// Check number of errors match
-	sudoErrs, err = keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes())
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes(), types.ErrorsFilter{}, nil)
 	s.Require().NoError(err)
 	s.Require().Len(sudoErrs, 3)
-	sudoErrs, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes())
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes(), types.ErrorsFilter{}, nil)
 	s.Require().NoError(err)
 	s.Require().Len(sudoErrs, 2)
+	s.Require().Empty(sudoErrs[1].TxHash)
+
+	// Set an error in a transaction for contract2
+	txBytes := []byte("tx")
+	err = keeper.SetError(ctx.WithTxBytes(txBytes), contract2Err)
+	s.Require().NoError(err)
+
+	// Check the error is filtered by height and records the tx hash
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes(), types.ErrorsFilter{MinHeight: ctx.BlockHeight()}, nil)
+	s.Require().NoError(err)
+	s.Require().Len(sudoErrs, 2)
+	s.Require().Equal(ctx.BlockHeight(), sudoErrs[1].BlockHeight)
+	s.Require().Equal(fmt.Sprintf("%X", tmhash.Sum(txBytes)), sudoErrs[1].TxHash)
 }
 
 func (s *KeeperTestSuite) TestPruneErrorsByBlockHeight() {
@@ -208,10 +227,10 @@ func (s *KeeperTestSuite) TestPruneErrorsByBlockHeight() {
 	s.Require().NoError(err)
 
 	// Check number of errors match
-	getErrors, err := keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes())
+	getErrors, _, err := keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes(), types.ErrorsFilter{}, nil)
 	s.Require().NoError(err)
 	s.Require().Len(getErrors, 2)
-	getErrors, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes())
+	getErrors, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes(), types.ErrorsFilter{}, nil)
 	s.Require().NoError(err)
 	s.Require().Len(getErrors, 1)
 
@@ -221,10 +240,10 @@ func (s *KeeperTestSuite) TestPruneErrorsByBlockHeight() {
 	s.Require().NoError(err)
 
 	// Check number of errors match
-	getErrors, err = keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes())
+	getErrors, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes(), types.ErrorsFilter{}, nil)
 	s.Require().NoError(err)
 	s.Require().Len(getErrors, 1)
-	getErrors, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes())
+	getErrors, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes(), types.ErrorsFilter{}, nil)
 	s.Require().NoError(err)
 	s.Require().Len(getErrors, 0)
 
@@ -236,10 +255,10 @@ func (s *KeeperTestSuite) TestPruneErrorsByBlockHeight() {
 	s.Require().NoError(err)
 
 	// Check number of errors match
-	getErrors, err = keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes())
+	getErrors, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr.Bytes(), types.ErrorsFilter{}, nil)
 	s.Require().NoError(err)
 	s.Require().Len(getErrors, 0)
-	getErrors, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes())
+	getErrors, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr2.Bytes(), types.ErrorsFilter{}, nil)
 	s.Require().NoError(err)
 	s.Require().Len(getErrors, 1)
 }
@@ -270,3 +289,199 @@ func (s *KeeperTestSuite) TestSudoErrorCallback() {
 	getErrs = keeper.GetAllSudoErrorCallbacks(s.chain.GetContext())
 	s.Require().Len(getErrs, 0)
 }
+
+func (s *KeeperTestSuite) TestSetErrorWithSubscriptionFilters() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().Keepers.CWErrorsKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := s.chain.GetAccount(0)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+
+	// Subscribe to the cwica errors and to the callback errors with code 2
+	filters := []types.SubscriptionFilter{
+		{ModuleName: "cwica"},
+		{ModuleName: "callback", ErrorCodes: []int32{2}},
+	}
+	_, err := keeper.SetSubscription(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), filters, 0, types.SubscriptionRenewal{})
+	s.Require().NoError(err)
+
+	// Set errors matching the filters
+	for _, sudoErr := range []types.SudoError{
+		{ContractAddress: contractAddr.String(), ModuleName: "cwica", ErrorCode: 1},
+		{ContractAddress: contractAddr.String(), ModuleName: "callback", ErrorCode: 2},
+	} {
+		s.Require().NoError(keeper.SetError(ctx, sudoErr))
+	}
+
+	// Set errors not matching the filters
+	for _, sudoErr := range []types.SudoError{
+		{ContractAddress: contractAddr.String(), ModuleName: "callback", ErrorCode: 1},
+		{ContractAddress: contractAddr.String(), ModuleName: "test", ErrorCode: 2},
+	} {
+		s.Require().NoError(keeper.SetError(ctx, sudoErr))
+	}
+
+	// Check the matching errors are queued as callbacks
+	callbackErrs := keeper.GetAllSudoErrorCallbacks(ctx)
+	s.Require().Len(callbackErrs, 2)
+	s.Require().Equal("cwica", callbackErrs[0].ModuleName)
+	s.Require().Equal("callback", callbackErrs[1].ModuleName)
+
+	// Check the other errors are stored in state
+	stateErrs, _, err := keeper.GetErrorsByContractAddress(ctx, contractAddr, types.ErrorsFilter{}, nil)
+	s.Require().NoError(err)
+	s.Require().Len(stateErrs, 2)
+	s.Require().Equal("callback", stateErrs[0].ModuleName)
+	s.Require().EqualValues(1, stateErrs[0].ErrorCode)
+	s.Require().Equal("test", stateErrs[1].ModuleName)
+}
+
+func (s *KeeperTestSuite) TestSetErrorsMetadata() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().Keepers.CWErrorsKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractAdminAcc := s.chain.GetAccount(0)
+	contractViewer.AddContractAdmin(
+		contractAddr.String(),
+		contractAdminAcc.Address.String(),
+	)
+
+	// Store an error and clear its metadata as if it was stored before the metadata was recorded
+	err := keeper.SetError(ctx, types.SudoError{ContractAddress: contractAddr.String(), ModuleName: "test"})
+	s.Require().NoError(err)
+	sudoErrs, _, err := keeper.GetErrorsByContractAddress(ctx, contractAddr, types.ErrorsFilter{}, nil)
+	s.Require().NoError(err)
+	s.Require().Len(sudoErrs, 1)
+	expectedErr := sudoErrs[0]
+	legacyErr := expectedErr
+	legacyErr.ErrorId = 0
+	legacyErr.DeletionHeight = 0
+	err = keeper.Errors.Set(ctx, expectedErr.ErrorId, legacyErr)
+	s.Require().NoError(err)
+
+	// Check the metadata is restored
+	err = keeper.SetErrorsMetadata(ctx)
+	s.Require().NoError(err)
+	sudoErrs, _, err = keeper.GetErrorsByContractAddress(ctx, contractAddr, types.ErrorsFilter{}, nil)
+	s.Require().NoError(err)
+	s.Require().Len(sudoErrs, 1)
+	s.Require().Equal(expectedErr, sudoErrs[0])
+}
+
+func (s *KeeperTestSuite) TestStoreErrorInStateEviction() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().Keepers.CWErrorsKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	contractAddresses := e2eTesting.GenContractAddresses(2)
+	contractAddr := contractAddresses[0]
+	contractAddr2 := contractAddresses[1]
+	contractAdminAcc := s.chain.GetAccount(0)
+	for _, addr := range contractAddresses {
+		contractViewer.AddContractAdmin(
+			addr.String(),
+			contractAdminAcc.Address.String(),
+		)
+	}
+	params := types.DefaultParams()
+	params.MaxErrorsPerContract = 3
+	err := keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	// TEST CASE 1: Errors up to the limit are all stored
+	for i := 0; i < 3; i++ {
+		err = keeper.SetError(ctx, types.SudoError{ContractAddress: contractAddr.String(), ModuleName: "test", ErrorCode: int32(i)})
+		s.Require().NoError(err)
+	}
+	err = keeper.SetError(ctx, types.SudoError{ContractAddress: contractAddr2.String(), ModuleName: "test"})
+	s.Require().NoError(err)
+	stored, dropped, err := keeper.GetErrorStats(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), stored)
+	s.Require().Equal(uint64(0), dropped)
+
+	// TEST CASE 2: The oldest errors are evicted once the limit is exceeded
+	for i := 3; i < 5; i++ {
+		err = keeper.SetError(ctx, types.SudoError{ContractAddress: contractAddr.String(), ModuleName: "test", ErrorCode: int32(i)})
+		s.Require().NoError(err)
+	}
+	sudoErrs, _, err := keeper.GetErrorsByContractAddress(ctx, contractAddr, types.ErrorsFilter{}, nil)
+	s.Require().NoError(err)
+	s.Require().Len(sudoErrs, 3)
+	for i, sudoErr := range sudoErrs {
+		s.Require().Equal(int32(i+2), sudoErr.ErrorCode)
+	}
+	stored, dropped, err = keeper.GetErrorStats(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), stored)
+	s.Require().Equal(uint64(2), dropped)
+
+	// TEST CASE 3: The errors of other contracts are not evicted
+	stored, dropped, err = keeper.GetErrorStats(ctx, contractAddr2)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), stored)
+	s.Require().Equal(uint64(0), dropped)
+
+	// TEST CASE 4: Removing an error decrements the stored count, but not the dropped count
+	err = keeper.AcknowledgeErrors(ctx, contractAdminAcc.Address, contractAddr, []uint64{sudoErrs[0].ErrorId})
+	s.Require().NoError(err)
+	stored, dropped, err = keeper.GetErrorStats(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), stored)
+	s.Require().Equal(uint64(2), dropped)
+
+	// TEST CASE 5: Pruning all the errors clears the stored count
+	err = keeper.PruneErrorsCurrentBlock(ctx.WithBlockHeight(ctx.BlockHeight() + params.ErrorStoredTime))
+	s.Require().NoError(err)
+	stored, dropped, err = keeper.GetErrorStats(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), stored)
+	s.Require().Equal(uint64(2), dropped)
+	has, err := keeper.ContractErrorsCount.Has(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestSetContractErrorsCount() {
+	// Setting up chain and contract in mock wasm keeper
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().Keepers.CWErrorsKeeper
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetWasmKeeper(contractViewer)
+	contractAddresses := e2eTesting.GenContractAddresses(2)
+	contractAddr := contractAddresses[0]
+	contractAddr2 := contractAddresses[1]
+	contractAdminAcc := s.chain.GetAccount(0)
+	for _, addr := range contractAddresses {
+		contractViewer.AddContractAdmin(
+			addr.String(),
+			contractAdminAcc.Address.String(),
+		)
+	}
+
+	// Store errors and clear the counts as if they were stored before the counts were recorded
+	for i := 0; i < 3; i++ {
+		err := keeper.SetError(ctx, types.SudoError{ContractAddress: contractAddr.String(), ModuleName: "test"})
+		s.Require().NoError(err)
+	}
+	err := keeper.SetError(ctx, types.SudoError{ContractAddress: contractAddr2.String(), ModuleName: "test"})
+	s.Require().NoError(err)
+	err = keeper.ContractErrorsCount.Clear(ctx, nil)
+	s.Require().NoError(err)
+
+	// Check the counts are restored
+	err = keeper.SetContractErrorsCount(ctx)
+	s.Require().NoError(err)
+	stored, _, err := keeper.GetErrorStats(ctx, contractAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), stored)
+	stored, _, err = keeper.GetErrorStats(ctx, contractAddr2)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), stored)
+}
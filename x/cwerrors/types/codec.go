@@ -14,6 +14,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSubscribeToError{}, "cwerrors/MsgSubscribeToError", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "cwerrors/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgAcknowledgeErrors{}, "cwerrors/MsgAcknowledgeErrors", nil)
+	cdc.RegisterConcrete(&MsgCancelSubscription{}, "cwerrors/MsgCancelSubscription", nil)
 }
 
 // RegisterInterfaces registers interfaces types with the interface registry.
@@ -21,6 +23,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubscribeToError{},
 		&MsgUpdateParams{},
+		&MsgAcknowledgeErrors{},
+		&MsgCancelSubscription{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,16 +36,21 @@ const (
 	ModuleErrors_ERR_UNKNOWN ModuleErrors = 0
 	// ERR_CALLBACK_EXECUTION_FAILED is the error code for when the error callback fails
 	ModuleErrors_ERR_CALLBACK_EXECUTION_FAILED ModuleErrors = 1
+	// ERR_EXPIRY_NOTICE_FAILED is the error code for when the subscription
+	// expiry notice fails
+	ModuleErrors_ERR_EXPIRY_NOTICE_FAILED ModuleErrors = 2
 )
 
 var ModuleErrors_name = map[int32]string{
 	0: "ERR_UNKNOWN",
 	1: "ERR_CALLBACK_EXECUTION_FAILED",
+	2: "ERR_EXPIRY_NOTICE_FAILED",
 }
 
 var ModuleErrors_value = map[string]int32{
 	"ERR_UNKNOWN":                   0,
 	"ERR_CALLBACK_EXECUTION_FAILED": 1,
+	"ERR_EXPIRY_NOTICE_FAILED":      2,
 }
 
 func (x ModuleErrors) String() string {
@@ -64,6 +74,20 @@ type SudoError struct {
 	InputPayload string `protobuf:"bytes,4,opt,name=input_payload,json=inputPayload,proto3" json:"input_payload,omitempty"`
 	// error_message is the error message
 	ErrorMessage string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// block_height is the height of the block in which the error happened
+	BlockHeight int64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the time of the block in which the error happened
+	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// tx_hash is the hash of the transaction in which the error happened.
+	// It is empty if the error did not happen in a transaction (e.g. in the end
+	// blocker)
+	TxHash string `protobuf:"bytes,8,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// error_id is the unique identifier of the error. It is used to acknowledge
+	// the error
+	ErrorId uint64 `protobuf:"varint,9,opt,name=error_id,json=errorId,proto3" json:"error_id,omitempty"`
+	// deletion_height is the block height at which the error is pruned from the
+	// state. It is zero if the error is not stored in state
+	DeletionHeight int64 `protobuf:"varint,10,opt,name=deletion_height,json=deletionHeight,proto3" json:"deletion_height,omitempty"`
 }
 
 func (m *SudoError) Reset()         { *m = SudoError{} }
@@ -134,9 +158,203 @@ func (m *SudoError) GetErrorMessage() string {
 	return ""
 }
 
+func (m *SudoError) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SudoError) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *SudoError) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *SudoError) GetErrorId() uint64 {
+	if m != nil {
+		return m.ErrorId
+	}
+	return 0
+}
+
+func (m *SudoError) GetDeletionHeight() int64 {
+	if m != nil {
+		return m.DeletionHeight
+	}
+	return 0
+}
+
+// SubscriptionFilter defines which errors are delivered to a subscribed
+// contract as sudo error callbacks. An error matches the filter if it is
+// thrown by the given module and has one of the given error codes. An empty
+// field matches any value
+type SubscriptionFilter struct {
+	// module_name is the name of the module throwing the error
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// error_codes are the module level error codes
+	ErrorCodes []int32 `protobuf:"varint,2,rep,packed,name=error_codes,json=errorCodes,proto3" json:"error_codes,omitempty"`
+}
+
+func (m *SubscriptionFilter) Reset()         { *m = SubscriptionFilter{} }
+func (m *SubscriptionFilter) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionFilter) ProtoMessage()    {}
+func (*SubscriptionFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5547f0c109cd175, []int{1}
+}
+func (m *SubscriptionFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriptionFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscriptionFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionFilter.Merge(m, src)
+}
+func (m *SubscriptionFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriptionFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionFilter proto.InternalMessageInfo
+
+func (m *SubscriptionFilter) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *SubscriptionFilter) GetErrorCodes() []int32 {
+	if m != nil {
+		return m.ErrorCodes
+	}
+	return nil
+}
+
+// SubscriptionRenewal defines the renewal settings and the escrowed fees of a
+// contract subscription
+type SubscriptionRenewal struct {
+	// fees_escrowed is the part of the subscription fees held by the module
+	// which has not been consumed yet. It is refunded pro-rata when the
+	// subscription is cancelled
+	FeesEscrowed types.Coin `protobuf:"bytes,1,opt,name=fees_escrowed,json=feesEscrowed,proto3" json:"fees_escrowed"`
+	// escrow_start_height is the block height from which the escrowed fees are
+	// consumed
+	EscrowStartHeight int64 `protobuf:"varint,2,opt,name=escrow_start_height,json=escrowStartHeight,proto3" json:"escrow_start_height,omitempty"`
+	// auto_renew defines if the subscription is renewed when it expires
+	AutoRenew bool `protobuf:"varint,3,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// renewal_payer is the address charged the subscription fee on renewal. The
+	// contract itself is charged if empty
+	RenewalPayer string `protobuf:"bytes,4,opt,name=renewal_payer,json=renewalPayer,proto3" json:"renewal_payer,omitempty"`
+	// expiry_notice defines if the contract receives a sudo notice before the
+	// subscription expires
+	ExpiryNotice bool `protobuf:"varint,5,opt,name=expiry_notice,json=expiryNotice,proto3" json:"expiry_notice,omitempty"`
+	// payer is the address which paid the escrowed fees. The unused fees are
+	// refunded to it when the subscription is cancelled. The sender cancelling
+	// the subscription is refunded if empty
+	Payer string `protobuf:"bytes,6,opt,name=payer,proto3" json:"payer,omitempty"`
+}
+
+func (m *SubscriptionRenewal) Reset()         { *m = SubscriptionRenewal{} }
+func (m *SubscriptionRenewal) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionRenewal) ProtoMessage()    {}
+func (*SubscriptionRenewal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5547f0c109cd175, []int{2}
+}
+func (m *SubscriptionRenewal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriptionRenewal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionRenewal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscriptionRenewal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionRenewal.Merge(m, src)
+}
+func (m *SubscriptionRenewal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriptionRenewal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionRenewal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionRenewal proto.InternalMessageInfo
+
+func (m *SubscriptionRenewal) GetFeesEscrowed() types.Coin {
+	if m != nil {
+		return m.FeesEscrowed
+	}
+	return types.Coin{}
+}
+
+func (m *SubscriptionRenewal) GetEscrowStartHeight() int64 {
+	if m != nil {
+		return m.EscrowStartHeight
+	}
+	return 0
+}
+
+func (m *SubscriptionRenewal) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
+}
+
+func (m *SubscriptionRenewal) GetRenewalPayer() string {
+	if m != nil {
+		return m.RenewalPayer
+	}
+	return ""
+}
+
+func (m *SubscriptionRenewal) GetExpiryNotice() bool {
+	if m != nil {
+		return m.ExpiryNotice
+	}
+	return false
+}
+
+func (m *SubscriptionRenewal) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("archway.cwerrors.v1.ModuleErrors", ModuleErrors_name, ModuleErrors_value)
 	proto.RegisterType((*SudoError)(nil), "archway.cwerrors.v1.SudoError")
+	proto.RegisterType((*SubscriptionFilter)(nil), "archway.cwerrors.v1.SubscriptionFilter")
+	proto.RegisterType((*SubscriptionRenewal)(nil), "archway.cwerrors.v1.SubscriptionRenewal")
 }
 
 func init() {
@@ -144,29 +362,50 @@ func init() {
 }
 
 var fileDescriptor_d5547f0c109cd175 = []byte{
-	// 337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0xd1, 0x41, 0x4f, 0xf2, 0x30,
-	0x18, 0x07, 0xf0, 0xf5, 0x7d, 0xc5, 0x84, 0x82, 0x81, 0x4c, 0x0f, 0x8b, 0x09, 0x13, 0xf1, 0x82,
-	0x26, 0xb2, 0x10, 0x3e, 0xc1, 0x98, 0x33, 0x21, 0xc0, 0x30, 0x53, 0xa2, 0xf1, 0xb2, 0x94, 0xb5,
-	0x19, 0x44, 0xb6, 0x2e, 0x6d, 0x07, 0xf2, 0x2d, 0xfc, 0x48, 0x1e, 0x3d, 0x72, 0xf4, 0x68, 0xe0,
-	0x8b, 0x98, 0xb5, 0x80, 0xb7, 0xa7, 0xbf, 0xfe, 0xf3, 0xb4, 0x7d, 0x0a, 0x1b, 0x88, 0x85, 0xd3,
-	0x25, 0x5a, 0x59, 0xe1, 0x92, 0x30, 0x46, 0x19, 0xb7, 0x16, 0xed, 0x43, 0xdd, 0x4a, 0x19, 0x15,
-	0x54, 0x3f, 0xdd, 0x65, 0x5a, 0x07, 0x5f, 0xb4, 0xcf, 0xcf, 0x22, 0x1a, 0x51, 0xb9, 0x6f, 0xe5,
-	0x95, 0x8a, 0x36, 0x3e, 0x01, 0x2c, 0x3e, 0x66, 0x98, 0xba, 0x79, 0x4e, 0xbf, 0x80, 0xa5, 0x98,
-	0xe2, 0x6c, 0x4e, 0x82, 0x04, 0xc5, 0xc4, 0x00, 0x75, 0xd0, 0x2c, 0xfa, 0x50, 0x91, 0x87, 0x62,
-	0xa2, 0xd7, 0x20, 0x94, 0x1d, 0x83, 0x90, 0x62, 0x62, 0xfc, 0xab, 0x83, 0x66, 0xc1, 0x2f, 0x4a,
-	0x71, 0x28, 0x26, 0xfa, 0x35, 0xac, 0x86, 0x34, 0x11, 0x0c, 0x85, 0x22, 0x40, 0x18, 0x33, 0xc2,
-	0xb9, 0xf1, 0x5f, 0x36, 0xa9, 0xec, 0xdd, 0x56, 0xac, 0x5f, 0xc1, 0x93, 0x59, 0x92, 0x66, 0x22,
-	0x48, 0xd1, 0x6a, 0x4e, 0x11, 0x36, 0x8e, 0x64, 0xae, 0x2c, 0xf1, 0x41, 0x59, 0x1e, 0x52, 0xc7,
-	0xc5, 0x84, 0x73, 0x14, 0x11, 0xa3, 0xa0, 0x42, 0x12, 0x87, 0xca, 0x6e, 0xba, 0xb0, 0x3c, 0x94,
-	0x37, 0x94, 0x6f, 0xe0, 0x7a, 0x05, 0x96, 0x5c, 0xdf, 0x0f, 0xc6, 0x5e, 0xdf, 0x1b, 0x3d, 0x7b,
-	0x55, 0x4d, 0xbf, 0x84, 0xb5, 0x1c, 0x1c, 0x7b, 0x30, 0xe8, 0xda, 0x4e, 0x3f, 0x70, 0x5f, 0x5c,
-	0x67, 0xfc, 0xd4, 0x1b, 0x79, 0xc1, 0xbd, 0xdd, 0x1b, 0xb8, 0x77, 0x55, 0xd0, 0x1d, 0x7e, 0x6d,
-	0x4c, 0xb0, 0xde, 0x98, 0xe0, 0x67, 0x63, 0x82, 0x8f, 0xad, 0xa9, 0xad, 0xb7, 0xa6, 0xf6, 0xbd,
-	0x35, 0xb5, 0xd7, 0x4e, 0x34, 0x13, 0xd3, 0x6c, 0xd2, 0x0a, 0x69, 0x6c, 0xed, 0xc6, 0x7a, 0x9b,
-	0x10, 0xb1, 0xa4, 0xec, 0x6d, 0xbf, 0xb6, 0xde, 0xff, 0x3e, 0x43, 0xac, 0x52, 0xc2, 0x27, 0xc7,
-	0x72, 0xb8, 0x9d, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x72, 0x74, 0xfa, 0x89, 0xad, 0x01, 0x00,
-	0x00,
+	// 682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x53, 0xda, 0x4e,
+	0x18, 0xc6, 0x09, 0x08, 0xc2, 0x0b, 0xfe, 0xf5, 0xbf, 0x3a, 0xd3, 0xe8, 0x54, 0x40, 0x7a, 0x28,
+	0xed, 0x4c, 0x93, 0x41, 0x3f, 0x01, 0x60, 0x1c, 0x19, 0x15, 0x9d, 0xa8, 0xad, 0xed, 0x25, 0x5d,
+	0x92, 0x15, 0x32, 0x92, 0x2c, 0xb3, 0xbb, 0x08, 0x7c, 0x80, 0x5e, 0x7a, 0xf2, 0x63, 0x79, 0xf4,
+	0xd8, 0x53, 0xdb, 0xd1, 0x2f, 0xd2, 0xd9, 0xdd, 0x44, 0x7b, 0xec, 0x2d, 0xfb, 0x7b, 0x9f, 0x7d,
+	0xf3, 0xe4, 0x7d, 0x1f, 0x80, 0x06, 0x66, 0xfe, 0x68, 0x86, 0x17, 0xb6, 0x3f, 0x23, 0x8c, 0x51,
+	0xc6, 0xed, 0xdb, 0xd6, 0xf3, 0xb3, 0x35, 0x61, 0x54, 0x50, 0xb4, 0x9e, 0x68, 0xac, 0x67, 0x7e,
+	0xdb, 0xda, 0xda, 0x18, 0xd2, 0x21, 0x55, 0x75, 0x5b, 0x3e, 0x69, 0xe9, 0x56, 0x6d, 0x48, 0xe9,
+	0x70, 0x4c, 0x6c, 0x75, 0x1a, 0x4c, 0xaf, 0x6d, 0x11, 0x46, 0x84, 0x0b, 0x1c, 0x4d, 0x12, 0x41,
+	0xd5, 0xa7, 0x3c, 0xa2, 0xdc, 0x1e, 0x60, 0x4e, 0xec, 0xdb, 0xd6, 0x80, 0x08, 0xdc, 0xb2, 0x7d,
+	0x1a, 0xc6, 0xba, 0xde, 0xf8, 0x96, 0x83, 0xd2, 0xf9, 0x34, 0xa0, 0x8e, 0x7c, 0x11, 0xaa, 0x41,
+	0x39, 0xa2, 0xc1, 0x74, 0x4c, 0xbc, 0x18, 0x47, 0xc4, 0x34, 0xea, 0x46, 0xb3, 0xe4, 0x82, 0x46,
+	0x7d, 0x1c, 0x11, 0xb4, 0x0d, 0xa0, 0x2c, 0x79, 0x3e, 0x0d, 0x88, 0x99, 0xad, 0x1b, 0xcd, 0xbc,
+	0x5b, 0x52, 0xa4, 0x4b, 0x03, 0x82, 0xde, 0xc1, 0x9a, 0x4f, 0x63, 0xc1, 0xb0, 0x2f, 0x3c, 0x1c,
+	0x04, 0x8c, 0x70, 0x6e, 0xe6, 0x54, 0x93, 0xd5, 0x94, 0xb7, 0x35, 0x46, 0x6f, 0x60, 0x25, 0x8c,
+	0x27, 0x53, 0xe1, 0x4d, 0xf0, 0x62, 0x4c, 0x71, 0x60, 0x2e, 0x29, 0x5d, 0x45, 0xc1, 0x33, 0xcd,
+	0xa4, 0x48, 0xbf, 0x2e, 0x22, 0x9c, 0xe3, 0x21, 0x31, 0xf3, 0x5a, 0xa4, 0xe0, 0x89, 0x66, 0x68,
+	0x07, 0x2a, 0x83, 0x31, 0xf5, 0x6f, 0xbc, 0x11, 0x09, 0x87, 0x23, 0x61, 0x16, 0xea, 0x46, 0x33,
+	0xe7, 0x96, 0x15, 0x3b, 0x54, 0x08, 0x75, 0x01, 0xb4, 0x44, 0x8e, 0xc7, 0x5c, 0xae, 0x1b, 0xcd,
+	0xf2, 0xee, 0x96, 0xa5, 0x67, 0x67, 0xa5, 0xb3, 0xb3, 0x2e, 0xd2, 0xd9, 0x75, 0x8a, 0xf7, 0x3f,
+	0x6b, 0x99, 0xbb, 0x5f, 0x35, 0xc3, 0x2d, 0xa9, 0x7b, 0xb2, 0x82, 0x5e, 0xc1, 0xb2, 0x98, 0x7b,
+	0x23, 0xcc, 0x47, 0x66, 0x51, 0xd9, 0x28, 0x88, 0xf9, 0x21, 0xe6, 0x23, 0xb4, 0x09, 0x45, 0xed,
+	0x32, 0x0c, 0xcc, 0x52, 0xdd, 0x68, 0x2e, 0xb9, 0xcb, 0xea, 0xdc, 0x0b, 0xd0, 0x5b, 0x58, 0x0d,
+	0xc8, 0x98, 0x88, 0x90, 0xc6, 0xa9, 0x3d, 0x50, 0xf6, 0xfe, 0x4b, 0xb1, 0x76, 0xd8, 0xf8, 0x08,
+	0xe8, 0x7c, 0x3a, 0xe0, 0x3e, 0x0b, 0x27, 0x92, 0x1e, 0x84, 0x63, 0x41, 0xfe, 0x61, 0x1f, 0x35,
+	0x28, 0xbf, 0xec, 0x83, 0x9b, 0xd9, 0x7a, 0xae, 0x99, 0x77, 0xe1, 0x79, 0x21, 0xbc, 0xf1, 0x3d,
+	0x0b, 0xeb, 0x7f, 0x37, 0x76, 0x49, 0x4c, 0x66, 0x78, 0x8c, 0xf6, 0x61, 0xe5, 0x9a, 0x10, 0xee,
+	0x11, 0xee, 0x33, 0x3a, 0x23, 0x81, 0xea, 0x5d, 0xde, 0xdd, 0xb4, 0x74, 0x5e, 0x2c, 0x99, 0x17,
+	0x2b, 0xc9, 0x8b, 0xd5, 0xa5, 0x61, 0xdc, 0x59, 0x92, 0x33, 0x71, 0x2b, 0xf2, 0x96, 0x93, 0x5c,
+	0x42, 0x16, 0xac, 0xeb, 0x06, 0x1e, 0x17, 0x98, 0x89, 0xf4, 0x13, 0xb3, 0xea, 0x13, 0xff, 0xd7,
+	0xa5, 0x73, 0x59, 0x49, 0xf6, 0xb0, 0x0d, 0x80, 0xa7, 0x82, 0x7a, 0x4c, 0xba, 0x50, 0xc9, 0x28,
+	0xba, 0x25, 0x49, 0x94, 0x2d, 0xb9, 0x6e, 0xa6, 0xfd, 0xc9, 0x54, 0x10, 0x96, 0x66, 0x22, 0x81,
+	0x67, 0x92, 0x49, 0x11, 0x99, 0x4f, 0x42, 0xb6, 0xf0, 0x62, 0x2a, 0x42, 0x5f, 0x67, 0xa2, 0xe8,
+	0x56, 0x34, 0xec, 0x2b, 0x86, 0x36, 0x20, 0xaf, 0x3b, 0x14, 0x54, 0x07, 0x7d, 0x78, 0xff, 0x15,
+	0x2a, 0x27, 0x6a, 0x76, 0x2a, 0xed, 0x1c, 0xad, 0x42, 0xd9, 0x71, 0x5d, 0xef, 0xb2, 0x7f, 0xd4,
+	0x3f, 0xfd, 0xd4, 0x5f, 0xcb, 0xa0, 0x1d, 0xd8, 0x96, 0xa0, 0xdb, 0x3e, 0x3e, 0xee, 0xb4, 0xbb,
+	0x47, 0x9e, 0x73, 0xe5, 0x74, 0x2f, 0x2f, 0x7a, 0xa7, 0x7d, 0xef, 0xa0, 0xdd, 0x3b, 0x76, 0xf6,
+	0xd7, 0x0c, 0xf4, 0x1a, 0x4c, 0x29, 0x71, 0xae, 0xce, 0x7a, 0xee, 0x67, 0xaf, 0x7f, 0x7a, 0xd1,
+	0xeb, 0x3a, 0x69, 0x35, 0xdb, 0x39, 0xb9, 0x7f, 0xac, 0x1a, 0x0f, 0x8f, 0x55, 0xe3, 0xf7, 0x63,
+	0xd5, 0xb8, 0x7b, 0xaa, 0x66, 0x1e, 0x9e, 0xaa, 0x99, 0x1f, 0x4f, 0xd5, 0xcc, 0x97, 0xbd, 0x61,
+	0x28, 0x46, 0xd3, 0x81, 0xe5, 0xd3, 0xc8, 0x4e, 0x7e, 0xdf, 0x1f, 0x62, 0x22, 0x66, 0x94, 0xdd,
+	0xa4, 0x67, 0x7b, 0xfe, 0xf2, 0xaf, 0x20, 0x16, 0x13, 0xc2, 0x07, 0x05, 0x95, 0xcd, 0xbd, 0x3f,
+	0x03, 0x00, 0xb1, 0xf0, 0xc8, 0x31, 0x36, 0x04, 0x00, 0x00,
 }
 
 func (m *SudoError) Marshal() (dAtA []byte, err error) {
@@ -189,6 +428,36 @@ func (m *SudoError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeletionHeight != 0 {
+		i = encodeVarintCwerrors(dAtA, i, uint64(m.DeletionHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ErrorId != 0 {
+		i = encodeVarintCwerrors(dAtA, i, uint64(m.ErrorId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintCwerrors(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCwerrors(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.BlockHeight != 0 {
+		i = encodeVarintCwerrors(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.ErrorMessage) > 0 {
 		i -= len(m.ErrorMessage)
 		copy(dAtA[i:], m.ErrorMessage)
@@ -225,6 +494,127 @@ func (m *SudoError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SubscriptionFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorCodes) > 0 {
+		dAtA3 := make([]byte, len(m.ErrorCodes)*10)
+		var j2 int
+		for _, num1 := range m.ErrorCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintCwerrors(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintCwerrors(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscriptionRenewal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionRenewal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionRenewal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintCwerrors(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpiryNotice {
+		i--
+		if m.ExpiryNotice {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RenewalPayer) > 0 {
+		i -= len(m.RenewalPayer)
+		copy(dAtA[i:], m.RenewalPayer)
+		i = encodeVarintCwerrors(dAtA, i, uint64(len(m.RenewalPayer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EscrowStartHeight != 0 {
+		i = encodeVarintCwerrors(dAtA, i, uint64(m.EscrowStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.FeesEscrowed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCwerrors(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCwerrors(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCwerrors(v)
 	base := offset
@@ -261,6 +651,69 @@ func (m *SudoError) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCwerrors(uint64(l))
 	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovCwerrors(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovCwerrors(uint64(l))
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovCwerrors(uint64(l))
+	}
+	if m.ErrorId != 0 {
+		n += 1 + sovCwerrors(uint64(m.ErrorId))
+	}
+	if m.DeletionHeight != 0 {
+		n += 1 + sovCwerrors(uint64(m.DeletionHeight))
+	}
+	return n
+}
+
+func (m *SubscriptionFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovCwerrors(uint64(l))
+	}
+	if len(m.ErrorCodes) > 0 {
+		l = 0
+		for _, e := range m.ErrorCodes {
+			l += sovCwerrors(uint64(e))
+		}
+		n += 1 + sovCwerrors(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SubscriptionRenewal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeesEscrowed.Size()
+	n += 1 + l + sovCwerrors(uint64(l))
+	if m.EscrowStartHeight != 0 {
+		n += 1 + sovCwerrors(uint64(m.EscrowStartHeight))
+	}
+	if m.AutoRenew {
+		n += 2
+	}
+	l = len(m.RenewalPayer)
+	if l > 0 {
+		n += 1 + l + sovCwerrors(uint64(l))
+	}
+	if m.ExpiryNotice {
+		n += 2
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovCwerrors(uint64(l))
+	}
 	return n
 }
 
@@ -446,6 +899,492 @@ func (m *SudoError) Unmarshal(dAtA []byte) error {
 			}
 			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwerrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwerrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwerrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorId", wireType)
+			}
+			m.ErrorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwerrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionHeight", wireType)
+			}
+			m.DeletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwerrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwerrors(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriptionFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwerrors
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwerrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCwerrors
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ErrorCodes = append(m.ErrorCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCwerrors
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCwerrors
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCwerrors
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ErrorCodes) == 0 {
+					m.ErrorCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCwerrors
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ErrorCodes = append(m.ErrorCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCodes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwerrors(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriptionRenewal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwerrors
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionRenewal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionRenewal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesEscrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwerrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeesEscrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowStartHeight", wireType)
+			}
+			m.EscrowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwerrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwerrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalPayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwerrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewalPayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryNotice", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwerrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpiryNotice = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwerrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwerrors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCwerrors(dAtA[iNdEx:])
@@ -3,9 +3,15 @@ package types
 import errorsmod "cosmossdk.io/errors"
 
 var (
-	DefaultCodespace            = ModuleName
-	ErrContractNotFound         = errorsmod.Register(DefaultCodespace, 2, "contract with given address not found")
-	ErrUnauthorized             = errorsmod.Register(DefaultCodespace, 3, "sender unauthorized to perform the action")
-	ErrModuleNameMissing        = errorsmod.Register(DefaultCodespace, 4, "module name missing from sudo error")
-	ErrIncorrectSubscriptionFee = errorsmod.Register(DefaultCodespace, 5, "incorrect subscription fee")
+	DefaultCodespace             = ModuleName
+	ErrContractNotFound          = errorsmod.Register(DefaultCodespace, 2, "contract with given address not found")
+	ErrUnauthorized              = errorsmod.Register(DefaultCodespace, 3, "sender unauthorized to perform the action")
+	ErrModuleNameMissing         = errorsmod.Register(DefaultCodespace, 4, "module name missing from sudo error")
+	ErrIncorrectSubscriptionFee  = errorsmod.Register(DefaultCodespace, 5, "incorrect subscription fee")
+	ErrInvalidErrorsFilter       = errorsmod.Register(DefaultCodespace, 6, "invalid errors filter")
+	ErrInvalidSubscriptionFilter = errorsmod.Register(DefaultCodespace, 7, "invalid subscription filter")
+	ErrErrorNotFound             = errorsmod.Register(DefaultCodespace, 8, "error with given id not found for the contract")
+	ErrInvalidCallbackGasLimit   = errorsmod.Register(DefaultCodespace, 9, "invalid callback gas limit")
+	ErrSubscriptionNotFound      = errorsmod.Register(DefaultCodespace, 10, "subscription not found for the contract")
+	ErrInvalidRenewalPayer       = errorsmod.Register(DefaultCodespace, 11, "invalid subscription renewal payer")
 )
@@ -18,18 +18,32 @@ func EmitParamsUpdatedEvent(ctx sdk.Context, authority string, newParams Params)
 }
 
 // EmitSubscribedToErrorsEvent emits an event when a contract is subscribed to errors
-func EmitSubscribedToErrorsEvent(ctx sdk.Context, sender, contractAddress string, fees sdk.Coin, subValidTill int64) {
+func EmitSubscribedToErrorsEvent(ctx sdk.Context, sender, contractAddress string, fees sdk.Coin, subValidTill int64, filters []SubscriptionFilter, callbackGasLimit uint64) {
 	err := ctx.EventManager().EmitTypedEvent(&SubscribedToErrorsEvent{
 		Sender:                sender,
 		ContractAddress:       contractAddress,
 		FeesPaid:              fees,
 		SubscriptionValidTill: subValidTill,
+		Filters:               filters,
+		CallbackGasLimit:      callbackGasLimit,
 	})
 	if err != nil {
 		panic(fmt.Errorf("sending SubscribedToErrorsEvent event: %w", err))
 	}
 }
 
+// EmitErrorsAcknowledgedEvent emits an event when the errors of a contract are acknowledged
+func EmitErrorsAcknowledgedEvent(ctx sdk.Context, sender, contractAddress string, errorIDs []uint64) {
+	err := ctx.EventManager().EmitTypedEvent(&ErrorsAcknowledgedEvent{
+		Sender:          sender,
+		ContractAddress: contractAddress,
+		ErrorIds:        errorIDs,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending ErrorsAcknowledgedEvent event: %w", err))
+	}
+}
+
 // EmitStoringErrorEvent emits an event when an error is stored
 func EmitStoringErrorEvent(ctx sdk.Context, sudoError SudoError, deletionBlockHeight int64) {
 	err := ctx.EventManager().EmitTypedEvent(&StoringErrorEvent{
@@ -51,3 +65,64 @@ func EmitSudoErrorCallbackFailedEvent(ctx sdk.Context, sudoError SudoError, call
 		panic(fmt.Errorf("sending SudoErrorCallbackFailedEvent event: %w", err))
 	}
 }
+
+// EmitSubscriptionCancelledEvent emits an event when the subscription of a contract is cancelled
+func EmitSubscriptionCancelledEvent(ctx sdk.Context, sender, contractAddress string, refund sdk.Coin) {
+	err := ctx.EventManager().EmitTypedEvent(&SubscriptionCancelledEvent{
+		Sender:          sender,
+		ContractAddress: contractAddress,
+		Refund:          refund,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending SubscriptionCancelledEvent event: %w", err))
+	}
+}
+
+// EmitSubscriptionRenewedEvent emits an event when the subscription of a contract is renewed on expiry
+func EmitSubscriptionRenewedEvent(ctx sdk.Context, contractAddress, payer string, fees sdk.Coin, subValidTill int64) {
+	err := ctx.EventManager().EmitTypedEvent(&SubscriptionRenewedEvent{
+		ContractAddress:       contractAddress,
+		Payer:                 payer,
+		FeesPaid:              fees,
+		SubscriptionValidTill: subValidTill,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending SubscriptionRenewedEvent event: %w", err))
+	}
+}
+
+// EmitSubscriptionRenewalFailedEvent emits an event when the renewal of a subscription fails
+func EmitSubscriptionRenewalFailedEvent(ctx sdk.Context, contractAddress, payer, errMsg string) {
+	err := ctx.EventManager().EmitTypedEvent(&SubscriptionRenewalFailedEvent{
+		ContractAddress: contractAddress,
+		Payer:           payer,
+		ErrorMessage:    errMsg,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending SubscriptionRenewalFailedEvent event: %w", err))
+	}
+}
+
+// EmitSubscriptionExpiringEvent emits an event when a subscription is about to expire
+func EmitSubscriptionExpiringEvent(ctx sdk.Context, contractAddress string, subValidTill int64, autoRenew bool) {
+	err := ctx.EventManager().EmitTypedEvent(&SubscriptionExpiringEvent{
+		ContractAddress:       contractAddress,
+		SubscriptionValidTill: subValidTill,
+		AutoRenew:             autoRenew,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending SubscriptionExpiringEvent event: %w", err))
+	}
+}
+
+// EmitErrorEvictedEvent emits an event when the oldest error of a contract is evicted from the state
+func EmitErrorEvictedEvent(ctx sdk.Context, contractAddress string, errorID, droppedErrors uint64) {
+	err := ctx.EventManager().EmitTypedEvent(&ErrorEvictedEvent{
+		ContractAddress: contractAddress,
+		ErrorId:         errorID,
+		DroppedErrors:   droppedErrors,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending ErrorEvictedEvent event: %w", err))
+	}
+}
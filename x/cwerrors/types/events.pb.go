@@ -93,6 +93,12 @@ type SubscribedToErrorsEvent struct {
 	// subscription_valid_till is the block height till which the subscription is
 	// valid
 	SubscriptionValidTill int64 `protobuf:"varint,4,opt,name=subscription_valid_till,json=subscriptionValidTill,proto3" json:"subscription_valid_till,omitempty"`
+	// filters are the filters of the errors delivered to the contract. All the
+	// errors are delivered if empty
+	Filters []SubscriptionFilter `protobuf:"bytes,5,rep,name=filters,proto3" json:"filters"`
+	// callback_gas_limit is the gas limit of the sudo error callbacks of the
+	// contract
+	CallbackGasLimit uint64 `protobuf:"varint,6,opt,name=callback_gas_limit,json=callbackGasLimit,proto3" json:"callback_gas_limit,omitempty"`
 }
 
 func (m *SubscribedToErrorsEvent) Reset()         { *m = SubscribedToErrorsEvent{} }
@@ -156,6 +162,85 @@ func (m *SubscribedToErrorsEvent) GetSubscriptionValidTill() int64 {
 	return 0
 }
 
+func (m *SubscribedToErrorsEvent) GetFilters() []SubscriptionFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *SubscribedToErrorsEvent) GetCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.CallbackGasLimit
+	}
+	return 0
+}
+
+// ErrorsAcknowledgedEvent defines the event which is thrown when the errors of
+// a contract are acknowledged and deleted
+type ErrorsAcknowledgedEvent struct {
+	// sender is the address which acknowledged the errors
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract_address is the address of the contract whose errors are deleted
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// error_ids are the ids of the deleted errors
+	ErrorIds []uint64 `protobuf:"varint,3,rep,packed,name=error_ids,json=errorIds,proto3" json:"error_ids,omitempty"`
+}
+
+func (m *ErrorsAcknowledgedEvent) Reset()         { *m = ErrorsAcknowledgedEvent{} }
+func (m *ErrorsAcknowledgedEvent) String() string { return proto.CompactTextString(m) }
+func (*ErrorsAcknowledgedEvent) ProtoMessage()    {}
+func (*ErrorsAcknowledgedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c8d29783e2342eb, []int{2}
+}
+func (m *ErrorsAcknowledgedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorsAcknowledgedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorsAcknowledgedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ErrorsAcknowledgedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorsAcknowledgedEvent.Merge(m, src)
+}
+func (m *ErrorsAcknowledgedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorsAcknowledgedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorsAcknowledgedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorsAcknowledgedEvent proto.InternalMessageInfo
+
+func (m *ErrorsAcknowledgedEvent) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ErrorsAcknowledgedEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ErrorsAcknowledgedEvent) GetErrorIds() []uint64 {
+	if m != nil {
+		return m.ErrorIds
+	}
+	return nil
+}
+
 // StoringErrorEvent defines the event which is thrown when an error is stored
 type StoringErrorEvent struct {
 	// error is the error which is stored
@@ -169,7 +254,7 @@ func (m *StoringErrorEvent) Reset()         { *m = StoringErrorEvent{} }
 func (m *StoringErrorEvent) String() string { return proto.CompactTextString(m) }
 func (*StoringErrorEvent) ProtoMessage()    {}
 func (*StoringErrorEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7c8d29783e2342eb, []int{2}
+	return fileDescriptor_7c8d29783e2342eb, []int{3}
 }
 func (m *StoringErrorEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -225,7 +310,7 @@ func (m *SudoErrorCallbackFailedEvent) Reset()         { *m = SudoErrorCallbackF
 func (m *SudoErrorCallbackFailedEvent) String() string { return proto.CompactTextString(m) }
 func (*SudoErrorCallbackFailedEvent) ProtoMessage()    {}
 func (*SudoErrorCallbackFailedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7c8d29783e2342eb, []int{3}
+	return fileDescriptor_7c8d29783e2342eb, []int{4}
 }
 func (m *SudoErrorCallbackFailedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,49 +353,413 @@ func (m *SudoErrorCallbackFailedEvent) GetCallbackErrorMessage() string {
 	return ""
 }
 
+// SubscriptionCancelledEvent defines the event which is thrown when the
+// subscription of a contract is cancelled
+type SubscriptionCancelledEvent struct {
+	// sender is the address which cancelled the subscription
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract_address is the address of the contract whose subscription is
+	// cancelled
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// refund is the refunded part of the subscription fees
+	Refund types.Coin `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund"`
+}
+
+func (m *SubscriptionCancelledEvent) Reset()         { *m = SubscriptionCancelledEvent{} }
+func (m *SubscriptionCancelledEvent) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionCancelledEvent) ProtoMessage()    {}
+func (*SubscriptionCancelledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c8d29783e2342eb, []int{5}
+}
+func (m *SubscriptionCancelledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriptionCancelledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionCancelledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscriptionCancelledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionCancelledEvent.Merge(m, src)
+}
+func (m *SubscriptionCancelledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriptionCancelledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionCancelledEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionCancelledEvent proto.InternalMessageInfo
+
+func (m *SubscriptionCancelledEvent) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *SubscriptionCancelledEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *SubscriptionCancelledEvent) GetRefund() types.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return types.Coin{}
+}
+
+// SubscriptionRenewedEvent defines the event which is thrown when the
+// subscription of a contract is automatically renewed on expiry
+type SubscriptionRenewedEvent struct {
+	// contract_address is the address of the contract whose subscription is
+	// renewed
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// payer is the address charged the renewal fees
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// fees_paid is the fees paid for the renewal
+	FeesPaid types.Coin `protobuf:"bytes,3,opt,name=fees_paid,json=feesPaid,proto3" json:"fees_paid"`
+	// subscription_valid_till is the block height till which the renewed
+	// subscription is valid
+	SubscriptionValidTill int64 `protobuf:"varint,4,opt,name=subscription_valid_till,json=subscriptionValidTill,proto3" json:"subscription_valid_till,omitempty"`
+}
+
+func (m *SubscriptionRenewedEvent) Reset()         { *m = SubscriptionRenewedEvent{} }
+func (m *SubscriptionRenewedEvent) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionRenewedEvent) ProtoMessage()    {}
+func (*SubscriptionRenewedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c8d29783e2342eb, []int{6}
+}
+func (m *SubscriptionRenewedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriptionRenewedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionRenewedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscriptionRenewedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionRenewedEvent.Merge(m, src)
+}
+func (m *SubscriptionRenewedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriptionRenewedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionRenewedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionRenewedEvent proto.InternalMessageInfo
+
+func (m *SubscriptionRenewedEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *SubscriptionRenewedEvent) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *SubscriptionRenewedEvent) GetFeesPaid() types.Coin {
+	if m != nil {
+		return m.FeesPaid
+	}
+	return types.Coin{}
+}
+
+func (m *SubscriptionRenewedEvent) GetSubscriptionValidTill() int64 {
+	if m != nil {
+		return m.SubscriptionValidTill
+	}
+	return 0
+}
+
+// SubscriptionRenewalFailedEvent defines the event which is thrown when the
+// automatic renewal of a subscription fails and the subscription expires
+type SubscriptionRenewalFailedEvent struct {
+	// contract_address is the address of the contract whose subscription
+	// expired
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// payer is the address which was charged the renewal fees
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// error_message is the error message of why the renewal failed
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *SubscriptionRenewalFailedEvent) Reset()         { *m = SubscriptionRenewalFailedEvent{} }
+func (m *SubscriptionRenewalFailedEvent) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionRenewalFailedEvent) ProtoMessage()    {}
+func (*SubscriptionRenewalFailedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c8d29783e2342eb, []int{7}
+}
+func (m *SubscriptionRenewalFailedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriptionRenewalFailedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionRenewalFailedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscriptionRenewalFailedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionRenewalFailedEvent.Merge(m, src)
+}
+func (m *SubscriptionRenewalFailedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriptionRenewalFailedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionRenewalFailedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionRenewalFailedEvent proto.InternalMessageInfo
+
+func (m *SubscriptionRenewalFailedEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *SubscriptionRenewalFailedEvent) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *SubscriptionRenewalFailedEvent) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+// SubscriptionExpiringEvent defines the event which is thrown
+// subscription_expiry_notice_period blocks before a subscription expires
+type SubscriptionExpiringEvent struct {
+	// contract_address is the address of the contract whose subscription is
+	// expiring
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// subscription_valid_till is the block height till which the subscription is
+	// valid
+	SubscriptionValidTill int64 `protobuf:"varint,2,opt,name=subscription_valid_till,json=subscriptionValidTill,proto3" json:"subscription_valid_till,omitempty"`
+	// auto_renew defines if the subscription is renewed when it expires
+	AutoRenew bool `protobuf:"varint,3,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+}
+
+func (m *SubscriptionExpiringEvent) Reset()         { *m = SubscriptionExpiringEvent{} }
+func (m *SubscriptionExpiringEvent) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionExpiringEvent) ProtoMessage()    {}
+func (*SubscriptionExpiringEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c8d29783e2342eb, []int{8}
+}
+func (m *SubscriptionExpiringEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriptionExpiringEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionExpiringEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscriptionExpiringEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionExpiringEvent.Merge(m, src)
+}
+func (m *SubscriptionExpiringEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriptionExpiringEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionExpiringEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionExpiringEvent proto.InternalMessageInfo
+
+func (m *SubscriptionExpiringEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *SubscriptionExpiringEvent) GetSubscriptionValidTill() int64 {
+	if m != nil {
+		return m.SubscriptionValidTill
+	}
+	return 0
+}
+
+func (m *SubscriptionExpiringEvent) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
+}
+
+// ErrorEvictedEvent defines the event which is thrown when the oldest error of
+// a contract is evicted from the state to respect the max_errors_per_contract
+// param
+type ErrorEvictedEvent struct {
+	// contract_address is the address of the contract whose error is evicted
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// error_id is the id of the evicted error
+	ErrorId uint64 `protobuf:"varint,2,opt,name=error_id,json=errorId,proto3" json:"error_id,omitempty"`
+	// dropped_errors is the total number of errors of the contract evicted so
+	// far
+	DroppedErrors uint64 `protobuf:"varint,3,opt,name=dropped_errors,json=droppedErrors,proto3" json:"dropped_errors,omitempty"`
+}
+
+func (m *ErrorEvictedEvent) Reset()         { *m = ErrorEvictedEvent{} }
+func (m *ErrorEvictedEvent) String() string { return proto.CompactTextString(m) }
+func (*ErrorEvictedEvent) ProtoMessage()    {}
+func (*ErrorEvictedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c8d29783e2342eb, []int{9}
+}
+func (m *ErrorEvictedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorEvictedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorEvictedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ErrorEvictedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorEvictedEvent.Merge(m, src)
+}
+func (m *ErrorEvictedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorEvictedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorEvictedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorEvictedEvent proto.InternalMessageInfo
+
+func (m *ErrorEvictedEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ErrorEvictedEvent) GetErrorId() uint64 {
+	if m != nil {
+		return m.ErrorId
+	}
+	return 0
+}
+
+func (m *ErrorEvictedEvent) GetDroppedErrors() uint64 {
+	if m != nil {
+		return m.DroppedErrors
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ParamsUpdatedEvent)(nil), "archway.cwerrors.v1.ParamsUpdatedEvent")
 	proto.RegisterType((*SubscribedToErrorsEvent)(nil), "archway.cwerrors.v1.SubscribedToErrorsEvent")
+	proto.RegisterType((*ErrorsAcknowledgedEvent)(nil), "archway.cwerrors.v1.ErrorsAcknowledgedEvent")
 	proto.RegisterType((*StoringErrorEvent)(nil), "archway.cwerrors.v1.StoringErrorEvent")
 	proto.RegisterType((*SudoErrorCallbackFailedEvent)(nil), "archway.cwerrors.v1.SudoErrorCallbackFailedEvent")
+	proto.RegisterType((*SubscriptionCancelledEvent)(nil), "archway.cwerrors.v1.SubscriptionCancelledEvent")
+	proto.RegisterType((*SubscriptionRenewedEvent)(nil), "archway.cwerrors.v1.SubscriptionRenewedEvent")
+	proto.RegisterType((*SubscriptionRenewalFailedEvent)(nil), "archway.cwerrors.v1.SubscriptionRenewalFailedEvent")
+	proto.RegisterType((*SubscriptionExpiringEvent)(nil), "archway.cwerrors.v1.SubscriptionExpiringEvent")
+	proto.RegisterType((*ErrorEvictedEvent)(nil), "archway.cwerrors.v1.ErrorEvictedEvent")
 }
 
 func init() { proto.RegisterFile("archway/cwerrors/v1/events.proto", fileDescriptor_7c8d29783e2342eb) }
 
 var fileDescriptor_7c8d29783e2342eb = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xcd, 0x6e, 0x13, 0x3d,
-	0x14, 0xcd, 0x7c, 0xe9, 0x57, 0x11, 0xb3, 0x00, 0xa6, 0x7f, 0x21, 0x54, 0x43, 0x94, 0x55, 0x58,
-	0xe0, 0x51, 0x5a, 0xc4, 0x02, 0xb1, 0x80, 0x54, 0x45, 0x6c, 0x2a, 0x55, 0x49, 0x61, 0xc1, 0x66,
-	0xe4, 0xb1, 0x2f, 0x13, 0x2b, 0x8e, 0x3d, 0xb2, 0x9d, 0x84, 0xac, 0x79, 0x81, 0x3e, 0x56, 0x97,
-	0x15, 0x2b, 0x56, 0x08, 0x25, 0x2f, 0x82, 0xc6, 0xf6, 0xb4, 0x2c, 0xb2, 0x63, 0x67, 0xdf, 0x73,
-	0xee, 0xb9, 0xe7, 0x1e, 0x1b, 0x75, 0x89, 0xa6, 0x93, 0x25, 0x59, 0xa5, 0x74, 0x09, 0x5a, 0x2b,
-	0x6d, 0xd2, 0xc5, 0x20, 0x85, 0x05, 0x48, 0x6b, 0x70, 0xa9, 0x95, 0x55, 0xf1, 0x5e, 0x60, 0xe0,
-	0x9a, 0x81, 0x17, 0x83, 0xce, 0x7e, 0xa1, 0x0a, 0xe5, 0xf0, 0xb4, 0x3a, 0x79, 0x6a, 0x67, 0xab,
-	0x58, 0x49, 0x34, 0x99, 0x05, 0xb1, 0x4e, 0x6f, 0x1b, 0xe3, 0x4e, 0xd8, 0x73, 0x12, 0xaa, 0xcc,
-	0x4c, 0x99, 0x34, 0x27, 0x06, 0xd2, 0xc5, 0x20, 0x07, 0x4b, 0x06, 0x29, 0x55, 0x5c, 0x7a, 0xbc,
-	0x67, 0x51, 0x7c, 0xe9, 0x34, 0x3f, 0x95, 0x8c, 0x58, 0x60, 0xe7, 0x95, 0xdb, 0xf8, 0x1d, 0x42,
-	0x12, 0x96, 0x99, 0x9f, 0xd6, 0x8e, 0xba, 0x51, 0xff, 0xe1, 0xc9, 0x33, 0xbc, 0xc5, 0x3b, 0xf6,
-	0xcd, 0xc3, 0x9d, 0x9b, 0x5f, 0xcf, 0x1b, 0xa3, 0x96, 0x84, 0xa5, 0x2f, 0xc4, 0xc7, 0xa8, 0x45,
-	0xe6, 0x76, 0xa2, 0x34, 0xb7, 0xab, 0xf6, 0x7f, 0xdd, 0xa8, 0xdf, 0x1a, 0xdd, 0x17, 0x7a, 0x3f,
-	0x22, 0x74, 0x34, 0x9e, 0xe7, 0x86, 0x6a, 0x9e, 0x03, 0xbb, 0x52, 0xe7, 0x4e, 0xcf, 0xcf, 0x3e,
-	0x44, 0xbb, 0x06, 0x24, 0x03, 0xed, 0xe6, 0xb6, 0x46, 0xe1, 0x16, 0xbf, 0x40, 0x8f, 0xa9, 0x92,
-	0x56, 0x13, 0x6a, 0x33, 0xc2, 0x98, 0x06, 0x63, 0x82, 0xf0, 0xa3, 0xba, 0xfe, 0xde, 0x97, 0xe3,
-	0xb7, 0xa8, 0xf5, 0x15, 0xc0, 0x64, 0x25, 0xe1, 0xac, 0xdd, 0x74, 0xee, 0x9f, 0x62, 0x1f, 0x04,
-	0xae, 0x82, 0xc0, 0x21, 0x08, 0x7c, 0xa6, 0xb8, 0x0c, 0xde, 0x1f, 0x54, 0x1d, 0x97, 0x84, 0xb3,
-	0xf8, 0x35, 0x3a, 0x32, 0xde, 0x5b, 0x69, 0xb9, 0x92, 0xd9, 0x82, 0x08, 0xce, 0x32, 0xcb, 0x85,
-	0x68, 0xef, 0x74, 0xa3, 0x7e, 0x73, 0x74, 0xf0, 0x37, 0xfc, 0xb9, 0x42, 0xaf, 0xb8, 0x10, 0xbd,
-	0xef, 0x11, 0x7a, 0x32, 0xb6, 0x4a, 0x73, 0x59, 0xb8, 0x7d, 0xfc, 0x3a, 0x6f, 0xd0, 0xff, 0x2e,
-	0xad, 0x90, 0x62, 0xb2, 0x35, 0xc5, 0xf1, 0x9c, 0xf9, 0x0c, 0x82, 0x19, 0xdf, 0x12, 0x9f, 0xa0,
-	0x03, 0x06, 0x02, 0x9c, 0x8b, 0x5c, 0x28, 0x3a, 0xcd, 0x26, 0xc0, 0x8b, 0x89, 0x75, 0x7b, 0x37,
-	0x47, 0x7b, 0x35, 0x38, 0xac, 0xb0, 0x8f, 0x0e, 0xea, 0x5d, 0x47, 0xe8, 0xf8, 0x4e, 0xee, 0x8c,
-	0x08, 0x91, 0x13, 0x3a, 0xfd, 0x40, 0xb8, 0xa8, 0xdf, 0xf6, 0x5f, 0x0c, 0xbd, 0x42, 0x87, 0x34,
-	0x48, 0x66, 0xae, 0x92, 0xcd, 0xc0, 0x18, 0x52, 0x40, 0x78, 0x89, 0xfd, 0x1a, 0x75, 0xbd, 0x17,
-	0x1e, 0x1b, 0x5e, 0xdc, 0xac, 0x93, 0xe8, 0x76, 0x9d, 0x44, 0xbf, 0xd7, 0x49, 0x74, 0xbd, 0x49,
-	0x1a, 0xb7, 0x9b, 0xa4, 0xf1, 0x73, 0x93, 0x34, 0xbe, 0x9c, 0x16, 0xdc, 0x4e, 0xe6, 0x39, 0xa6,
-	0x6a, 0x96, 0x06, 0x1b, 0x2f, 0x25, 0xd8, 0xa5, 0xd2, 0xd3, 0xfa, 0x9e, 0x7e, 0xbb, 0xff, 0xde,
-	0x76, 0x55, 0x82, 0xc9, 0x77, 0xdd, 0xcf, 0x3d, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xc0,
-	0x6d, 0x26, 0x6e, 0x03, 0x00, 0x00,
+	// 764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xce, 0x6c, 0xb2, 0x69, 0x32, 0xa5, 0xd0, 0xba, 0xdb, 0xae, 0x37, 0x2d, 0x26, 0x32, 0x42,
+	0x04, 0x09, 0x6c, 0x65, 0x8b, 0x40, 0x42, 0x1c, 0xe8, 0xae, 0xb6, 0x05, 0x89, 0x4a, 0x95, 0xb7,
+	0x70, 0xe0, 0x62, 0x8d, 0x3d, 0x6f, 0x9d, 0x51, 0x26, 0x1e, 0x6b, 0x66, 0x92, 0x34, 0x37, 0x24,
+	0x24, 0xce, 0x3d, 0x72, 0xe1, 0xff, 0x29, 0xb7, 0x72, 0xe3, 0x84, 0xd0, 0xee, 0x3f, 0x82, 0x3c,
+	0x33, 0xde, 0x06, 0x11, 0x2a, 0x60, 0x57, 0xea, 0xcd, 0xf3, 0xbe, 0xf7, 0xe3, 0x9b, 0xef, 0xcd,
+	0x7b, 0xc6, 0x43, 0x22, 0xf3, 0xc9, 0x92, 0xac, 0xe2, 0x7c, 0x09, 0x52, 0x0a, 0xa9, 0xe2, 0xc5,
+	0x38, 0x86, 0x05, 0x94, 0x5a, 0x45, 0x95, 0x14, 0x5a, 0x78, 0x37, 0x9d, 0x47, 0xd4, 0x78, 0x44,
+	0x8b, 0xf1, 0x60, 0xa7, 0x10, 0x85, 0x30, 0x78, 0x5c, 0x7f, 0x59, 0xd7, 0xc1, 0xc6, 0x64, 0x15,
+	0x91, 0x64, 0xe6, 0x92, 0x0d, 0xc2, 0x4d, 0x1e, 0xe7, 0x89, 0xad, 0x4f, 0x90, 0x0b, 0x35, 0x13,
+	0x2a, 0xce, 0x88, 0x82, 0x78, 0x31, 0xce, 0x40, 0x93, 0x71, 0x9c, 0x0b, 0x56, 0x5a, 0x3c, 0xd4,
+	0xd8, 0x7b, 0x6c, 0x72, 0x7e, 0x53, 0x51, 0xa2, 0x81, 0x1e, 0xd5, 0x6c, 0xbd, 0x2f, 0x30, 0x2e,
+	0x61, 0x99, 0xda, 0x6a, 0x3e, 0x1a, 0xa2, 0xd1, 0xd5, 0xfd, 0x3b, 0xd1, 0x06, 0xee, 0x91, 0x0d,
+	0x3e, 0xe8, 0x3c, 0xff, 0xfd, 0x9d, 0x56, 0xd2, 0x2f, 0x61, 0x69, 0x0d, 0xde, 0x5d, 0xdc, 0x27,
+	0x73, 0x3d, 0x11, 0x92, 0xe9, 0x95, 0xbf, 0x35, 0x44, 0xa3, 0x7e, 0xf2, 0xd2, 0x10, 0xfe, 0xb2,
+	0x85, 0x77, 0x8f, 0xe7, 0x99, 0xca, 0x25, 0xcb, 0x80, 0x3e, 0x11, 0x47, 0x26, 0x9f, 0xad, 0x7d,
+	0x1b, 0x77, 0x15, 0x94, 0x14, 0xa4, 0xa9, 0xdb, 0x4f, 0xdc, 0xc9, 0xfb, 0x00, 0x5f, 0xcf, 0x45,
+	0xa9, 0x25, 0xc9, 0x75, 0x4a, 0x28, 0x95, 0xa0, 0x94, 0x4b, 0xfc, 0x56, 0x63, 0xbf, 0x6f, 0xcd,
+	0xde, 0xe7, 0xb8, 0x7f, 0x02, 0xa0, 0xd2, 0x8a, 0x30, 0xea, 0xb7, 0x0d, 0xfb, 0xbd, 0xc8, 0x0a,
+	0x11, 0xd5, 0x42, 0x44, 0x4e, 0x88, 0xe8, 0x50, 0xb0, 0xd2, 0x71, 0xef, 0xd5, 0x11, 0x8f, 0x09,
+	0xa3, 0xde, 0x27, 0x78, 0x57, 0x59, 0x6e, 0x95, 0x66, 0xa2, 0x4c, 0x17, 0x84, 0x33, 0x9a, 0x6a,
+	0xc6, 0xb9, 0xdf, 0x19, 0xa2, 0x51, 0x3b, 0xb9, 0xb5, 0x0e, 0x7f, 0x5b, 0xa3, 0x4f, 0x18, 0xe7,
+	0xde, 0x43, 0x7c, 0xe5, 0x84, 0x71, 0x0d, 0x52, 0xf9, 0xdb, 0xc3, 0xf6, 0xe8, 0xea, 0xfe, 0xfb,
+	0x1b, 0x15, 0x3b, 0x5e, 0x0b, 0x7e, 0x60, 0xfc, 0x1d, 0x83, 0x26, 0xda, 0xfb, 0x10, 0x7b, 0x39,
+	0xe1, 0x3c, 0x23, 0xf9, 0x34, 0x2d, 0x88, 0x4a, 0x39, 0x9b, 0x31, 0xed, 0x77, 0x87, 0x68, 0xd4,
+	0x49, 0xae, 0x37, 0xc8, 0x43, 0xa2, 0xbe, 0xae, 0xed, 0xe1, 0x0a, 0xef, 0x5a, 0xf9, 0xee, 0xe7,
+	0xd3, 0x52, 0x2c, 0x39, 0xd0, 0x02, 0xe8, 0xa5, 0x49, 0x79, 0x07, 0xf7, 0x0d, 0xf5, 0x94, 0x51,
+	0xe5, 0xb7, 0x87, 0xed, 0x51, 0x27, 0xe9, 0x19, 0xc3, 0x57, 0x54, 0x85, 0x3f, 0x20, 0x7c, 0xe3,
+	0x58, 0x0b, 0xc9, 0xca, 0xc2, 0x50, 0xb0, 0x55, 0x3f, 0xc3, 0xdb, 0xc6, 0xc3, 0xbd, 0x9b, 0xe0,
+	0x1f, 0x54, 0xa0, 0xb6, 0xeb, 0xee, 0xf2, 0x36, 0xc4, 0xdb, 0xc7, 0xb7, 0x28, 0x70, 0x30, 0xba,
+	0x67, 0x5c, 0xe4, 0xd3, 0x74, 0x02, 0xac, 0x98, 0x68, 0x43, 0xaf, 0x9d, 0xdc, 0x6c, 0xc0, 0x83,
+	0x1a, 0xfb, 0xd2, 0x40, 0xe1, 0x33, 0x84, 0xef, 0x9e, 0xa7, 0x3b, 0x74, 0xf2, 0x3c, 0x20, 0x8c,
+	0x03, 0xbd, 0x38, 0xa1, 0x8f, 0xf1, 0xed, 0xf3, 0x5e, 0x58, 0x21, 0x66, 0xa0, 0x14, 0x29, 0xc0,
+	0x09, 0xb6, 0xd3, 0xa0, 0x26, 0xf6, 0x91, 0xc5, 0xc2, 0x9f, 0x10, 0x1e, 0xac, 0xf7, 0xf9, 0x90,
+	0x94, 0x39, 0x70, 0x7e, 0x89, 0x7d, 0xf9, 0x14, 0x77, 0x25, 0x9c, 0xcc, 0xcb, 0x7f, 0xfd, 0xbe,
+	0x9d, 0x7b, 0xf8, 0x2b, 0xc2, 0xfe, 0x3a, 0xb5, 0x04, 0x4a, 0x58, 0x36, 0xc4, 0x36, 0x11, 0x40,
+	0x9b, 0x09, 0xec, 0xe0, 0xed, 0x8a, 0xac, 0x40, 0x3a, 0x82, 0xf6, 0xf0, 0x7a, 0x26, 0x2f, 0xfc,
+	0x11, 0xe1, 0xe0, 0x6f, 0x77, 0x22, 0x7c, 0xfd, 0x0d, 0x5c, 0xf8, 0x66, 0xef, 0xe2, 0x6b, 0x7f,
+	0xed, 0x7f, 0xdb, 0xa0, 0x6f, 0xc0, 0x7a, 0xdf, 0x7f, 0x46, 0x78, 0x6f, 0x9d, 0xc8, 0xd1, 0xd3,
+	0x8a, 0x99, 0xe9, 0xf8, 0xaf, 0x1c, 0x5e, 0xa1, 0xc4, 0xd6, 0xab, 0x76, 0xd0, 0xdb, 0x18, 0x93,
+	0xb9, 0x16, 0xa9, 0xac, 0x15, 0x30, 0x14, 0x7b, 0x66, 0xef, 0x0a, 0x23, 0x49, 0xf8, 0x3d, 0xc2,
+	0x37, 0xdc, 0xa4, 0xb2, 0x5c, 0xff, 0x0f, 0x6d, 0xf6, 0x70, 0xaf, 0x59, 0x07, 0x86, 0x48, 0x27,
+	0xb9, 0xe2, 0xb6, 0x81, 0xf7, 0x1e, 0x7e, 0x93, 0x4a, 0x51, 0x55, 0x40, 0xed, 0xa0, 0x28, 0x53,
+	0xbe, 0x93, 0x5c, 0x73, 0x56, 0x53, 0x57, 0x1d, 0x3c, 0x7a, 0x7e, 0x1a, 0xa0, 0x17, 0xa7, 0x01,
+	0xfa, 0xe3, 0x34, 0x40, 0xcf, 0xce, 0x82, 0xd6, 0x8b, 0xb3, 0xa0, 0xf5, 0xdb, 0x59, 0xd0, 0xfa,
+	0xee, 0x5e, 0xc1, 0xf4, 0x64, 0x9e, 0x45, 0xb9, 0x98, 0xc5, 0x6e, 0x42, 0x3f, 0x2a, 0x41, 0x2f,
+	0x85, 0x9c, 0x36, 0xe7, 0xf8, 0xe9, 0xcb, 0x7f, 0x9d, 0x5e, 0x55, 0xa0, 0xb2, 0xae, 0xf9, 0x8d,
+	0xdd, 0xfb, 0x73, 0x00, 0xdf, 0x72, 0x73, 0xe9, 0x7b, 0x07, 0x00, 0x00,
 }
 
 func (m *ParamsUpdatedEvent) Marshal() (dAtA []byte, err error) {
@@ -373,10 +822,29 @@ func (m *SubscribedToErrorsEvent) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
-	if m.SubscriptionValidTill != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SubscriptionValidTill))
+	if m.CallbackGasLimit != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CallbackGasLimit))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x30
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SubscriptionValidTill != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SubscriptionValidTill))
+		i--
+		dAtA[i] = 0x20
 	}
 	{
 		size, err := m.FeesPaid.MarshalToSizedBuffer(dAtA[:i])
@@ -405,6 +873,61 @@ func (m *SubscribedToErrorsEvent) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ErrorsAcknowledgedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorsAcknowledgedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorsAcknowledgedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorIds) > 0 {
+		dAtA4 := make([]byte, len(m.ErrorIds)*10)
+		var j3 int
+		for _, num := range m.ErrorIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvents(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StoringErrorEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -483,6 +1006,234 @@ func (m *SudoErrorCallbackFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *SubscriptionCancelledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscriptionRenewedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionRenewedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionRenewedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionValidTill != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SubscriptionValidTill))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.FeesPaid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscriptionRenewalFailedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionRenewalFailedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionRenewalFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscriptionExpiringEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionExpiringEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionExpiringEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SubscriptionValidTill != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SubscriptionValidTill))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ErrorEvictedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorEvictedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorEvictedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DroppedErrors != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DroppedErrors))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ErrorId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ErrorId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -528,6 +1279,39 @@ func (m *SubscribedToErrorsEvent) Size() (n int) {
 	if m.SubscriptionValidTill != 0 {
 		n += 1 + sovEvents(uint64(m.SubscriptionValidTill))
 	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.CallbackGasLimit != 0 {
+		n += 1 + sovEvents(uint64(m.CallbackGasLimit))
+	}
+	return n
+}
+
+func (m *ErrorsAcknowledgedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ErrorIds) > 0 {
+		l = 0
+		for _, e := range m.ErrorIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
 	return n
 }
 
@@ -560,7 +1344,107 @@ func (m *SudoErrorCallbackFailedEvent) Size() (n int) {
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
+func (m *SubscriptionCancelledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Refund.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *SubscriptionRenewedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.FeesPaid.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SubscriptionValidTill != 0 {
+		n += 1 + sovEvents(uint64(m.SubscriptionValidTill))
+	}
+	return n
+}
+
+func (m *SubscriptionRenewalFailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscriptionExpiringEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SubscriptionValidTill != 0 {
+		n += 1 + sovEvents(uint64(m.SubscriptionValidTill))
+	}
+	if m.AutoRenew {
+		n += 2
+	}
+	return n
+}
+
+func (m *ErrorEvictedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ErrorId != 0 {
+		n += 1 + sovEvents(uint64(m.ErrorId))
+	}
+	if m.DroppedErrors != 0 {
+		n += 1 + sovEvents(uint64(m.DroppedErrors))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozEvents(x uint64) (n int) {
@@ -585,21 +1469,794 @@ func (m *ParamsUpdatedEvent) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsUpdatedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsUpdatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribedToErrorsEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribedToErrorsEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribedToErrorsEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeesPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionValidTill", wireType)
+			}
+			m.SubscriptionValidTill = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionValidTill |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, SubscriptionFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimit", wireType)
+			}
+			m.CallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ErrorsAcknowledgedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorsAcknowledgedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorsAcknowledgedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ErrorIds = append(m.ErrorIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ErrorIds) == 0 {
+					m.ErrorIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ErrorIds = append(m.ErrorIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoringErrorEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoringErrorEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoringErrorEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionBlockHeight", wireType)
+			}
+			m.DeletionBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletionBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SudoErrorCallbackFailedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SudoErrorCallbackFailedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SudoErrorCallbackFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriptionCancelledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionCancelledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -609,30 +2266,29 @@ func (m *ParamsUpdatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -642,23 +2298,24 @@ func (m *ParamsUpdatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -681,7 +2338,7 @@ func (m *ParamsUpdatedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribedToErrorsEvent) Unmarshal(dAtA []byte) error {
+func (m *SubscriptionRenewedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -704,15 +2361,15 @@ func (m *SubscribedToErrorsEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribedToErrorsEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscriptionRenewedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribedToErrorsEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscriptionRenewedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -740,11 +2397,11 @@ func (m *SubscribedToErrorsEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -772,7 +2429,7 @@ func (m *SubscribedToErrorsEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -847,7 +2504,7 @@ func (m *SubscribedToErrorsEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoringErrorEvent) Unmarshal(dAtA []byte) error {
+func (m *SubscriptionRenewalFailedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -870,17 +2527,17 @@ func (m *StoringErrorEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoringErrorEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscriptionRenewalFailedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoringErrorEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscriptionRenewalFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -890,30 +2547,29 @@ func (m *StoringErrorEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletionBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
-			m.DeletionBlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -923,11 +2579,56 @@ func (m *StoringErrorEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeletionBlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -949,7 +2650,7 @@ func (m *StoringErrorEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SudoErrorCallbackFailedEvent) Unmarshal(dAtA []byte) error {
+func (m *SubscriptionExpiringEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -972,17 +2673,17 @@ func (m *SudoErrorCallbackFailedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SudoErrorCallbackFailedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscriptionExpiringEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SudoErrorCallbackFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscriptionExpiringEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -992,28 +2693,116 @@ func (m *SudoErrorCallbackFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionValidTill", wireType)
+			}
+			m.SubscriptionValidTill = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionValidTill |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ErrorEvictedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorEvictedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorEvictedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackErrorMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1041,8 +2830,46 @@ func (m *SudoErrorCallbackFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallbackErrorMessage = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorId", wireType)
+			}
+			m.ErrorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedErrors", wireType)
+			}
+			m.DroppedErrors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedErrors |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -23,6 +23,12 @@ type WasmKeeperExpected interface {
 type BankKeeperExpected interface {
 	// SendCoinsFromAccountToModule sends coins from an account to a module
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	// SendCoinsFromModuleToAccount sends coins from a module to an account
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	// SendCoinsFromModuleToModule sends coins from a module to another module
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	// BlockedAddr checks if the address is blocked from receiving funds
+	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 // RewardsKeeperExpected is a subset of the expected rewards keeper
@@ -29,6 +29,11 @@ func TestGenesisValidate(t *testing.T) {
 					0,
 					sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
 					100,
+					sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+					150_000,
+					1_000_000,
+					10,
+					100,
 				),
 			},
 			errExpected: true,
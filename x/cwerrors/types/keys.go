@@ -32,6 +32,16 @@ var (
 	ContractSubscriptionsKeyPrefix = collections.NewPrefix(6)
 	// SubscriptionEndBlockKeyPrefix is the prefix for the collection of all subscriptions which end at given block
 	SubscriptionEndBlockKeyPrefix = collections.NewPrefix(7)
+	// SubscriptionFiltersKeyPrefix is the prefix for the collection of the filters of the contract subscriptions
+	SubscriptionFiltersKeyPrefix = collections.NewPrefix(8)
+	// SubscriptionGasLimitsKeyPrefix is the prefix for the collection of the error callback gas limits purchased by the contract subscriptions
+	SubscriptionGasLimitsKeyPrefix = collections.NewPrefix(9)
+	// SubscriptionRenewalsKeyPrefix is the prefix for the collection of the renewal settings and escrowed fees of the contract subscriptions
+	SubscriptionRenewalsKeyPrefix = collections.NewPrefix(10)
+	// ContractErrorsCountKeyPrefix is the prefix for the collection of the number of errors stored in state for each contract
+	ContractErrorsCountKeyPrefix = collections.NewPrefix(11)
+	// DroppedErrorsCountKeyPrefix is the prefix for the collection of the number of errors evicted from state for each contract
+	DroppedErrorsCountKeyPrefix = collections.NewPrefix(12)
 )
 
 // Transient Store
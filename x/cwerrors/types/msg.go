@@ -9,6 +9,8 @@ import (
 var (
 	_ sdk.Msg = &MsgSubscribeToError{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgAcknowledgeErrors{}
+	_ sdk.Msg = &MsgCancelSubscription{}
 )
 
 // GetSigners implements the sdk.Msg interface.
@@ -27,6 +29,61 @@ func (m MsgSubscribeToError) ValidateBasic() error {
 	if err := m.Fee.Validate(); err != nil {
 		return errorsmod.Wrapf(sdkErrors.ErrInvalidCoins, "invalid fee: %v", err)
 	}
+	for i, filter := range m.Filters {
+		if err := filter.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "filters[%d]", i)
+		}
+	}
+	if m.RenewalPayer != "" {
+		if !m.AutoRenew {
+			return errorsmod.Wrap(ErrInvalidRenewalPayer, "renewal payer set without auto renew")
+		}
+		if m.RenewalPayer != m.Sender {
+			return errorsmod.Wrap(ErrInvalidRenewalPayer, "renewal payer must be the sender")
+		}
+	}
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgAcknowledgeErrors) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgAcknowledgeErrors) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+	if len(m.ErrorIds) == 0 {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "error ids must not be empty")
+	}
+	seen := make(map[uint64]struct{}, len(m.ErrorIds))
+	for _, errorID := range m.ErrorIds {
+		if _, ok := seen[errorID]; ok {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "duplicate error id: %d", errorID)
+		}
+		seen[errorID] = struct{}{}
+	}
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgCancelSubscription) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgCancelSubscription) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
 	return nil
 }
 
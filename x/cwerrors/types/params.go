@@ -3,13 +3,20 @@ package types
 import (
 	fmt "fmt"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
-	DefaultErrorStoredTime    = int64(302400)                             // roughly 21 days
-	DefaultSubscriptionFee    = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0) // 1 ARCH (1e18 attoarch)
-	DefaultSubscriptionPeriod = int64(302400)                             // roughly 21 days
+	DefaultErrorStoredTime                = int64(302400)                             // roughly 21 days
+	DefaultSubscriptionFee                = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0) // 1 ARCH (1e18 attoarch)
+	DefaultSubscriptionPeriod             = int64(302400)                             // roughly 21 days
+	DefaultSubscriptionFilterFee          = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+	DefaultErrorCallbackGasLimit          = uint64(150_000)
+	DefaultMaxErrorCallbackGasLimit       = uint64(1_000_000)
+	DefaultSubscriptionExpiryNoticePeriod = int64(14400) // roughly 1 day
+	DefaultMaxErrorsPerContract           = uint64(1000)
 )
 
 // NewParams creates a new Params instance.
@@ -17,11 +24,21 @@ func NewParams(
 	errorStoredTime int64,
 	subscriptionFee sdk.Coin,
 	subscriptionPeriod int64,
+	subscriptionFilterFee sdk.Coin,
+	errorCallbackGasLimit uint64,
+	maxErrorCallbackGasLimit uint64,
+	subscriptionExpiryNoticePeriod int64,
+	maxErrorsPerContract uint64,
 ) Params {
 	return Params{
-		ErrorStoredTime:    errorStoredTime,
-		SubscriptionFee:    subscriptionFee,
-		SubscriptionPeriod: subscriptionPeriod,
+		ErrorStoredTime:                errorStoredTime,
+		SubscriptionFee:                subscriptionFee,
+		SubscriptionPeriod:             subscriptionPeriod,
+		SubscriptionFilterFee:          subscriptionFilterFee,
+		ErrorCallbackGasLimit:          errorCallbackGasLimit,
+		MaxErrorCallbackGasLimit:       maxErrorCallbackGasLimit,
+		SubscriptionExpiryNoticePeriod: subscriptionExpiryNoticePeriod,
+		MaxErrorsPerContract:           maxErrorsPerContract,
 	}
 }
 
@@ -31,6 +48,11 @@ func DefaultParams() Params {
 		DefaultErrorStoredTime,
 		DefaultSubscriptionFee,
 		DefaultSubscriptionPeriod,
+		DefaultSubscriptionFilterFee,
+		DefaultErrorCallbackGasLimit,
+		DefaultMaxErrorCallbackGasLimit,
+		DefaultSubscriptionExpiryNoticePeriod,
+		DefaultMaxErrorsPerContract,
 	)
 }
 
@@ -45,5 +67,50 @@ func (p Params) Validate() error {
 	if p.SubscriptionPeriod <= 0 {
 		return fmt.Errorf("SubscriptionPeriod must be greater than 0. Current value: %d", p.SubscriptionPeriod)
 	}
+	if !p.SubscriptionFilterFee.IsValid() {
+		return fmt.Errorf("SubscriptionFilterFee is not valid. Current value: %s", p.SubscriptionFilterFee)
+	}
+	if p.SubscriptionFilterFee.Denom != p.SubscriptionFee.Denom {
+		return fmt.Errorf("SubscriptionFilterFee denom must match the SubscriptionFee denom. Current value: %s", p.SubscriptionFilterFee.Denom)
+	}
+	if p.ErrorCallbackGasLimit == 0 {
+		return fmt.Errorf("ErrorCallbackGasLimit must be greater than 0")
+	}
+	if p.MaxErrorCallbackGasLimit < p.ErrorCallbackGasLimit {
+		return fmt.Errorf("MaxErrorCallbackGasLimit must be greater than or equal to ErrorCallbackGasLimit. Current value: %d", p.MaxErrorCallbackGasLimit)
+	}
+	if p.SubscriptionExpiryNoticePeriod < 0 || p.SubscriptionExpiryNoticePeriod >= p.SubscriptionPeriod {
+		return fmt.Errorf("SubscriptionExpiryNoticePeriod must be non-negative and lower than the SubscriptionPeriod. Current value: %d", p.SubscriptionExpiryNoticePeriod)
+	}
+	return nil
+}
+
+// ValidateCallbackGasLimit checks the gas limit requested for the error callbacks of a subscription.
+// Zero stands for the default ErrorCallbackGasLimit, otherwise the limit must be between the
+// ErrorCallbackGasLimit and the MaxErrorCallbackGasLimit
+func (p Params) ValidateCallbackGasLimit(callbackGasLimit uint64) error {
+	if callbackGasLimit == 0 {
+		return nil
+	}
+	if callbackGasLimit < p.ErrorCallbackGasLimit || callbackGasLimit > p.MaxErrorCallbackGasLimit {
+		return errorsmod.Wrapf(ErrInvalidCallbackGasLimit, "must be between %d and %d. Current value: %d", p.ErrorCallbackGasLimit, p.MaxErrorCallbackGasLimit, callbackGasLimit)
+	}
 	return nil
 }
+
+// SubscriptionFeeFor returns the fee to subscribe to errors with the given number of filters and callback gas limit.
+// Each filter is charged the SubscriptionFilterFee on top of the SubscriptionFee. The total is then scaled
+// by the ratio of the callback gas limit to the ErrorCallbackGasLimit, rounded up
+func (p Params) SubscriptionFeeFor(filtersCount int, callbackGasLimit uint64) sdk.Coin {
+	fee := p.SubscriptionFee
+	if filtersCount > 0 {
+		fee = fee.Add(sdk.NewCoin(p.SubscriptionFilterFee.Denom, p.SubscriptionFilterFee.Amount.MulRaw(int64(filtersCount))))
+	}
+	if callbackGasLimit <= p.ErrorCallbackGasLimit {
+		return fee
+	}
+	gasLimit := math.NewIntFromUint64(callbackGasLimit)
+	defaultGasLimit := math.NewIntFromUint64(p.ErrorCallbackGasLimit)
+	scaledAmount := fee.Amount.Mul(gasLimit).Add(defaultGasLimit).SubRaw(1).Quo(defaultGasLimit)
+	return sdk.NewCoin(fee.Denom, scaledAmount)
+}
@@ -32,6 +32,23 @@ type Params struct {
 	SubscriptionFee types.Coin `protobuf:"bytes,2,opt,name=subscription_fee,json=subscriptionFee,proto3" json:"subscription_fee"`
 	// subscription_period is the period for which the subscription is valid
 	SubscriptionPeriod int64 `protobuf:"varint,3,opt,name=subscription_period,json=subscriptionPeriod,proto3" json:"subscription_period,omitempty"`
+	// subscription_filter_fee is the fee charged for each filter of a
+	// subscription on top of the subscription_fee
+	SubscriptionFilterFee types.Coin `protobuf:"bytes,4,opt,name=subscription_filter_fee,json=subscriptionFilterFee,proto3" json:"subscription_filter_fee"`
+	// error_callback_gas_limit is the default gas limit of a sudo error
+	// callback. The subscription fee covers a callback with this gas limit
+	ErrorCallbackGasLimit uint64 `protobuf:"varint,5,opt,name=error_callback_gas_limit,json=errorCallbackGasLimit,proto3" json:"error_callback_gas_limit,omitempty"`
+	// max_error_callback_gas_limit is the maximum gas limit of a sudo error
+	// callback which can be purchased when subscribing
+	MaxErrorCallbackGasLimit uint64 `protobuf:"varint,6,opt,name=max_error_callback_gas_limit,json=maxErrorCallbackGasLimit,proto3" json:"max_error_callback_gas_limit,omitempty"`
+	// subscription_expiry_notice_period is the number of blocks before the
+	// expiry of a subscription at which the expiry notice is sent. The notice
+	// is disabled if zero
+	SubscriptionExpiryNoticePeriod int64 `protobuf:"varint,7,opt,name=subscription_expiry_notice_period,json=subscriptionExpiryNoticePeriod,proto3" json:"subscription_expiry_notice_period,omitempty"`
+	// max_errors_per_contract is the maximum number of errors stored in state
+	// for a contract. The oldest errors of the contract are evicted once
+	// exceeded. The number of errors is not limited if zero
+	MaxErrorsPerContract uint64 `protobuf:"varint,8,opt,name=max_errors_per_contract,json=maxErrorsPerContract,proto3" json:"max_errors_per_contract,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -88,6 +105,41 @@ func (m *Params) GetSubscriptionPeriod() int64 {
 	return 0
 }
 
+func (m *Params) GetSubscriptionFilterFee() types.Coin {
+	if m != nil {
+		return m.SubscriptionFilterFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetErrorCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.ErrorCallbackGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxErrorCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.MaxErrorCallbackGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetSubscriptionExpiryNoticePeriod() int64 {
+	if m != nil {
+		return m.SubscriptionExpiryNoticePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxErrorsPerContract() uint64 {
+	if m != nil {
+		return m.MaxErrorsPerContract
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "archway.cwerrors.v1.Params")
 }
@@ -95,26 +147,34 @@ func init() {
 func init() { proto.RegisterFile("archway/cwerrors/v1/params.proto", fileDescriptor_178d89d427939559) }
 
 var fileDescriptor_178d89d427939559 = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x18, 0x85, 0x63, 0x8a, 0x3a, 0x84, 0xa1, 0x90, 0x32, 0x94, 0x0e, 0xa6, 0x62, 0xaa, 0x90, 0xb0,
-	0x15, 0x7a, 0x83, 0x22, 0x31, 0x20, 0x21, 0x55, 0x85, 0x89, 0x25, 0x72, 0xdc, 0x9f, 0xd4, 0x42,
-	0xc9, 0x1f, 0xd9, 0x6e, 0x42, 0x6f, 0xc1, 0x55, 0xb8, 0x45, 0xc7, 0x8e, 0x4c, 0x08, 0x25, 0x17,
-	0x41, 0x75, 0x52, 0x51, 0x36, 0xdb, 0xdf, 0xf3, 0xfb, 0xa4, 0xe7, 0x8f, 0x84, 0x96, 0xcb, 0x52,
-	0xac, 0xb9, 0x2c, 0x41, 0x6b, 0xd4, 0x86, 0x17, 0x21, 0xcf, 0x85, 0x16, 0xa9, 0x61, 0xb9, 0x46,
-	0x8b, 0x41, 0xbf, 0x4d, 0xb0, 0x7d, 0x82, 0x15, 0xe1, 0xf0, 0x3c, 0xc1, 0x04, 0x1d, 0xe7, 0xbb,
-	0x53, 0x13, 0x1d, 0x52, 0x89, 0x26, 0x45, 0xc3, 0x63, 0x61, 0x80, 0x17, 0x61, 0x0c, 0x56, 0x84,
-	0x5c, 0xa2, 0xca, 0x1a, 0x7e, 0xf5, 0x49, 0xfc, 0xee, 0xcc, 0x75, 0x07, 0xd7, 0xfe, 0x99, 0x6b,
-	0x8b, 0x8c, 0x45, 0x0d, 0x8b, 0xc8, 0xaa, 0x14, 0x06, 0x64, 0x44, 0xc6, 0x9d, 0x79, 0xcf, 0x81,
-	0x27, 0xf7, 0xfe, 0xac, 0x52, 0x08, 0x1e, 0xfc, 0x53, 0xb3, 0x8a, 0x8d, 0xd4, 0x2a, 0xb7, 0x0a,
-	0xb3, 0xe8, 0x15, 0x60, 0x70, 0x34, 0x22, 0xe3, 0x93, 0xdb, 0x0b, 0xd6, 0x18, 0xd9, 0xce, 0xc8,
-	0x5a, 0x23, 0xbb, 0x43, 0x95, 0x4d, 0x8f, 0x37, 0xdf, 0x97, 0xde, 0xbc, 0x77, 0xf8, 0xf1, 0x1e,
-	0x20, 0xe0, 0x7e, 0xff, 0x5f, 0x57, 0x0e, 0x5a, 0xe1, 0x62, 0xd0, 0x71, 0xe6, 0xe0, 0x10, 0xcd,
-	0x1c, 0x99, 0x3e, 0x6e, 0x2a, 0x4a, 0xb6, 0x15, 0x25, 0x3f, 0x15, 0x25, 0x1f, 0x35, 0xf5, 0xb6,
-	0x35, 0xf5, 0xbe, 0x6a, 0xea, 0xbd, 0x4c, 0x12, 0x65, 0x97, 0xab, 0x98, 0x49, 0x4c, 0x79, 0xbb,
-	0xd1, 0x4d, 0x06, 0xb6, 0x44, 0xfd, 0xb6, 0xbf, 0xf3, 0xf7, 0xbf, 0x5d, 0xed, 0x3a, 0x07, 0x13,
-	0x77, 0xdd, 0x12, 0x93, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x73, 0xb3, 0xb4, 0x99, 0x78, 0x01,
-	0x00, 0x00,
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0xb3, 0x36, 0x46, 0x19, 0x0f, 0xd5, 0x6d, 0x4b, 0xd7, 0x22, 0x63, 0xf4, 0x14, 0x04,
+	0x67, 0x88, 0x45, 0xbc, 0x79, 0x68, 0xa8, 0xa2, 0xa8, 0x84, 0x28, 0x08, 0x5e, 0x86, 0xd9, 0xe9,
+	0x6b, 0x3a, 0x34, 0xb3, 0xef, 0x32, 0x33, 0xcd, 0x9f, 0x0f, 0xe0, 0xdd, 0x8f, 0xd5, 0x63, 0x8e,
+	0x9e, 0x44, 0x92, 0x2f, 0x22, 0xfb, 0x66, 0xa3, 0x09, 0x28, 0xf4, 0xb6, 0xbb, 0xbf, 0xe7, 0x79,
+	0x9f, 0x67, 0x5f, 0x5e, 0xd6, 0xd6, 0xde, 0x9c, 0x4f, 0xf4, 0x4c, 0x9a, 0x09, 0x78, 0x8f, 0x3e,
+	0xc8, 0x71, 0x57, 0x96, 0xda, 0x6b, 0x17, 0x44, 0xe9, 0x31, 0x62, 0xba, 0x57, 0x2b, 0xc4, 0x5a,
+	0x21, 0xc6, 0xdd, 0xa3, 0xfd, 0x21, 0x0e, 0x91, 0xb8, 0xac, 0x9e, 0x56, 0xd2, 0x23, 0x6e, 0x30,
+	0x38, 0x0c, 0x32, 0xd7, 0x01, 0xe4, 0xb8, 0x9b, 0x43, 0xd4, 0x5d, 0x69, 0xd0, 0x16, 0x2b, 0xfe,
+	0xf8, 0x5b, 0x93, 0xb5, 0xfa, 0x34, 0x3b, 0x7d, 0xc2, 0xee, 0xd1, 0x34, 0x15, 0x22, 0x7a, 0x38,
+	0x53, 0xd1, 0x3a, 0xc8, 0x92, 0x76, 0xd2, 0xd9, 0x19, 0xec, 0x12, 0xf8, 0x48, 0xdf, 0x3f, 0x59,
+	0x07, 0xe9, 0x5b, 0x76, 0x37, 0x5c, 0xe6, 0xc1, 0x78, 0x5b, 0x46, 0x8b, 0x85, 0xfa, 0x0a, 0x90,
+	0xdd, 0x68, 0x27, 0x9d, 0x3b, 0xcf, 0xee, 0x8b, 0x55, 0xa2, 0xa8, 0x12, 0x45, 0x9d, 0x28, 0x7a,
+	0x68, 0x8b, 0x93, 0xe6, 0xd5, 0xcf, 0x87, 0x8d, 0xc1, 0xee, 0xa6, 0xf1, 0x15, 0x40, 0x2a, 0xd9,
+	0xde, 0xd6, 0xac, 0x12, 0xbc, 0xc5, 0xb3, 0x6c, 0x87, 0x92, 0xd3, 0x4d, 0xd4, 0x27, 0x92, 0x7e,
+	0x66, 0x87, 0xdb, 0xe1, 0x76, 0x14, 0xc1, 0x53, 0x87, 0xe6, 0xf5, 0x3a, 0x1c, 0x6c, 0x75, 0x20,
+	0x7b, 0xd5, 0xe4, 0x05, 0xcb, 0x56, 0x1b, 0x30, 0x7a, 0x34, 0xca, 0xb5, 0xb9, 0x50, 0x43, 0x1d,
+	0xd4, 0xc8, 0x3a, 0x1b, 0xb3, 0x9b, 0xed, 0xa4, 0xd3, 0x1c, 0x1c, 0x10, 0xef, 0xd5, 0xf8, 0xb5,
+	0x0e, 0xef, 0x2a, 0x98, 0xbe, 0x64, 0x0f, 0x9c, 0x9e, 0xaa, 0xff, 0x9a, 0x5b, 0x64, 0xce, 0x9c,
+	0x9e, 0x9e, 0xfe, 0xd3, 0xff, 0x86, 0x3d, 0xda, 0xfa, 0x23, 0x98, 0x96, 0xd6, 0xcf, 0x54, 0x81,
+	0xd1, 0x1a, 0x58, 0x2f, 0xe4, 0x16, 0x2d, 0x84, 0x6f, 0x0a, 0x4f, 0x49, 0xf7, 0x81, 0x64, 0xf5,
+	0x72, 0x9e, 0xb3, 0xc3, 0x3f, 0x55, 0x42, 0x65, 0x55, 0x06, 0x8b, 0xe8, 0xb5, 0x89, 0xd9, 0x6d,
+	0x6a, 0xb1, 0xbf, 0x6e, 0x11, 0xfa, 0xe0, 0x7b, 0x35, 0x3b, 0x79, 0x7f, 0xb5, 0xe0, 0xc9, 0x7c,
+	0xc1, 0x93, 0x5f, 0x0b, 0x9e, 0x7c, 0x5f, 0xf2, 0xc6, 0x7c, 0xc9, 0x1b, 0x3f, 0x96, 0xbc, 0xf1,
+	0xe5, 0x78, 0x68, 0xe3, 0xf9, 0x65, 0x2e, 0x0c, 0x3a, 0x59, 0xdf, 0xdd, 0xd3, 0x02, 0xe2, 0x04,
+	0xfd, 0xc5, 0xfa, 0x5d, 0x4e, 0xff, 0xde, 0x6a, 0x9c, 0x95, 0x10, 0xf2, 0x16, 0x5d, 0xd7, 0xf1,
+	0xef, 0x01, 0x00, 0x18, 0xe0, 0xb5, 0x97, 0xcc, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -137,6 +197,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxErrorsPerContract != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxErrorsPerContract))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SubscriptionExpiryNoticePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SubscriptionExpiryNoticePeriod))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxErrorCallbackGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxErrorCallbackGasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ErrorCallbackGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ErrorCallbackGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.SubscriptionFilterFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.SubscriptionPeriod != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SubscriptionPeriod))
 		i--
@@ -185,6 +275,20 @@ func (m *Params) Size() (n int) {
 	if m.SubscriptionPeriod != 0 {
 		n += 1 + sovParams(uint64(m.SubscriptionPeriod))
 	}
+	l = m.SubscriptionFilterFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ErrorCallbackGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.ErrorCallbackGasLimit))
+	}
+	if m.MaxErrorCallbackGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.MaxErrorCallbackGasLimit))
+	}
+	if m.SubscriptionExpiryNoticePeriod != 0 {
+		n += 1 + sovParams(uint64(m.SubscriptionExpiryNoticePeriod))
+	}
+	if m.MaxErrorsPerContract != 0 {
+		n += 1 + sovParams(uint64(m.MaxErrorsPerContract))
+	}
 	return n
 }
 
@@ -294,6 +398,115 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionFilterFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubscriptionFilterFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCallbackGasLimit", wireType)
+			}
+			m.ErrorCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxErrorCallbackGasLimit", wireType)
+			}
+			m.MaxErrorCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxErrorCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionExpiryNoticePeriod", wireType)
+			}
+			m.SubscriptionExpiryNoticePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionExpiryNoticePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxErrorsPerContract", wireType)
+			}
+			m.MaxErrorsPerContract = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxErrorsPerContract |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -29,6 +29,11 @@ func TestParamsValidate(t *testing.T) {
 				100,
 				sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
 				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				150_000,
+				1_000_000,
+				10,
+				100,
 			),
 			errExpected: false,
 		},
@@ -38,6 +43,11 @@ func TestParamsValidate(t *testing.T) {
 				0,
 				sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
 				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				150_000,
+				1_000_000,
+				10,
+				100,
 			),
 			errExpected: true,
 		},
@@ -47,6 +57,11 @@ func TestParamsValidate(t *testing.T) {
 				-2,
 				sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
 				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				150_000,
+				1_000_000,
+				10,
+				100,
 			),
 			errExpected: true,
 		},
@@ -56,6 +71,11 @@ func TestParamsValidate(t *testing.T) {
 				100,
 				sdk.Coin{Denom: "", Amount: math.NewInt(100)},
 				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				150_000,
+				1_000_000,
+				10,
+				100,
 			),
 			errExpected: true,
 		},
@@ -65,6 +85,95 @@ func TestParamsValidate(t *testing.T) {
 				100,
 				sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
 				-2,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				150_000,
+				1_000_000,
+				10,
+				100,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: SubscriptionFilterFee: invalid",
+			params: types.NewParams(
+				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				100,
+				sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: math.NewInt(-1)},
+				150_000,
+				1_000_000,
+				10,
+				100,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: SubscriptionFilterFee: denom mismatch",
+			params: types.NewParams(
+				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				100,
+				sdk.NewInt64Coin("uatom", 10),
+				150_000,
+				1_000_000,
+				10,
+				100,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: ErrorCallbackGasLimit: zero",
+			params: types.NewParams(
+				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				0,
+				1_000_000,
+				10,
+				100,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: MaxErrorCallbackGasLimit: lower than ErrorCallbackGasLimit",
+			params: types.NewParams(
+				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				150_000,
+				100_000,
+				10,
+				100,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: SubscriptionExpiryNoticePeriod: negative",
+			params: types.NewParams(
+				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				150_000,
+				1_000_000,
+				-1,
+				100,
+			),
+			errExpected: true,
+		},
+		{
+			name: "Fail: SubscriptionExpiryNoticePeriod: not lower than SubscriptionPeriod",
+			params: types.NewParams(
+				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				100,
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				150_000,
+				1_000_000,
+				100,
+				100,
 			),
 			errExpected: true,
 		},
@@ -81,3 +190,29 @@ func TestParamsValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsSubscriptionFeeFor(t *testing.T) {
+	params := types.DefaultParams()
+	params.SubscriptionFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	params.SubscriptionFilterFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	params.ErrorCallbackGasLimit = 150_000
+
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), params.SubscriptionFeeFor(0, 0))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 130), params.SubscriptionFeeFor(3, 0))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), params.SubscriptionFeeFor(0, 150_000))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), params.SubscriptionFeeFor(0, 300_000))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 260), params.SubscriptionFeeFor(3, 300_000))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 101), params.SubscriptionFeeFor(0, 150_001))
+}
+
+func TestParamsValidateCallbackGasLimit(t *testing.T) {
+	params := types.DefaultParams()
+	params.ErrorCallbackGasLimit = 150_000
+	params.MaxErrorCallbackGasLimit = 1_000_000
+
+	assert.NoError(t, params.ValidateCallbackGasLimit(0))
+	assert.NoError(t, params.ValidateCallbackGasLimit(150_000))
+	assert.NoError(t, params.ValidateCallbackGasLimit(1_000_000))
+	assert.ErrorIs(t, params.ValidateCallbackGasLimit(100_000), types.ErrInvalidCallbackGasLimit)
+	assert.ErrorIs(t, params.ValidateCallbackGasLimit(1_000_001), types.ErrInvalidCallbackGasLimit)
+}
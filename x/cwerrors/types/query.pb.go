@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -117,6 +118,19 @@ func (m *QueryParamsResponse) GetParams() Params {
 type QueryErrorsRequest struct {
 	// contract_address is the address of the contract whose errors to query for
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// module_name if set, only returns the errors thrown by the given module
+	ModuleName string `protobuf:"bytes,3,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// error_codes if set, only returns the errors with one of the given module
+	// level error codes
+	ErrorCodes []int32 `protobuf:"varint,4,rep,packed,name=error_codes,json=errorCodes,proto3" json:"error_codes,omitempty"`
+	// min_height if set, only returns the errors which happened at or after the
+	// given block height
+	MinHeight int64 `protobuf:"varint,5,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// max_height if set, only returns the errors which happened at or before the
+	// given block height
+	MaxHeight int64 `protobuf:"varint,6,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
 }
 
 func (m *QueryErrorsRequest) Reset()         { *m = QueryErrorsRequest{} }
@@ -159,10 +173,47 @@ func (m *QueryErrorsRequest) GetContractAddress() string {
 	return ""
 }
 
+func (m *QueryErrorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryErrorsRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *QueryErrorsRequest) GetErrorCodes() []int32 {
+	if m != nil {
+		return m.ErrorCodes
+	}
+	return nil
+}
+
+func (m *QueryErrorsRequest) GetMinHeight() int64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *QueryErrorsRequest) GetMaxHeight() int64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
 // QueryErrorsResponse is the response for Query.Errors.
 type QueryErrorsResponse struct {
-	// errors defines all the contract errors which will be returned
+	// errors defines the contract errors which will be returned
 	Errors []SudoError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryErrorsResponse) Reset()         { *m = QueryErrorsResponse{} }
@@ -205,6 +256,13 @@ func (m *QueryErrorsResponse) GetErrors() []SudoError {
 	return nil
 }
 
+func (m *QueryErrorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryIsSubscribedRequest is the request for Query.IsSubscribed.
 type QueryIsSubscribedRequest struct {
 	// contract_address is the address of the contract to query if subscribed
@@ -258,6 +316,20 @@ type QueryIsSubscribedResponse struct {
 	// subscription_valid_till defines the block height till which the
 	// subscription is valid
 	SubscriptionValidTill int64 `protobuf:"varint,2,opt,name=subscription_valid_till,json=subscriptionValidTill,proto3" json:"subscription_valid_till,omitempty"`
+	// filters defines the filters of the errors delivered to the contract. All
+	// the errors are delivered if empty
+	Filters []SubscriptionFilter `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters"`
+	// callback_gas_limit defines the gas limit of the sudo error callbacks of
+	// the contract
+	CallbackGasLimit uint64 `protobuf:"varint,4,opt,name=callback_gas_limit,json=callbackGasLimit,proto3" json:"callback_gas_limit,omitempty"`
+	// auto_renew defines if the subscription is renewed when it expires
+	AutoRenew bool `protobuf:"varint,5,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// renewal_payer is the address charged the subscription fee on renewal. The
+	// contract itself is charged if empty
+	RenewalPayer string `protobuf:"bytes,6,opt,name=renewal_payer,json=renewalPayer,proto3" json:"renewal_payer,omitempty"`
+	// expiry_notice defines if the contract receives a sudo notice before the
+	// subscription expires
+	ExpiryNotice bool `protobuf:"varint,7,opt,name=expiry_notice,json=expiryNotice,proto3" json:"expiry_notice,omitempty"`
 }
 
 func (m *QueryIsSubscribedResponse) Reset()         { *m = QueryIsSubscribedResponse{} }
@@ -307,6 +379,145 @@ func (m *QueryIsSubscribedResponse) GetSubscriptionValidTill() int64 {
 	return 0
 }
 
+func (m *QueryIsSubscribedResponse) GetFilters() []SubscriptionFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *QueryIsSubscribedResponse) GetCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.CallbackGasLimit
+	}
+	return 0
+}
+
+func (m *QueryIsSubscribedResponse) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
+}
+
+func (m *QueryIsSubscribedResponse) GetRenewalPayer() string {
+	if m != nil {
+		return m.RenewalPayer
+	}
+	return ""
+}
+
+func (m *QueryIsSubscribedResponse) GetExpiryNotice() bool {
+	if m != nil {
+		return m.ExpiryNotice
+	}
+	return false
+}
+
+// QueryErrorStatsRequest is the request for Query.ErrorStats.
+type QueryErrorStatsRequest struct {
+	// contract_address is the address of the contract to query the error stats
+	// for
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *QueryErrorStatsRequest) Reset()         { *m = QueryErrorStatsRequest{} }
+func (m *QueryErrorStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryErrorStatsRequest) ProtoMessage()    {}
+func (*QueryErrorStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1be36abcb817ffd, []int{6}
+}
+func (m *QueryErrorStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryErrorStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryErrorStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryErrorStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryErrorStatsRequest.Merge(m, src)
+}
+func (m *QueryErrorStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryErrorStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryErrorStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryErrorStatsRequest proto.InternalMessageInfo
+
+func (m *QueryErrorStatsRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// QueryErrorStatsResponse is the response for Query.ErrorStats.
+type QueryErrorStatsResponse struct {
+	// stored_errors is the number of errors of the contract currently stored in
+	// state
+	StoredErrors uint64 `protobuf:"varint,1,opt,name=stored_errors,json=storedErrors,proto3" json:"stored_errors,omitempty"`
+	// dropped_errors is the total number of errors of the contract evicted from
+	// state to respect the max_errors_per_contract param
+	DroppedErrors uint64 `protobuf:"varint,2,opt,name=dropped_errors,json=droppedErrors,proto3" json:"dropped_errors,omitempty"`
+}
+
+func (m *QueryErrorStatsResponse) Reset()         { *m = QueryErrorStatsResponse{} }
+func (m *QueryErrorStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryErrorStatsResponse) ProtoMessage()    {}
+func (*QueryErrorStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1be36abcb817ffd, []int{7}
+}
+func (m *QueryErrorStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryErrorStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryErrorStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryErrorStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryErrorStatsResponse.Merge(m, src)
+}
+func (m *QueryErrorStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryErrorStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryErrorStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryErrorStatsResponse proto.InternalMessageInfo
+
+func (m *QueryErrorStatsResponse) GetStoredErrors() uint64 {
+	if m != nil {
+		return m.StoredErrors
+	}
+	return 0
+}
+
+func (m *QueryErrorStatsResponse) GetDroppedErrors() uint64 {
+	if m != nil {
+		return m.DroppedErrors
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "archway.cwerrors.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "archway.cwerrors.v1.QueryParamsResponse")
@@ -314,44 +525,66 @@ func init() {
 	proto.RegisterType((*QueryErrorsResponse)(nil), "archway.cwerrors.v1.QueryErrorsResponse")
 	proto.RegisterType((*QueryIsSubscribedRequest)(nil), "archway.cwerrors.v1.QueryIsSubscribedRequest")
 	proto.RegisterType((*QueryIsSubscribedResponse)(nil), "archway.cwerrors.v1.QueryIsSubscribedResponse")
+	proto.RegisterType((*QueryErrorStatsRequest)(nil), "archway.cwerrors.v1.QueryErrorStatsRequest")
+	proto.RegisterType((*QueryErrorStatsResponse)(nil), "archway.cwerrors.v1.QueryErrorStatsResponse")
 }
 
 func init() { proto.RegisterFile("archway/cwerrors/v1/query.proto", fileDescriptor_a1be36abcb817ffd) }
 
 var fileDescriptor_a1be36abcb817ffd = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6a, 0x13, 0x41,
-	0x18, 0xc7, 0x33, 0x8d, 0x06, 0x9d, 0x0a, 0xca, 0xb4, 0x62, 0x4c, 0x75, 0x1a, 0x56, 0xc1, 0x28,
-	0x74, 0x87, 0xa4, 0x20, 0x08, 0x82, 0x58, 0xe8, 0xc1, 0x83, 0x50, 0x37, 0xe2, 0xc1, 0x4b, 0x98,
-	0xdd, 0x1d, 0xb6, 0x83, 0x9b, 0x9d, 0xed, 0xcc, 0x6c, 0x62, 0x6e, 0xe2, 0x13, 0x08, 0x9e, 0x7c,
-	0x04, 0xdf, 0xa4, 0xc7, 0x82, 0x17, 0x4f, 0x22, 0x89, 0x0f, 0x22, 0x99, 0x99, 0x4d, 0x13, 0xdc,
-	0xb6, 0xd2, 0xdb, 0xec, 0xf7, 0xfd, 0xbf, 0xff, 0xf7, 0x9b, 0xfd, 0xef, 0xc2, 0x6d, 0x2a, 0xa3,
-	0xc3, 0x31, 0x9d, 0x90, 0x68, 0xcc, 0xa4, 0x14, 0x52, 0x91, 0x51, 0x97, 0x1c, 0x15, 0x4c, 0x4e,
-	0xfc, 0x5c, 0x0a, 0x2d, 0xd0, 0x86, 0x13, 0xf8, 0xa5, 0xc0, 0x1f, 0x75, 0x5b, 0x9b, 0x89, 0x48,
-	0x84, 0xe9, 0x93, 0xf9, 0xc9, 0x4a, 0x5b, 0xf7, 0x12, 0x21, 0x92, 0x94, 0x11, 0x9a, 0x73, 0x42,
-	0xb3, 0x4c, 0x68, 0xaa, 0xb9, 0xc8, 0x94, 0xeb, 0xe2, 0x48, 0xa8, 0xa1, 0x50, 0x24, 0xa4, 0x8a,
-	0x91, 0x51, 0x37, 0x64, 0x9a, 0x76, 0x49, 0x24, 0x78, 0xe6, 0xfa, 0x5e, 0x15, 0xc9, 0x62, 0xa9,
-	0xd5, 0xb4, 0xab, 0x34, 0x39, 0x95, 0x74, 0xe8, 0x14, 0xde, 0x26, 0x44, 0x6f, 0xe6, 0xf4, 0x07,
-	0xa6, 0x18, 0xb0, 0xa3, 0x82, 0x29, 0xed, 0x1d, 0xc0, 0x8d, 0x95, 0xaa, 0xca, 0x45, 0xa6, 0x18,
-	0x7a, 0x06, 0x1b, 0x76, 0xb8, 0x09, 0xda, 0xa0, 0xb3, 0xde, 0xdb, 0xf2, 0x2b, 0x2e, 0xeb, 0xdb,
-	0xa1, 0xbd, 0x2b, 0xc7, 0xbf, 0xb6, 0x6b, 0x81, 0x1b, 0xf0, 0x5e, 0xb8, 0x3d, 0xfb, 0x46, 0xe6,
-	0xf6, 0xa0, 0xc7, 0xf0, 0x56, 0x24, 0x32, 0x2d, 0x69, 0xa4, 0x07, 0x34, 0x8e, 0x25, 0x53, 0xd6,
-	0xfa, 0x7a, 0x70, 0xb3, 0xac, 0xbf, 0xb4, 0x65, 0xaf, 0xef, 0x90, 0x4a, 0x03, 0x87, 0xf4, 0x1c,
-	0x36, 0xec, 0xe6, 0x26, 0x68, 0xd7, 0x3b, 0xeb, 0x3d, 0x5c, 0x89, 0xd4, 0x2f, 0x62, 0x61, 0x06,
-	0x4b, 0x2a, 0xdb, 0xf2, 0xf6, 0x61, 0xd3, 0x98, 0xbe, 0x52, 0xfd, 0x22, 0x54, 0x91, 0xe4, 0x21,
-	0x8b, 0x2f, 0xc1, 0xa6, 0xe0, 0xdd, 0x0a, 0x1b, 0x47, 0x88, 0x21, 0x54, 0x8b, 0xaa, 0x71, 0xb8,
-	0x16, 0x2c, 0x55, 0xd0, 0x53, 0x78, 0xc7, 0x3d, 0xe5, 0xf3, 0xf8, 0x07, 0x23, 0x9a, 0xf2, 0x78,
-	0xa0, 0x79, 0x9a, 0x36, 0xd7, 0xda, 0xa0, 0x53, 0x0f, 0x6e, 0x2f, 0xb7, 0xdf, 0xcd, 0xbb, 0x6f,
-	0x79, 0x9a, 0xf6, 0xbe, 0xd7, 0xe1, 0x55, 0xb3, 0x15, 0x7d, 0x02, 0xb0, 0x61, 0x5f, 0x3a, 0x7a,
-	0x54, 0x79, 0xfd, 0x7f, 0x13, 0x6e, 0x75, 0x2e, 0x16, 0x5a, 0x7e, 0xef, 0xc1, 0xe7, 0x1f, 0x7f,
-	0xbe, 0xae, 0xdd, 0x47, 0x5b, 0xe4, 0xec, 0x8f, 0xc9, 0x20, 0xd8, 0x64, 0xce, 0x43, 0x58, 0x09,
-	0xff, 0x3c, 0x84, 0xd5, 0x90, 0x2f, 0x40, 0xb0, 0x27, 0xf4, 0x0d, 0xc0, 0x1b, 0xcb, 0x01, 0xa0,
-	0x9d, 0xb3, 0xfd, 0x2b, 0xf2, 0x6e, 0xf9, 0xff, 0x2b, 0x77, 0x50, 0x4f, 0x0c, 0xd4, 0x43, 0xe4,
-	0x55, 0x42, 0x71, 0x35, 0x38, 0xcd, 0x78, 0xef, 0xf5, 0xf1, 0x14, 0x83, 0x93, 0x29, 0x06, 0xbf,
-	0xa7, 0x18, 0x7c, 0x99, 0xe1, 0xda, 0xc9, 0x0c, 0xd7, 0x7e, 0xce, 0x70, 0xed, 0xfd, 0x6e, 0xc2,
-	0xf5, 0x61, 0x11, 0xfa, 0x91, 0x18, 0x96, 0x3e, 0x3b, 0x19, 0xd3, 0x63, 0x21, 0x3f, 0x2c, 0x7c,
-	0x3f, 0x9e, 0x3a, 0xeb, 0x49, 0xce, 0x54, 0xd8, 0x30, 0xff, 0xee, 0xee, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xf9, 0xd7, 0x52, 0x90, 0x8d, 0x04, 0x00, 0x00,
+	// 831 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x18, 0x8d, 0x93, 0x34, 0xbb, 0x99, 0xa6, 0xb0, 0x9a, 0x2e, 0xac, 0xc9, 0xb2, 0x6e, 0xe4, 0x02,
+	0x6b, 0x96, 0x5d, 0x5b, 0xe9, 0x4a, 0x48, 0x48, 0x5c, 0xd8, 0x55, 0xb7, 0x20, 0xc1, 0x2a, 0xb8,
+	0x88, 0x03, 0x17, 0x6b, 0x62, 0x0f, 0xce, 0x68, 0x6d, 0x8f, 0x3b, 0x33, 0xf9, 0x75, 0x43, 0x5c,
+	0xb8, 0x82, 0x38, 0x21, 0xf1, 0xaf, 0x70, 0xef, 0xb1, 0x52, 0x2f, 0x9c, 0x10, 0x6a, 0xf9, 0x43,
+	0x90, 0x67, 0x26, 0x89, 0xab, 0x3a, 0xa4, 0xec, 0xcd, 0x79, 0xef, 0x7d, 0xdf, 0xbc, 0x79, 0xf3,
+	0xcd, 0x04, 0xec, 0x21, 0x16, 0x8e, 0xa6, 0x68, 0xee, 0x85, 0x53, 0xcc, 0x18, 0x65, 0xdc, 0x9b,
+	0xf4, 0xbd, 0x93, 0x31, 0x66, 0x73, 0x37, 0x67, 0x54, 0x50, 0xb8, 0xab, 0x05, 0xee, 0x42, 0xe0,
+	0x4e, 0xfa, 0xdd, 0xbb, 0x31, 0x8d, 0xa9, 0xe4, 0xbd, 0xe2, 0x4b, 0x49, 0xbb, 0xef, 0xc6, 0x94,
+	0xc6, 0x09, 0xf6, 0x50, 0x4e, 0x3c, 0x94, 0x65, 0x54, 0x20, 0x41, 0x68, 0xc6, 0x35, 0x6b, 0x85,
+	0x94, 0xa7, 0x94, 0x7b, 0x43, 0xc4, 0xb1, 0x37, 0xe9, 0x0f, 0xb1, 0x40, 0x7d, 0x2f, 0xa4, 0x24,
+	0xd3, 0xfc, 0xa3, 0x32, 0x2f, 0x1d, 0x2c, 0x55, 0x39, 0x8a, 0x49, 0x26, 0x9b, 0x69, 0xad, 0x5d,
+	0xe5, 0x7a, 0x69, 0x50, 0x69, 0x7a, 0x55, 0x9a, 0x1c, 0x31, 0x94, 0x6a, 0x85, 0x7d, 0x17, 0xc0,
+	0xaf, 0x8b, 0x75, 0x06, 0x12, 0xf4, 0xf1, 0xc9, 0x18, 0x73, 0x61, 0x0f, 0xc0, 0xee, 0x15, 0x94,
+	0xe7, 0x34, 0xe3, 0x18, 0x7e, 0x02, 0x5a, 0xaa, 0xd8, 0x34, 0x7a, 0x86, 0xb3, 0x7d, 0x70, 0xdf,
+	0xad, 0x08, 0xc6, 0x55, 0x45, 0xcf, 0x9a, 0xa7, 0x7f, 0xed, 0xd5, 0x7c, 0x5d, 0x60, 0xff, 0x54,
+	0xd7, 0x0b, 0x1d, 0x4a, 0x9d, 0x5e, 0x08, 0x7e, 0x08, 0xee, 0x84, 0x34, 0x13, 0x0c, 0x85, 0x22,
+	0x40, 0x51, 0xc4, 0x30, 0x57, 0xbd, 0xdb, 0xfe, 0x9b, 0x0b, 0xfc, 0x33, 0x05, 0xc3, 0x17, 0x00,
+	0xac, 0x32, 0x30, 0xeb, 0xd2, 0xc0, 0x07, 0xae, 0x0a, 0xcc, 0x2d, 0x02, 0x73, 0xd5, 0x91, 0xe9,
+	0xc0, 0xdc, 0x01, 0x8a, 0xb1, 0x5e, 0xc6, 0x2f, 0x55, 0xc2, 0x3d, 0xb0, 0x9d, 0xd2, 0x68, 0x9c,
+	0xe0, 0x20, 0x43, 0x29, 0x36, 0x1b, 0x72, 0x35, 0xa0, 0xa0, 0x97, 0x28, 0xc5, 0x85, 0x40, 0x6e,
+	0x26, 0x08, 0x69, 0x84, 0xb9, 0xd9, 0xec, 0x35, 0x9c, 0x2d, 0x1f, 0x48, 0xe8, 0x79, 0x81, 0xc0,
+	0x07, 0x00, 0xa4, 0x24, 0x0b, 0x46, 0x98, 0xc4, 0x23, 0x61, 0x6e, 0xf5, 0x0c, 0xa7, 0xe1, 0xb7,
+	0x53, 0x92, 0x7d, 0x2e, 0x01, 0x49, 0xa3, 0xd9, 0x82, 0x6e, 0x69, 0x1a, 0xcd, 0x14, 0x6d, 0xff,
+	0x6e, 0x80, 0xdd, 0x2b, 0x49, 0xe8, 0x70, 0x3f, 0x05, 0x2d, 0x95, 0xa1, 0x69, 0xf4, 0x1a, 0xce,
+	0xf6, 0x81, 0x55, 0x19, 0xee, 0xf1, 0x38, 0xa2, 0xb2, 0x70, 0x91, 0xaf, 0xa2, 0xe0, 0x51, 0x45,
+	0x3a, 0x0f, 0x37, 0xa6, 0xa3, 0x96, 0x2e, 0xc7, 0x63, 0x1f, 0x02, 0x53, 0xba, 0xfb, 0x82, 0x1f,
+	0x8f, 0x87, 0x3c, 0x64, 0x64, 0x88, 0xa3, 0xff, 0x7f, 0x5a, 0xf6, 0x79, 0x1d, 0xbc, 0x53, 0xd1,
+	0x47, 0xef, 0xd5, 0x02, 0x80, 0x2f, 0x51, 0xd9, 0xe2, 0xb6, 0x5f, 0x42, 0xe0, 0xc7, 0xe0, 0x9e,
+	0xfe, 0x95, 0x17, 0xa6, 0x82, 0x09, 0x4a, 0x48, 0x14, 0x08, 0x92, 0x24, 0x72, 0x6b, 0x0d, 0xff,
+	0xad, 0x32, 0xfd, 0x6d, 0xc1, 0x7e, 0x43, 0x92, 0x04, 0x1e, 0x81, 0x5b, 0xdf, 0x93, 0x44, 0x60,
+	0xc6, 0xcd, 0x86, 0x0c, 0xf1, 0xe1, 0x9a, 0x10, 0x57, 0xc5, 0x2f, 0xa4, 0x5e, 0xa7, 0xb9, 0xa8,
+	0x86, 0x8f, 0x01, 0x0c, 0x51, 0x92, 0x0c, 0x51, 0xf8, 0x2a, 0x88, 0x11, 0x0f, 0x12, 0x92, 0x12,
+	0x61, 0x36, 0x7b, 0x86, 0xd3, 0xf4, 0xef, 0x2c, 0x98, 0x23, 0xc4, 0xbf, 0x2c, 0xf0, 0xe2, 0xc4,
+	0xd1, 0x58, 0xd0, 0x80, 0xe1, 0x0c, 0x4f, 0xe5, 0x40, 0xdc, 0xf6, 0xdb, 0x05, 0xe2, 0x17, 0x00,
+	0xdc, 0x07, 0x3b, 0x92, 0x41, 0x49, 0x90, 0xa3, 0x39, 0x66, 0x72, 0x26, 0xda, 0x7e, 0x47, 0x83,
+	0x83, 0x02, 0x2b, 0x44, 0x78, 0x96, 0x13, 0x36, 0x0f, 0x32, 0x2a, 0x48, 0x88, 0xcd, 0x5b, 0xb2,
+	0x4d, 0x47, 0x81, 0x2f, 0x25, 0x66, 0x3f, 0x07, 0x6f, 0xaf, 0x46, 0xe7, 0x58, 0x20, 0xf1, 0x1a,
+	0x17, 0xc9, 0xc6, 0xe0, 0xde, 0xb5, 0x26, 0xfa, 0x5c, 0xf6, 0xc1, 0x0e, 0x17, 0x94, 0xe1, 0x28,
+	0x58, 0x8e, 0x62, 0xb1, 0xe3, 0x8e, 0x02, 0x0f, 0xd5, 0xa8, 0xbd, 0x0f, 0xde, 0x88, 0x18, 0xcd,
+	0xf3, 0x95, 0xaa, 0x2e, 0x55, 0x3b, 0x1a, 0x55, 0xb2, 0x83, 0x3f, 0x9a, 0x60, 0x4b, 0xae, 0x03,
+	0x7f, 0x30, 0x40, 0x4b, 0x3d, 0x0a, 0xb0, 0xfa, 0x3c, 0xae, 0xbf, 0x40, 0x5d, 0x67, 0xb3, 0x50,
+	0x79, 0xb6, 0xf7, 0x7f, 0x3c, 0xff, 0xe7, 0xd7, 0xfa, 0x03, 0x78, 0xdf, 0x5b, 0xff, 0xd8, 0x49,
+	0x0b, 0xda, 0xfe, 0x7f, 0x58, 0xb8, 0xf2, 0x36, 0x75, 0x9d, 0xcd, 0xc2, 0x1b, 0x59, 0x50, 0x5f,
+	0xf0, 0x37, 0x03, 0x74, 0xca, 0x97, 0x01, 0x3e, 0x59, 0xdf, 0xbf, 0xe2, 0xf2, 0x75, 0xdd, 0x9b,
+	0xca, 0xb5, 0xa9, 0x47, 0xd2, 0xd4, 0x7b, 0xd0, 0xae, 0x34, 0x45, 0x78, 0x50, 0xba, 0x6f, 0xbf,
+	0x18, 0x00, 0xac, 0xc6, 0x01, 0x7e, 0xb4, 0x61, 0xe7, 0xe5, 0xc9, 0xeb, 0x3e, 0xbe, 0x99, 0x58,
+	0xbb, 0x72, 0xa4, 0x2b, 0x1b, 0xf6, 0xd6, 0x47, 0x15, 0xf0, 0xa2, 0xe2, 0xd9, 0x57, 0xa7, 0x17,
+	0x96, 0x71, 0x76, 0x61, 0x19, 0x7f, 0x5f, 0x58, 0xc6, 0xcf, 0x97, 0x56, 0xed, 0xec, 0xd2, 0xaa,
+	0xfd, 0x79, 0x69, 0xd5, 0xbe, 0x7b, 0x1a, 0x13, 0x31, 0x1a, 0x0f, 0xdd, 0x90, 0xa6, 0x8b, 0x2e,
+	0x4f, 0x32, 0x2c, 0xa6, 0x94, 0xbd, 0x5a, 0x76, 0x9d, 0xad, 0xfa, 0x8a, 0x79, 0x8e, 0xf9, 0xb0,
+	0x25, 0xff, 0xef, 0x9e, 0xfe, 0x3b, 0x00, 0xe6, 0x59, 0x6c, 0x97, 0xed, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -368,10 +601,15 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries all the module parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Errors queries all the errors for a given contract.
+	// Errors queries the errors for a given contract with pagination.
+	// The errors can be filtered by module name, error code and block height.
 	Errors(ctx context.Context, in *QueryErrorsRequest, opts ...grpc.CallOption) (*QueryErrorsResponse, error)
 	// IsSubscribed queries if a contract is subscribed to sudo error callbacks.
 	IsSubscribed(ctx context.Context, in *QueryIsSubscribedRequest, opts ...grpc.CallOption) (*QueryIsSubscribedResponse, error)
+	// ErrorStats queries the number of errors stored in state for a given
+	// contract and the number of its errors evicted to respect the
+	// max_errors_per_contract param.
+	ErrorStats(ctx context.Context, in *QueryErrorStatsRequest, opts ...grpc.CallOption) (*QueryErrorStatsResponse, error)
 }
 
 type queryClient struct {
@@ -409,14 +647,28 @@ func (c *queryClient) IsSubscribed(ctx context.Context, in *QueryIsSubscribedReq
 	return out, nil
 }
 
+func (c *queryClient) ErrorStats(ctx context.Context, in *QueryErrorStatsRequest, opts ...grpc.CallOption) (*QueryErrorStatsResponse, error) {
+	out := new(QueryErrorStatsResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwerrors.v1.Query/ErrorStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all the module parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Errors queries all the errors for a given contract.
+	// Errors queries the errors for a given contract with pagination.
+	// The errors can be filtered by module name, error code and block height.
 	Errors(context.Context, *QueryErrorsRequest) (*QueryErrorsResponse, error)
 	// IsSubscribed queries if a contract is subscribed to sudo error callbacks.
 	IsSubscribed(context.Context, *QueryIsSubscribedRequest) (*QueryIsSubscribedResponse, error)
+	// ErrorStats queries the number of errors stored in state for a given
+	// contract and the number of its errors evicted to respect the
+	// max_errors_per_contract param.
+	ErrorStats(context.Context, *QueryErrorStatsRequest) (*QueryErrorStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -432,6 +684,9 @@ func (*UnimplementedQueryServer) Errors(ctx context.Context, req *QueryErrorsReq
 func (*UnimplementedQueryServer) IsSubscribed(ctx context.Context, req *QueryIsSubscribedRequest) (*QueryIsSubscribedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsSubscribed not implemented")
 }
+func (*UnimplementedQueryServer) ErrorStats(ctx context.Context, req *QueryErrorStatsRequest) (*QueryErrorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ErrorStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -491,6 +746,24 @@ func _Query_IsSubscribed_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ErrorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryErrorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ErrorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwerrors.v1.Query/ErrorStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ErrorStats(ctx, req.(*QueryErrorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "archway.cwerrors.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -507,6 +780,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IsSubscribed",
 			Handler:    _Query_IsSubscribed_Handler,
 		},
+		{
+			MethodName: "ErrorStats",
+			Handler:    _Query_ErrorStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "archway/cwerrors/v1/query.proto",
@@ -588,6 +865,54 @@ func (m *QueryErrorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ErrorCodes) > 0 {
+		dAtA3 := make([]byte, len(m.ErrorCodes)*10)
+		var j2 int
+		for _, num1 := range m.ErrorCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintQuery(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ContractAddress) > 0 {
 		i -= len(m.ContractAddress)
 		copy(dAtA[i:], m.ContractAddress)
@@ -618,6 +943,18 @@ func (m *QueryErrorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Errors) > 0 {
 		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -685,6 +1022,52 @@ func (m *QueryIsSubscribedResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryNotice {
+		i--
+		if m.ExpiryNotice {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RenewalPayer) > 0 {
+		i -= len(m.RenewalPayer)
+		copy(dAtA[i:], m.RenewalPayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RenewalPayer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CallbackGasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CallbackGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.SubscriptionValidTill != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.SubscriptionValidTill))
 		i--
@@ -703,6 +1086,69 @@ func (m *QueryIsSubscribedResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryErrorStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryErrorStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryErrorStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryErrorStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryErrorStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryErrorStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DroppedErrors != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DroppedErrors))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StoredErrors != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StoredErrors))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -744,6 +1190,27 @@ func (m *QueryErrorsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ErrorCodes) > 0 {
+		l = 0
+		for _, e := range m.ErrorCodes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
 	return n
 }
 
@@ -759,6 +1226,10 @@ func (m *QueryErrorsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -787,14 +1258,61 @@ func (m *QueryIsSubscribedResponse) Size() (n int) {
 	if m.SubscriptionValidTill != 0 {
 		n += 1 + sovQuery(uint64(m.SubscriptionValidTill))
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CallbackGasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.CallbackGasLimit))
+	}
+	if m.AutoRenew {
+		n += 2
+	}
+	l = len(m.RenewalPayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExpiryNotice {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryErrorStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryErrorStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StoredErrors != 0 {
+		n += 1 + sovQuery(uint64(m.StoredErrors))
+	}
+	if m.DroppedErrors != 0 {
+		n += 1 + sovQuery(uint64(m.DroppedErrors))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -990,6 +1508,188 @@ func (m *QueryErrorsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ErrorCodes = append(m.ErrorCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ErrorCodes) == 0 {
+					m.ErrorCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ErrorCodes = append(m.ErrorCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCodes", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1074,6 +1774,42 @@ func (m *QueryErrorsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1245,6 +1981,301 @@ func (m *QueryIsSubscribedResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, SubscriptionFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimit", wireType)
+			}
+			m.CallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalPayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewalPayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryNotice", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpiryNotice = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryErrorStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryErrorStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryErrorStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryErrorStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryErrorStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryErrorStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredErrors", wireType)
+			}
+			m.StoredErrors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredErrors |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedErrors", wireType)
+			}
+			m.DroppedErrors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedErrors |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -26,7 +27,19 @@ func TestSudoErrorMsgString(t *testing.T) {
 				InputPayload:    "hello",
 				ErrorMessage:    "world",
 			},
-			`{"module_name":"callback","error_code":1,"contract_address":"cosmos1w0w8sasnut0jx0vvsnvlc8nayq0q2ej8xgrpwgel05tn6wy4r57q8wwdxx","input_payload":"hello","error_message":"world"}`,
+			`{"module_name":"callback","error_code":1,"contract_address":"cosmos1w0w8sasnut0jx0vvsnvlc8nayq0q2ej8xgrpwgel05tn6wy4r57q8wwdxx","input_payload":"hello","error_message":"world","block_time":"0001-01-01T00:00:00Z"}`,
+		},
+		{
+			"ok: with block and tx",
+			types.SudoError{
+				ModuleName:      "callback",
+				ContractAddress: contractAddr.String(),
+				ErrorCode:       1,
+				BlockHeight:     10,
+				BlockTime:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				TxHash:          "ABCD",
+			},
+			`{"module_name":"callback","error_code":1,"contract_address":"cosmos1w0w8sasnut0jx0vvsnvlc8nayq0q2ej8xgrpwgel05tn6wy4r57q8wwdxx","block_height":10,"block_time":"2024-01-01T00:00:00Z","tx_hash":"ABCD"}`,
 		},
 	}
 
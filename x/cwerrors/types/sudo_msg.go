@@ -7,6 +7,18 @@ import "encoding/json"
 type SudoMsg struct {
 	// Error is the endpoint name at the contract which is called
 	Error *SudoError `json:"error,omitempty"`
+	// SubscriptionExpiring is the endpoint name at the contract which is called before the subscription expires
+	SubscriptionExpiring *SubscriptionExpiringNotice `json:"subscription_expiring,omitempty"`
+}
+
+// SubscriptionExpiringNotice is the notice sent to a contract before its error callback subscription expires
+type SubscriptionExpiringNotice struct {
+	// ContractAddress is the address of the subscribed contract
+	ContractAddress string `json:"contract_address"`
+	// SubscriptionValidTill is the block height till which the subscription is valid
+	SubscriptionValidTill int64 `json:"subscription_valid_till"`
+	// AutoRenew defines if the subscription is renewed when it expires
+	AutoRenew bool `json:"auto_renew"`
 }
 
 // NewSudoMsg creates a new SudoMsg instance.
@@ -16,6 +28,17 @@ func NewSudoMsg(sudoErr SudoError) SudoMsg {
 	}
 }
 
+// NewSubscriptionExpiringSudoMsg creates a new SudoMsg instance notifying the contract of its subscription expiry.
+func NewSubscriptionExpiringSudoMsg(contractAddress string, subscriptionValidTill int64, autoRenew bool) SudoMsg {
+	return SudoMsg{
+		SubscriptionExpiring: &SubscriptionExpiringNotice{
+			ContractAddress:       contractAddress,
+			SubscriptionValidTill: subscriptionValidTill,
+			AutoRenew:             autoRenew,
+		},
+	}
+}
+
 // Bytes returns the sudo message as JSON bytes
 func (s SudoMsg) Bytes() []byte {
 	msgBz, err := json.Marshal(s)
@@ -136,6 +136,25 @@ type MsgSubscribeToError struct {
 	// fee is the subscription fee for the feature (current no fee is charged for
 	// this feature)
 	Fee types.Coin `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+	// filters if set, only the errors matching one of the filters are delivered
+	// as sudo error callbacks, and the other errors are stored in state.
+	// The filters replace the filters of an existing subscription
+	Filters []SubscriptionFilter `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters"`
+	// callback_gas_limit is the gas limit of the sudo error callbacks of the
+	// contract. The default error_callback_gas_limit param is used if zero.
+	// The subscription fee is scaled by the ratio of the callback_gas_limit to
+	// the default error_callback_gas_limit
+	CallbackGasLimit uint64 `protobuf:"varint,5,opt,name=callback_gas_limit,json=callbackGasLimit,proto3" json:"callback_gas_limit,omitempty"`
+	// auto_renew if set, the subscription is renewed for another subscription
+	// period when it expires, by charging the renewal_payer
+	AutoRenew bool `protobuf:"varint,6,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// renewal_payer is the address charged the subscription fee on renewal. It
+	// must be either empty, in which case the contract itself is charged, or the
+	// sender
+	RenewalPayer string `protobuf:"bytes,7,opt,name=renewal_payer,json=renewalPayer,proto3" json:"renewal_payer,omitempty"`
+	// expiry_notice if set, the contract receives a sudo notice
+	// subscription_expiry_notice_period blocks before the subscription expires
+	ExpiryNotice bool `protobuf:"varint,8,opt,name=expiry_notice,json=expiryNotice,proto3" json:"expiry_notice,omitempty"`
 }
 
 func (m *MsgSubscribeToError) Reset()         { *m = MsgSubscribeToError{} }
@@ -192,6 +211,41 @@ func (m *MsgSubscribeToError) GetFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSubscribeToError) GetFilters() []SubscriptionFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *MsgSubscribeToError) GetCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.CallbackGasLimit
+	}
+	return 0
+}
+
+func (m *MsgSubscribeToError) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
+}
+
+func (m *MsgSubscribeToError) GetRenewalPayer() string {
+	if m != nil {
+		return m.RenewalPayer
+	}
+	return ""
+}
+
+func (m *MsgSubscribeToError) GetExpiryNotice() bool {
+	if m != nil {
+		return m.ExpiryNotice
+	}
+	return false
+}
+
 // MsgSubscribeToErrorResponse defines the response structure for executing a
 // MsgSubscribeToError message.
 type MsgSubscribeToErrorResponse struct {
@@ -240,48 +294,278 @@ func (m *MsgSubscribeToErrorResponse) GetSubscriptionValidTill() int64 {
 	return 0
 }
 
+// MsgAcknowledgeErrors is the Msg/AcknowledgeErrors request type.
+type MsgAcknowledgeErrors struct {
+	// sender is the address of who is acknowledging the errors. It must be the
+	// contract itself, its admin or its owner
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract_address is the address of the contract whose errors are
+	// acknowledged
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// error_ids are the ids of the errors to delete
+	ErrorIds []uint64 `protobuf:"varint,3,rep,packed,name=error_ids,json=errorIds,proto3" json:"error_ids,omitempty"`
+}
+
+func (m *MsgAcknowledgeErrors) Reset()         { *m = MsgAcknowledgeErrors{} }
+func (m *MsgAcknowledgeErrors) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgeErrors) ProtoMessage()    {}
+func (*MsgAcknowledgeErrors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833e7f9e8fbc63c, []int{4}
+}
+func (m *MsgAcknowledgeErrors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgeErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgeErrors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcknowledgeErrors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgeErrors.Merge(m, src)
+}
+func (m *MsgAcknowledgeErrors) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgeErrors) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgeErrors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgeErrors proto.InternalMessageInfo
+
+func (m *MsgAcknowledgeErrors) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgAcknowledgeErrors) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgAcknowledgeErrors) GetErrorIds() []uint64 {
+	if m != nil {
+		return m.ErrorIds
+	}
+	return nil
+}
+
+// MsgAcknowledgeErrorsResponse defines the response structure for executing a
+// MsgAcknowledgeErrors message.
+type MsgAcknowledgeErrorsResponse struct {
+}
+
+func (m *MsgAcknowledgeErrorsResponse) Reset()         { *m = MsgAcknowledgeErrorsResponse{} }
+func (m *MsgAcknowledgeErrorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgeErrorsResponse) ProtoMessage()    {}
+func (*MsgAcknowledgeErrorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833e7f9e8fbc63c, []int{5}
+}
+func (m *MsgAcknowledgeErrorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgeErrorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgeErrorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcknowledgeErrorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgeErrorsResponse.Merge(m, src)
+}
+func (m *MsgAcknowledgeErrorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgeErrorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgeErrorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgeErrorsResponse proto.InternalMessageInfo
+
+// MsgCancelSubscription is the Msg/CancelSubscription request type.
+type MsgCancelSubscription struct {
+	// sender is the address of who is cancelling the subscription. It must be
+	// the contract itself, its admin or its owner. The refund is sent to the
+	// address which paid the escrowed fees
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract_address is the address of the contract whose subscription is
+	// cancelled
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgCancelSubscription) Reset()         { *m = MsgCancelSubscription{} }
+func (m *MsgCancelSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSubscription) ProtoMessage()    {}
+func (*MsgCancelSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833e7f9e8fbc63c, []int{6}
+}
+func (m *MsgCancelSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSubscription.Merge(m, src)
+}
+func (m *MsgCancelSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelSubscription proto.InternalMessageInfo
+
+func (m *MsgCancelSubscription) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCancelSubscription) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgCancelSubscriptionResponse defines the response structure for executing a
+// MsgCancelSubscription message.
+type MsgCancelSubscriptionResponse struct {
+	// refund is the refunded part of the subscription fees for the unused
+	// subscription period
+	Refund types.Coin `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund"`
+}
+
+func (m *MsgCancelSubscriptionResponse) Reset()         { *m = MsgCancelSubscriptionResponse{} }
+func (m *MsgCancelSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSubscriptionResponse) ProtoMessage()    {}
+func (*MsgCancelSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833e7f9e8fbc63c, []int{7}
+}
+func (m *MsgCancelSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgCancelSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSubscriptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelSubscriptionResponse proto.InternalMessageInfo
+
+func (m *MsgCancelSubscriptionResponse) GetRefund() types.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "archway.cwerrors.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "archway.cwerrors.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgSubscribeToError)(nil), "archway.cwerrors.v1.MsgSubscribeToError")
 	proto.RegisterType((*MsgSubscribeToErrorResponse)(nil), "archway.cwerrors.v1.MsgSubscribeToErrorResponse")
+	proto.RegisterType((*MsgAcknowledgeErrors)(nil), "archway.cwerrors.v1.MsgAcknowledgeErrors")
+	proto.RegisterType((*MsgAcknowledgeErrorsResponse)(nil), "archway.cwerrors.v1.MsgAcknowledgeErrorsResponse")
+	proto.RegisterType((*MsgCancelSubscription)(nil), "archway.cwerrors.v1.MsgCancelSubscription")
+	proto.RegisterType((*MsgCancelSubscriptionResponse)(nil), "archway.cwerrors.v1.MsgCancelSubscriptionResponse")
 }
 
 func init() { proto.RegisterFile("archway/cwerrors/v1/tx.proto", fileDescriptor_f833e7f9e8fbc63c) }
 
 var fileDescriptor_f833e7f9e8fbc63c = []byte{
-	// 489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc6, 0x6b, 0x0a, 0x95, 0xea, 0x21, 0x56, 0x65, 0x40, 0xbb, 0x6c, 0xca, 0xaa, 0x8a, 0x43,
-	0x99, 0x86, 0x4d, 0x36, 0x89, 0xc3, 0x6e, 0x14, 0x71, 0x8c, 0x84, 0xc2, 0xc6, 0x81, 0x4b, 0xe5,
-	0x24, 0x26, 0xb5, 0x48, 0xe2, 0xc8, 0x76, 0xdb, 0xf5, 0x86, 0x78, 0x00, 0xc4, 0x91, 0xc7, 0xd8,
-	0x63, 0xec, 0xb8, 0x23, 0xa7, 0x69, 0x6a, 0x0f, 0x93, 0x78, 0x0a, 0x94, 0xd8, 0xa1, 0x50, 0x3a,
-	0x69, 0xb7, 0xda, 0xbf, 0xaf, 0xdf, 0xff, 0xfb, 0x7f, 0x31, 0xdc, 0x25, 0x22, 0x1c, 0x4d, 0xc9,
-	0x0c, 0x87, 0x53, 0x2a, 0x04, 0x17, 0x12, 0x4f, 0x5c, 0xac, 0xce, 0x50, 0x2e, 0xb8, 0xe2, 0xd6,
-	0x96, 0xa1, 0xa8, 0xa2, 0x68, 0xe2, 0xda, 0x8f, 0x63, 0x1e, 0xf3, 0x92, 0xe3, 0xe2, 0x97, 0x96,
-	0xda, 0xed, 0x90, 0xcb, 0x94, 0x4b, 0x9c, 0xca, 0xb8, 0xb0, 0x48, 0x65, 0x6c, 0x80, 0x63, 0x40,
-	0x40, 0x24, 0xc5, 0x13, 0x37, 0xa0, 0x8a, 0xb8, 0x38, 0xe4, 0x2c, 0x33, 0xbc, 0xbb, 0x2e, 0x41,
-	0x4e, 0x04, 0x49, 0xa5, 0x56, 0xf4, 0xbe, 0x01, 0xb8, 0xe9, 0xc9, 0xf8, 0x34, 0x8f, 0x88, 0xa2,
-	0xef, 0x4a, 0x62, 0xed, 0xc2, 0x26, 0x19, 0xab, 0x11, 0x17, 0x4c, 0xcd, 0x3a, 0xa0, 0x0b, 0xfa,
-	0x4d, 0x7f, 0x79, 0x61, 0x79, 0xb0, 0xa1, 0x1d, 0x3a, 0xf7, 0xba, 0xa0, 0xbf, 0x71, 0xb8, 0x83,
-	0xd6, 0x2c, 0x82, 0xb4, 0xd5, 0xa0, 0x73, 0x71, 0xb5, 0x57, 0xfb, 0x75, 0xb5, 0xd7, 0xd2, 0x7f,
-	0x39, 0xe0, 0x29, 0x53, 0x34, 0xcd, 0xd5, 0xcc, 0x37, 0x26, 0xc7, 0x8f, 0xbe, 0xde, 0x9c, 0xef,
-	0x2f, 0xed, 0x7b, 0xdb, 0xb0, 0xbd, 0x92, 0xc7, 0xa7, 0x32, 0xe7, 0x99, 0xa4, 0xbd, 0x1f, 0x00,
-	0x6e, 0x79, 0x32, 0x7e, 0x3f, 0x0e, 0x64, 0x28, 0x58, 0x40, 0x4f, 0xf8, 0xdb, 0x62, 0x9e, 0xf5,
-	0x14, 0x36, 0x24, 0xcd, 0x22, 0x2a, 0x4c, 0x58, 0x73, 0xb2, 0x9e, 0xc3, 0x56, 0xc8, 0x33, 0x25,
-	0x48, 0xa8, 0x86, 0x24, 0x8a, 0x04, 0x95, 0x3a, 0x73, 0xd3, 0xdf, 0xac, 0xee, 0x5f, 0xeb, 0x6b,
-	0xcb, 0x85, 0xf5, 0x4f, 0x94, 0x76, 0xea, 0xe5, 0x46, 0xdb, 0x48, 0xd7, 0x8a, 0x8a, 0x5a, 0x91,
-	0xa9, 0x15, 0xbd, 0xe1, 0x2c, 0x1b, 0xdc, 0x2f, 0xf6, 0xf1, 0x0b, 0xed, 0xf1, 0x46, 0x11, 0xdc,
-	0x8c, 0xea, 0x9d, 0xc2, 0x9d, 0x35, 0xc9, 0xaa, 0xe4, 0xd6, 0x2b, 0xd8, 0x96, 0x9a, 0xe5, 0x8a,
-	0xf1, 0x6c, 0x38, 0x21, 0x09, 0x8b, 0x86, 0x8a, 0x25, 0x49, 0x19, 0xb9, 0xee, 0x3f, 0xf9, 0x1b,
-	0x7f, 0x28, 0xe8, 0x09, 0x4b, 0x92, 0xc3, 0x6b, 0x00, 0xeb, 0x9e, 0x8c, 0xad, 0x00, 0x3e, 0xfc,
-	0xe7, 0x0b, 0x3d, 0x5b, 0xdb, 0xf9, 0x4a, 0x6f, 0xf6, 0xc1, 0x5d, 0x54, 0x7f, 0x32, 0x66, 0xb0,
-	0xf5, 0x5f, 0xb3, 0xfd, 0xdb, 0x1c, 0x56, 0x95, 0xf6, 0xcb, 0xbb, 0x2a, 0xab, 0x79, 0xf6, 0x83,
-	0x2f, 0x37, 0xe7, 0xfb, 0x60, 0xe0, 0x5d, 0xcc, 0x1d, 0x70, 0x39, 0x77, 0xc0, 0xf5, 0xdc, 0x01,
-	0xdf, 0x17, 0x4e, 0xed, 0x72, 0xe1, 0xd4, 0x7e, 0x2e, 0x9c, 0xda, 0xc7, 0xa3, 0x98, 0xa9, 0xd1,
-	0x38, 0x40, 0x21, 0x4f, 0xb1, 0x31, 0x7f, 0x91, 0x51, 0x35, 0xe5, 0xe2, 0x73, 0x75, 0xc6, 0x67,
-	0xcb, 0x97, 0xad, 0x66, 0x39, 0x95, 0x41, 0xa3, 0x7c, 0xd6, 0x47, 0xbf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xc7, 0x3c, 0x1c, 0xf2, 0x7c, 0x03, 0x00, 0x00,
+	// 740 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0x8d, 0x71, 0x08, 0x64, 0xe0, 0x3d, 0xf2, 0x0c, 0x3c, 0x4c, 0x00, 0x13, 0xa5, 0x95, 0x1a,
+	0x10, 0xb5, 0x9b, 0x20, 0xb5, 0x12, 0x3b, 0x40, 0x2d, 0xaa, 0xd4, 0x54, 0xc8, 0x85, 0xaa, 0xea,
+	0xc6, 0x1a, 0xdb, 0x83, 0x19, 0x61, 0x7b, 0xdc, 0x99, 0x49, 0x42, 0x76, 0x55, 0xbb, 0xaf, 0xfa,
+	0x53, 0xf8, 0x19, 0x74, 0xc7, 0xb2, 0x2b, 0x54, 0xc1, 0x02, 0xa9, 0xbf, 0xa0, 0xcb, 0xca, 0xf6,
+	0x24, 0x7c, 0xc4, 0x48, 0x59, 0xb0, 0x4b, 0xce, 0x39, 0x73, 0xee, 0xb9, 0xf7, 0xce, 0xc8, 0x60,
+	0x11, 0x52, 0xe7, 0xb0, 0x03, 0xbb, 0x86, 0xd3, 0x41, 0x94, 0x12, 0xca, 0x8c, 0x76, 0xdd, 0xe0,
+	0xc7, 0x7a, 0x44, 0x09, 0x27, 0xca, 0xb4, 0x60, 0xf5, 0x1e, 0xab, 0xb7, 0xeb, 0xe5, 0x19, 0x8f,
+	0x78, 0x24, 0xe1, 0x8d, 0xf8, 0x57, 0x2a, 0x2d, 0xcf, 0x39, 0x84, 0x05, 0x84, 0x19, 0x01, 0xf3,
+	0x62, 0x8b, 0x80, 0x79, 0x82, 0xd0, 0x04, 0x61, 0x43, 0x86, 0x8c, 0x76, 0xdd, 0x46, 0x1c, 0xd6,
+	0x0d, 0x87, 0xe0, 0x50, 0xf0, 0x95, 0xac, 0x04, 0x11, 0xa4, 0x30, 0x60, 0x42, 0x51, 0xcd, 0x52,
+	0xf4, 0x13, 0x25, 0x9a, 0xea, 0x37, 0x09, 0x4c, 0x35, 0x99, 0xb7, 0x1f, 0xb9, 0x90, 0xa3, 0xdd,
+	0xe4, 0xb4, 0xb2, 0x08, 0x8a, 0xb0, 0xc5, 0x0f, 0x09, 0xc5, 0xbc, 0xab, 0x4a, 0x15, 0xa9, 0x56,
+	0x34, 0xaf, 0x01, 0xa5, 0x09, 0x0a, 0x69, 0x15, 0x75, 0xa4, 0x22, 0xd5, 0x26, 0x1a, 0x0b, 0x7a,
+	0x46, 0xb3, 0x7a, 0x6a, 0xb5, 0xa5, 0x9e, 0x9e, 0x2f, 0xe7, 0x7e, 0x9f, 0x2f, 0x97, 0xd2, 0x23,
+	0x6b, 0x24, 0xc0, 0x1c, 0x05, 0x11, 0xef, 0x9a, 0xc2, 0x64, 0xe3, 0xdf, 0x2f, 0x57, 0x27, 0xab,
+	0xd7, 0xf6, 0xd5, 0x79, 0x30, 0x77, 0x27, 0x8f, 0x89, 0x58, 0x44, 0x42, 0x86, 0xaa, 0x7f, 0x46,
+	0xc0, 0x74, 0x93, 0x79, 0xef, 0x5a, 0x36, 0x73, 0x28, 0xb6, 0xd1, 0x1e, 0x79, 0x19, 0xd7, 0x53,
+	0xfe, 0x07, 0x05, 0x86, 0x42, 0x17, 0x51, 0x11, 0x56, 0xfc, 0x53, 0x56, 0x40, 0xc9, 0x21, 0x21,
+	0xa7, 0xd0, 0xe1, 0x16, 0x74, 0x5d, 0x8a, 0x58, 0x9a, 0xb9, 0x68, 0x4e, 0xf5, 0xf0, 0xcd, 0x14,
+	0x56, 0xea, 0x40, 0x3e, 0x40, 0x48, 0x95, 0x93, 0x8e, 0xe6, 0xf5, 0x74, 0xf4, 0x7a, 0x3c, 0x7a,
+	0x5d, 0x8c, 0x5e, 0xdf, 0x26, 0x38, 0xdc, 0xca, 0xc7, 0xfd, 0x98, 0xb1, 0x56, 0xd9, 0x01, 0x63,
+	0x07, 0xd8, 0xe7, 0x88, 0x32, 0x35, 0x5f, 0x91, 0x6b, 0x13, 0x8d, 0x27, 0x99, 0x83, 0x10, 0x69,
+	0x23, 0x8e, 0x49, 0xf8, 0x2a, 0xd1, 0x0b, 0x93, 0xde, 0x69, 0x65, 0x0d, 0x28, 0x0e, 0xf4, 0x7d,
+	0x1b, 0x3a, 0x47, 0x96, 0x07, 0x99, 0xe5, 0xe3, 0x00, 0x73, 0x75, 0xb4, 0x22, 0xd5, 0xf2, 0x66,
+	0xa9, 0xc7, 0xec, 0x40, 0xf6, 0x26, 0xc6, 0x95, 0x25, 0x00, 0x60, 0x8b, 0x13, 0x8b, 0xa2, 0x10,
+	0x75, 0xd4, 0x42, 0x45, 0xaa, 0x8d, 0x27, 0xdb, 0x21, 0x66, 0x0c, 0x28, 0x8f, 0xc0, 0x3f, 0x09,
+	0x03, 0x7d, 0x2b, 0x82, 0x5d, 0x44, 0xd5, 0xb1, 0xa4, 0xe1, 0x49, 0x01, 0xee, 0xc6, 0x58, 0x2c,
+	0x42, 0xc7, 0x11, 0xa6, 0x5d, 0x2b, 0x24, 0x1c, 0x3b, 0x48, 0x1d, 0x4f, 0x6c, 0x26, 0x53, 0xf0,
+	0x6d, 0x82, 0x6d, 0x4c, 0xc4, 0x8b, 0x11, 0xa3, 0xac, 0xee, 0x83, 0x85, 0x8c, 0xc9, 0xf7, 0x36,
+	0xa3, 0x3c, 0x07, 0x73, 0xec, 0x46, 0x9f, 0x56, 0x1b, 0xfa, 0xd8, 0xb5, 0x38, 0xf6, 0xfd, 0x64,
+	0x25, 0xb2, 0x39, 0x7b, 0x93, 0x7e, 0x1f, 0xb3, 0x7b, 0xd8, 0xf7, 0xab, 0x5f, 0x25, 0x30, 0xd3,
+	0x64, 0xde, 0xa6, 0x73, 0x14, 0x92, 0x8e, 0x8f, 0x5c, 0x0f, 0x25, 0xbe, 0xec, 0x21, 0x56, 0xba,
+	0x00, 0x8a, 0xc9, 0x16, 0x2c, 0xec, 0x32, 0x55, 0xae, 0xc8, 0xb5, 0xbc, 0x39, 0x9e, 0x00, 0xaf,
+	0x5d, 0x76, 0xbb, 0x39, 0x0d, 0x2c, 0x66, 0x85, 0xe8, 0xdf, 0x3b, 0x0f, 0xcc, 0x36, 0x99, 0xb7,
+	0x0d, 0x43, 0x07, 0xf9, 0x37, 0xd7, 0xf9, 0x00, 0x29, 0x6f, 0x07, 0xf9, 0x00, 0x96, 0x32, 0x0b,
+	0xf5, 0xe7, 0xfc, 0x02, 0x14, 0x28, 0x3a, 0x68, 0x85, 0xae, 0x2a, 0x0d, 0x77, 0x53, 0x85, 0xbc,
+	0xf1, 0x43, 0x06, 0x72, 0x93, 0x79, 0x8a, 0x0d, 0x26, 0x6f, 0x3d, 0xf5, 0xc7, 0x99, 0x77, 0xf6,
+	0xce, 0x03, 0x2c, 0xaf, 0x0d, 0xa3, 0xea, 0x87, 0x0c, 0x41, 0x69, 0xe0, 0x89, 0xd6, 0xee, 0x73,
+	0xb8, 0xab, 0x2c, 0x3f, 0x1b, 0x56, 0xd9, 0xaf, 0xf7, 0x09, 0xfc, 0x37, 0x78, 0x81, 0x56, 0xee,
+	0xb3, 0x19, 0x90, 0x96, 0xeb, 0x43, 0x4b, 0xfb, 0x25, 0x39, 0x50, 0x32, 0xae, 0xc3, 0xea, 0x7d,
+	0x46, 0x83, 0xda, 0x72, 0x63, 0x78, 0x6d, 0xaf, 0x6a, 0x79, 0xf4, 0xf3, 0xd5, 0xc9, 0xaa, 0xb4,
+	0xd5, 0x3c, 0xbd, 0xd0, 0xa4, 0xb3, 0x0b, 0x4d, 0xfa, 0x75, 0xa1, 0x49, 0xdf, 0x2f, 0xb5, 0xdc,
+	0xd9, 0xa5, 0x96, 0xfb, 0x79, 0xa9, 0xe5, 0x3e, 0xae, 0x7b, 0x98, 0x1f, 0xb6, 0x6c, 0xdd, 0x21,
+	0x81, 0x21, 0xec, 0x9f, 0x86, 0x88, 0x77, 0x08, 0x3d, 0xea, 0xfd, 0x37, 0x8e, 0xaf, 0xbf, 0x06,
+	0xbc, 0x1b, 0x21, 0x66, 0x17, 0x92, 0x0f, 0xc1, 0xfa, 0xdf, 0x01, 0x00, 0x2d, 0x95, 0xdf, 0x16,
+	0xd2, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -302,6 +586,12 @@ type MsgClient interface {
 	// SubscribeToError defines an operation which will register a contract for a
 	// sudo callback on errors
 	SubscribeToError(ctx context.Context, in *MsgSubscribeToError, opts ...grpc.CallOption) (*MsgSubscribeToErrorResponse, error)
+	// AcknowledgeErrors defines an operation which deletes the given errors of a
+	// contract from the state once they are handled
+	AcknowledgeErrors(ctx context.Context, in *MsgAcknowledgeErrors, opts ...grpc.CallOption) (*MsgAcknowledgeErrorsResponse, error)
+	// CancelSubscription defines an operation which cancels the error callback
+	// subscription of a contract and refunds the unused subscription period
+	CancelSubscription(ctx context.Context, in *MsgCancelSubscription, opts ...grpc.CallOption) (*MsgCancelSubscriptionResponse, error)
 }
 
 type msgClient struct {
@@ -330,6 +620,24 @@ func (c *msgClient) SubscribeToError(ctx context.Context, in *MsgSubscribeToErro
 	return out, nil
 }
 
+func (c *msgClient) AcknowledgeErrors(ctx context.Context, in *MsgAcknowledgeErrors, opts ...grpc.CallOption) (*MsgAcknowledgeErrorsResponse, error) {
+	out := new(MsgAcknowledgeErrorsResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwerrors.v1.Msg/AcknowledgeErrors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelSubscription(ctx context.Context, in *MsgCancelSubscription, opts ...grpc.CallOption) (*MsgCancelSubscriptionResponse, error) {
+	out := new(MsgCancelSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwerrors.v1.Msg/CancelSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/cwerrors
@@ -338,6 +646,12 @@ type MsgServer interface {
 	// SubscribeToError defines an operation which will register a contract for a
 	// sudo callback on errors
 	SubscribeToError(context.Context, *MsgSubscribeToError) (*MsgSubscribeToErrorResponse, error)
+	// AcknowledgeErrors defines an operation which deletes the given errors of a
+	// contract from the state once they are handled
+	AcknowledgeErrors(context.Context, *MsgAcknowledgeErrors) (*MsgAcknowledgeErrorsResponse, error)
+	// CancelSubscription defines an operation which cancels the error callback
+	// subscription of a contract and refunds the unused subscription period
+	CancelSubscription(context.Context, *MsgCancelSubscription) (*MsgCancelSubscriptionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -350,6 +664,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) SubscribeToError(ctx context.Context, req *MsgSubscribeToError) (*MsgSubscribeToErrorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubscribeToError not implemented")
 }
+func (*UnimplementedMsgServer) AcknowledgeErrors(ctx context.Context, req *MsgAcknowledgeErrors) (*MsgAcknowledgeErrorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeErrors not implemented")
+}
+func (*UnimplementedMsgServer) CancelSubscription(ctx context.Context, req *MsgCancelSubscription) (*MsgCancelSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -391,6 +711,42 @@ func _Msg_SubscribeToError_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AcknowledgeErrors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgeErrors)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcknowledgeErrors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwerrors.v1.Msg/AcknowledgeErrors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcknowledgeErrors(ctx, req.(*MsgAcknowledgeErrors))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwerrors.v1.Msg/CancelSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSubscription(ctx, req.(*MsgCancelSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "archway.cwerrors.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -403,6 +759,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubscribeToError",
 			Handler:    _Msg_SubscribeToError_Handler,
 		},
+		{
+			MethodName: "AcknowledgeErrors",
+			Handler:    _Msg_AcknowledgeErrors_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _Msg_CancelSubscription_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "archway/cwerrors/v1/tx.proto",
@@ -491,6 +855,52 @@ func (m *MsgSubscribeToError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryNotice {
+		i--
+		if m.ExpiryNotice {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RenewalPayer) > 0 {
+		i -= len(m.RenewalPayer)
+		copy(dAtA[i:], m.RenewalPayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RenewalPayer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CallbackGasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CallbackGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -546,57 +956,224 @@ func (m *MsgSubscribeToErrorResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAcknowledgeErrors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgAcknowledgeErrors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubscribeToError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAcknowledgeErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ErrorIds) > 0 {
+		dAtA4 := make([]byte, len(m.ErrorIds)*10)
+		var j3 int
+		for _, num := range m.ErrorIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ContractAddress)
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgeErrorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgeErrorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgeErrorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubscribeToError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = m.Fee.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CallbackGasLimit != 0 {
+		n += 1 + sovTx(uint64(m.CallbackGasLimit))
+	}
+	if m.AutoRenew {
+		n += 2
+	}
+	l = len(m.RenewalPayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiryNotice {
+		n += 2
+	}
 	return n
 }
 
@@ -612,6 +1189,67 @@ func (m *MsgSubscribeToErrorResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAcknowledgeErrors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ErrorIds) > 0 {
+		l = 0
+		for _, e := range m.ErrorIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgAcknowledgeErrorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Refund.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -909,42 +1547,167 @@ func (m *MsgSubscribeToError) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSubscribeToErrorResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
+			m.Filters = append(m.Filters, SubscriptionFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimit", wireType)
+			}
+			m.CallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalPayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewalPayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryNotice", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpiryNotice = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubscribeToErrorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
@@ -999,6 +1762,443 @@ func (m *MsgSubscribeToErrorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAcknowledgeErrors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeErrors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeErrors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ErrorIds = append(m.ErrorIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ErrorIds) == 0 {
+					m.ErrorIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ErrorIds = append(m.ErrorIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgeErrorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeErrorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeErrorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
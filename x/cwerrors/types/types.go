@@ -2,7 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"slices"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -26,3 +29,96 @@ func (s SudoError) Bytes() []byte {
 	}
 	return msgBz
 }
+
+// ErrorsFilter defines the filters applied when querying the errors of a contract.
+// The zero value of a field does not filter the errors.
+type ErrorsFilter struct {
+	// ModuleName is the name of the module which threw the error
+	ModuleName string
+	// ErrorCodes are the accepted module level error codes
+	ErrorCodes []int32
+	// MinHeight is the lowest block height the error happened at (inclusive)
+	MinHeight int64
+	// MaxHeight is the highest block height the error happened at (inclusive)
+	MaxHeight int64
+}
+
+// Validate perform object fields validation.
+func (f ErrorsFilter) Validate() error {
+	if f.MinHeight < 0 || f.MaxHeight < 0 {
+		return errorsmod.Wrap(ErrInvalidErrorsFilter, "height range can not be negative")
+	}
+	if f.MaxHeight != 0 && f.MinHeight > f.MaxHeight {
+		return errorsmod.Wrapf(ErrInvalidErrorsFilter, "min height %d is greater than max height %d", f.MinHeight, f.MaxHeight)
+	}
+	return nil
+}
+
+// Matches returns true if the sudo error passes all the filters.
+func (f ErrorsFilter) Matches(s SudoError) bool {
+	if f.ModuleName != "" && s.ModuleName != f.ModuleName {
+		return false
+	}
+	if len(f.ErrorCodes) != 0 && !slices.Contains(f.ErrorCodes, s.ErrorCode) {
+		return false
+	}
+	if f.MinHeight != 0 && s.BlockHeight < f.MinHeight {
+		return false
+	}
+	if f.MaxHeight != 0 && s.BlockHeight > f.MaxHeight {
+		return false
+	}
+	return true
+}
+
+// Validate perform object fields validation.
+func (f SubscriptionFilter) Validate() error {
+	if f.ModuleName == "" && len(f.ErrorCodes) == 0 {
+		return errorsmod.Wrap(ErrInvalidSubscriptionFilter, "module name or error codes must be set")
+	}
+	return nil
+}
+
+// Matches returns true if the sudo error is thrown by the filter module and has one of the filter error codes.
+func (f SubscriptionFilter) Matches(s SudoError) bool {
+	if f.ModuleName != "" && s.ModuleName != f.ModuleName {
+		return false
+	}
+	return len(f.ErrorCodes) == 0 || slices.Contains(f.ErrorCodes, s.ErrorCode)
+}
+
+// MatchesSubscriptionFilters returns true if the sudo error matches one of the subscription filters.
+// All the errors match a subscription without filters.
+func MatchesSubscriptionFilters(filters []SubscriptionFilter, s SudoError) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, filter := range filters {
+		if filter.Matches(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Refund returns the part of the escrowed fees which is not consumed at the given height, for a subscription ending
+// at the given end height. The escrowed fees are consumed linearly from the escrow start height to the end height
+func (r SubscriptionRenewal) Refund(endHeight, height int64) sdk.Coin {
+	if r.FeesEscrowed.IsNil() || r.FeesEscrowed.IsZero() || height >= endHeight || endHeight <= r.EscrowStartHeight {
+		return sdk.Coin{Denom: r.FeesEscrowed.Denom, Amount: math.ZeroInt()}
+	}
+	if height <= r.EscrowStartHeight {
+		return r.FeesEscrowed
+	}
+	unusedAmount := r.FeesEscrowed.Amount.MulRaw(endHeight - height).QuoRaw(endHeight - r.EscrowStartHeight)
+	return sdk.NewCoin(r.FeesEscrowed.Denom, unusedAmount)
+}
+
+// PayerAddress returns the address which paid the escrowed fees, or the given fallback address if it is not recorded,
+// as for the subscriptions created before the payer was recorded
+func (r SubscriptionRenewal) PayerAddress(fallback sdk.AccAddress) sdk.AccAddress {
+	if r.Payer == "" {
+		return fallback
+	}
+	return sdk.MustAccAddressFromBech32(r.Payer)
+}
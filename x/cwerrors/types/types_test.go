@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
@@ -70,3 +71,196 @@ func TestSudoErrorValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorsFilter(t *testing.T) {
+	sudoErr := types.SudoError{
+		ModuleName:  "callback",
+		ErrorCode:   2,
+		BlockHeight: 10,
+	}
+
+	type testCase struct {
+		name        string
+		filter      types.ErrorsFilter
+		errExpected bool
+		matches     bool
+	}
+
+	testCases := []testCase{
+		{
+			name:    "OK: Empty filter",
+			filter:  types.ErrorsFilter{},
+			matches: true,
+		},
+		{
+			name: "OK: All filters",
+			filter: types.ErrorsFilter{
+				ModuleName: "callback",
+				ErrorCodes: []int32{1, 2},
+				MinHeight:  10,
+				MaxHeight:  10,
+			},
+			matches: true,
+		},
+		{
+			name:   "OK: Other module",
+			filter: types.ErrorsFilter{ModuleName: "cwfees"},
+		},
+		{
+			name:   "OK: Other error code",
+			filter: types.ErrorsFilter{ErrorCodes: []int32{1}},
+		},
+		{
+			name:   "OK: Before min height",
+			filter: types.ErrorsFilter{MinHeight: 11},
+		},
+		{
+			name:   "OK: After max height",
+			filter: types.ErrorsFilter{MaxHeight: 9},
+		},
+		{
+			name:        "Fail: Negative height",
+			filter:      types.ErrorsFilter{MinHeight: -1},
+			errExpected: true,
+		},
+		{
+			name:        "Fail: Min height greater than max height",
+			filter:      types.ErrorsFilter{MinHeight: 11, MaxHeight: 10},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.filter.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.matches, tc.filter.Matches(sudoErr))
+		})
+	}
+}
+
+func TestSubscriptionFilter(t *testing.T) {
+	sudoErr := types.SudoError{
+		ModuleName: "callback",
+		ErrorCode:  2,
+	}
+
+	type testCase struct {
+		name        string
+		filter      types.SubscriptionFilter
+		errExpected bool
+		matches     bool
+	}
+
+	testCases := []testCase{
+		{
+			name:        "Fail: Empty filter",
+			filter:      types.SubscriptionFilter{},
+			errExpected: true,
+		},
+		{
+			name:    "OK: Module",
+			filter:  types.SubscriptionFilter{ModuleName: "callback"},
+			matches: true,
+		},
+		{
+			name:    "OK: Error codes",
+			filter:  types.SubscriptionFilter{ErrorCodes: []int32{1, 2}},
+			matches: true,
+		},
+		{
+			name:    "OK: Module and error codes",
+			filter:  types.SubscriptionFilter{ModuleName: "callback", ErrorCodes: []int32{2}},
+			matches: true,
+		},
+		{
+			name:   "OK: Other module",
+			filter: types.SubscriptionFilter{ModuleName: "cwica", ErrorCodes: []int32{2}},
+		},
+		{
+			name:   "OK: Other error code",
+			filter: types.SubscriptionFilter{ModuleName: "callback", ErrorCodes: []int32{1}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.filter.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.matches, tc.filter.Matches(sudoErr))
+		})
+	}
+
+	assert.True(t, types.MatchesSubscriptionFilters(nil, sudoErr))
+	assert.False(t, types.MatchesSubscriptionFilters([]types.SubscriptionFilter{{ModuleName: "cwica"}}, sudoErr))
+}
+
+func TestSubscriptionRenewalRefund(t *testing.T) {
+	renewal := types.SubscriptionRenewal{
+		FeesEscrowed:      sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		EscrowStartHeight: 100,
+	}
+
+	type testCase struct {
+		name           string
+		renewal        types.SubscriptionRenewal
+		endHeight      int64
+		height         int64
+		expectedRefund sdk.Coin
+	}
+
+	testCases := []testCase{
+		{
+			name:           "OK: Nothing consumed",
+			renewal:        renewal,
+			endHeight:      200,
+			height:         100,
+			expectedRefund: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		},
+		{
+			name:           "OK: Partially consumed",
+			renewal:        renewal,
+			endHeight:      200,
+			height:         130,
+			expectedRefund: sdk.NewInt64Coin(sdk.DefaultBondDenom, 70),
+		},
+		{
+			name:           "OK: Partially consumed rounded down",
+			renewal:        renewal,
+			endHeight:      400,
+			height:         101,
+			expectedRefund: sdk.NewInt64Coin(sdk.DefaultBondDenom, 99),
+		},
+		{
+			name:           "OK: Fully consumed",
+			renewal:        renewal,
+			endHeight:      200,
+			height:         200,
+			expectedRefund: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+		},
+		{
+			name: "OK: Nothing escrowed",
+			renewal: types.SubscriptionRenewal{
+				FeesEscrowed:      sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				EscrowStartHeight: 100,
+			},
+			endHeight:      200,
+			height:         150,
+			expectedRefund: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedRefund, tc.renewal.Refund(tc.endHeight, tc.height))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package cwfees
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.AnteDecorator = TrackGrantGasDecorator{}
+
+// TrackGrantGasDecorator records the gas consumed by the TX once the ante handler finished in the grant accepted
+// for it, so that the granting contract is notified of it if the TX fails. It must be the last ante decorator.
+type TrackGrantGasDecorator struct {
+	k Keeper
+}
+
+// NewTrackGrantGasDecorator returns a new TrackGrantGasDecorator instance.
+func NewTrackGrantGasDecorator(k Keeper) TrackGrantGasDecorator {
+	return TrackGrantGasDecorator{k: k}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (d TrackGrantGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// the grants are only settled when finalizing the block or simulating the TX
+	if mode := ctx.ExecMode(); mode != sdk.ExecModeFinalize && mode != sdk.ExecModeSimulate {
+		return next(ctx, tx, simulate)
+	}
+	if err := d.k.TrackGrantGas(ctx); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
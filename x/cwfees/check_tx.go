@@ -0,0 +1,66 @@
+package cwfees
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/cwfees/types"
+)
+
+// checkTxFailures keeps the consecutive grant request failures of the granting contracts in CheckTx.
+// A TX whose grant request fails in CheckTx is rejected from the mempool, so it never reaches a block where
+// the failure would count towards the suspension of the contract. The node counts these failures in memory instead,
+// with the same params, and a contract reaching the maximum is suspended in CheckTx only: its grant requests are
+// rejected from the mempool without invoking it until the suspension ends. None of it is part of the consensus.
+type checkTxFailures struct {
+	mu          sync.Mutex
+	failures    map[string]types.GranterFailures
+	suspensions map[string]int64 // the heights the suspensions end at
+}
+
+func newCheckTxFailures() *checkTxFailures {
+	return &checkTxFailures{
+		failures:    make(map[string]types.GranterFailures),
+		suspensions: make(map[string]int64),
+	}
+}
+
+// suspendedUntil returns the height the CheckTx suspension of the granting contract ends at,
+// and whether it is suspended at the given height.
+func (f *checkTxFailures) suspendedUntil(granter sdk.AccAddress, height int64) (int64, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	until, suspended := f.suspensions[string(granter)]
+	if suspended && until <= height {
+		delete(f.suspensions, string(granter))
+		return 0, false
+	}
+	return until, suspended
+}
+
+// record applies the outcome of a grant request of CheckTx to the failures of the granting contract,
+// the same way ApplyGrantOutcomes does for the grant requests of the block execution.
+func (f *checkTxFailures) record(params types.Params, granter sdk.AccAddress, height int64, failed bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := string(granter)
+	if !failed {
+		delete(f.failures, key)
+		return
+	}
+	if params.MaxConsecutiveFailures == 0 {
+		return
+	}
+	failures := f.failures[key]
+	if failures.ConsecutiveFailures == 0 || height-failures.WindowStartHeight >= params.FailureWindow {
+		failures = types.GranterFailures{WindowStartHeight: height}
+	}
+	failures.ConsecutiveFailures++
+	if failures.ConsecutiveFailures < params.MaxConsecutiveFailures {
+		f.failures[key] = failures
+		return
+	}
+	delete(f.failures, key)
+	f.suspensions[key] = height + params.SuspensionPeriod
+}
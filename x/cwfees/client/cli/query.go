@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/archway-network/archway/x/cwfees/types"
+)
+
+const flagSigner = "signer"
+
+// GetQueryCmd builds query command group for the module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the cwfees module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		getQueryGrantingContractsCmd(),
+		getQueryGranterStatsCmd(),
+		getQueryGranterSuspensionCmd(),
+		getQueryParamsCmd(),
+	)
+	return cmd
+}
+
+// getQueryGrantingContractsCmd returns the command to query the granting contracts.
+func getQueryGrantingContractsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "granting-contracts",
+		Args:  cobra.NoArgs,
+		Short: "Query the granting contracts with pagination",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GrantingContracts(cmd.Context(), &types.GrantingContractsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "granting-contracts")
+	return cmd
+}
+
+// getQueryGranterStatsCmd returns the command to query the grant usage statistics of a granting contract.
+func getQueryGranterStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "granter-stats [contract_address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the grant usage statistics of a granting contract, or of one of its signers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			signer, err := cmd.Flags().GetString(flagSigner)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GranterStats(cmd.Context(), &types.GranterStatsRequest{
+				ContractAddress: args[0],
+				SignerAddress:   signer,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Stats)
+		},
+	}
+	cmd.Flags().String(flagSigner, "", "Query the statistics of the given signer instead of the ones of all the signers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// getQueryGranterSuspensionCmd returns the command to query the suspension state of a granting contract.
+func getQueryGranterSuspensionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "granter-suspension [contract_address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the suspension state of a granting contract",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GranterSuspension(cmd.Context(), &types.GranterSuspensionRequest{
+				ContractAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// getQueryParamsCmd returns the command to query the module parameters.
+func getQueryParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the module parameters",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -3,12 +3,17 @@ package cwfees
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/archway-network/archway/pkg"
 
@@ -24,18 +29,117 @@ type WasmdKeeper interface {
 type Keeper struct {
 	wasmdKeeper WasmdKeeper
 
-	cdc codec.BinaryCodec
+	cdc       codec.BinaryCodec
+	authority string // this should be the x/gov module account
 
 	Schema            collections.Schema
 	GrantingContracts collections.KeySet[[]byte]
+	// PendingSettlements keeps the grants accepted for the TXs of the block by height and TX hash, until they are settled.
+	PendingSettlements collections.Map[collections.Pair[int64, []byte], types.PendingSettlement]
+	GrantPolicies      collections.Map[[]byte, types.GrantPolicy]
+	// SignerGrants counts the grants each signer was granted by a granting contract under its grant policy.
+	SignerGrants collections.Map[collections.Pair[[]byte, []byte], uint64]
+	// GranterStats and SignerStats keep the grant usage statistics of the granting contracts and of their signers.
+	GranterStats collections.Map[[]byte, types.GrantStats]
+	SignerStats  collections.Map[collections.Pair[[]byte, []byte], types.GrantStats]
+	Params       collections.Item[types.Params]
+	// Suspensions keeps the suspensions of the granting contracts, and GranterFailures the consecutive
+	// grant request failures which lead to them.
+	Suspensions     collections.Map[[]byte, types.GranterSuspension]
+	GranterFailures collections.Map[[]byte, types.GranterFailures]
+
+	// grantOutcomes buffers the outcomes of the grant requests sent to the granting contracts during the block,
+	// and the grant requests rejected during the block.
+	// A failed grant request makes the TX fail in the ante handler, which reverts all its state changes,
+	// so the outcomes are kept in memory and applied to the failure counters and to the grant usage
+	// statistics in the end blocker.
+	grantOutcomes *grantOutcomes
+	// checkTxFailures counts the grant request failures of CheckTx, which are not part of the consensus.
+	checkTxFailures *checkTxFailures
+}
+
+// grantOutcomes records, in order, the outcomes of the grant requests sent to the granting contracts,
+// and the rejected grant requests.
+type grantOutcomes struct {
+	outcomes   []grantOutcome
+	rejections []grantRejection
+}
+
+type grantOutcome struct {
+	grantingContract sdk.AccAddress
+	failed           bool
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, wasmdKeeper WasmdKeeper) Keeper {
+type grantRejection struct {
+	grantingContract sdk.AccAddress
+	signers          []sdk.AccAddress
+	sudoGasUsed      uint64
+}
+
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, wasmdKeeper WasmdKeeper, authority string) Keeper {
 	schemaBuilder := collections.NewSchemaBuilder(collcompat.NewKVStoreService(storeKey))
 	k := Keeper{
 		cdc:               cdc,
+		authority:         authority,
 		wasmdKeeper:       wasmdKeeper,
+		grantOutcomes:     new(grantOutcomes),
+		checkTxFailures:   newCheckTxFailures(),
 		GrantingContracts: collections.NewKeySet(schemaBuilder, types.GrantersPrefix, "granting_contracts", collections.BytesKey),
+		PendingSettlements: collections.NewMap(
+			schemaBuilder,
+			types.PendingSettlementsPrefix,
+			"pending_settlements",
+			collections.PairKeyCodec(collections.Int64Key, collections.BytesKey),
+			collcompat.ProtoValue[types.PendingSettlement](cdc),
+		),
+		GrantPolicies: collections.NewMap(
+			schemaBuilder,
+			types.GrantPoliciesPrefix,
+			"grant_policies",
+			collections.BytesKey,
+			collcompat.ProtoValue[types.GrantPolicy](cdc),
+		),
+		SignerGrants: collections.NewMap(
+			schemaBuilder,
+			types.SignerGrantsPrefix,
+			"signer_grants",
+			collections.PairKeyCodec(collections.BytesKey, collections.BytesKey),
+			collections.Uint64Value,
+		),
+		GranterStats: collections.NewMap(
+			schemaBuilder,
+			types.GranterStatsPrefix,
+			"granter_stats",
+			collections.BytesKey,
+			collcompat.ProtoValue[types.GrantStats](cdc),
+		),
+		SignerStats: collections.NewMap(
+			schemaBuilder,
+			types.SignerStatsPrefix,
+			"signer_stats",
+			collections.PairKeyCodec(collections.BytesKey, collections.BytesKey),
+			collcompat.ProtoValue[types.GrantStats](cdc),
+		),
+		Params: collections.NewItem(
+			schemaBuilder,
+			types.ParamsPrefix,
+			"params",
+			collcompat.ProtoValue[types.Params](cdc),
+		),
+		Suspensions: collections.NewMap(
+			schemaBuilder,
+			types.SuspensionsPrefix,
+			"suspensions",
+			collections.BytesKey,
+			collcompat.ProtoValue[types.GranterSuspension](cdc),
+		),
+		GranterFailures: collections.NewMap(
+			schemaBuilder,
+			types.GranterFailuresPrefix,
+			"granter_failures",
+			collections.BytesKey,
+			collcompat.ProtoValue[types.GranterFailures](cdc),
+		),
 	}
 	schema, err := schemaBuilder.Build()
 	if err != nil {
@@ -45,12 +149,53 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, wasmdKeeper
 	return k
 }
 
+// GetAuthority returns the x/cwfees module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module parameters.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	return k.Params.Get(ctx)
+}
+
+// SetParams sets the module parameters. The granting contracts in the deny list are unregistered.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := k.Params.Set(ctx, params); err != nil {
+		return err
+	}
+	for _, addrStr := range params.DeniedGranters {
+		granter, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			return err
+		}
+		isGranter, err := k.IsGrantingContract(ctx, granter)
+		if err != nil {
+			return err
+		}
+		if !isGranter {
+			continue
+		}
+		if err := k.UnregisterAsGranter(ctx, granter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // RegisterAsGranter registers the contract as a granter.
 func (k Keeper) RegisterAsGranter(ctx context.Context, granter sdk.AccAddress) error {
 	// we want to assess that the granter is a CW contract.
 	if !k.wasmdKeeper.HasContractInfo(sdk.UnwrapSDKContext(ctx), granter) {
 		return types.ErrNotAContract
 	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.IsDeniedGranter(granter) {
+		return types.ErrGranterDenied.Wrapf("address %s", granter.String())
+	}
 	isGranter, err := k.IsGrantingContract(ctx, granter)
 	if err != nil {
 		return err
@@ -62,6 +207,7 @@ func (k Keeper) RegisterAsGranter(ctx context.Context, granter sdk.AccAddress) e
 }
 
 // UnregisterAsGranter removes the contract from the granting contracts list.
+// The suspension of the contract is kept, so that it can not be lifted by registering again.
 func (k Keeper) UnregisterAsGranter(ctx context.Context, granter sdk.AccAddress) error {
 	isGranter, err := k.IsGrantingContract(ctx, granter)
 	if err != nil {
@@ -70,9 +216,62 @@ func (k Keeper) UnregisterAsGranter(ctx context.Context, granter sdk.AccAddress)
 	if !isGranter {
 		return types.ErrNotAGranter.Wrapf("address %s", granter.String())
 	}
+	if err := k.GrantPolicies.Remove(ctx, granter); err != nil {
+		return err
+	}
+	if err := k.SignerGrants.Clear(ctx, collections.NewPrefixedPairRange[[]byte, []byte](granter)); err != nil {
+		return err
+	}
+	if err := k.GranterFailures.Remove(ctx, granter); err != nil {
+		return err
+	}
 	return k.GrantingContracts.Remove(ctx, granter)
 }
 
+// SetGrantPolicy sets the policy the grant requests of the granting contract are natively evaluated with.
+// The grants counted against the signer quota of the previous policy are reset.
+func (k Keeper) SetGrantPolicy(ctx context.Context, granter sdk.AccAddress, policy types.GrantPolicy) error {
+	isGranter, err := k.IsGrantingContract(ctx, granter)
+	if err != nil {
+		return err
+	}
+	if !isGranter {
+		return types.ErrNotAGranter.Wrapf("address %s", granter.String())
+	}
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	if err := k.SignerGrants.Clear(ctx, collections.NewPrefixedPairRange[[]byte, []byte](granter)); err != nil {
+		return err
+	}
+	return k.GrantPolicies.Set(ctx, granter, policy)
+}
+
+// RemoveGrantPolicy removes the grant policy of the granting contract, its grant requests are then
+// always sent to the contract.
+func (k Keeper) RemoveGrantPolicy(ctx context.Context, granter sdk.AccAddress) error {
+	hasPolicy, err := k.GrantPolicies.Has(ctx, granter)
+	if err != nil {
+		return err
+	}
+	if !hasPolicy {
+		return types.ErrGrantPolicyNotFound.Wrapf("address %s", granter.String())
+	}
+	if err := k.SignerGrants.Clear(ctx, collections.NewPrefixedPairRange[[]byte, []byte](granter)); err != nil {
+		return err
+	}
+	return k.GrantPolicies.Remove(ctx, granter)
+}
+
+// GetGrantPolicy returns the grant policy of the granting contract.
+func (k Keeper) GetGrantPolicy(ctx context.Context, granter sdk.AccAddress) (types.GrantPolicy, error) {
+	policy, err := k.GrantPolicies.Get(ctx, granter)
+	if errors.Is(err, collections.ErrNotFound) {
+		return policy, types.ErrGrantPolicyNotFound.Wrapf("address %s", granter.String())
+	}
+	return policy, err
+}
+
 // IsGrantingContract checks if the provided granter address is one of the registered granting contracts.
 func (k Keeper) IsGrantingContract(ctx context.Context, granter sdk.AccAddress) (bool, error) {
 	return k.GrantingContracts.Has(ctx, granter)
@@ -81,32 +280,504 @@ func (k Keeper) IsGrantingContract(ctx context.Context, granter sdk.AccAddress)
 const RequestGrantGasLimit = 100_000
 
 // RequestGrant will signal to the contract that there's a grant request for a set of messages and the fees.
-// In case the contract does not accept the grant then an error is returned.
-func (k Keeper) RequestGrant(ctx context.Context, grantingContract sdk.AccAddress, txMsgs []sdk.Msg, wantFees sdk.Coins, signers []sdk.AccAddress) error {
+// In case the contract does not accept the grant then an error is returned. Otherwise, the fees the contract
+// covers are returned, which can be only a part of the wanted fees if the contract responded with a covered fee.
+// If the contract has a grant policy, the grant request is evaluated with it instead, and only sent to the contract
+// when it does not match the policy and the policy falls back to the contract.
+// The outcome of the grant request is recorded in the grant usage statistics of the contract.
+// The grant requests of a suspended contract are rejected without being sent to it.
+// The failures of the contract to handle the grant request do not count towards its suspension, see RequestTxGrant.
+func (k Keeper) RequestGrant(ctx context.Context, grantingContract sdk.AccAddress, txMsgs []sdk.Msg, wantFees sdk.Coins, signers []sdk.AccAddress) (sdk.Coins, error) {
+	return k.handleGrantRequest(ctx, grantingContract, txMsgs, wantFees, signers, false)
+}
+
+// RequestTxGrant requests the grant of the fees of the current TX from its fee granter, as RequestGrant does.
+// The failures of the contract to handle the grant request count towards its suspension: a contract is accountable
+// for the grant requests of the TXs naming it as their fee granter, but not for the ones made on behalf of others,
+// such as the fee payers of the callbacks, as anyone could make them fail.
+func (k Keeper) RequestTxGrant(ctx context.Context, grantingContract sdk.AccAddress, txMsgs []sdk.Msg, wantFees sdk.Coins, signers []sdk.AccAddress) (sdk.Coins, error) {
+	return k.handleGrantRequest(ctx, grantingContract, txMsgs, wantFees, signers, true)
+}
+
+func (k Keeper) handleGrantRequest(ctx context.Context, grantingContract sdk.AccAddress, txMsgs []sdk.Msg, wantFees sdk.Coins, signers []sdk.AccAddress, isTxFeeGranter bool) (sdk.Coins, error) {
+	coveredFees, sudoGasUsed, err := k.requestGrant(ctx, grantingContract, txMsgs, wantFees, signers, isTxFeeGranter)
+	if statsErr := k.recordGrantRequest(ctx, grantingContract, signers, coveredFees, sudoGasUsed, err == nil); statsErr != nil {
+		return nil, statsErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return coveredFees, nil
+}
+
+// requestGrant evaluates the grant request and returns the fees covered by the contract,
+// along with the gas the contract consumed handling the request. The outcome of the request
+// is only recorded for the suspension of the contract if it is the fee granter of the TX.
+func (k Keeper) requestGrant(ctx context.Context, grantingContract sdk.AccAddress, txMsgs []sdk.Msg, wantFees sdk.Coins, signers []sdk.AccAddress, isTxFeeGranter bool) (sdk.Coins, uint64, error) {
+	suspension, suspended, err := k.GetSuspension(ctx, grantingContract)
+	if err != nil {
+		return nil, 0, err
+	}
+	if suspended {
+		return nil, 0, types.ErrGranterSuspended.Wrapf("address %s until height %d", grantingContract.String(), suspension.SuspendedUntilHeight)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if isCheckTx(sdkCtx) {
+		if until, suspended := k.checkTxFailures.suspendedUntil(grantingContract, sdkCtx.BlockHeight()); suspended {
+			return nil, 0, types.ErrGranterSuspended.Wrapf("address %s in CheckTx until height %d", grantingContract.String(), until)
+		}
+	}
+
+	policy, err := k.GrantPolicies.Get(ctx, grantingContract)
+	switch {
+	case err == nil:
+		granted, err := k.evaluateGrantPolicy(ctx, grantingContract, policy, txMsgs, wantFees, signers)
+		if err != nil {
+			return nil, 0, err
+		}
+		if granted {
+			// A grant approved by the policy is a successful grant request of the contract as well
+			if isTxFeeGranter {
+				k.recordGrantOutcome(sdkCtx, grantingContract, false)
+			}
+			return wantFees, 0, nil
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, 0, err
+	}
+
 	msg, err := types.NewSudoMsg(k.cdc, wantFees, txMsgs, signers)
 	if err != nil {
-		return err
+		return nil, 0, err
 	}
 	msgBytes, err := json.Marshal(msg)
 	if err != nil {
-		return err
+		return nil, 0, err
 	}
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	// if tx limit remaining is < RequestGrantGasLimit, we want to pick that over our gas limit.
 	// otherwise we pick request grant gas limit because a failure in the ante means the whole
 	// tx is reverted, so if a malicious user creates a granting contract that consumes 100_000_000gas,
 	// and we allow it to run fully, then basically the malicious user can make the chain do
 	// computation for free.
 	gasLimitToUse := min(sdkCtx.GasMeter().GasRemaining(), RequestGrantGasLimit)
-	_, err = pkg.ExecuteWithGasLimit(sdkCtx, gasLimitToUse, func(ctx sdk.Context) error {
-		_, err = k.wasmdKeeper.Sudo(sdk.UnwrapSDKContext(ctx), grantingContract, msgBytes)
+	var resp []byte
+	gasUsed, err := pkg.ExecuteWithGasLimit(sdkCtx, gasLimitToUse, func(ctx sdk.Context) error {
+		resp, err = k.wasmdKeeper.Sudo(sdk.UnwrapSDKContext(ctx), grantingContract, msgBytes)
+		return err
+	})
+	failed := isGranterFailure(err, gasLimitToUse)
+	var coveredFees sdk.Coins
+	if err == nil {
+		coveredFees, err = types.ParseCWGrantResponse(resp, wantFees)
+	}
+	if isTxFeeGranter {
+		k.recordGrantOutcome(sdkCtx, grantingContract, failed)
+	}
+	if err != nil {
+		return nil, gasUsed, err
+	}
+	return coveredFees, gasUsed, nil
+}
+
+// wasmVMExecutionError is the prefix of the errors of the VM executing a contract, which include its panics.
+// wasmd wraps them as a message only, so they can not be told apart from the errors returned by the contract otherwise.
+const wasmVMExecutionError = "Error calling the VM: Error executing Wasm"
+
+// isGranterFailure returns true if the granting contract failed to handle the grant request, rather than rejected it:
+// the contract panicked, or it ran out of gas while it was given the full RequestGrantGasLimit, as the signer of a TX
+// with less gas left could make any contract run out of gas.
+func isGranterFailure(err error, gasLimit uint64) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, sdkerrors.ErrOutOfGas) {
+		return gasLimit == RequestGrantGasLimit
+	}
+	return strings.Contains(err.Error(), wasmVMExecutionError)
+}
+
+// isCheckTx returns true if the TX is checked for the mempool rather than executed.
+func isCheckTx(ctx sdk.Context) bool {
+	return ctx.ExecMode() == sdk.ExecModeCheck || ctx.ExecMode() == sdk.ExecModeReCheck
+}
+
+// recordGrantOutcome buffers the outcome of a grant request sent to the granting contract, to be applied
+// to its failure counter in the end blocker. The outcomes of CheckTx are counted by the node only, as they are
+// not part of the consensus, and the ones of simulations are not counted.
+func (k Keeper) recordGrantOutcome(ctx sdk.Context, grantingContract sdk.AccAddress, failed bool) {
+	switch {
+	case ctx.ExecMode() == sdk.ExecModeFinalize:
+		k.grantOutcomes.outcomes = append(k.grantOutcomes.outcomes, grantOutcome{grantingContract: grantingContract, failed: failed})
+	case isCheckTx(ctx):
+		// the params are read without charging the TX, so CheckTx consumes the same gas as the block execution
+		params, err := k.GetParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+		if err != nil {
+			ctx.Logger().Error("failed to read the params to count the grant request failures of CheckTx", "error", err)
+			return
+		}
+		k.checkTxFailures.record(params, grantingContract, ctx.BlockHeight(), failed)
+	}
+}
+
+// recordGrantRejection buffers a rejected grant request, to be applied to the grant usage statistics
+// in the end blocker. As for the outcomes, only the grant requests of the block execution are recorded.
+func (k Keeper) recordGrantRejection(ctx sdk.Context, grantingContract sdk.AccAddress, signers []sdk.AccAddress, sudoGasUsed uint64) {
+	if ctx.ExecMode() != sdk.ExecModeFinalize {
+		return
+	}
+	k.grantOutcomes.rejections = append(k.grantOutcomes.rejections, grantRejection{
+		grantingContract: grantingContract,
+		signers:          signers,
+		sudoGasUsed:      sudoGasUsed,
+	})
+}
+
+// ResetGrantOutcomes discards the grant request outcomes buffered by a previous execution of the block,
+// which can be the case if it was aborted.
+func (k Keeper) ResetGrantOutcomes() {
+	k.grantOutcomes.outcomes = nil
+	k.grantOutcomes.rejections = nil
+}
+
+// ApplyGrantOutcomes applies the outcomes of the grant requests of the block to the failure counters of the
+// granting contracts. A successful grant request resets the counter, and a granting contract whose consecutive
+// failures within the failure window reach the maximum is suspended. The expired suspensions are then removed.
+// The rejected grant requests of the block are also counted in the grant usage statistics.
+func (k Keeper) ApplyGrantOutcomes(ctx sdk.Context) error {
+	outcomes, rejections := k.grantOutcomes.outcomes, k.grantOutcomes.rejections
+	k.ResetGrantOutcomes()
+
+	for _, rejection := range rejections {
+		err := k.updateGrantStats(ctx, rejection.grantingContract, rejection.signers, func(stats types.GrantStats) types.GrantStats {
+			stats.RejectedRequests++
+			stats.SudoGasUsed += rejection.sudoGasUsed
+			return stats
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	height := ctx.BlockHeight()
+	for _, outcome := range outcomes {
+		if !outcome.failed {
+			if err := k.GranterFailures.Remove(ctx, outcome.grantingContract); err != nil {
+				return err
+			}
+			continue
+		}
+		if params.MaxConsecutiveFailures == 0 {
+			continue
+		}
+		failures, err := k.GetGranterFailures(ctx, outcome.grantingContract)
+		if err != nil {
+			return err
+		}
+		if failures.ConsecutiveFailures == 0 || height-failures.WindowStartHeight >= params.FailureWindow {
+			failures = types.GranterFailures{WindowStartHeight: height}
+		}
+		failures.ConsecutiveFailures++
+		if failures.ConsecutiveFailures < params.MaxConsecutiveFailures {
+			if err := k.GranterFailures.Set(ctx, outcome.grantingContract, failures); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.GranterFailures.Remove(ctx, outcome.grantingContract); err != nil {
+			return err
+		}
+		suspension := types.GranterSuspension{
+			GrantingContract:     outcome.grantingContract.String(),
+			SuspendedAtHeight:    height,
+			SuspendedUntilHeight: height + params.SuspensionPeriod,
+		}
+		if err := k.Suspensions.Set(ctx, outcome.grantingContract, suspension); err != nil {
+			return err
+		}
+		types.EmitGranterSuspendedEvent(ctx, suspension.GrantingContract, failures.ConsecutiveFailures, suspension.SuspendedUntilHeight)
+	}
+
+	var expired [][]byte
+	err = k.Suspensions.Walk(ctx, nil, func(key []byte, suspension types.GranterSuspension) (stop bool, err error) {
+		if suspension.SuspendedUntilHeight <= height {
+			expired = append(expired, key)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range expired {
+		if err := k.Suspensions.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSuspension returns the suspension of the granting contract, and whether it is currently suspended.
+func (k Keeper) GetSuspension(ctx context.Context, granter sdk.AccAddress) (types.GranterSuspension, bool, error) {
+	suspension, err := k.Suspensions.Get(ctx, granter)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.GranterSuspension{}, false, nil
+	}
+	if err != nil {
+		return suspension, false, err
+	}
+	return suspension, suspension.SuspendedUntilHeight > sdk.UnwrapSDKContext(ctx).BlockHeight(), nil
+}
+
+// GetGranterFailures returns the consecutive grant request failures of the granting contract.
+func (k Keeper) GetGranterFailures(ctx context.Context, granter sdk.AccAddress) (types.GranterFailures, error) {
+	failures, err := k.GranterFailures.Get(ctx, granter)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.GranterFailures{}, nil
+	}
+	return failures, err
+}
+
+// recordGrantRequest records the outcome of a grant request in the grant usage statistics
+// of the granting contract and of the signer. A rejected grant request fails the TX in the ante handler,
+// or the callback charge, reverting their state changes, so it is buffered and counted in the end blocker.
+func (k Keeper) recordGrantRequest(ctx context.Context, grantingContract sdk.AccAddress, signers []sdk.AccAddress, feesPaid sdk.Coins, sudoGasUsed uint64, accepted bool) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !accepted {
+		k.recordGrantRejection(sdkCtx, grantingContract, signers, sudoGasUsed)
+		return nil
+	}
+	height := sdkCtx.BlockHeight()
+	return k.updateGrantStats(ctx, grantingContract, signers, func(stats types.GrantStats) types.GrantStats {
+		stats.SponsoredTxs++
+		stats.FeesPaid = sdk.Coins(stats.FeesPaid).Add(feesPaid...)
+		stats.LastUsedHeight = height
+		stats.SudoGasUsed += sudoGasUsed
+		return stats
+	})
+}
+
+// updateGrantStats updates the grant usage statistics of the granting contract and of the signer.
+func (k Keeper) updateGrantStats(ctx context.Context, grantingContract sdk.AccAddress, signers []sdk.AccAddress, update func(types.GrantStats) types.GrantStats) error {
+	granterStats, err := k.GetGranterStats(ctx, grantingContract)
+	if err != nil {
+		return err
+	}
+	if err := k.GranterStats.Set(ctx, grantingContract, update(granterStats)); err != nil {
+		return err
+	}
+	// grants are only requested for single signer TXs
+	if len(signers) != 1 {
+		return nil
+	}
+	signerStats, err := k.GetSignerStats(ctx, grantingContract, signers[0])
+	if err != nil {
+		return err
+	}
+	return k.SignerStats.Set(ctx, collections.Join(grantingContract.Bytes(), signers[0].Bytes()), update(signerStats))
+}
+
+// GetGranterStats returns the grant usage statistics of the granting contract.
+func (k Keeper) GetGranterStats(ctx context.Context, granter sdk.AccAddress) (types.GrantStats, error) {
+	stats, err := k.GranterStats.Get(ctx, granter)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.GrantStats{}, nil
+	}
+	return stats, err
+}
+
+// GetSignerStats returns the grant usage statistics of the signer for the granting contract.
+func (k Keeper) GetSignerStats(ctx context.Context, granter, signer sdk.AccAddress) (types.GrantStats, error) {
+	stats, err := k.SignerStats.Get(ctx, collections.Join(granter.Bytes(), signer.Bytes()))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.GrantStats{}, nil
+	}
+	return stats, err
+}
+
+// GetGrantingContracts returns the paginated list of the granting contracts.
+func (k Keeper) GetGrantingContracts(ctx context.Context, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx,
+		k.GrantingContracts,
+		pageReq,
+		func(key []byte, _ collections.NoValue) (string, error) {
+			return sdk.AccAddress(key).String(), nil
+		},
+	)
+}
+
+// evaluateGrantPolicy evaluates the grant request with the grant policy of the granting contract and counts the grant
+// against the signer quota when it matches. It returns false if the request does not match the policy and has to be
+// sent to the contract, or an error if it does not match and the policy does not fall back to the contract.
+func (k Keeper) evaluateGrantPolicy(ctx context.Context, grantingContract sdk.AccAddress, policy types.GrantPolicy, txMsgs []sdk.Msg, wantFees sdk.Coins, signers []sdk.AccAddress) (bool, error) {
+	if len(signers) != 1 {
+		return false, fmt.Errorf("cw grants on multi signer messages are disallowed, got number of signers: %d", len(signers))
+	}
+	key := collections.Join(grantingContract.Bytes(), signers[0].Bytes())
+	signerGrants, err := k.SignerGrants.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, err
+	}
+
+	matchErr := policy.Match(txMsgs, signers[0], wantFees)
+	if matchErr == nil && policy.SignerQuota != 0 && signerGrants >= policy.SignerQuota {
+		matchErr = fmt.Errorf("signer %s exhausted its quota of %d grants", signers[0], policy.SignerQuota)
+	}
+	if matchErr != nil {
+		if policy.SudoFallback {
+			return false, nil
+		}
+		return false, types.ErrGrantDenied.Wrap(matchErr.Error())
+	}
+
+	if policy.SignerQuota != 0 {
+		if err := k.SignerGrants.Set(ctx, key, signerGrants+1); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+const GrantSettledGasLimit = 100_000
+
+// pendingSettlementKey returns the key of the grant accepted for the current TX.
+func pendingSettlementKey(ctx sdk.Context) collections.Pair[int64, []byte] {
+	return collections.Join(ctx.BlockHeight(), tmhash.Sum(ctx.TxBytes()))
+}
+
+// TrackGrant records the grant accepted by the granting contract for the current TX,
+// so that the contract is notified of the outcome of the TX once it is executed.
+func (k Keeper) TrackGrant(ctx context.Context, grantingContract, sender sdk.AccAddress, feeCharged, feeCovered sdk.Coins) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.PendingSettlements.Set(ctx, pendingSettlementKey(sdkCtx), types.PendingSettlement{
+		GrantingContract: grantingContract.String(),
+		Sender:           sender.String(),
+		FeeCharged:       feeCharged,
+		FeeCovered:       feeCovered,
+		GasLimit:         sdkCtx.GasMeter().Limit(),
+		GasUsed:          sdkCtx.GasMeter().GasConsumed(),
+	})
+}
+
+// TrackGrantGas records the gas consumed by the current TX once the ante handler finished in the grant accepted for it.
+// The state changes of a failed TX are reverted after the ante handler, so this is the gas reported when it is settled.
+func (k Keeper) TrackGrantGas(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// the gas consumed by the TX, before the tracking
+	gasUsed := sdkCtx.GasMeter().GasConsumed()
+	key := pendingSettlementKey(sdkCtx)
+	settlement, err := k.PendingSettlements.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	settlement.GasUsed = gasUsed
+	return k.PendingSettlements.Set(ctx, key, settlement)
+}
+
+// SettleGrant notifies the granting contract which accepted the grant for the current TX that the TX
+// was executed successfully, along with the gas it consumed. The state changes of a failed TX are reverted,
+// including the ones of its settlement, so the grants of failed TXs are settled in the end blocker instead.
+func (k Keeper) SettleGrant(ctx context.Context, success bool) error {
+	if !success {
+		return nil
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// the gas consumed by the TX, before the settlement
+	txGasUsed := sdkCtx.GasMeter().GasConsumed()
+	key := pendingSettlementKey(sdkCtx)
+	settlement, err := k.PendingSettlements.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if err := k.PendingSettlements.Remove(ctx, key); err != nil {
+		return err
+	}
+	// the settlement is charged to the TX, up to its remaining gas so that it can never make the TX fail.
+	gasMeter := sdkCtx.GasMeter()
+	gasLimit := min(gasMeter.GasRemaining(), GrantSettledGasLimit)
+	settleGasUsed := k.settle(sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()), settlement, true, txGasUsed, gasLimit)
+	gasMeter.ConsumeGas(min(settleGasUsed, gasLimit), "cw grant settlement")
+	return nil
+}
+
+// SettlePendingGrants notifies the granting contracts of the TXs which failed, as their grants were not settled
+// after their execution, along with the gas the TXs consumed once the ante handler finished. The settlements are
+// sent oldest first within the BlockSettlementGasLimit param, the ones which do not fit are sent in the next blocks.
+// The first settlement of the block always fits, so a settlement can not be delayed indefinitely.
+func (k Keeper) SettlePendingGrants(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	var gasConsumed uint64
+	for {
+		key, settlement, found, err := k.nextPendingSettlement(ctx)
+		if err != nil {
+			return err
+		}
+		if !found || (gasConsumed > 0 && gasConsumed+GrantSettledGasLimit > params.BlockSettlementGasLimit) {
+			return nil
+		}
+		if err := k.PendingSettlements.Remove(ctx, key); err != nil {
+			return err
+		}
+		gasConsumed += min(k.settle(ctx, settlement, false, settlement.GasUsed, GrantSettledGasLimit), GrantSettledGasLimit)
+	}
+}
+
+// nextPendingSettlement returns the oldest grant which is not settled yet. The iterator is closed before returning,
+// so the pending settlements can be modified by the caller.
+func (k Keeper) nextPendingSettlement(ctx sdk.Context) (collections.Pair[int64, []byte], types.PendingSettlement, bool, error) {
+	iter, err := k.PendingSettlements.Iterate(ctx, nil)
+	if err != nil {
+		return collections.Pair[int64, []byte]{}, types.PendingSettlement{}, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return collections.Pair[int64, []byte]{}, types.PendingSettlement{}, false, nil
+	}
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return collections.Pair[int64, []byte]{}, types.PendingSettlement{}, false, err
+	}
+	return kv.Key, kv.Value, true, nil
+}
+
+// settle sends the CWGrantSettled sudo message to the granting contract. As the contract is only notified,
+// its failure to handle the message is logged and does not affect the TX. It returns the gas consumed by the contract.
+func (k Keeper) settle(ctx sdk.Context, settlement types.PendingSettlement, success bool, gasUsed, gasLimit uint64) uint64 {
+	grantingContract, err := sdk.AccAddressFromBech32(settlement.GrantingContract)
+	if err != nil {
+		panic(err)
+	}
+	msgBytes, err := json.Marshal(types.NewSettledSudoMsg(settlement, success, gasUsed))
+	if err != nil {
+		panic(err)
+	}
+	settleGasUsed, err := pkg.ExecuteWithGasLimit(ctx, gasLimit, func(ctx sdk.Context) error {
+		_, err := k.wasmdKeeper.Sudo(ctx, grantingContract, msgBytes)
 		return err
 	})
-	return err
+	if err != nil {
+		ctx.Logger().Debug("granting contract failed to handle the grant settlement", "granting_contract", settlement.GrantingContract, "error", err)
+	}
+	return settleGasUsed
 }
 
 // ImportState imports the state, assumes all contracts provided are valid.
 func (k Keeper) ImportState(ctx context.Context, state *types.GenesisState) error {
+	if err := k.SetParams(ctx, state.Params); err != nil {
+		return err
+	}
 	for i, addrStr := range state.GrantingContracts {
 		addr, err := sdk.AccAddressFromBech32(addrStr)
 		if err != nil {
@@ -117,17 +788,149 @@ func (k Keeper) ImportState(ctx context.Context, state *types.GenesisState) erro
 			return err
 		}
 	}
+	for i, p := range state.GrantPolicies {
+		addr, err := sdk.AccAddressFromBech32(p.GrantingContract)
+		if err != nil {
+			return fmt.Errorf("invalid address of grant policy at index %d, %s: %w", i, p.GrantingContract, err)
+		}
+		err = k.SetGrantPolicy(ctx, addr, p.Policy)
+		if err != nil {
+			return err
+		}
+	}
+	for i, suspension := range state.Suspensions {
+		addr, err := sdk.AccAddressFromBech32(suspension.GrantingContract)
+		if err != nil {
+			return fmt.Errorf("invalid address of suspension at index %d, %s: %w", i, suspension.GrantingContract, err)
+		}
+		err = k.Suspensions.Set(ctx, addr, suspension)
+		if err != nil {
+			return err
+		}
+	}
+	for i, stats := range state.GranterStats {
+		addr, err := sdk.AccAddressFromBech32(stats.GrantingContract)
+		if err != nil {
+			return fmt.Errorf("invalid address of granter stats at index %d, %s: %w", i, stats.GrantingContract, err)
+		}
+		err = k.GranterStats.Set(ctx, addr, stats.Stats)
+		if err != nil {
+			return err
+		}
+	}
+	for i, stats := range state.SignerStats {
+		addr, err := sdk.AccAddressFromBech32(stats.GrantingContract)
+		if err != nil {
+			return fmt.Errorf("invalid address of signer stats at index %d, %s: %w", i, stats.GrantingContract, err)
+		}
+		signer, err := sdk.AccAddressFromBech32(stats.Signer)
+		if err != nil {
+			return fmt.Errorf("invalid signer address of signer stats at index %d, %s: %w", i, stats.Signer, err)
+		}
+		err = k.SignerStats.Set(ctx, collections.Join(addr.Bytes(), signer.Bytes()), stats.Stats)
+		if err != nil {
+			return err
+		}
+	}
+	for i, f := range state.GranterFailures {
+		addr, err := sdk.AccAddressFromBech32(f.GrantingContract)
+		if err != nil {
+			return fmt.Errorf("invalid address of granter failures at index %d, %s: %w", i, f.GrantingContract, err)
+		}
+		err = k.GranterFailures.Set(ctx, addr, f.Failures)
+		if err != nil {
+			return err
+		}
+	}
+	// the signer grants are imported after the grant policies, as setting a policy resets them
+	for i, g := range state.SignerGrants {
+		addr, err := sdk.AccAddressFromBech32(g.GrantingContract)
+		if err != nil {
+			return fmt.Errorf("invalid address of signer grants at index %d, %s: %w", i, g.GrantingContract, err)
+		}
+		signer, err := sdk.AccAddressFromBech32(g.Signer)
+		if err != nil {
+			return fmt.Errorf("invalid signer address of signer grants at index %d, %s: %w", i, g.Signer, err)
+		}
+		err = k.SignerGrants.Set(ctx, collections.Join(addr.Bytes(), signer.Bytes()), g.Grants)
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
 // ExportState exports the state.
 func (k Keeper) ExportState(ctx context.Context) (*types.GenesisState, error) {
-	s := new(types.GenesisState)
-	err := k.GrantingContracts.Walk(ctx, nil, func(key []byte) (stop bool, err error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s := &types.GenesisState{Params: params}
+	err = k.GrantingContracts.Walk(ctx, nil, func(key []byte) (stop bool, err error) {
 		addrStr := sdk.AccAddress(key).String()
 		s.GrantingContracts = append(s.GrantingContracts, addrStr)
 		return false, nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.GrantPolicies.Walk(ctx, nil, func(key []byte, policy types.GrantPolicy) (stop bool, err error) {
+		s.GrantPolicies = append(s.GrantPolicies, types.GrantingContractPolicy{
+			GrantingContract: sdk.AccAddress(key).String(),
+			Policy:           policy,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.Suspensions.Walk(ctx, nil, func(_ []byte, suspension types.GranterSuspension) (stop bool, err error) {
+		s.Suspensions = append(s.Suspensions, suspension)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.GranterStats.Walk(ctx, nil, func(key []byte, stats types.GrantStats) (stop bool, err error) {
+		s.GranterStats = append(s.GranterStats, types.GrantingContractStats{
+			GrantingContract: sdk.AccAddress(key).String(),
+			Stats:            stats,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.SignerStats.Walk(ctx, nil, func(key collections.Pair[[]byte, []byte], stats types.GrantStats) (stop bool, err error) {
+		s.SignerStats = append(s.SignerStats, types.GrantingContractStats{
+			GrantingContract: sdk.AccAddress(key.K1()).String(),
+			Signer:           sdk.AccAddress(key.K2()).String(),
+			Stats:            stats,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.GranterFailures.Walk(ctx, nil, func(key []byte, failures types.GranterFailures) (stop bool, err error) {
+		s.GranterFailures = append(s.GranterFailures, types.GrantingContractFailures{
+			GrantingContract: sdk.AccAddress(key).String(),
+			Failures:         failures,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.SignerGrants.Walk(ctx, nil, func(key collections.Pair[[]byte, []byte], grants uint64) (stop bool, err error) {
+		s.SignerGrants = append(s.SignerGrants, types.GrantingContractSignerGrants{
+			GrantingContract: sdk.AccAddress(key.K1()).String(),
+			Signer:           sdk.AccAddress(key.K2()).String(),
+			Grants:           grants,
+		})
+		return false, nil
+	})
 	return s, err
 }
@@ -1,15 +1,30 @@
 package cwfees_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
 	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/x/cwfees"
 	"github.com/archway-network/archway/x/cwfees/types"
 )
 
@@ -51,6 +66,30 @@ func TestKeeper(t *testing.T) {
 		require.False(t, isGranter)
 	})
 
+	t.Run("request grant – contract without covered fee covers all the fees", func(t *testing.T) {
+		codeID := app.UploadContract(app.GetAccount(0), "../../contracts/cwfees/artifacts/cwfees.wasm", wasmdTypes.DefaultUploadAccess)
+		grantedAcc := app.GetAccount(1) // account who receives grants.
+		initMsg := fmt.Sprintf(`{"grants": ["%s"]}`, grantedAcc.Address)
+		cwGranter, _ := app.InstantiateContract(app.GetAccount(0), codeID, app.GetAccount(0).Address.String(), "cwfees", sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000_000_000_000)), json.RawMessage(initMsg))
+		ctx := app.GetContext()
+		err := k.RegisterAsGranter(ctx, cwGranter)
+		require.NoError(t, err)
+
+		msgs := []sdk.Msg{&banktypes.MsgSend{
+			FromAddress: grantedAcc.Address.String(),
+			ToAddress:   app.GetAccount(0).Address.String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+		}}
+		fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000))
+		covered, err := k.RequestGrant(ctx, cwGranter, msgs, fees, []sdk.AccAddress{grantedAcc.Address})
+		require.NoError(t, err)
+		require.Equal(t, fees, covered)
+
+		// not granted sender
+		_, err = k.RequestGrant(ctx, cwGranter, msgs, fees, []sdk.AccAddress{app.GetAccount(2).Address})
+		require.Error(t, err)
+	})
+
 	t.Run("state import and export", func(t *testing.T) {
 		codeID := app.UploadContract(app.GetAccount(0), "../../contracts/cwfees/artifacts/cwfees.wasm", wasmdTypes.DefaultUploadAccess)
 		grantedAcc := app.GetAccount(1) // account who receives grants.
@@ -58,7 +97,7 @@ func TestKeeper(t *testing.T) {
 		cwGranter, _ := app.InstantiateContract(app.GetAccount(0), codeID, app.GetAccount(0).Address.String(), "cwfees", sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000_000_000_000)), json.RawMessage(initMsg))
 
 		ctx := app.GetContext()
-		wantState := &types.GenesisState{GrantingContracts: []string{cwGranter.String()}}
+		wantState := &types.GenesisState{Params: types.DefaultParams(), GrantingContracts: []string{cwGranter.String()}}
 		err := k.ImportState(ctx, wantState)
 		require.NoError(t, err)
 
@@ -69,6 +108,415 @@ func TestKeeper(t *testing.T) {
 	})
 }
 
+func TestGranterSuspensionInBlock(t *testing.T) {
+	app := e2eTesting.NewTestChain(t, 0)
+	k := app.GetApp().Keepers.CWFeesKeeper
+
+	codeID := app.UploadContract(app.GetAccount(0), "../../contracts/cwfees/artifacts/cwfees.wasm", wasmdTypes.DefaultUploadAccess)
+	grantedAcc := app.GetAccount(1) // account who receives grants.
+	initMsg := fmt.Sprintf(`{"grants": ["%s"]}`, grantedAcc.Address)
+	cwGranter, _ := app.InstantiateContract(app.GetAccount(0), codeID, app.GetAccount(0).Address.String(), "cwfees", sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000_000_000_000)), json.RawMessage(initMsg))
+
+	// the changes of an uncached context are committed with the next block
+	ctx := app.GetApp().NewUncachedContext(false, cmtproto.Header{Height: app.GetBlockHeight()})
+	require.NoError(t, k.SetParams(ctx, types.Params{MaxConsecutiveFailures: 2, FailureWindow: 10, SuspensionPeriod: 100, BlockSettlementGasLimit: 1}))
+	require.NoError(t, k.RegisterAsGranter(ctx, cwGranter))
+
+	// the contract rejects the grant requests of a not granted sender in the ante handler, which is not a failure
+	notGrantedAcc := app.GetAccount(2)
+	msg := &banktypes.MsgSend{
+		FromAddress: notGrantedAcc.Address.String(),
+		ToAddress:   app.GetAccount(0).Address.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+	}
+	fees := sdk.NewInt64Coin("stake", 100_000_000_000)
+	_, _, _, err := app.SendMsgs(notGrantedAcc, false, []sdk.Msg{msg}, e2eTesting.WithGranter(cwGranter), e2eTesting.WithMsgFees(fees))
+	require.Error(t, err)
+
+	failures, err := k.GetGranterFailures(app.GetContext(), cwGranter)
+	require.NoError(t, err)
+	require.Zero(t, failures.ConsecutiveFailures)
+
+	// the contract runs out of gas handling the grant requests with a malicious fee denom
+	maliciousFees := sdk.NewCoins(fees, sdk.NewInt64Coin("malicious", 1))
+	_, _, _, err = app.SendMsgs(notGrantedAcc, false, []sdk.Msg{msg}, e2eTesting.WithGranter(cwGranter), e2eTesting.WithMsgFees(maliciousFees...))
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+
+	failures, err = k.GetGranterFailures(app.GetContext(), cwGranter)
+	require.NoError(t, err)
+	require.Equal(t, types.GranterFailures{ConsecutiveFailures: 1, WindowStartHeight: app.GetBlockHeight()}, failures)
+
+	_, _, _, err = app.SendMsgs(notGrantedAcc, false, []sdk.Msg{msg}, e2eTesting.WithGranter(cwGranter), e2eTesting.WithMsgFees(maliciousFees...))
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+
+	suspension, suspended, err := k.GetSuspension(app.GetContext(), cwGranter)
+	require.NoError(t, err)
+	require.True(t, suspended)
+	require.Equal(t, app.GetBlockHeight(), suspension.SuspendedAtHeight)
+
+	// the grant requests of the granted sender are rejected while the contract is suspended
+	msg.FromAddress = grantedAcc.Address.String()
+	_, _, _, err = app.SendMsgs(grantedAcc, false, []sdk.Msg{msg}, e2eTesting.WithGranter(cwGranter), e2eTesting.WithMsgFees(fees))
+	require.ErrorContains(t, err, types.ErrGranterSuspended.Error())
+}
+
+// mockWasmdKeeper records the sudo messages sent to the contracts.
+type mockWasmdKeeper struct {
+	sudoMsgs map[string][]types.SudoMsg
+	sudoErr  error
+	sudoGas  uint64
+}
+
+func (k *mockWasmdKeeper) HasContractInfo(_ context.Context, _ sdk.AccAddress) bool { return true }
+
+func (k *mockWasmdKeeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	var sudoMsg types.SudoMsg
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+	k.sudoMsgs[contractAddress.String()] = append(k.sudoMsgs[contractAddress.String()], sudoMsg)
+	// as the VM, the contract stops at the gas limit and runs out of gas when it reaches it
+	gasMeter := sdk.UnwrapSDKContext(ctx).GasMeter()
+	gasMeter.ConsumeGas(min(k.sudoGas, gasMeter.GasRemaining()), "sudo")
+	if gasMeter.IsOutOfGas() {
+		panic(storetypes.ErrorOutOfGas{Descriptor: "sudo"})
+	}
+	return nil, k.sudoErr
+}
+
+func TestGrantSettlement(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	wasmdKeeper := &mockWasmdKeeper{sudoMsgs: map[string][]types.SudoMsg{}}
+	k := cwfees.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), storeKey, wasmdKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	granter := sdk.AccAddress("granter")
+	sender := sdk.AccAddress("sender")
+	feeCharged := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	feeCovered := sdk.NewCoins(sdk.NewInt64Coin("stake", 800))
+	newTxCtx := func(txBytes string) sdk.Context {
+		gasMeter := storetypes.NewGasMeter(200_000)
+		gasMeter.ConsumeGas(50_000, "tx")
+		return ctx.WithTxBytes([]byte(txBytes)).WithGasMeter(gasMeter)
+	}
+
+	t.Run("successful tx is settled after execution", func(t *testing.T) {
+		require.NoError(t, k.TrackGrant(newTxCtx("tx1"), granter, sender, feeCharged, feeCovered))
+
+		// failed tx is not settled after execution
+		require.NoError(t, k.SettleGrant(newTxCtx("tx1"), false))
+		require.Empty(t, wasmdKeeper.sudoMsgs[granter.String()])
+
+		txCtx := newTxCtx("tx1")
+		require.NoError(t, k.SettleGrant(txCtx, true))
+		require.Equal(t, []types.SudoMsg{{CWGrantSettled: &types.CWGrantSettled{
+			Sender:     sender.String(),
+			Success:    true,
+			GasLimit:   200_000,
+			GasUsed:    50_000,
+			FeeCharged: wasmdTypes.NewWasmCoins(feeCharged),
+			FeeCovered: wasmdTypes.NewWasmCoins(feeCovered),
+		}}}, wasmdKeeper.sudoMsgs[granter.String()])
+
+		// the settlement is removed
+		_, err := k.PendingSettlements.Get(txCtx, collections.Join(txCtx.BlockHeight(), tmhash.Sum([]byte("tx1"))))
+		require.Error(t, err)
+		require.NoError(t, k.SettleGrant(txCtx, true))
+		require.Len(t, wasmdKeeper.sudoMsgs[granter.String()], 1)
+	})
+
+	t.Run("contract failing to handle the settlement does not fail the tx", func(t *testing.T) {
+		wasmdKeeper.sudoErr = errors.New("unknown variant")
+		defer func() { wasmdKeeper.sudoErr = nil }()
+		txCtx := newTxCtx("tx2")
+		require.NoError(t, k.TrackGrant(txCtx, granter, sender, feeCharged, feeCovered))
+		require.NoError(t, k.SettleGrant(txCtx, true))
+		require.Len(t, wasmdKeeper.sudoMsgs[granter.String()], 2)
+	})
+
+	t.Run("failed txs are settled at the end of the block", func(t *testing.T) {
+		otherGranter := sdk.AccAddress("other_granter")
+		require.NoError(t, k.TrackGrant(newTxCtx("tx3"), otherGranter, sender, feeCharged, feeCovered))
+		txCtx := newTxCtx("tx4")
+		require.NoError(t, k.TrackGrant(txCtx, otherGranter, sender, feeCharged, feeCharged))
+		// the gas consumed by the rest of the ante handler is recorded for the settlement
+		txCtx.GasMeter().ConsumeGas(10_000, "ante")
+		anteGasUsed := txCtx.GasMeter().GasConsumed()
+		require.NoError(t, k.TrackGrantGas(txCtx))
+
+		require.NoError(t, k.SettlePendingGrants(ctx))
+		settled := wasmdKeeper.sudoMsgs[otherGranter.String()]
+		require.Len(t, settled, 2)
+		for _, msg := range settled {
+			require.False(t, msg.CWGrantSettled.Success)
+			require.Equal(t, uint64(200_000), msg.CWGrantSettled.GasLimit)
+		}
+		gasUsed := []uint64{settled[0].CWGrantSettled.GasUsed, settled[1].CWGrantSettled.GasUsed}
+		require.ElementsMatch(t, []uint64{50_000, anteGasUsed}, gasUsed)
+
+		iter, err := k.PendingSettlements.Iterate(ctx, nil)
+		require.NoError(t, err)
+		defer iter.Close()
+		require.False(t, iter.Valid())
+	})
+
+	t.Run("failed txs which do not fit in the block settlement gas limit are settled in the next blocks", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.BlockSettlementGasLimit = 150_000
+		require.NoError(t, k.SetParams(ctx, params))
+		wasmdKeeper.sudoGas = 60_000
+		defer func() { wasmdKeeper.sudoGas = 0 }()
+
+		lateGranter := sdk.AccAddress("late_granter")
+		for _, txBytes := range []string{"tx5", "tx6", "tx7"} {
+			require.NoError(t, k.TrackGrant(newTxCtx(txBytes), lateGranter, sender, feeCharged, feeCovered))
+		}
+		// a settlement tracked at a later height is settled after the ones tracked before it
+		laterCtx := newTxCtx("tx0")
+		laterCtx = laterCtx.WithBlockHeight(laterCtx.BlockHeight() + 1)
+		require.NoError(t, k.TrackGrant(laterCtx, lateGranter, sender, feeCharged, feeCovered))
+
+		// a second settlement would exceed the limit after the first one
+		require.NoError(t, k.SettlePendingGrants(ctx))
+		require.Len(t, wasmdKeeper.sudoMsgs[lateGranter.String()], 1)
+		require.NoError(t, k.SettlePendingGrants(ctx))
+		require.Len(t, wasmdKeeper.sudoMsgs[lateGranter.String()], 2)
+		require.NoError(t, k.SettlePendingGrants(ctx))
+		require.Len(t, wasmdKeeper.sudoMsgs[lateGranter.String()], 3)
+		_, err := k.PendingSettlements.Get(ctx, collections.Join(laterCtx.BlockHeight(), tmhash.Sum([]byte("tx0"))))
+		require.NoError(t, err)
+		require.NoError(t, k.SettlePendingGrants(ctx))
+		require.Len(t, wasmdKeeper.sudoMsgs[lateGranter.String()], 4)
+		_, err = k.PendingSettlements.Get(ctx, collections.Join(laterCtx.BlockHeight(), tmhash.Sum([]byte("tx0"))))
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	})
+}
+
+func TestGrantPolicy(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	wasmdKeeper := &mockWasmdKeeper{sudoMsgs: map[string][]types.SudoMsg{}}
+	k := cwfees.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), storeKey, wasmdKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	granter := sdk.AccAddress("granter")
+	signer := sdk.AccAddress("signer")
+	otherSigner := sdk.AccAddress("other_signer")
+	contract := sdk.AccAddress("contract")
+	msgs := []sdk.Msg{&wasmdTypes.MsgExecuteContract{Sender: signer.String(), Contract: contract.String()}}
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	policy := types.GrantPolicy{
+		AllowedContracts: []string{contract.String()},
+		AllowedSigners:   []string{signer.String()},
+		SignerQuota:      2,
+	}
+
+	t.Run("set grant policy – not a granter", func(t *testing.T) {
+		err := k.SetGrantPolicy(ctx, granter, policy)
+		require.ErrorIs(t, err, types.ErrNotAGranter)
+	})
+
+	require.NoError(t, k.RegisterAsGranter(ctx, granter))
+
+	t.Run("remove grant policy – not found", func(t *testing.T) {
+		err := k.RemoveGrantPolicy(ctx, granter)
+		require.ErrorIs(t, err, types.ErrGrantPolicyNotFound)
+	})
+
+	t.Run("set grant policy – invalid", func(t *testing.T) {
+		err := k.SetGrantPolicy(ctx, granter, types.GrantPolicy{AllowedSigners: []string{"invalid-address"}})
+		require.ErrorIs(t, err, types.ErrInvalidGrantPolicy)
+	})
+
+	t.Run("matching requests are granted natively up to the signer quota", func(t *testing.T) {
+		require.NoError(t, k.SetGrantPolicy(ctx, granter, policy))
+		gotPolicy, err := k.GetGrantPolicy(ctx, granter)
+		require.NoError(t, err)
+		require.Equal(t, policy, gotPolicy)
+
+		for i := 0; i < 2; i++ {
+			covered, err := k.RequestGrant(ctx, granter, msgs, fees, []sdk.AccAddress{signer})
+			require.NoError(t, err)
+			require.Equal(t, fees, covered)
+		}
+		_, err = k.RequestGrant(ctx, granter, msgs, fees, []sdk.AccAddress{signer})
+		require.ErrorIs(t, err, types.ErrGrantDenied)
+		require.ErrorContains(t, err, "quota")
+		require.Empty(t, wasmdKeeper.sudoMsgs[granter.String()])
+
+		// setting the policy again resets the quota
+		require.NoError(t, k.SetGrantPolicy(ctx, granter, policy))
+		_, err = k.RequestGrant(ctx, granter, msgs, fees, []sdk.AccAddress{signer})
+		require.NoError(t, err)
+	})
+
+	t.Run("not matching requests are denied without fallback", func(t *testing.T) {
+		_, err := k.RequestGrant(ctx, granter, msgs, fees, []sdk.AccAddress{otherSigner})
+		require.ErrorIs(t, err, types.ErrGrantDenied)
+		require.Empty(t, wasmdKeeper.sudoMsgs[granter.String()])
+	})
+
+	t.Run("not matching requests are sent to the contract with fallback", func(t *testing.T) {
+		fallbackPolicy := policy
+		fallbackPolicy.SudoFallback = true
+		require.NoError(t, k.SetGrantPolicy(ctx, granter, fallbackPolicy))
+
+		covered, err := k.RequestGrant(ctx, granter, msgs, fees, []sdk.AccAddress{otherSigner})
+		require.NoError(t, err)
+		require.Equal(t, fees, covered)
+		require.Len(t, wasmdKeeper.sudoMsgs[granter.String()], 1)
+		require.NotNil(t, wasmdKeeper.sudoMsgs[granter.String()][0].CWGrant)
+
+		// matching requests are still granted natively
+		_, err = k.RequestGrant(ctx, granter, msgs, fees, []sdk.AccAddress{signer})
+		require.NoError(t, err)
+		require.Len(t, wasmdKeeper.sudoMsgs[granter.String()], 1)
+	})
+
+	t.Run("state export", func(t *testing.T) {
+		gotState, err := k.ExportState(ctx)
+		require.NoError(t, err)
+		require.Len(t, gotState.GrantPolicies, 1)
+		require.Equal(t, granter.String(), gotState.GrantPolicies[0].GrantingContract)
+		require.True(t, gotState.GrantPolicies[0].Policy.SudoFallback)
+		require.Equal(t, []types.GrantingContractSignerGrants{{GrantingContract: granter.String(), Signer: signer.String(), Grants: 1}}, gotState.SignerGrants)
+		require.NoError(t, gotState.Validate())
+
+		// the grants counted against the signer quota are kept through an import
+		importStoreKey := storetypes.NewKVStoreKey(types.ModuleName)
+		importKeeper := cwfees.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), importStoreKey, wasmdKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+		importCtx := testutil.DefaultContext(importStoreKey, storetypes.NewTransientStoreKey("transient_test"))
+		require.NoError(t, importKeeper.ImportState(importCtx, gotState))
+		importedState, err := importKeeper.ExportState(importCtx)
+		require.NoError(t, err)
+		require.Equal(t, gotState, importedState)
+	})
+
+	t.Run("remove grant policy – requests are sent to the contract", func(t *testing.T) {
+		require.NoError(t, k.RemoveGrantPolicy(ctx, granter))
+		_, err := k.GetGrantPolicy(ctx, granter)
+		require.ErrorIs(t, err, types.ErrGrantPolicyNotFound)
+
+		_, err = k.RequestGrant(ctx, granter, msgs, fees, []sdk.AccAddress{signer})
+		require.NoError(t, err)
+		require.Len(t, wasmdKeeper.sudoMsgs[granter.String()], 2)
+	})
+
+	t.Run("unregister as granter removes the grant policy", func(t *testing.T) {
+		require.NoError(t, k.SetGrantPolicy(ctx, granter, policy))
+		require.NoError(t, k.UnregisterAsGranter(ctx, granter))
+		_, err := k.GetGrantPolicy(ctx, granter)
+		require.ErrorIs(t, err, types.ErrGrantPolicyNotFound)
+	})
+}
+
+func TestGranterStats(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	wasmdKeeper := &mockWasmdKeeper{sudoMsgs: map[string][]types.SudoMsg{}, sudoGas: 1_000}
+	k := cwfees.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), storeKey, wasmdKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test")).WithBlockHeight(10).WithExecMode(sdk.ExecModeFinalize)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	granter := sdk.AccAddress("granter")
+	otherGranter := sdk.AccAddress("other_granter")
+	signer := sdk.AccAddress("signer")
+	otherSigner := sdk.AccAddress("other_signer")
+	msgs := []sdk.Msg{&banktypes.MsgSend{FromAddress: signer.String()}}
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, k.RegisterAsGranter(ctx, granter))
+	require.NoError(t, k.RegisterAsGranter(ctx, otherGranter))
+
+	// accepted by the contract
+	_, err := k.RequestGrant(ctx, granter, msgs, fees, []sdk.AccAddress{signer})
+	require.NoError(t, err)
+	_, err = k.RequestGrant(ctx.WithBlockHeight(11), granter, msgs, fees, []sdk.AccAddress{otherSigner})
+	require.NoError(t, err)
+	// rejected by the contract
+	wasmdKeeper.sudoErr = errors.New("unauthorized")
+	_, err = k.RequestGrant(ctx, granter, msgs, fees, []sdk.AccAddress{signer})
+	require.Error(t, err)
+	wasmdKeeper.sudoErr = nil
+	// accepted by the grant policy, without invoking the contract
+	require.NoError(t, k.SetGrantPolicy(ctx, granter, types.GrantPolicy{AllowedSigners: []string{signer.String()}}))
+	_, err = k.RequestGrant(ctx.WithBlockHeight(12), granter, msgs, fees, []sdk.AccAddress{signer})
+	require.NoError(t, err)
+	// rejected by the grant policy
+	_, err = k.RequestGrant(ctx, granter, msgs, fees, []sdk.AccAddress{otherSigner})
+	require.ErrorIs(t, err, types.ErrGrantDenied)
+	// rejected outside of the block execution
+	_, err = k.RequestGrant(ctx.WithExecMode(sdk.ExecModeCheck), granter, msgs, fees, []sdk.AccAddress{otherSigner})
+	require.ErrorIs(t, err, types.ErrGrantDenied)
+
+	// the rejected requests are counted at the end of the block, as their state changes are reverted
+	granterStats, err := k.GetGranterStats(ctx, granter)
+	require.NoError(t, err)
+	require.Zero(t, granterStats.RejectedRequests)
+	require.NoError(t, k.ApplyGrantOutcomes(ctx))
+
+	granterStats, err = k.GetGranterStats(ctx, granter)
+	require.NoError(t, err)
+	require.Equal(t, types.GrantStats{
+		SponsoredTxs:     3,
+		FeesPaid:         sdk.NewCoins(sdk.NewInt64Coin("stake", 3000)),
+		RejectedRequests: 2,
+		LastUsedHeight:   12,
+		SudoGasUsed:      3_000,
+	}, granterStats)
+
+	signerStats, err := k.GetSignerStats(ctx, granter, signer)
+	require.NoError(t, err)
+	require.Equal(t, types.GrantStats{
+		SponsoredTxs:     2,
+		FeesPaid:         sdk.NewCoins(sdk.NewInt64Coin("stake", 2000)),
+		RejectedRequests: 1,
+		LastUsedHeight:   12,
+		SudoGasUsed:      2_000,
+	}, signerStats)
+
+	otherSignerStats, err := k.GetSignerStats(ctx, granter, otherSigner)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), otherSignerStats.SponsoredTxs)
+	require.Equal(t, uint64(1), otherSignerStats.RejectedRequests)
+	require.Equal(t, int64(11), otherSignerStats.LastUsedHeight)
+
+	// no stats
+	otherGranterStats, err := k.GetGranterStats(ctx, otherGranter)
+	require.NoError(t, err)
+	require.Equal(t, types.GrantStats{}, otherGranterStats)
+
+	// paginated granting contracts
+	queryServer := cwfees.NewQueryServer(k)
+	res, err := queryServer.GrantingContracts(ctx, &types.GrantingContractsRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, res.GrantingContracts, 1)
+	require.NotNil(t, res.Pagination.NextKey)
+	res, err = queryServer.GrantingContracts(ctx, &types.GrantingContractsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.GrantingContracts, 1)
+	require.Nil(t, res.Pagination.NextKey)
+
+	statsRes, err := queryServer.GranterStats(ctx, &types.GranterStatsRequest{ContractAddress: granter.String(), SignerAddress: signer.String()})
+	require.NoError(t, err)
+	require.Equal(t, signerStats, statsRes.Stats)
+
+	// state export and import
+	gotState, err := k.ExportState(ctx)
+	require.NoError(t, err)
+	require.NoError(t, gotState.Validate())
+	require.Equal(t, []types.GrantingContractStats{{GrantingContract: granter.String(), Stats: granterStats}}, gotState.GranterStats)
+	require.Len(t, gotState.SignerStats, 2)
+	require.Contains(t, gotState.SignerStats, types.GrantingContractStats{GrantingContract: granter.String(), Signer: signer.String(), Stats: signerStats})
+
+	importStoreKey := storetypes.NewKVStoreKey(types.ModuleName)
+	importKeeper := cwfees.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), importStoreKey, wasmdKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	importCtx := testutil.DefaultContext(importStoreKey, storetypes.NewTransientStoreKey("transient_test"))
+	require.NoError(t, importKeeper.ImportState(importCtx, gotState))
+	importedState, err := importKeeper.ExportState(importCtx)
+	require.NoError(t, err)
+	require.Equal(t, gotState, importedState)
+}
+
 // func TestFullIntegration(t *testing.T) {
 // 	app := e2eTesting.NewTestChain(t, 0, e2eTesting.WithGenAccounts(10))
 // 	deployer := app.GetAccount(0)
@@ -134,3 +582,258 @@ func TestKeeper(t *testing.T) {
 // 	// as RequestGrantGasLimit will have shutdown the execution before.
 // 	require.Less(t, gasInfo.GasUsed, txGasLimit)
 // }
+
+func TestGranterSuspension(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	wasmdKeeper := &mockWasmdKeeper{sudoMsgs: map[string][]types.SudoMsg{}}
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	k := cwfees.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), storeKey, wasmdKeeper, authority)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test")).WithExecMode(sdk.ExecModeFinalize)
+	params := types.Params{MaxConsecutiveFailures: 3, FailureWindow: 10, SuspensionPeriod: 100, BlockSettlementGasLimit: 1}
+	require.NoError(t, k.SetParams(ctx, params))
+	msgServer := cwfees.NewMsgServer(k)
+	queryServer := cwfees.NewQueryServer(k)
+
+	granter := sdk.AccAddress("granter")
+	signer := sdk.AccAddress("signer")
+	msgs := []sdk.Msg{&banktypes.MsgSend{FromAddress: signer.String()}}
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, k.RegisterAsGranter(ctx, granter))
+
+	// the contract panics on a failed grant request
+	contractPanic := errors.New("Error calling the VM: Error executing Wasm: Wasmer runtime error: RuntimeError: unreachable")
+	requestGrants := func(ctx sdk.Context, outcomes ...bool) {
+		for _, failed := range outcomes {
+			wasmdKeeper.sudoErr = nil
+			if failed {
+				wasmdKeeper.sudoErr = contractPanic
+			}
+			_, _ = k.RequestTxGrant(ctx, granter, msgs, fees, []sdk.AccAddress{signer})
+		}
+		wasmdKeeper.sudoErr = nil
+	}
+	getFailures := func() uint64 {
+		failures, err := k.GetGranterFailures(ctx, granter)
+		require.NoError(t, err)
+		return failures.ConsecutiveFailures
+	}
+
+	t.Run("failures of simulations are not counted", func(t *testing.T) {
+		requestGrants(ctx.WithBlockHeight(10).WithExecMode(sdk.ExecModeSimulate), true, true, true)
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(10)))
+		require.Zero(t, getFailures())
+	})
+
+	t.Run("rejected grant requests are not counted", func(t *testing.T) {
+		wasmdKeeper.sudoErr = errors.New("unauthorized")
+		defer func() { wasmdKeeper.sudoErr = nil }()
+		for i := 0; i < 3; i++ {
+			_, err := k.RequestTxGrant(ctx.WithBlockHeight(10), granter, msgs, fees, []sdk.AccAddress{signer})
+			require.Error(t, err)
+		}
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(10)))
+		require.Zero(t, getFailures())
+	})
+
+	t.Run("failures of the grant requests of the callback fee payers are not counted", func(t *testing.T) {
+		wasmdKeeper.sudoErr = contractPanic
+		defer func() { wasmdKeeper.sudoErr = nil }()
+		for i := 0; i < 3; i++ {
+			_, err := k.RequestGrant(ctx.WithBlockHeight(10), granter, msgs, fees, []sdk.AccAddress{signer})
+			require.Error(t, err)
+		}
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(10)))
+		require.Zero(t, getFailures())
+	})
+
+	t.Run("running out of gas is only counted with the full gas limit", func(t *testing.T) {
+		wasmdKeeper.sudoGas = cwfees.RequestGrantGasLimit + 1
+		defer func() { wasmdKeeper.sudoGas = 0 }()
+		// the TX has less gas left than the gas limit of the grant requests
+		lowGasCtx := ctx.WithBlockHeight(10).WithGasMeter(storetypes.NewGasMeter(cwfees.RequestGrantGasLimit - 1))
+		_, err := k.RequestTxGrant(lowGasCtx, granter, msgs, fees, []sdk.AccAddress{signer})
+		require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(10)))
+		require.Zero(t, getFailures())
+
+		_, err = k.RequestTxGrant(ctx.WithBlockHeight(10), granter, msgs, fees, []sdk.AccAddress{signer})
+		require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(10)))
+		require.Equal(t, uint64(1), getFailures())
+
+		wasmdKeeper.sudoGas = 0
+		requestGrants(ctx.WithBlockHeight(10), false)
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(10)))
+		require.Zero(t, getFailures())
+	})
+
+	t.Run("failures of CheckTx suspend the granter in CheckTx only", func(t *testing.T) {
+		checkCtx := ctx.WithBlockHeight(10).WithExecMode(sdk.ExecModeCheck)
+		requestGrants(checkCtx, true, true, true)
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(10)))
+		require.Zero(t, getFailures())
+		_, suspended, err := k.GetSuspension(ctx.WithBlockHeight(10), granter)
+		require.NoError(t, err)
+		require.False(t, suspended)
+
+		// the grant requests of CheckTx are rejected without being sent to the contract
+		sudoCalls := len(wasmdKeeper.sudoMsgs[granter.String()])
+		_, err = k.RequestTxGrant(checkCtx.WithExecMode(sdk.ExecModeReCheck), granter, msgs, fees, []sdk.AccAddress{signer})
+		require.ErrorIs(t, err, types.ErrGranterSuspended)
+		require.Len(t, wasmdKeeper.sudoMsgs[granter.String()], sudoCalls)
+
+		// the block execution still sends them to the contract
+		_, err = k.RequestTxGrant(ctx.WithBlockHeight(10), granter, msgs, fees, []sdk.AccAddress{signer})
+		require.NoError(t, err)
+		require.Len(t, wasmdKeeper.sudoMsgs[granter.String()], sudoCalls+1)
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(10)))
+
+		// the CheckTx suspension expires with the suspension period
+		_, err = k.RequestTxGrant(checkCtx.WithBlockHeight(110), granter, msgs, fees, []sdk.AccAddress{signer})
+		require.NoError(t, err)
+	})
+
+	t.Run("aborted block outcomes are discarded", func(t *testing.T) {
+		requestGrants(ctx.WithBlockHeight(10), true, true, true)
+		k.ResetGrantOutcomes()
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(10)))
+		require.Zero(t, getFailures())
+	})
+
+	t.Run("a successful request resets the failures", func(t *testing.T) {
+		requestGrants(ctx.WithBlockHeight(10), true, true, false, true, true)
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(10)))
+		require.Equal(t, uint64(2), getFailures())
+	})
+
+	t.Run("a request granted by the policy resets the failures", func(t *testing.T) {
+		require.NoError(t, k.SetGrantPolicy(ctx, granter, types.GrantPolicy{AllowedSigners: []string{signer.String()}}))
+		_, err := k.RequestTxGrant(ctx.WithBlockHeight(10), granter, msgs, fees, []sdk.AccAddress{signer})
+		require.NoError(t, err)
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(10)))
+		require.Zero(t, getFailures())
+
+		require.NoError(t, k.RemoveGrantPolicy(ctx, granter))
+		requestGrants(ctx.WithBlockHeight(10), true, true)
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(10)))
+		require.Equal(t, uint64(2), getFailures())
+	})
+
+	t.Run("failures outside of the window start a new window", func(t *testing.T) {
+		requestGrants(ctx.WithBlockHeight(20), true)
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(20)))
+		failures, err := k.GetGranterFailures(ctx, granter)
+		require.NoError(t, err)
+		require.Equal(t, types.GranterFailures{ConsecutiveFailures: 1, WindowStartHeight: 20}, failures)
+	})
+
+	t.Run("state export and import of the failures", func(t *testing.T) {
+		gotState, err := k.ExportState(ctx)
+		require.NoError(t, err)
+		wantFailures := []types.GrantingContractFailures{{GrantingContract: granter.String(), Failures: types.GranterFailures{ConsecutiveFailures: 1, WindowStartHeight: 20}}}
+		require.Equal(t, wantFailures, gotState.GranterFailures)
+		require.NoError(t, gotState.Validate())
+
+		importStoreKey := storetypes.NewKVStoreKey(types.ModuleName)
+		importKeeper := cwfees.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), importStoreKey, wasmdKeeper, authority)
+		importCtx := testutil.DefaultContext(importStoreKey, storetypes.NewTransientStoreKey("transient_test"))
+		require.NoError(t, importKeeper.ImportState(importCtx, gotState))
+		failures, err := importKeeper.GetGranterFailures(importCtx, granter)
+		require.NoError(t, err)
+		require.Equal(t, wantFailures[0].Failures, failures)
+	})
+
+	t.Run("too many consecutive failures suspend the granter", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(25).WithEventManager(sdk.NewEventManager())
+		requestGrants(ctx, true, true)
+		require.NoError(t, k.ApplyGrantOutcomes(ctx))
+		require.Zero(t, getFailures())
+
+		res, err := queryServer.GranterSuspension(ctx, &types.GranterSuspensionRequest{ContractAddress: granter.String()})
+		require.NoError(t, err)
+		require.True(t, res.Suspended)
+		require.Equal(t, types.GranterSuspension{GrantingContract: granter.String(), SuspendedAtHeight: 25, SuspendedUntilHeight: 125}, res.Suspension)
+		require.Len(t, ctx.EventManager().Events(), 1)
+		require.Equal(t, "archway.cwfees.v1.GranterSuspendedEvent", ctx.EventManager().Events()[0].Type)
+
+		// the grant requests are rejected without being sent to the contract
+		sudoCalls := len(wasmdKeeper.sudoMsgs[granter.String()])
+		_, err = k.RequestGrant(ctx, granter, msgs, fees, []sdk.AccAddress{signer})
+		require.ErrorIs(t, err, types.ErrGranterSuspended)
+		require.Len(t, wasmdKeeper.sudoMsgs[granter.String()], sudoCalls)
+	})
+
+	t.Run("registering again does not lift the suspension", func(t *testing.T) {
+		require.NoError(t, k.UnregisterAsGranter(ctx, granter))
+		require.NoError(t, k.RegisterAsGranter(ctx, granter))
+		_, suspended, err := k.GetSuspension(ctx.WithBlockHeight(124), granter)
+		require.NoError(t, err)
+		require.True(t, suspended)
+	})
+
+	t.Run("state export", func(t *testing.T) {
+		gotState, err := k.ExportState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, params, gotState.Params)
+		require.Equal(t, []types.GranterSuspension{{GrantingContract: granter.String(), SuspendedAtHeight: 25, SuspendedUntilHeight: 125}}, gotState.Suspensions)
+		require.NoError(t, gotState.Validate())
+	})
+
+	t.Run("the suspension expires", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(125)
+		require.NoError(t, k.ApplyGrantOutcomes(ctx))
+		has, err := k.Suspensions.Has(ctx, granter)
+		require.NoError(t, err)
+		require.False(t, has)
+		_, err = k.RequestGrant(ctx, granter, msgs, fees, []sdk.AccAddress{signer})
+		require.NoError(t, err)
+	})
+
+	t.Run("automatic suspension can be disabled", func(t *testing.T) {
+		disabled := params
+		disabled.MaxConsecutiveFailures = 0
+		require.NoError(t, k.SetParams(ctx, disabled))
+		requestGrants(ctx.WithBlockHeight(130), true, true, true)
+		require.NoError(t, k.ApplyGrantOutcomes(ctx.WithBlockHeight(130)))
+		require.Zero(t, getFailures())
+		_, suspended, err := k.GetSuspension(ctx.WithBlockHeight(130), granter)
+		require.NoError(t, err)
+		require.False(t, suspended)
+	})
+
+	t.Run("update params – unauthorized", func(t *testing.T) {
+		_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: signer.String(), Params: params})
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("denied granters are unregistered and can not register", func(t *testing.T) {
+		denied := params
+		denied.DeniedGranters = []string{granter.String()}
+		_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: denied})
+		require.NoError(t, err)
+
+		isGranter, err := k.IsGrantingContract(ctx, granter)
+		require.NoError(t, err)
+		require.False(t, isGranter)
+		err = k.RegisterAsGranter(ctx, granter)
+		require.ErrorIs(t, err, types.ErrGranterDenied)
+
+		_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		require.NoError(t, err)
+		require.NoError(t, k.RegisterAsGranter(ctx, granter))
+	})
+
+	t.Run("force unregister granter", func(t *testing.T) {
+		_, err := msgServer.ForceUnregisterGranter(ctx, &types.MsgForceUnregisterGranter{Authority: signer.String(), GrantingContract: granter.String()})
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+
+		_, err = msgServer.ForceUnregisterGranter(ctx, &types.MsgForceUnregisterGranter{Authority: authority, GrantingContract: granter.String()})
+		require.NoError(t, err)
+		isGranter, err := k.IsGrantingContract(ctx, granter)
+		require.NoError(t, err)
+		require.False(t, isGranter)
+
+		_, err = msgServer.ForceUnregisterGranter(ctx, &types.MsgForceUnregisterGranter{Authority: authority, GrantingContract: granter.String()})
+		require.ErrorIs(t, err, types.ErrNotAGranter)
+	})
+}
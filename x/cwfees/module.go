@@ -1,8 +1,10 @@
 package cwfees
 
 import (
+	"context"
 	"encoding/json"
 
+	"cosmossdk.io/core/appmodule"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -11,6 +13,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
+	"github.com/archway-network/archway/x/cwfees/client/cli"
 	"github.com/archway-network/archway/x/cwfees/types"
 )
 
@@ -22,6 +25,9 @@ const (
 var (
 	_ module.AppModule  = (*AppModule)(nil)
 	_ module.HasGenesis = (*AppModule)(nil)
+
+	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker   = (*AppModule)(nil)
 )
 
 func NewAppModule(k Keeper) AppModule { return AppModule{k} }
@@ -35,7 +41,7 @@ func (a AppModule) Name() string { return ModuleName }
 func (a AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
 func (AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(new(types.GenesisState))
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
 func (AppModule) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
@@ -64,6 +70,22 @@ func (a AppModule) ExportGenesis(ctx sdk.Context, codec codec.JSONCodec) json.Ra
 	return codec.MustMarshalJSON(state)
 }
 
+// BeginBlock discards the grant request outcomes buffered by an aborted execution of the block.
+func (a AppModule) BeginBlock(_ context.Context) error {
+	a.k.ResetGrantOutcomes()
+	return nil
+}
+
+// EndBlock applies the grant request outcomes of the block to the failure counters of the granting contracts,
+// and settles the grants of the TXs which failed in the block, or in the previous blocks if they did not fit.
+func (a AppModule) EndBlock(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := a.k.ApplyGrantOutcomes(sdkCtx); err != nil {
+		return err
+	}
+	return a.k.SettlePendingGrants(sdkCtx)
+}
+
 func (a AppModule) RegisterInterfaces(ir codectypes.InterfaceRegistry) { types.RegisterInterfaces(ir) }
 
 func (a AppModule) RegisterServices(cfg module.Configurator) {
@@ -75,7 +97,7 @@ func (a AppModule) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeM
 
 func (a AppModule) GetTxCmd() *cobra.Command { return &cobra.Command{Use: ModuleName} }
 
-func (a AppModule) GetQueryCmd() *cobra.Command { return &cobra.Command{Use: ModuleName} }
+func (a AppModule) GetQueryCmd() *cobra.Command { return cli.GetQueryCmd() }
 
 func (a AppModule) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
 
@@ -30,3 +30,45 @@ func (m msgServer) UnregisterAsGranter(ctx context.Context, msg *types.MsgUnregi
 
 	return &types.MsgUnregisterAsGranterResponse{}, m.k.UnregisterAsGranter(ctx, granterAddr)
 }
+
+func (m msgServer) SetGrantPolicy(ctx context.Context, msg *types.MsgSetGrantPolicy) (*types.MsgSetGrantPolicyResponse, error) {
+	granterAddr, err := sdk.AccAddressFromBech32(msg.GrantingContract)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetGrantPolicyResponse{}, m.k.SetGrantPolicy(ctx, granterAddr, msg.Policy)
+}
+
+func (m msgServer) RemoveGrantPolicy(ctx context.Context, msg *types.MsgRemoveGrantPolicy) (*types.MsgRemoveGrantPolicyResponse, error) {
+	granterAddr, err := sdk.AccAddressFromBech32(msg.GrantingContract)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveGrantPolicyResponse{}, m.k.RemoveGrantPolicy(ctx, granterAddr)
+}
+
+func (m msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != m.k.GetAuthority() {
+		return nil, types.ErrUnauthorized.Wrapf("expected %s, got %s", m.k.GetAuthority(), msg.Authority)
+	}
+	// need to explicitly validate as x/gov invokes this msg and it does not validate
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, m.k.SetParams(ctx, msg.Params)
+}
+
+func (m msgServer) ForceUnregisterGranter(ctx context.Context, msg *types.MsgForceUnregisterGranter) (*types.MsgForceUnregisterGranterResponse, error) {
+	if msg.Authority != m.k.GetAuthority() {
+		return nil, types.ErrUnauthorized.Wrapf("expected %s, got %s", m.k.GetAuthority(), msg.Authority)
+	}
+	granterAddr, err := sdk.AccAddressFromBech32(msg.GrantingContract)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceUnregisterGranterResponse{}, m.k.UnregisterAsGranter(ctx, granterAddr)
+}
@@ -0,0 +1,30 @@
+package cwfees
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.PostDecorator = SettleGrantDecorator{}
+
+// SettleGrantDecorator notifies the granting contract which paid the fees of the TX about its outcome
+// with the CWGrantSettled sudo message, once the TX messages are executed.
+type SettleGrantDecorator struct {
+	k Keeper
+}
+
+// NewSettleGrantDecorator returns a new SettleGrantDecorator instance.
+func NewSettleGrantDecorator(k Keeper) SettleGrantDecorator {
+	return SettleGrantDecorator{k: k}
+}
+
+// PostHandle implements the sdk.PostDecorator interface.
+func (d SettleGrantDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// the TX messages are only executed when finalizing the block or simulating the TX
+	if mode := ctx.ExecMode(); mode != sdk.ExecModeFinalize && mode != sdk.ExecModeSimulate {
+		return next(ctx, tx, simulate, success)
+	}
+	if err := d.k.SettleGrant(ctx, success); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate, success)
+}
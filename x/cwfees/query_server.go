@@ -22,3 +22,71 @@ func (q queryServer) IsGrantingContract(ctx context.Context, request *types.IsGr
 	isGrantingContract, err := q.k.IsGrantingContract(ctx, addr)
 	return &types.IsGrantingContractResponse{IsGrantingContract: isGrantingContract}, err
 }
+
+func (q queryServer) GrantPolicy(ctx context.Context, request *types.GrantPolicyRequest) (*types.GrantPolicyResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := q.k.GetGrantPolicy(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GrantPolicyResponse{Policy: policy}, nil
+}
+
+func (q queryServer) GrantingContracts(ctx context.Context, request *types.GrantingContractsRequest) (*types.GrantingContractsResponse, error) {
+	grantingContracts, pageRes, err := q.k.GetGrantingContracts(ctx, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GrantingContractsResponse{GrantingContracts: grantingContracts, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GranterStats(ctx context.Context, request *types.GranterStatsRequest) (*types.GranterStatsResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	var stats types.GrantStats
+	if request.SignerAddress != "" {
+		signerAddr, err := sdk.AccAddressFromBech32(request.SignerAddress)
+		if err != nil {
+			return nil, err
+		}
+		stats, err = q.k.GetSignerStats(ctx, addr, signerAddr)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		stats, err = q.k.GetGranterStats(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &types.GranterStatsResponse{Stats: stats}, nil
+}
+
+func (q queryServer) GranterSuspension(ctx context.Context, request *types.GranterSuspensionRequest) (*types.GranterSuspensionResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	suspension, suspended, err := q.k.GetSuspension(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	failures, err := q.k.GetGranterFailures(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GranterSuspensionResponse{Suspended: suspended, Suspension: suspension, Failures: failures}, nil
+}
+
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 
+	"cosmossdk.io/math"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -13,7 +15,9 @@ import (
 // SudoMsg defines the message sudo enum that is sent to the CosmWasm contract.
 type SudoMsg struct {
 	// CWGrant defines the enum variant of the grant message.
-	CWGrant *CWGrant `json:"cw_grant"`
+	CWGrant *CWGrant `json:"cw_grant,omitempty"`
+	// CWGrantSettled defines the enum variant of the grant settlement message.
+	CWGrantSettled *CWGrantSettled `json:"cw_grant_settled,omitempty"`
 }
 
 // CWGrant defines the CWGrant variant of the SudoMsg.
@@ -37,6 +41,75 @@ type CWGrantMessage struct {
 	Msg []byte `json:"msg"`
 }
 
+// CWGrantSettled defines the CWGrantSettled variant of the SudoMsg,
+// which notifies the granting contract of the outcome of a TX it accepted the grant for.
+type CWGrantSettled struct {
+	// Sender defines the signer of the TX.
+	Sender string `json:"sender"`
+	// Success defines if the TX messages were executed successfully.
+	Success bool `json:"success"`
+	// GasLimit defines the gas limit of the TX.
+	GasLimit uint64 `json:"gas_limit"`
+	// GasUsed defines the gas consumed by the TX. It is zero when the TX failed,
+	// as the outcome of a failed TX is only settled at the end of the block.
+	GasUsed uint64 `json:"gas_used"`
+	// FeeCharged defines the fees charged for the TX.
+	FeeCharged wasmVmTypes.Coins `json:"fee_charged"`
+	// FeeCovered defines the part of the charged fees covered by the contract.
+	FeeCovered wasmVmTypes.Coins `json:"fee_covered"`
+}
+
+// NewSettledSudoMsg creates the SudoMsg notifying the granting contract of the outcome of the TX of the pending settlement.
+func NewSettledSudoMsg(settlement PendingSettlement, success bool, gasUsed uint64) *SudoMsg {
+	return &SudoMsg{CWGrantSettled: &CWGrantSettled{
+		Sender:     settlement.Sender,
+		Success:    success,
+		GasLimit:   settlement.GasLimit,
+		GasUsed:    gasUsed,
+		FeeCharged: types.NewWasmCoins(settlement.FeeCharged),
+		FeeCovered: types.NewWasmCoins(settlement.FeeCovered),
+	}}
+}
+
+// CWGrantResponse defines the optional response data of the CWGrant sudo message.
+type CWGrantResponse struct {
+	// CoveredFee defines the part of the requested fees the contract covers, the rest
+	// is paid by the fee payer of the TX. When it is not set, the contract covers all the requested fees.
+	CoveredFee *wasmVmTypes.Coins `json:"covered_fee,omitempty"`
+}
+
+// ParseCWGrantResponse returns the fees covered by the granting contract given the data it responded with.
+// An empty response or a response without covered fee means all the requested fees are covered.
+func ParseCWGrantResponse(data []byte, requestedFees sdk.Coins) (sdk.Coins, error) {
+	if len(data) == 0 {
+		return requestedFees, nil
+	}
+	var resp CWGrantResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, ErrInvalidGrantResponse.Wrapf("unable to decode: %s", err)
+	}
+	if resp.CoveredFee == nil {
+		return requestedFees, nil
+	}
+
+	covered := sdk.NewCoins()
+	for _, c := range *resp.CoveredFee {
+		amount, ok := math.NewIntFromString(c.Amount)
+		if !ok {
+			return nil, ErrInvalidGrantResponse.Wrapf("invalid covered fee amount: %s%s", c.Amount, c.Denom)
+		}
+		coin := sdk.Coin{Denom: c.Denom, Amount: amount}
+		if err := coin.Validate(); err != nil {
+			return nil, ErrInvalidGrantResponse.Wrapf("invalid covered fee: %s", err)
+		}
+		covered = covered.Add(coin)
+	}
+	if !covered.IsAllLTE(requestedFees) {
+		return nil, ErrInvalidGrantResponse.Wrapf("covered fee %s exceeds the requested fee %s", covered, requestedFees)
+	}
+	return covered, nil
+}
+
 func NewSudoMsg(cdc codec.BinaryCodec, requestedFees sdk.Coins, msgs []sdk.Msg, signers []sdk.AccAddress) (*SudoMsg, error) {
 	cwGrantMsgs, err := NewCWGrantMessages(cdc, msgs, signers)
 	if err != nil {
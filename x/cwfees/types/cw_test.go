@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCWGrantResponse(t *testing.T) {
+	type tc struct {
+		data        string
+		wantCovered sdk.Coins
+		errContains string // if empty, no error.
+	}
+
+	requested := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("uarch", 500))
+
+	tests := map[string]tc{
+		"no response": {
+			data:        "",
+			wantCovered: requested,
+		},
+		"no covered fee": {
+			data:        `{}`,
+			wantCovered: requested,
+		},
+		"partial covered fee": {
+			data:        `{"covered_fee":[{"denom":"stake","amount":"800"}]}`,
+			wantCovered: sdk.NewCoins(sdk.NewInt64Coin("stake", 800)),
+		},
+		"zero amounts are dropped": {
+			data:        `{"covered_fee":[{"denom":"stake","amount":"800"},{"denom":"uarch","amount":"0"}]}`,
+			wantCovered: sdk.NewCoins(sdk.NewInt64Coin("stake", 800)),
+		},
+		"nothing covered": {
+			data:        `{"covered_fee":[]}`,
+			wantCovered: sdk.NewCoins(),
+		},
+		"full covered fee": {
+			data:        `{"covered_fee":[{"denom":"uarch","amount":"500"},{"denom":"stake","amount":"1000"}]}`,
+			wantCovered: requested,
+		},
+		"exceeds requested fee": {
+			data:        `{"covered_fee":[{"denom":"stake","amount":"1001"}]}`,
+			errContains: "exceeds the requested fee",
+		},
+		"denom not requested": {
+			data:        `{"covered_fee":[{"denom":"uatom","amount":"1"}]}`,
+			errContains: "exceeds the requested fee",
+		},
+		"invalid amount": {
+			data:        `{"covered_fee":[{"denom":"stake","amount":"abc"}]}`,
+			errContains: "invalid covered fee amount",
+		},
+		"invalid json": {
+			data:        `{"covered_fee":`,
+			errContains: "unable to decode",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			covered, err := ParseCWGrantResponse([]byte(test.data), requested)
+			if test.errContains == "" {
+				require.Nilf(t, err, "unexpected error %s", err)
+				require.Equal(t, test.wantCovered.String(), covered.String())
+			} else {
+				require.ErrorIs(t, err, ErrInvalidGrantResponse)
+				require.ErrorContains(t, err, test.errContains)
+			}
+		})
+	}
+}
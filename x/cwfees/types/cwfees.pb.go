@@ -7,7 +7,10 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -193,6 +196,367 @@ func (m *MsgUnregisterAsGranterResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnregisterAsGranterResponse proto.InternalMessageInfo
 
+// MsgSetGrantPolicy can be used by a granting contract to set its grant policy.
+// Setting the policy again resets the signer quotas.
+type MsgSetGrantPolicy struct {
+	GrantingContract string `protobuf:"bytes,1,opt,name=granting_contract,json=grantingContract,proto3" json:"granting_contract,omitempty"`
+	// policy defines the grant policy of the contract.
+	Policy GrantPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgSetGrantPolicy) Reset()         { *m = MsgSetGrantPolicy{} }
+func (m *MsgSetGrantPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGrantPolicy) ProtoMessage()    {}
+func (*MsgSetGrantPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{4}
+}
+func (m *MsgSetGrantPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGrantPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGrantPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGrantPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGrantPolicy.Merge(m, src)
+}
+func (m *MsgSetGrantPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGrantPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGrantPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGrantPolicy proto.InternalMessageInfo
+
+func (m *MsgSetGrantPolicy) GetGrantingContract() string {
+	if m != nil {
+		return m.GrantingContract
+	}
+	return ""
+}
+
+func (m *MsgSetGrantPolicy) GetPolicy() GrantPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return GrantPolicy{}
+}
+
+// MsgSetGrantPolicyResponse defines the response of SetGrantPolicy.
+type MsgSetGrantPolicyResponse struct {
+}
+
+func (m *MsgSetGrantPolicyResponse) Reset()         { *m = MsgSetGrantPolicyResponse{} }
+func (m *MsgSetGrantPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGrantPolicyResponse) ProtoMessage()    {}
+func (*MsgSetGrantPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{5}
+}
+func (m *MsgSetGrantPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGrantPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGrantPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGrantPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGrantPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetGrantPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGrantPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGrantPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGrantPolicyResponse proto.InternalMessageInfo
+
+// MsgRemoveGrantPolicy can be used by a granting contract to remove its grant policy.
+type MsgRemoveGrantPolicy struct {
+	GrantingContract string `protobuf:"bytes,1,opt,name=granting_contract,json=grantingContract,proto3" json:"granting_contract,omitempty"`
+}
+
+func (m *MsgRemoveGrantPolicy) Reset()         { *m = MsgRemoveGrantPolicy{} }
+func (m *MsgRemoveGrantPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveGrantPolicy) ProtoMessage()    {}
+func (*MsgRemoveGrantPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{6}
+}
+func (m *MsgRemoveGrantPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveGrantPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveGrantPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveGrantPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveGrantPolicy.Merge(m, src)
+}
+func (m *MsgRemoveGrantPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveGrantPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveGrantPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveGrantPolicy proto.InternalMessageInfo
+
+func (m *MsgRemoveGrantPolicy) GetGrantingContract() string {
+	if m != nil {
+		return m.GrantingContract
+	}
+	return ""
+}
+
+// MsgRemoveGrantPolicyResponse defines the response of RemoveGrantPolicy.
+type MsgRemoveGrantPolicyResponse struct {
+}
+
+func (m *MsgRemoveGrantPolicyResponse) Reset()         { *m = MsgRemoveGrantPolicyResponse{} }
+func (m *MsgRemoveGrantPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveGrantPolicyResponse) ProtoMessage()    {}
+func (*MsgRemoveGrantPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{7}
+}
+func (m *MsgRemoveGrantPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveGrantPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveGrantPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveGrantPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveGrantPolicyResponse.Merge(m, src)
+}
+func (m *MsgRemoveGrantPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveGrantPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveGrantPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveGrantPolicyResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/cwfees parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{8}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response of UpdateParams.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{9}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgForceUnregisterGranter can be used by the authority to unregister a granting contract,
+// along with its grant policy and its suspension.
+type MsgForceUnregisterGranter struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// granting_contract defines the address of the granting contract to unregister.
+	GrantingContract string `protobuf:"bytes,2,opt,name=granting_contract,json=grantingContract,proto3" json:"granting_contract,omitempty"`
+}
+
+func (m *MsgForceUnregisterGranter) Reset()         { *m = MsgForceUnregisterGranter{} }
+func (m *MsgForceUnregisterGranter) String() string { return proto.CompactTextString(m) }
+func (*MsgForceUnregisterGranter) ProtoMessage()    {}
+func (*MsgForceUnregisterGranter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{10}
+}
+func (m *MsgForceUnregisterGranter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceUnregisterGranter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceUnregisterGranter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceUnregisterGranter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceUnregisterGranter.Merge(m, src)
+}
+func (m *MsgForceUnregisterGranter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceUnregisterGranter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceUnregisterGranter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceUnregisterGranter proto.InternalMessageInfo
+
+func (m *MsgForceUnregisterGranter) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgForceUnregisterGranter) GetGrantingContract() string {
+	if m != nil {
+		return m.GrantingContract
+	}
+	return ""
+}
+
+// MsgForceUnregisterGranterResponse defines the response of ForceUnregisterGranter.
+type MsgForceUnregisterGranterResponse struct {
+}
+
+func (m *MsgForceUnregisterGranterResponse) Reset()         { *m = MsgForceUnregisterGranterResponse{} }
+func (m *MsgForceUnregisterGranterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceUnregisterGranterResponse) ProtoMessage()    {}
+func (*MsgForceUnregisterGranterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{11}
+}
+func (m *MsgForceUnregisterGranterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceUnregisterGranterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceUnregisterGranterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceUnregisterGranterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceUnregisterGranterResponse.Merge(m, src)
+}
+func (m *MsgForceUnregisterGranterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceUnregisterGranterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceUnregisterGranterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceUnregisterGranterResponse proto.InternalMessageInfo
+
 // IsGrantingContract is the request type of IsGrantingContract RPC.
 type IsGrantingContractRequest struct {
 	// contract_address defines the address of the contract
@@ -204,7 +568,7 @@ func (m *IsGrantingContractRequest) Reset()         { *m = IsGrantingContractReq
 func (m *IsGrantingContractRequest) String() string { return proto.CompactTextString(m) }
 func (*IsGrantingContractRequest) ProtoMessage()    {}
 func (*IsGrantingContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac735a27b071201b, []int{4}
+	return fileDescriptor_ac735a27b071201b, []int{12}
 }
 func (m *IsGrantingContractRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,7 +614,7 @@ func (m *IsGrantingContractResponse) Reset()         { *m = IsGrantingContractRe
 func (m *IsGrantingContractResponse) String() string { return proto.CompactTextString(m) }
 func (*IsGrantingContractResponse) ProtoMessage()    {}
 func (*IsGrantingContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac735a27b071201b, []int{5}
+	return fileDescriptor_ac735a27b071201b, []int{13}
 }
 func (m *IsGrantingContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,23 +650,24 @@ func (m *IsGrantingContractResponse) GetIsGrantingContract() bool {
 	return false
 }
 
-// GenesisState represents the genesis state of the cwfeesant module.
-type GenesisState struct {
-	GrantingContracts []string `protobuf:"bytes,1,rep,name=granting_contracts,json=grantingContracts,proto3" json:"granting_contracts,omitempty"`
+// GrantPolicyRequest is the request type of GrantPolicy RPC.
+type GrantPolicyRequest struct {
+	// contract_address defines the address of the granting contract.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac735a27b071201b, []int{6}
+func (m *GrantPolicyRequest) Reset()         { *m = GrantPolicyRequest{} }
+func (m *GrantPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*GrantPolicyRequest) ProtoMessage()    {}
+func (*GrantPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{14}
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+func (m *GrantPolicyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GrantPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GrantPolicyRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -312,566 +677,7188 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+func (m *GrantPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantPolicyRequest.Merge(m, src)
 }
-func (m *GenesisState) XXX_Size() int {
+func (m *GrantPolicyRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+func (m *GrantPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantPolicyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+var xxx_messageInfo_GrantPolicyRequest proto.InternalMessageInfo
 
-func (m *GenesisState) GetGrantingContracts() []string {
+func (m *GrantPolicyRequest) GetContractAddress() string {
 	if m != nil {
-		return m.GrantingContracts
+		return m.ContractAddress
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgRegisterAsGranter)(nil), "archway.cwfees.v1.MsgRegisterAsGranter")
-	proto.RegisterType((*MsgRegisterAsGranterResponse)(nil), "archway.cwfees.v1.MsgRegisterAsGranterResponse")
-	proto.RegisterType((*MsgUnregisterAsGranter)(nil), "archway.cwfees.v1.MsgUnregisterAsGranter")
-	proto.RegisterType((*MsgUnregisterAsGranterResponse)(nil), "archway.cwfees.v1.MsgUnregisterAsGranterResponse")
-	proto.RegisterType((*IsGrantingContractRequest)(nil), "archway.cwfees.v1.IsGrantingContractRequest")
-	proto.RegisterType((*IsGrantingContractResponse)(nil), "archway.cwfees.v1.IsGrantingContractResponse")
-	proto.RegisterType((*GenesisState)(nil), "archway.cwfees.v1.GenesisState")
+// GrantPolicyResponse is the response type of GrantPolicy RPC.
+type GrantPolicyResponse struct {
+	// policy defines the grant policy of the contract.
+	Policy GrantPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
 }
 
-func init() { proto.RegisterFile("archway/cwfees/v1/cwfees.proto", fileDescriptor_ac735a27b071201b) }
-
-var fileDescriptor_ac735a27b071201b = []byte{
-	// 435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x37, 0x94, 0x8a, 0x7d, 0x08, 0x36, 0x63, 0xa9, 0x36, 0xc8, 0x50, 0x72, 0xb1, 0xad,
-	0x6e, 0xc6, 0xd4, 0x9b, 0xe0, 0xa1, 0x0a, 0x16, 0x91, 0x15, 0x8c, 0x78, 0x51, 0x64, 0xc9, 0xa6,
-	0xaf, 0xd3, 0xa0, 0x99, 0xd9, 0xce, 0x9b, 0x74, 0x8d, 0x27, 0xf1, 0x13, 0xf8, 0x51, 0xfa, 0x31,
-	0x3c, 0xf6, 0xe8, 0x51, 0x76, 0x0f, 0xbd, 0xfa, 0x11, 0x64, 0x9b, 0xa4, 0x88, 0x19, 0x61, 0x2f,
-	0xbd, 0x4d, 0xfe, 0xef, 0x3f, 0xbf, 0xf7, 0x26, 0xff, 0x19, 0xe0, 0xa9, 0xc9, 0x8e, 0x26, 0x69,
-	0x25, 0xb2, 0xc9, 0x21, 0x22, 0x89, 0x93, 0xb8, 0x59, 0x45, 0x63, 0xa3, 0xad, 0x66, 0x7e, 0x53,
-	0x8f, 0x1a, 0xf5, 0x24, 0x0e, 0x36, 0xa4, 0xd6, 0xf2, 0x13, 0x8a, 0x0b, 0xc3, 0xa8, 0x3c, 0x14,
-	0xa9, 0xaa, 0x6a, 0x77, 0x70, 0x3b, 0xd3, 0x54, 0x68, 0x12, 0x05, 0xc9, 0x39, 0xa9, 0x20, 0x59,
-	0x17, 0xc2, 0xf7, 0xb0, 0x36, 0x20, 0x99, 0xa0, 0xcc, 0xc9, 0xa2, 0xd9, 0xa3, 0x7d, 0x93, 0x2a,
-	0x8b, 0x86, 0xdd, 0x07, 0x5f, 0xce, 0x97, 0xb9, 0x92, 0xc3, 0x4c, 0x2b, 0x6b, 0xd2, 0xcc, 0xde,
-	0xf1, 0x36, 0xbd, 0xad, 0x95, 0x64, 0xb5, 0x2d, 0x3c, 0x6b, 0xf4, 0xc7, 0xeb, 0xdf, 0xce, 0x4f,
-	0x77, 0xba, 0xfe, 0x90, 0xc3, 0x5d, 0x17, 0x3c, 0x41, 0x1a, 0x6b, 0x45, 0x18, 0x7e, 0x80, 0xf5,
-	0x01, 0xc9, 0xb7, 0xca, 0x5c, 0x4d, 0xfb, 0x4d, 0xe0, 0x6e, 0xfc, 0xe5, 0x00, 0xcf, 0x61, 0xe3,
-	0x45, 0x2d, 0xfe, 0xc5, 0x4b, 0xf0, 0xb8, 0x44, 0xb2, 0x6c, 0x1b, 0x56, 0x5b, 0xd4, 0x30, 0x3d,
-	0x38, 0x30, 0x48, 0xd4, 0x8c, 0x70, 0xb3, 0xd5, 0xf7, 0x6a, 0x39, 0x7c, 0x05, 0x81, 0x8b, 0x53,
-	0x77, 0x61, 0x0f, 0x61, 0x2d, 0xa7, 0xa1, 0xfb, 0x3c, 0xd7, 0x13, 0x96, 0x77, 0x76, 0x86, 0x4f,
-	0xe0, 0xc6, 0x3e, 0x2a, 0xa4, 0x9c, 0xde, 0xd8, 0xd4, 0x22, 0xeb, 0x03, 0xeb, 0x6c, 0x9f, 0x0f,
-	0xb3, 0xb4, 0xb5, 0x92, 0xf8, 0xff, 0xfe, 0x0f, 0xda, 0xfd, 0xed, 0xc1, 0xd2, 0x80, 0x24, 0x2b,
-	0xc0, 0xef, 0x26, 0x7b, 0x2f, 0xea, 0xdc, 0x9c, 0xc8, 0x95, 0x52, 0x20, 0x16, 0x34, 0x5e, 0x9e,
-	0x93, 0xe0, 0x96, 0x2b, 0xcb, 0x6d, 0x37, 0xc7, 0x61, 0x0d, 0xe2, 0x85, 0xad, 0x6d, 0xd3, 0x60,
-	0xf9, 0xeb, 0xf9, 0xe9, 0x8e, 0xb7, 0xfb, 0x05, 0x96, 0x5f, 0x97, 0x68, 0x2a, 0x76, 0x0c, 0xac,
-	0x1b, 0x05, 0x7b, 0xe0, 0x00, 0xff, 0x37, 0xf9, 0xa0, 0xbf, 0xa0, 0xbb, 0x1e, 0xe1, 0xe9, 0xcb,
-	0x1f, 0x53, 0xee, 0x9d, 0x4d, 0xb9, 0xf7, 0x6b, 0xca, 0xbd, 0xef, 0x33, 0xde, 0x3b, 0x9b, 0xf1,
-	0xde, 0xcf, 0x19, 0xef, 0xbd, 0x8b, 0x65, 0x6e, 0x8f, 0xca, 0x51, 0x94, 0xe9, 0x42, 0x34, 0xc8,
-	0xbe, 0x42, 0x3b, 0xd1, 0xe6, 0x63, 0xfb, 0x2d, 0x3e, 0xb7, 0x2f, 0xdc, 0x56, 0x63, 0xa4, 0xd1,
-	0xb5, 0x8b, 0x77, 0xf9, 0xe8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x54, 0x4b, 0xe1, 0x6f, 0x00,
-	0x04, 0x00, 0x00,
+func (m *GrantPolicyResponse) Reset()         { *m = GrantPolicyResponse{} }
+func (m *GrantPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*GrantPolicyResponse) ProtoMessage()    {}
+func (*GrantPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{15}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// RegisterAsGranter allows a cosmwasm contract to register itself as a fee granter.
-	RegisterAsGranter(ctx context.Context, in *MsgRegisterAsGranter, opts ...grpc.CallOption) (*MsgRegisterAsGranterResponse, error)
-	// UnregisterAsGranter allows a cosmwasm contract to unregister itself as a fee granter.
-	UnregisterAsGranter(ctx context.Context, in *MsgUnregisterAsGranter, opts ...grpc.CallOption) (*MsgUnregisterAsGranterResponse, error)
+func (m *GrantPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantPolicyResponse.Merge(m, src)
+}
+func (m *GrantPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantPolicyResponse.DiscardUnknown(m)
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_GrantPolicyResponse proto.InternalMessageInfo
+
+func (m *GrantPolicyResponse) GetPolicy() GrantPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return GrantPolicy{}
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// GrantingContractsRequest is the request type of GrantingContracts RPC.
+type GrantingContractsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *msgClient) RegisterAsGranter(ctx context.Context, in *MsgRegisterAsGranter, opts ...grpc.CallOption) (*MsgRegisterAsGranterResponse, error) {
-	out := new(MsgRegisterAsGranterResponse)
-	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Msg/RegisterAsGranter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GrantingContractsRequest) Reset()         { *m = GrantingContractsRequest{} }
+func (m *GrantingContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*GrantingContractsRequest) ProtoMessage()    {}
+func (*GrantingContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{16}
+}
+func (m *GrantingContractsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantingContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantingContractsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GrantingContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantingContractsRequest.Merge(m, src)
+}
+func (m *GrantingContractsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantingContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantingContractsRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) UnregisterAsGranter(ctx context.Context, in *MsgUnregisterAsGranter, opts ...grpc.CallOption) (*MsgUnregisterAsGranterResponse, error) {
-	out := new(MsgUnregisterAsGranterResponse)
-	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Msg/UnregisterAsGranter", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GrantingContractsRequest proto.InternalMessageInfo
+
+func (m *GrantingContractsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// RegisterAsGranter allows a cosmwasm contract to register itself as a fee granter.
-	RegisterAsGranter(context.Context, *MsgRegisterAsGranter) (*MsgRegisterAsGranterResponse, error)
-	// UnregisterAsGranter allows a cosmwasm contract to unregister itself as a fee granter.
-	UnregisterAsGranter(context.Context, *MsgUnregisterAsGranter) (*MsgUnregisterAsGranterResponse, error)
+// GrantingContractsResponse is the response type of GrantingContracts RPC.
+type GrantingContractsResponse struct {
+	// granting_contracts defines the addresses of the granting contracts.
+	GrantingContracts []string `protobuf:"bytes,1,rep,name=granting_contracts,json=grantingContracts,proto3" json:"granting_contracts,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *GrantingContractsResponse) Reset()         { *m = GrantingContractsResponse{} }
+func (m *GrantingContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*GrantingContractsResponse) ProtoMessage()    {}
+func (*GrantingContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{17}
 }
-
-func (*UnimplementedMsgServer) RegisterAsGranter(ctx context.Context, req *MsgRegisterAsGranter) (*MsgRegisterAsGranterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterAsGranter not implemented")
+func (m *GrantingContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UnregisterAsGranter(ctx context.Context, req *MsgUnregisterAsGranter) (*MsgUnregisterAsGranterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterAsGranter not implemented")
+func (m *GrantingContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantingContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *GrantingContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantingContractsResponse.Merge(m, src)
+}
+func (m *GrantingContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantingContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantingContractsResponse.DiscardUnknown(m)
 }
 
-func _Msg_RegisterAsGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterAsGranter)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterAsGranter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.cwfees.v1.Msg/RegisterAsGranter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterAsGranter(ctx, req.(*MsgRegisterAsGranter))
+var xxx_messageInfo_GrantingContractsResponse proto.InternalMessageInfo
+
+func (m *GrantingContractsResponse) GetGrantingContracts() []string {
+	if m != nil {
+		return m.GrantingContracts
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UnregisterAsGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnregisterAsGranter)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnregisterAsGranter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.cwfees.v1.Msg/UnregisterAsGranter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnregisterAsGranter(ctx, req.(*MsgUnregisterAsGranter))
+func (m *GrantingContractsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "archway.cwfees.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterAsGranter",
-			Handler:    _Msg_RegisterAsGranter_Handler,
-		},
-		{
-			MethodName: "UnregisterAsGranter",
-			Handler:    _Msg_UnregisterAsGranter_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "archway/cwfees/v1/cwfees.proto",
+// GranterStatsRequest is the request type of GranterStats RPC.
+type GranterStatsRequest struct {
+	// contract_address defines the address of the granting contract.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// signer_address defines the optional address of the signer to get the statistics of
+	// instead of the ones of all the signers.
+	SignerAddress string `protobuf:"bytes,2,opt,name=signer_address,json=signerAddress,proto3" json:"signer_address,omitempty"`
 }
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// IsGrantingContract can be used to check if a contract is a granting contract.
-	IsGrantingContract(ctx context.Context, in *IsGrantingContractRequest, opts ...grpc.CallOption) (*IsGrantingContractResponse, error)
+func (m *GranterStatsRequest) Reset()         { *m = GranterStatsRequest{} }
+func (m *GranterStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GranterStatsRequest) ProtoMessage()    {}
+func (*GranterStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{18}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *GranterStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *GranterStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GranterStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GranterStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GranterStatsRequest.Merge(m, src)
+}
+func (m *GranterStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GranterStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GranterStatsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) IsGrantingContract(ctx context.Context, in *IsGrantingContractRequest, opts ...grpc.CallOption) (*IsGrantingContractResponse, error) {
-	out := new(IsGrantingContractResponse)
-	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Query/IsGrantingContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GranterStatsRequest proto.InternalMessageInfo
+
+func (m *GranterStatsRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// IsGrantingContract can be used to check if a contract is a granting contract.
-	IsGrantingContract(context.Context, *IsGrantingContractRequest) (*IsGrantingContractResponse, error)
+func (m *GranterStatsRequest) GetSignerAddress() string {
+	if m != nil {
+		return m.SignerAddress
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// GranterStatsResponse is the response type of GranterStats RPC.
+type GranterStatsResponse struct {
+	// stats defines the grant usage statistics.
+	Stats GrantStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
 }
 
-func (*UnimplementedQueryServer) IsGrantingContract(ctx context.Context, req *IsGrantingContractRequest) (*IsGrantingContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsGrantingContract not implemented")
+func (m *GranterStatsResponse) Reset()         { *m = GranterStatsResponse{} }
+func (m *GranterStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GranterStatsResponse) ProtoMessage()    {}
+func (*GranterStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{19}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *GranterStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_IsGrantingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsGrantingContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsGrantingContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.cwfees.v1.Query/IsGrantingContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsGrantingContract(ctx, req.(*IsGrantingContractRequest))
+func (m *GranterStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GranterStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "archway.cwfees.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "IsGrantingContract",
-			Handler:    _Query_IsGrantingContract_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "archway/cwfees/v1/cwfees.proto",
+func (m *GranterStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GranterStatsResponse.Merge(m, src)
+}
+func (m *GranterStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GranterStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GranterStatsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterAsGranter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GranterStatsResponse proto.InternalMessageInfo
+
+func (m *GranterStatsResponse) GetStats() GrantStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return GrantStats{}
 }
 
-func (m *MsgRegisterAsGranter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GranterSuspensionRequest is the request type of GranterSuspension RPC.
+type GranterSuspensionRequest struct {
+	// contract_address defines the address of the granting contract.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func (m *MsgRegisterAsGranter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GrantingContract) > 0 {
-		i -= len(m.GrantingContract)
-		copy(dAtA[i:], m.GrantingContract)
-		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *GranterSuspensionRequest) Reset()         { *m = GranterSuspensionRequest{} }
+func (m *GranterSuspensionRequest) String() string { return proto.CompactTextString(m) }
+func (*GranterSuspensionRequest) ProtoMessage()    {}
+func (*GranterSuspensionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{20}
 }
-
-func (m *MsgRegisterAsGranterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GranterSuspensionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GranterSuspensionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GranterSuspensionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterAsGranterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GranterSuspensionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GranterSuspensionRequest.Merge(m, src)
 }
-
-func (m *MsgRegisterAsGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GranterSuspensionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GranterSuspensionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GranterSuspensionRequest.DiscardUnknown(m)
 }
 
-func (m *MsgUnregisterAsGranter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GranterSuspensionRequest proto.InternalMessageInfo
+
+func (m *GranterSuspensionRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUnregisterAsGranter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GranterSuspensionResponse is the response type of GranterSuspension RPC.
+type GranterSuspensionResponse struct {
+	// suspended reports if the granting contract is currently suspended.
+	Suspended bool `protobuf:"varint,1,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// suspension defines the suspension of the granting contract, if it is suspended.
+	Suspension GranterSuspension `protobuf:"bytes,2,opt,name=suspension,proto3" json:"suspension"`
+	// failures defines the consecutive grant request failures of the granting contract
+	// counted in the current failure window.
+	Failures GranterFailures `protobuf:"bytes,3,opt,name=failures,proto3" json:"failures"`
 }
 
-func (m *MsgUnregisterAsGranter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GrantingContract) > 0 {
-		i -= len(m.GrantingContract)
-		copy(dAtA[i:], m.GrantingContract)
-		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *GranterSuspensionResponse) Reset()         { *m = GranterSuspensionResponse{} }
+func (m *GranterSuspensionResponse) String() string { return proto.CompactTextString(m) }
+func (*GranterSuspensionResponse) ProtoMessage()    {}
+func (*GranterSuspensionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{21}
 }
-
-func (m *MsgUnregisterAsGranterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GranterSuspensionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GranterSuspensionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GranterSuspensionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUnregisterAsGranterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GranterSuspensionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GranterSuspensionResponse.Merge(m, src)
 }
-
-func (m *MsgUnregisterAsGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GranterSuspensionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GranterSuspensionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GranterSuspensionResponse.DiscardUnknown(m)
 }
 
-func (m *IsGrantingContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GranterSuspensionResponse proto.InternalMessageInfo
+
+func (m *GranterSuspensionResponse) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *IsGrantingContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GranterSuspensionResponse) GetSuspension() GranterSuspension {
+	if m != nil {
+		return m.Suspension
+	}
+	return GranterSuspension{}
 }
 
-func (m *IsGrantingContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintCwfees(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *GranterSuspensionResponse) GetFailures() GranterFailures {
+	if m != nil {
+		return m.Failures
 	}
-	return len(dAtA) - i, nil
+	return GranterFailures{}
 }
 
-func (m *IsGrantingContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryParamsRequest is the request type of Params RPC.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{22}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *IsGrantingContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type of Params RPC.
+type QueryParamsResponse struct {
+	// params defines the module parameters.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *IsGrantingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsGrantingContract {
-		i--
-		if m.IsGrantingContract {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{23}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Params defines the module parameters.
+type Params struct {
+	// denied_granters defines the contracts which can not register as granters,
+	// and whose grant requests are rejected without being sent to them.
+	DeniedGranters []string `protobuf:"bytes,1,rep,name=denied_granters,json=deniedGranters,proto3" json:"denied_granters,omitempty"`
+	// max_consecutive_failures defines the number of consecutive grant request failures within the failure window
+	// after which a granting contract is suspended. Zero disables the automatic suspension.
+	MaxConsecutiveFailures uint64 `protobuf:"varint,2,opt,name=max_consecutive_failures,json=maxConsecutiveFailures,proto3" json:"max_consecutive_failures,omitempty"`
+	// failure_window defines the number of blocks within which the consecutive grant request failures are counted.
+	FailureWindow int64 `protobuf:"varint,3,opt,name=failure_window,json=failureWindow,proto3" json:"failure_window,omitempty"`
+	// suspension_period defines the number of blocks a granting contract is suspended for.
+	SuspensionPeriod int64 `protobuf:"varint,4,opt,name=suspension_period,json=suspensionPeriod,proto3" json:"suspension_period,omitempty"`
+	// block_settlement_gas_limit defines the total gas the settlements of the failed TXs can consume in the end blocker.
+	// The settlements which do not fit are sent in the next blocks.
+	BlockSettlementGasLimit uint64 `protobuf:"varint,5,opt,name=block_settlement_gas_limit,json=blockSettlementGasLimit,proto3" json:"block_settlement_gas_limit,omitempty"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GrantingContracts) > 0 {
-		for iNdEx := len(m.GrantingContracts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GrantingContracts[iNdEx])
-			copy(dAtA[i:], m.GrantingContracts[iNdEx])
-			i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContracts[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{24}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
 }
 
-func encodeVarintCwfees(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCwfees(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDeniedGranters() []string {
+	if m != nil {
+		return m.DeniedGranters
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgRegisterAsGranter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GrantingContract)
-	if l > 0 {
-		n += 1 + l + sovCwfees(uint64(l))
+
+func (m *Params) GetMaxConsecutiveFailures() uint64 {
+	if m != nil {
+		return m.MaxConsecutiveFailures
 	}
-	return n
+	return 0
 }
 
-func (m *MsgRegisterAsGranterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) GetFailureWindow() int64 {
+	if m != nil {
+		return m.FailureWindow
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgUnregisterAsGranter) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) GetSuspensionPeriod() int64 {
+	if m != nil {
+		return m.SuspensionPeriod
 	}
-	var l int
-	_ = l
-	l = len(m.GrantingContract)
-	if l > 0 {
-		n += 1 + l + sovCwfees(uint64(l))
+	return 0
+}
+
+func (m *Params) GetBlockSettlementGasLimit() uint64 {
+	if m != nil {
+		return m.BlockSettlementGasLimit
 	}
-	return n
+	return 0
 }
 
-func (m *MsgUnregisterAsGranterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// GranterSuspension defines the suspension of a granting contract, during which its grant requests
+// are rejected without being sent to it.
+type GranterSuspension struct {
+	// granting_contract defines the address of the suspended granting contract.
+	GrantingContract string `protobuf:"bytes,1,opt,name=granting_contract,json=grantingContract,proto3" json:"granting_contract,omitempty"`
+	// suspended_at_height defines the height the contract was suspended at.
+	SuspendedAtHeight int64 `protobuf:"varint,2,opt,name=suspended_at_height,json=suspendedAtHeight,proto3" json:"suspended_at_height,omitempty"`
+	// suspended_until_height defines the height the suspension ends at.
+	SuspendedUntilHeight int64 `protobuf:"varint,3,opt,name=suspended_until_height,json=suspendedUntilHeight,proto3" json:"suspended_until_height,omitempty"`
+}
+
+func (m *GranterSuspension) Reset()         { *m = GranterSuspension{} }
+func (m *GranterSuspension) String() string { return proto.CompactTextString(m) }
+func (*GranterSuspension) ProtoMessage()    {}
+func (*GranterSuspension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{25}
+}
+func (m *GranterSuspension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GranterSuspension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GranterSuspension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *GranterSuspension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GranterSuspension.Merge(m, src)
+}
+func (m *GranterSuspension) XXX_Size() int {
+	return m.Size()
+}
+func (m *GranterSuspension) XXX_DiscardUnknown() {
+	xxx_messageInfo_GranterSuspension.DiscardUnknown(m)
 }
 
-func (m *IsGrantingContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_GranterSuspension proto.InternalMessageInfo
+
+func (m *GranterSuspension) GetGrantingContract() string {
+	if m != nil {
+		return m.GrantingContract
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovCwfees(uint64(l))
+	return ""
+}
+
+func (m *GranterSuspension) GetSuspendedAtHeight() int64 {
+	if m != nil {
+		return m.SuspendedAtHeight
 	}
-	return n
+	return 0
 }
 
-func (m *IsGrantingContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GranterSuspension) GetSuspendedUntilHeight() int64 {
+	if m != nil {
+		return m.SuspendedUntilHeight
 	}
-	var l int
-	_ = l
-	if m.IsGrantingContract {
-		n += 2
+	return 0
+}
+
+// GranterFailures defines the consecutive grant request failures of a granting contract.
+type GranterFailures struct {
+	// consecutive_failures defines the number of grant requests which failed since the last successful one,
+	// within the failure window.
+	ConsecutiveFailures uint64 `protobuf:"varint,1,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// window_start_height defines the height of the first failure counted in the failure window.
+	WindowStartHeight int64 `protobuf:"varint,2,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+}
+
+func (m *GranterFailures) Reset()         { *m = GranterFailures{} }
+func (m *GranterFailures) String() string { return proto.CompactTextString(m) }
+func (*GranterFailures) ProtoMessage()    {}
+func (*GranterFailures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{26}
+}
+func (m *GranterFailures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GranterFailures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GranterFailures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *GranterFailures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GranterFailures.Merge(m, src)
+}
+func (m *GranterFailures) XXX_Size() int {
+	return m.Size()
+}
+func (m *GranterFailures) XXX_DiscardUnknown() {
+	xxx_messageInfo_GranterFailures.DiscardUnknown(m)
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_GranterFailures proto.InternalMessageInfo
+
+func (m *GranterFailures) GetConsecutiveFailures() uint64 {
+	if m != nil {
+		return m.ConsecutiveFailures
 	}
-	var l int
-	_ = l
-	if len(m.GrantingContracts) > 0 {
-		for _, s := range m.GrantingContracts {
-			l = len(s)
-			n += 1 + l + sovCwfees(uint64(l))
+	return 0
+}
+
+func (m *GranterFailures) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
+// GranterSuspendedEvent is emitted when a granting contract is suspended after too many consecutive
+// grant request failures.
+type GranterSuspendedEvent struct {
+	// granting_contract defines the address of the suspended granting contract.
+	GrantingContract string `protobuf:"bytes,1,opt,name=granting_contract,json=grantingContract,proto3" json:"granting_contract,omitempty"`
+	// consecutive_failures defines the number of consecutive grant request failures which led to the suspension.
+	ConsecutiveFailures uint64 `protobuf:"varint,2,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// suspended_until_height defines the height the suspension ends at.
+	SuspendedUntilHeight int64 `protobuf:"varint,3,opt,name=suspended_until_height,json=suspendedUntilHeight,proto3" json:"suspended_until_height,omitempty"`
+}
+
+func (m *GranterSuspendedEvent) Reset()         { *m = GranterSuspendedEvent{} }
+func (m *GranterSuspendedEvent) String() string { return proto.CompactTextString(m) }
+func (*GranterSuspendedEvent) ProtoMessage()    {}
+func (*GranterSuspendedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{27}
+}
+func (m *GranterSuspendedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GranterSuspendedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GranterSuspendedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GranterSuspendedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GranterSuspendedEvent.Merge(m, src)
+}
+func (m *GranterSuspendedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *GranterSuspendedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_GranterSuspendedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GranterSuspendedEvent proto.InternalMessageInfo
+
+func (m *GranterSuspendedEvent) GetGrantingContract() string {
+	if m != nil {
+		return m.GrantingContract
+	}
+	return ""
+}
+
+func (m *GranterSuspendedEvent) GetConsecutiveFailures() uint64 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *GranterSuspendedEvent) GetSuspendedUntilHeight() int64 {
+	if m != nil {
+		return m.SuspendedUntilHeight
+	}
+	return 0
+}
+
+// GrantStats defines the grant usage statistics of a granting contract, or of a signer of a granting contract.
+type GrantStats struct {
+	// sponsored_txs defines the number of grant requests accepted, which are the TXs and the callbacks sponsored.
+	SponsoredTxs uint64 `protobuf:"varint,1,opt,name=sponsored_txs,json=sponsoredTxs,proto3" json:"sponsored_txs,omitempty"`
+	// fees_paid defines the total fees paid for the accepted grant requests.
+	FeesPaid []types.Coin `protobuf:"bytes,2,rep,name=fees_paid,json=feesPaid,proto3" json:"fees_paid"`
+	// rejected_requests defines the number of grant requests rejected.
+	// As a rejected grant request reverts the state changes of the TX, or of the callback, the rejected
+	// grant requests are counted at the end of the block. The ones of CheckTx are not counted.
+	RejectedRequests uint64 `protobuf:"varint,3,opt,name=rejected_requests,json=rejectedRequests,proto3" json:"rejected_requests,omitempty"`
+	// last_used_height defines the height of the last accepted grant request.
+	LastUsedHeight int64 `protobuf:"varint,4,opt,name=last_used_height,json=lastUsedHeight,proto3" json:"last_used_height,omitempty"`
+	// sudo_gas_used defines the total gas consumed by the contract handling the grant requests.
+	SudoGasUsed uint64 `protobuf:"varint,5,opt,name=sudo_gas_used,json=sudoGasUsed,proto3" json:"sudo_gas_used,omitempty"`
+}
+
+func (m *GrantStats) Reset()         { *m = GrantStats{} }
+func (m *GrantStats) String() string { return proto.CompactTextString(m) }
+func (*GrantStats) ProtoMessage()    {}
+func (*GrantStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{28}
+}
+func (m *GrantStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantStats.Merge(m, src)
+}
+func (m *GrantStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantStats proto.InternalMessageInfo
+
+func (m *GrantStats) GetSponsoredTxs() uint64 {
+	if m != nil {
+		return m.SponsoredTxs
+	}
+	return 0
+}
+
+func (m *GrantStats) GetFeesPaid() []types.Coin {
+	if m != nil {
+		return m.FeesPaid
+	}
+	return nil
+}
+
+func (m *GrantStats) GetRejectedRequests() uint64 {
+	if m != nil {
+		return m.RejectedRequests
+	}
+	return 0
+}
+
+func (m *GrantStats) GetLastUsedHeight() int64 {
+	if m != nil {
+		return m.LastUsedHeight
+	}
+	return 0
+}
+
+func (m *GrantStats) GetSudoGasUsed() uint64 {
+	if m != nil {
+		return m.SudoGasUsed
+	}
+	return 0
+}
+
+// GrantPolicy defines the policy a granting contract's grant requests are natively evaluated with,
+// without invoking the contract. A grant request matching the policy is accepted for all the requested fees.
+// Empty lists and zero values do not restrict the grant requests.
+type GrantPolicy struct {
+	// allowed_msg_type_urls defines the type URLs of the messages the TX can contain.
+	AllowedMsgTypeUrls []string `protobuf:"bytes,1,rep,name=allowed_msg_type_urls,json=allowedMsgTypeUrls,proto3" json:"allowed_msg_type_urls,omitempty"`
+	// allowed_contracts defines the contracts the TX can execute. When set, every message of the TX,
+	// including the ones wrapped in an authz MsgExec, must execute one of the contracts.
+	AllowedContracts []string `protobuf:"bytes,2,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+	// allowed_signers defines the signers the fees can be granted to.
+	AllowedSigners []string `protobuf:"bytes,3,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
+	// signer_quota defines the number of grants each signer can be granted.
+	SignerQuota uint64 `protobuf:"varint,4,opt,name=signer_quota,json=signerQuota,proto3" json:"signer_quota,omitempty"`
+	// max_fee defines the maximum fees which can be granted per TX.
+	MaxFee []types.Coin `protobuf:"bytes,5,rep,name=max_fee,json=maxFee,proto3" json:"max_fee"`
+	// sudo_fallback defines if the grant requests not matching the policy are sent to the contract
+	// with the CWGrant sudo message, instead of being rejected.
+	SudoFallback bool `protobuf:"varint,6,opt,name=sudo_fallback,json=sudoFallback,proto3" json:"sudo_fallback,omitempty"`
+}
+
+func (m *GrantPolicy) Reset()         { *m = GrantPolicy{} }
+func (m *GrantPolicy) String() string { return proto.CompactTextString(m) }
+func (*GrantPolicy) ProtoMessage()    {}
+func (*GrantPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{29}
+}
+func (m *GrantPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantPolicy.Merge(m, src)
+}
+func (m *GrantPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantPolicy proto.InternalMessageInfo
+
+func (m *GrantPolicy) GetAllowedMsgTypeUrls() []string {
+	if m != nil {
+		return m.AllowedMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *GrantPolicy) GetAllowedContracts() []string {
+	if m != nil {
+		return m.AllowedContracts
+	}
+	return nil
+}
+
+func (m *GrantPolicy) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+func (m *GrantPolicy) GetSignerQuota() uint64 {
+	if m != nil {
+		return m.SignerQuota
+	}
+	return 0
+}
+
+func (m *GrantPolicy) GetMaxFee() []types.Coin {
+	if m != nil {
+		return m.MaxFee
+	}
+	return nil
+}
+
+func (m *GrantPolicy) GetSudoFallback() bool {
+	if m != nil {
+		return m.SudoFallback
+	}
+	return false
+}
+
+// GrantingContractFailures defines the consecutive grant request failures of a granting contract.
+type GrantingContractFailures struct {
+	// granting_contract defines the address of the granting contract.
+	GrantingContract string `protobuf:"bytes,1,opt,name=granting_contract,json=grantingContract,proto3" json:"granting_contract,omitempty"`
+	// failures defines the consecutive grant request failures.
+	Failures GranterFailures `protobuf:"bytes,2,opt,name=failures,proto3" json:"failures"`
+}
+
+func (m *GrantingContractFailures) Reset()         { *m = GrantingContractFailures{} }
+func (m *GrantingContractFailures) String() string { return proto.CompactTextString(m) }
+func (*GrantingContractFailures) ProtoMessage()    {}
+func (*GrantingContractFailures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{30}
+}
+func (m *GrantingContractFailures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantingContractFailures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantingContractFailures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantingContractFailures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantingContractFailures.Merge(m, src)
+}
+func (m *GrantingContractFailures) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantingContractFailures) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantingContractFailures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantingContractFailures proto.InternalMessageInfo
+
+func (m *GrantingContractFailures) GetGrantingContract() string {
+	if m != nil {
+		return m.GrantingContract
+	}
+	return ""
+}
+
+func (m *GrantingContractFailures) GetFailures() GranterFailures {
+	if m != nil {
+		return m.Failures
+	}
+	return GranterFailures{}
+}
+
+// GrantingContractStats defines the grant usage statistics of a granting contract, or of one of its signers.
+type GrantingContractStats struct {
+	// granting_contract defines the address of the granting contract.
+	GrantingContract string `protobuf:"bytes,1,opt,name=granting_contract,json=grantingContract,proto3" json:"granting_contract,omitempty"`
+	// signer defines the address of the signer, empty for the statistics of the granting contract.
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// stats defines the grant usage statistics.
+	Stats GrantStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *GrantingContractStats) Reset()         { *m = GrantingContractStats{} }
+func (m *GrantingContractStats) String() string { return proto.CompactTextString(m) }
+func (*GrantingContractStats) ProtoMessage()    {}
+func (*GrantingContractStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{31}
+}
+func (m *GrantingContractStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantingContractStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantingContractStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantingContractStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantingContractStats.Merge(m, src)
+}
+func (m *GrantingContractStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantingContractStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantingContractStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantingContractStats proto.InternalMessageInfo
+
+func (m *GrantingContractStats) GetGrantingContract() string {
+	if m != nil {
+		return m.GrantingContract
+	}
+	return ""
+}
+
+func (m *GrantingContractStats) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *GrantingContractStats) GetStats() GrantStats {
+	if m != nil {
+		return m.Stats
+	}
+	return GrantStats{}
+}
+
+// GrantingContractSignerGrants defines the number of grants a signer was granted by a granting contract
+// under its grant policy, which are counted against the signer quota of the policy.
+type GrantingContractSignerGrants struct {
+	// granting_contract defines the address of the granting contract.
+	GrantingContract string `protobuf:"bytes,1,opt,name=granting_contract,json=grantingContract,proto3" json:"granting_contract,omitempty"`
+	// signer defines the address of the signer.
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// grants defines the number of grants the signer was granted.
+	Grants uint64 `protobuf:"varint,3,opt,name=grants,proto3" json:"grants,omitempty"`
+}
+
+func (m *GrantingContractSignerGrants) Reset()         { *m = GrantingContractSignerGrants{} }
+func (m *GrantingContractSignerGrants) String() string { return proto.CompactTextString(m) }
+func (*GrantingContractSignerGrants) ProtoMessage()    {}
+func (*GrantingContractSignerGrants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{32}
+}
+func (m *GrantingContractSignerGrants) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantingContractSignerGrants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantingContractSignerGrants.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantingContractSignerGrants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantingContractSignerGrants.Merge(m, src)
+}
+func (m *GrantingContractSignerGrants) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantingContractSignerGrants) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantingContractSignerGrants.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantingContractSignerGrants proto.InternalMessageInfo
+
+func (m *GrantingContractSignerGrants) GetGrantingContract() string {
+	if m != nil {
+		return m.GrantingContract
+	}
+	return ""
+}
+
+func (m *GrantingContractSignerGrants) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *GrantingContractSignerGrants) GetGrants() uint64 {
+	if m != nil {
+		return m.Grants
+	}
+	return 0
+}
+
+// GrantingContractPolicy defines the grant policy of a granting contract.
+type GrantingContractPolicy struct {
+	// granting_contract defines the address of the granting contract.
+	GrantingContract string `protobuf:"bytes,1,opt,name=granting_contract,json=grantingContract,proto3" json:"granting_contract,omitempty"`
+	// policy defines the grant policy of the contract.
+	Policy GrantPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *GrantingContractPolicy) Reset()         { *m = GrantingContractPolicy{} }
+func (m *GrantingContractPolicy) String() string { return proto.CompactTextString(m) }
+func (*GrantingContractPolicy) ProtoMessage()    {}
+func (*GrantingContractPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{33}
+}
+func (m *GrantingContractPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantingContractPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantingContractPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantingContractPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantingContractPolicy.Merge(m, src)
+}
+func (m *GrantingContractPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantingContractPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantingContractPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantingContractPolicy proto.InternalMessageInfo
+
+func (m *GrantingContractPolicy) GetGrantingContract() string {
+	if m != nil {
+		return m.GrantingContract
+	}
+	return ""
+}
+
+func (m *GrantingContractPolicy) GetPolicy() GrantPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return GrantPolicy{}
+}
+
+// PendingSettlement defines a grant accepted by a granting contract for a TX
+// which the contract was not yet notified the outcome of.
+type PendingSettlement struct {
+	// granting_contract defines the address of the contract which accepted the grant.
+	GrantingContract string `protobuf:"bytes,1,opt,name=granting_contract,json=grantingContract,proto3" json:"granting_contract,omitempty"`
+	// sender defines the signer of the TX the grant was accepted for.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// fee_charged defines the fees charged for the TX.
+	FeeCharged []types.Coin `protobuf:"bytes,3,rep,name=fee_charged,json=feeCharged,proto3" json:"fee_charged"`
+	// fee_covered defines the part of the charged fees covered by the granting contract.
+	FeeCovered []types.Coin `protobuf:"bytes,4,rep,name=fee_covered,json=feeCovered,proto3" json:"fee_covered"`
+	// gas_limit defines the gas limit of the TX.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// gas_used defines the gas consumed by the TX once the ante handler finished, which is reported for a failed TX
+	// as its state changes, along with the gas it consumed afterwards, are reverted.
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *PendingSettlement) Reset()         { *m = PendingSettlement{} }
+func (m *PendingSettlement) String() string { return proto.CompactTextString(m) }
+func (*PendingSettlement) ProtoMessage()    {}
+func (*PendingSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{34}
+}
+func (m *PendingSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSettlement.Merge(m, src)
+}
+func (m *PendingSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSettlement proto.InternalMessageInfo
+
+func (m *PendingSettlement) GetGrantingContract() string {
+	if m != nil {
+		return m.GrantingContract
+	}
+	return ""
+}
+
+func (m *PendingSettlement) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingSettlement) GetFeeCharged() []types.Coin {
+	if m != nil {
+		return m.FeeCharged
+	}
+	return nil
+}
+
+func (m *PendingSettlement) GetFeeCovered() []types.Coin {
+	if m != nil {
+		return m.FeeCovered
+	}
+	return nil
+}
+
+func (m *PendingSettlement) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *PendingSettlement) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+// GenesisState represents the genesis state of the cwfeesant module.
+type GenesisState struct {
+	GrantingContracts []string `protobuf:"bytes,1,rep,name=granting_contracts,json=grantingContracts,proto3" json:"granting_contracts,omitempty"`
+	// grant_policies defines the grant policies of the granting contracts.
+	GrantPolicies []GrantingContractPolicy `protobuf:"bytes,2,rep,name=grant_policies,json=grantPolicies,proto3" json:"grant_policies"`
+	// params defines the module parameters.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// suspensions defines the suspensions of the granting contracts.
+	Suspensions []GranterSuspension `protobuf:"bytes,4,rep,name=suspensions,proto3" json:"suspensions"`
+	// granter_stats defines the grant usage statistics of the granting contracts.
+	GranterStats []GrantingContractStats `protobuf:"bytes,5,rep,name=granter_stats,json=granterStats,proto3" json:"granter_stats"`
+	// signer_stats defines the grant usage statistics of the signers of the granting contracts.
+	SignerStats []GrantingContractStats `protobuf:"bytes,6,rep,name=signer_stats,json=signerStats,proto3" json:"signer_stats"`
+	// granter_failures defines the consecutive grant request failures of the granting contracts.
+	GranterFailures []GrantingContractFailures `protobuf:"bytes,7,rep,name=granter_failures,json=granterFailures,proto3" json:"granter_failures"`
+	// signer_grants defines the grants counted against the signer quota of the grant policies.
+	SignerGrants []GrantingContractSignerGrants `protobuf:"bytes,8,rep,name=signer_grants,json=signerGrants,proto3" json:"signer_grants"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac735a27b071201b, []int{35}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetGrantingContracts() []string {
+	if m != nil {
+		return m.GrantingContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGrantPolicies() []GrantingContractPolicy {
+	if m != nil {
+		return m.GrantPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetSuspensions() []GranterSuspension {
+	if m != nil {
+		return m.Suspensions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGranterStats() []GrantingContractStats {
+	if m != nil {
+		return m.GranterStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSignerStats() []GrantingContractStats {
+	if m != nil {
+		return m.SignerStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGranterFailures() []GrantingContractFailures {
+	if m != nil {
+		return m.GranterFailures
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSignerGrants() []GrantingContractSignerGrants {
+	if m != nil {
+		return m.SignerGrants
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgRegisterAsGranter)(nil), "archway.cwfees.v1.MsgRegisterAsGranter")
+	proto.RegisterType((*MsgRegisterAsGranterResponse)(nil), "archway.cwfees.v1.MsgRegisterAsGranterResponse")
+	proto.RegisterType((*MsgUnregisterAsGranter)(nil), "archway.cwfees.v1.MsgUnregisterAsGranter")
+	proto.RegisterType((*MsgUnregisterAsGranterResponse)(nil), "archway.cwfees.v1.MsgUnregisterAsGranterResponse")
+	proto.RegisterType((*MsgSetGrantPolicy)(nil), "archway.cwfees.v1.MsgSetGrantPolicy")
+	proto.RegisterType((*MsgSetGrantPolicyResponse)(nil), "archway.cwfees.v1.MsgSetGrantPolicyResponse")
+	proto.RegisterType((*MsgRemoveGrantPolicy)(nil), "archway.cwfees.v1.MsgRemoveGrantPolicy")
+	proto.RegisterType((*MsgRemoveGrantPolicyResponse)(nil), "archway.cwfees.v1.MsgRemoveGrantPolicyResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "archway.cwfees.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "archway.cwfees.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgForceUnregisterGranter)(nil), "archway.cwfees.v1.MsgForceUnregisterGranter")
+	proto.RegisterType((*MsgForceUnregisterGranterResponse)(nil), "archway.cwfees.v1.MsgForceUnregisterGranterResponse")
+	proto.RegisterType((*IsGrantingContractRequest)(nil), "archway.cwfees.v1.IsGrantingContractRequest")
+	proto.RegisterType((*IsGrantingContractResponse)(nil), "archway.cwfees.v1.IsGrantingContractResponse")
+	proto.RegisterType((*GrantPolicyRequest)(nil), "archway.cwfees.v1.GrantPolicyRequest")
+	proto.RegisterType((*GrantPolicyResponse)(nil), "archway.cwfees.v1.GrantPolicyResponse")
+	proto.RegisterType((*GrantingContractsRequest)(nil), "archway.cwfees.v1.GrantingContractsRequest")
+	proto.RegisterType((*GrantingContractsResponse)(nil), "archway.cwfees.v1.GrantingContractsResponse")
+	proto.RegisterType((*GranterStatsRequest)(nil), "archway.cwfees.v1.GranterStatsRequest")
+	proto.RegisterType((*GranterStatsResponse)(nil), "archway.cwfees.v1.GranterStatsResponse")
+	proto.RegisterType((*GranterSuspensionRequest)(nil), "archway.cwfees.v1.GranterSuspensionRequest")
+	proto.RegisterType((*GranterSuspensionResponse)(nil), "archway.cwfees.v1.GranterSuspensionResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "archway.cwfees.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "archway.cwfees.v1.QueryParamsResponse")
+	proto.RegisterType((*Params)(nil), "archway.cwfees.v1.Params")
+	proto.RegisterType((*GranterSuspension)(nil), "archway.cwfees.v1.GranterSuspension")
+	proto.RegisterType((*GranterFailures)(nil), "archway.cwfees.v1.GranterFailures")
+	proto.RegisterType((*GranterSuspendedEvent)(nil), "archway.cwfees.v1.GranterSuspendedEvent")
+	proto.RegisterType((*GrantStats)(nil), "archway.cwfees.v1.GrantStats")
+	proto.RegisterType((*GrantPolicy)(nil), "archway.cwfees.v1.GrantPolicy")
+	proto.RegisterType((*GrantingContractFailures)(nil), "archway.cwfees.v1.GrantingContractFailures")
+	proto.RegisterType((*GrantingContractStats)(nil), "archway.cwfees.v1.GrantingContractStats")
+	proto.RegisterType((*GrantingContractSignerGrants)(nil), "archway.cwfees.v1.GrantingContractSignerGrants")
+	proto.RegisterType((*GrantingContractPolicy)(nil), "archway.cwfees.v1.GrantingContractPolicy")
+	proto.RegisterType((*PendingSettlement)(nil), "archway.cwfees.v1.PendingSettlement")
+	proto.RegisterType((*GenesisState)(nil), "archway.cwfees.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("archway/cwfees/v1/cwfees.proto", fileDescriptor_ac735a27b071201b) }
+
+var fileDescriptor_ac735a27b071201b = []byte{
+	// 1778 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xdb, 0x89, 0x27, 0x79, 0xf9, 0x74, 0x25, 0xeb, 0x71, 0xbc, 0xc1, 0x64, 0x7b, 0x76,
+	0x93, 0x4c, 0x76, 0xc7, 0xc6, 0xc3, 0x4a, 0x2c, 0xcb, 0x4a, 0x30, 0x1b, 0x36, 0xe1, 0x63, 0xb3,
+	0x4a, 0xec, 0x09, 0x23, 0x0d, 0x03, 0xad, 0xb2, 0xfb, 0xa5, 0xd3, 0x8c, 0xdd, 0xed, 0x74, 0x95,
+	0x9d, 0x44, 0x70, 0x40, 0x70, 0x46, 0x42, 0x02, 0x01, 0x77, 0x24, 0x6e, 0x48, 0xf3, 0x67, 0x8c,
+	0xc4, 0x65, 0x8e, 0x9c, 0x10, 0x9a, 0x39, 0x8c, 0xc4, 0x91, 0x33, 0x42, 0xa8, 0xab, 0xaa, 0x3f,
+	0xdc, 0x6e, 0x4f, 0xec, 0x08, 0xb8, 0xb9, 0xdf, 0xfb, 0xd5, 0x7b, 0xaf, 0x7e, 0xef, 0xd5, 0xab,
+	0x0f, 0x43, 0x99, 0x7a, 0xad, 0xb3, 0x0b, 0x7a, 0x55, 0x6d, 0x5d, 0x9c, 0x22, 0xb2, 0x6a, 0xbf,
+	0xa6, 0x7e, 0x55, 0xba, 0x9e, 0xcb, 0x5d, 0x92, 0x57, 0xfa, 0x8a, 0x92, 0xf6, 0x6b, 0xa5, 0x35,
+	0xcb, 0xb5, 0x5c, 0xa1, 0xad, 0xfa, 0xbf, 0x24, 0xb0, 0xb4, 0x6e, 0xb9, 0xae, 0xd5, 0xc6, 0xaa,
+	0xf8, 0x6a, 0xf6, 0x4e, 0xab, 0xd4, 0xb9, 0x52, 0xaa, 0x72, 0xcb, 0x65, 0x1d, 0x97, 0x55, 0x9b,
+	0x94, 0x61, 0xb5, 0x5f, 0x6b, 0x22, 0xa7, 0xb5, 0x6a, 0xcb, 0xb5, 0x1d, 0xa5, 0xdf, 0x8d, 0xeb,
+	0xcf, 0x7b, 0xe8, 0x5d, 0x85, 0xa8, 0x2e, 0xb5, 0x6c, 0x87, 0x72, 0xdb, 0x0d, 0xb0, 0xb7, 0x15,
+	0xb6, 0xc3, 0x2c, 0x3f, 0xd6, 0x0e, 0xb3, 0xa4, 0x42, 0xff, 0x21, 0xac, 0x1d, 0x32, 0xab, 0x8e,
+	0x96, 0xcd, 0x38, 0x7a, 0x0f, 0xd8, 0x81, 0x47, 0x1d, 0x8e, 0x1e, 0x79, 0x1f, 0xf2, 0x96, 0xff,
+	0xd3, 0x76, 0x2c, 0xa3, 0xe5, 0x3a, 0xdc, 0xa3, 0x2d, 0x5e, 0xd4, 0x36, 0xb5, 0x9d, 0xb9, 0xfa,
+	0x4a, 0xa0, 0xd8, 0x53, 0xf2, 0x8f, 0x0b, 0xbf, 0x78, 0xfd, 0x6c, 0x77, 0x18, 0xaf, 0x97, 0x61,
+	0x23, 0xcd, 0x78, 0x1d, 0x59, 0xd7, 0x75, 0x18, 0xea, 0x3f, 0x82, 0xc2, 0x21, 0xb3, 0x4e, 0x1c,
+	0xef, 0x7f, 0xe3, 0x7e, 0x13, 0xca, 0xe9, 0xe6, 0xc3, 0x00, 0x7e, 0xa7, 0x41, 0xfe, 0x90, 0x59,
+	0x0d, 0xe4, 0x42, 0x73, 0xe4, 0xb6, 0xed, 0xd6, 0xd5, 0x44, 0xce, 0xc9, 0x27, 0x90, 0xeb, 0x8a,
+	0x61, 0xc5, 0xcc, 0xa6, 0xb6, 0x33, 0x7f, 0xbf, 0x5c, 0x19, 0x4a, 0x7d, 0x25, 0x66, 0xfc, 0xd3,
+	0xe9, 0xe7, 0x7f, 0xfb, 0xf2, 0x54, 0x5d, 0x8d, 0x19, 0x19, 0xfa, 0xdb, 0xb0, 0x3e, 0x14, 0x57,
+	0x18, 0x75, 0x90, 0xb3, 0x8e, 0xdb, 0xc7, 0x9b, 0xc6, 0x7d, 0x6d, 0xce, 0x12, 0xc6, 0x43, 0xe7,
+	0x97, 0xb0, 0xec, 0x93, 0xda, 0x35, 0x29, 0xc7, 0x23, 0xea, 0xd1, 0x0e, 0x23, 0x1b, 0x30, 0x47,
+	0x7b, 0xfc, 0xcc, 0xf5, 0x6c, 0x7e, 0xa5, 0xfc, 0x45, 0x02, 0xf2, 0x35, 0xc8, 0x75, 0x05, 0x4e,
+	0x11, 0xb4, 0x9e, 0x42, 0x90, 0x34, 0x14, 0x72, 0x23, 0xbe, 0x3e, 0x5e, 0xf2, 0x23, 0x8c, 0x0c,
+	0xe9, 0xeb, 0x70, 0x3b, 0xe1, 0x39, 0x0c, 0xaa, 0x2f, 0xe8, 0xda, 0x77, 0xbd, 0x16, 0x46, 0xe9,
+	0x0e, 0x6a, 0xe9, 0xcd, 0xe1, 0xa5, 0x92, 0x96, 0x19, 0x41, 0x5a, 0x32, 0xa4, 0x3b, 0xf0, 0xce,
+	0x48, 0xbf, 0x61, 0x70, 0xfb, 0xb0, 0xfe, 0x5d, 0x76, 0x90, 0x30, 0x55, 0xc7, 0xf3, 0x1e, 0x32,
+	0x4e, 0xee, 0xc2, 0x4a, 0xe0, 0xd5, 0xa0, 0xa6, 0xe9, 0x21, 0x63, 0x2a, 0xc6, 0xe5, 0x40, 0xfe,
+	0x40, 0x8a, 0xf5, 0x2f, 0xa0, 0x94, 0x66, 0x47, 0x7a, 0x21, 0x5f, 0x81, 0x35, 0x9b, 0x19, 0xe9,
+	0xf9, 0x9f, 0xad, 0x13, 0x7b, 0x68, 0xa4, 0xfe, 0x4d, 0x20, 0x03, 0x09, 0x9e, 0x38, 0xa0, 0x06,
+	0xac, 0xa6, 0x54, 0x48, 0x6c, 0x45, 0x68, 0x93, 0xaf, 0x08, 0xbd, 0x09, 0xc5, 0x64, 0xa4, 0x2c,
+	0x88, 0x6d, 0x1f, 0x20, 0xea, 0x6c, 0xca, 0xfa, 0x56, 0x45, 0xb6, 0xb6, 0x8a, 0xdf, 0x06, 0x2b,
+	0xa2, 0x0d, 0x56, 0x54, 0x1b, 0xac, 0x1c, 0x51, 0x0b, 0xd5, 0xd8, 0x7a, 0x6c, 0xa4, 0xfe, 0x1b,
+	0x0d, 0xd6, 0x53, 0x9c, 0xa8, 0xf8, 0xef, 0x01, 0x19, 0xa2, 0xd1, 0xe7, 0x20, 0xbb, 0x33, 0x57,
+	0xcf, 0x27, 0x4b, 0x82, 0x91, 0x83, 0x81, 0xa0, 0x64, 0x8d, 0x6f, 0x5f, 0x1b, 0x94, 0xf4, 0x35,
+	0x10, 0x95, 0xa5, 0xe8, 0x44, 0xaf, 0xc1, 0x29, 0x67, 0x93, 0x27, 0x84, 0xbc, 0x07, 0x4b, 0xcc,
+	0xb6, 0x1c, 0xf4, 0x42, 0xa0, 0x2c, 0xe4, 0x45, 0x29, 0x0d, 0xf2, 0x76, 0x0c, 0x6b, 0x83, 0x8e,
+	0xd4, 0xc4, 0xbf, 0x0e, 0x33, 0xcc, 0x17, 0x28, 0x66, 0xbf, 0x34, 0x2a, 0x6f, 0x62, 0x94, 0x4a,
+	0x9b, 0x1c, 0xa1, 0x7f, 0xa6, 0xb2, 0x86, 0x5e, 0xa3, 0xc7, 0xba, 0xe8, 0x30, 0xdb, 0x75, 0x6e,
+	0x50, 0x51, 0x7f, 0x09, 0x12, 0x33, 0x68, 0x47, 0xc5, 0xb7, 0x01, 0x73, 0x4c, 0x48, 0x4d, 0x34,
+	0x55, 0x5d, 0x47, 0x02, 0xf2, 0x3d, 0x00, 0x16, 0x8e, 0x51, 0x79, 0x78, 0x77, 0xd4, 0x14, 0xe2,
+	0xf6, 0xd5, 0x4c, 0x62, 0xa3, 0xc9, 0xb7, 0x61, 0xf6, 0x94, 0xda, 0xed, 0x9e, 0x87, 0xac, 0x98,
+	0x15, 0x96, 0xf4, 0xd1, 0x96, 0xf6, 0x15, 0x52, 0xd9, 0x09, 0x47, 0xea, 0x6b, 0x40, 0x8e, 0xfd,
+	0xd4, 0x07, 0xcd, 0x4a, 0xd0, 0xa1, 0x7f, 0x01, 0xab, 0x03, 0x52, 0x35, 0xb9, 0xa8, 0x4d, 0x6a,
+	0x13, 0xb5, 0x49, 0xfd, 0x5f, 0x1a, 0xe4, 0xa4, 0x82, 0x6c, 0xc3, 0xb2, 0x89, 0x8e, 0x8d, 0xa6,
+	0xec, 0x03, 0xe8, 0x05, 0x65, 0xbb, 0x24, 0xc5, 0x2a, 0x60, 0x46, 0x3e, 0x82, 0x62, 0x87, 0x5e,
+	0xfa, 0xd5, 0xcd, 0xb0, 0xd5, 0xe3, 0x76, 0x1f, 0x8d, 0x70, 0xbe, 0x3e, 0x73, 0xd3, 0xf5, 0x42,
+	0x87, 0x5e, 0xee, 0x45, 0xea, 0x60, 0x8e, 0x7e, 0x89, 0x29, 0xa4, 0x71, 0x61, 0x3b, 0xa6, 0x7b,
+	0x21, 0xf8, 0xc9, 0xd6, 0x17, 0x95, 0xf4, 0x91, 0x10, 0xfa, 0x5d, 0x35, 0xa2, 0xd3, 0xe8, 0xa2,
+	0x67, 0xbb, 0x66, 0x71, 0x5a, 0x20, 0x57, 0x22, 0xc5, 0x91, 0x90, 0x93, 0x6f, 0x40, 0xa9, 0xd9,
+	0x76, 0x5b, 0x4f, 0x0d, 0x86, 0x9c, 0xb7, 0xb1, 0x83, 0x0e, 0x37, 0x2c, 0xca, 0x8c, 0xb6, 0xdd,
+	0xb1, 0x79, 0x71, 0x46, 0xc4, 0x73, 0x5b, 0x20, 0x1a, 0x21, 0xe0, 0x80, 0xb2, 0xcf, 0x7d, 0xb5,
+	0xfe, 0x27, 0x0d, 0xf2, 0x43, 0x29, 0x9d, 0x6c, 0x0b, 0xaf, 0xc0, 0x6a, 0x58, 0x46, 0x06, 0xe5,
+	0xc6, 0x19, 0xda, 0xd6, 0x99, 0xdc, 0x04, 0xb2, 0xf5, 0x7c, 0xa8, 0x7a, 0xc0, 0xbf, 0x23, 0x14,
+	0xe4, 0x43, 0x28, 0x44, 0xf8, 0x9e, 0xc3, 0xed, 0x76, 0x30, 0x44, 0x72, 0xb1, 0x16, 0x6a, 0x4f,
+	0x7c, 0xa5, 0x1c, 0xa5, 0x73, 0x58, 0x4e, 0x14, 0x0c, 0xa9, 0xc1, 0x5a, 0x6a, 0x0a, 0x34, 0x31,
+	0xe5, 0xd5, 0x56, 0x0a, 0xff, 0x15, 0x58, 0x95, 0xbc, 0x1b, 0x8c, 0x53, 0x2f, 0x19, 0xab, 0x54,
+	0x35, 0x7c, 0x8d, 0xf2, 0xfa, 0x67, 0x0d, 0xde, 0x1a, 0xa0, 0xc7, 0x44, 0xf3, 0xb3, 0x3e, 0x3a,
+	0x7c, 0x32, 0x8a, 0x46, 0x45, 0x9a, 0x19, 0x1d, 0xe9, 0xcd, 0x58, 0xfa, 0x87, 0x06, 0x10, 0x35,
+	0x19, 0x72, 0x07, 0x16, 0xc5, 0xfa, 0x70, 0x3d, 0x34, 0x0d, 0x7e, 0x19, 0x50, 0xb3, 0x10, 0x0a,
+	0x1f, 0x5e, 0x32, 0xf2, 0x09, 0xcc, 0xf9, 0x2b, 0xc4, 0xe8, 0x52, 0xdb, 0x2c, 0x66, 0x36, 0xb3,
+	0x62, 0xf5, 0xc4, 0x1b, 0x70, 0xd0, 0x7a, 0xf7, 0x5c, 0xdb, 0x09, 0x57, 0x29, 0x22, 0x3b, 0xa2,
+	0xb6, 0xe9, 0xf3, 0xe0, 0xe1, 0x4f, 0xb0, 0xc5, 0xd1, 0x34, 0x3c, 0xb9, 0x46, 0xe5, 0xa2, 0x9f,
+	0xae, 0xaf, 0x04, 0x0a, 0xb5, 0x76, 0x19, 0xd9, 0x81, 0x95, 0x36, 0x65, 0xdc, 0xe8, 0x31, 0x34,
+	0x83, 0xe9, 0xc8, 0xb2, 0x5e, 0xf2, 0xe5, 0x27, 0x0c, 0x4d, 0x55, 0x24, 0x3a, 0x2c, 0xb2, 0x9e,
+	0xe9, 0x8a, 0x42, 0xf6, 0xd1, 0xaa, 0x8e, 0xe7, 0x7d, 0xe1, 0x01, 0x65, 0x3e, 0x52, 0xff, 0x43,
+	0x06, 0xe6, 0xe3, 0x07, 0xb8, 0x1a, 0xbc, 0x45, 0xdb, 0x6d, 0xf7, 0x02, 0x4d, 0xa3, 0xc3, 0x2c,
+	0x83, 0x5f, 0x75, 0xd1, 0xe8, 0x79, 0xed, 0x60, 0x15, 0x13, 0xa5, 0x3c, 0x64, 0xd6, 0xc3, 0xab,
+	0x2e, 0x9e, 0x78, 0x6d, 0xe6, 0x47, 0x1f, 0x0c, 0x89, 0xf6, 0xaa, 0x8c, 0x80, 0xaf, 0x28, 0x45,
+	0xb4, 0x55, 0x6d, 0xc3, 0x72, 0x00, 0x96, 0x3b, 0x82, 0x3f, 0x51, 0xd1, 0x1f, 0x94, 0xb8, 0x21,
+	0xa5, 0xe4, 0x1d, 0x58, 0x50, 0x1b, 0xc9, 0x79, 0xcf, 0xe5, 0xb4, 0x38, 0xad, 0x62, 0x17, 0xb2,
+	0x63, 0x5f, 0x44, 0x3e, 0x82, 0x5b, 0x7e, 0x0b, 0x39, 0x45, 0x2c, 0xce, 0x8c, 0x47, 0x79, 0xae,
+	0x43, 0x2f, 0xf7, 0x11, 0xc9, 0x1d, 0xc5, 0xcc, 0x29, 0x6d, 0xb7, 0x9b, 0xb4, 0xf5, 0xb4, 0x98,
+	0x13, 0xad, 0x7c, 0xc1, 0x17, 0xee, 0x2b, 0x99, 0xfe, 0x2b, 0x6d, 0xf8, 0x1c, 0x10, 0x96, 0xd6,
+	0x44, 0xa5, 0x1b, 0xef, 0xe5, 0x99, 0x1b, 0xf7, 0xf2, 0xdf, 0x07, 0xeb, 0x28, 0x66, 0x5a, 0x96,
+	0xe8, 0x44, 0xc1, 0x14, 0x20, 0x27, 0x49, 0x54, 0x3b, 0xb3, 0xfa, 0x8a, 0xb6, 0xde, 0xec, 0xc4,
+	0x5b, 0xef, 0x4f, 0x61, 0x63, 0x28, 0x30, 0x61, 0x54, 0x48, 0xff, 0x4b, 0xf1, 0x15, 0x20, 0x27,
+	0xb0, 0xc1, 0xca, 0x50, 0x5f, 0xfa, 0x2f, 0x35, 0x28, 0x24, 0xbd, 0xff, 0xdf, 0x6f, 0x51, 0xfa,
+	0x6f, 0x33, 0x90, 0x3f, 0x42, 0xc7, 0xb4, 0x1d, 0x2b, 0xda, 0x21, 0x26, 0x9f, 0xb8, 0xdf, 0x8c,
+	0xa2, 0x89, 0x8b, 0x2f, 0xf2, 0x2d, 0x98, 0x3f, 0x45, 0x34, 0x5a, 0x67, 0xd4, 0xb3, 0xd0, 0x2c,
+	0x66, 0xc7, 0x2b, 0x75, 0x38, 0x45, 0xdc, 0x93, 0x43, 0x42, 0x0b, 0x6e, 0x1f, 0x3d, 0xf4, 0x37,
+	0xc1, 0xf1, 0x2d, 0xc8, 0x21, 0xe4, 0x6d, 0x98, 0x4b, 0x6e, 0x87, 0xb3, 0x96, 0xda, 0xff, 0xc8,
+	0x3a, 0xcc, 0x86, 0x2d, 0x26, 0x27, 0x74, 0xb7, 0x2c, 0xd5, 0x5e, 0xfe, 0x3d, 0x0d, 0x0b, 0x07,
+	0xe8, 0x20, 0xb3, 0x99, 0x5f, 0x37, 0x13, 0x9f, 0x6c, 0x7f, 0x00, 0x4b, 0x42, 0x68, 0x08, 0x9a,
+	0x6d, 0x64, 0xaa, 0xb9, 0xde, 0x1d, 0x95, 0x9c, 0xa1, 0x22, 0x50, 0x93, 0x59, 0xb4, 0xc2, 0xd4,
+	0xd9, 0xc8, 0x62, 0x47, 0x9d, 0xec, 0x44, 0x47, 0x1d, 0xf2, 0x39, 0xcc, 0x47, 0x87, 0x07, 0xa6,
+	0xa8, 0x9c, 0xe4, 0x8c, 0x17, 0x1f, 0x4e, 0x1a, 0xb0, 0xa8, 0x8e, 0x49, 0x86, 0x5c, 0x7b, 0xb2,
+	0x8f, 0xed, 0x8c, 0x31, 0xbb, 0xf8, 0x32, 0x5c, 0xb0, 0x62, 0x67, 0x69, 0x72, 0x1c, 0x76, 0x4e,
+	0x69, 0x33, 0x77, 0x23, 0x9b, 0xaa, 0xd3, 0x4a, 0x93, 0x4f, 0x60, 0x25, 0x88, 0x33, 0x6c, 0x64,
+	0xb7, 0x84, 0xd9, 0xf7, 0xc7, 0x30, 0x9b, 0xe8, 0x68, 0xcb, 0x56, 0xe2, 0x0c, 0xf2, 0x18, 0xd4,
+	0xed, 0xc0, 0x50, 0x0b, 0x7c, 0x56, 0x98, 0xae, 0x8e, 0x13, 0x71, 0xac, 0xcd, 0x04, 0x64, 0xb0,
+	0x98, 0xec, 0xfe, 0x1f, 0x67, 0x20, 0x7b, 0xc8, 0x2c, 0xd2, 0x81, 0xfc, 0xf0, 0x0b, 0xd3, 0x76,
+	0x8a, 0x87, 0xb4, 0xd7, 0xa2, 0x52, 0x75, 0x4c, 0x60, 0x78, 0x94, 0x66, 0xb0, 0x9a, 0xf6, 0xa6,
+	0x74, 0x37, 0xdd, 0x4e, 0x0a, 0xb4, 0x54, 0x1b, 0x1b, 0x1a, 0x3a, 0x35, 0x61, 0x29, 0xf1, 0x8c,
+	0xf4, 0x6e, 0xba, 0x91, 0x41, 0x54, 0xe9, 0x83, 0x71, 0x50, 0xa1, 0x17, 0xc1, 0x64, 0xf2, 0xdd,
+	0x67, 0x24, 0x93, 0x09, 0x60, 0xa9, 0x3a, 0x26, 0x30, 0x74, 0xf7, 0x63, 0x58, 0x18, 0x78, 0xe9,
+	0xd1, 0x47, 0xf0, 0x12, 0xc3, 0x94, 0x76, 0xaf, 0xc7, 0x84, 0xf6, 0x7f, 0x06, 0x85, 0x11, 0x8f,
+	0x36, 0x23, 0x68, 0x49, 0x47, 0x97, 0x3e, 0x9c, 0x04, 0x1d, 0x78, 0x2f, 0xcd, 0xfc, 0xfc, 0xf5,
+	0xb3, 0x5d, 0xed, 0xfe, 0x3f, 0xa7, 0x61, 0x46, 0xdc, 0xc8, 0xc8, 0x39, 0x90, 0xe1, 0x17, 0x96,
+	0xd4, 0x50, 0x46, 0x3e, 0xe8, 0x94, 0xee, 0x8d, 0x89, 0x56, 0x0c, 0x3c, 0x19, 0x3c, 0x01, 0xbe,
+	0xf7, 0xe6, 0x7d, 0x2f, 0x70, 0xb2, 0x75, 0x1d, 0x4c, 0x59, 0x77, 0x20, 0x9f, 0xf4, 0xcc, 0xc8,
+	0x38, 0x5d, 0x23, 0xb8, 0xad, 0x96, 0x3e, 0x18, 0x0f, 0xac, 0xfc, 0x19, 0xb0, 0x10, 0x7f, 0x59,
+	0x20, 0x5b, 0x6f, 0xe8, 0xcd, 0xb1, 0x37, 0x8e, 0xd2, 0xf6, 0xb5, 0xb8, 0xc4, 0x84, 0x06, 0x2f,
+	0x7b, 0xe3, 0xec, 0x00, 0xd7, 0x4e, 0x28, 0xf5, 0xc9, 0xe1, 0x51, 0x78, 0xb7, 0x4e, 0xcb, 0xcc,
+	0xf0, 0xed, 0xbe, 0xb4, 0x75, 0x1d, 0x4c, 0x1a, 0xfe, 0xf4, 0xfb, 0xcf, 0x5f, 0x96, 0xb5, 0x17,
+	0x2f, 0xcb, 0xda, 0xdf, 0x5f, 0x96, 0xb5, 0x5f, 0xbf, 0x2a, 0x4f, 0xbd, 0x78, 0x55, 0x9e, 0xfa,
+	0xeb, 0xab, 0xf2, 0xd4, 0xe3, 0x9a, 0x65, 0xf3, 0xb3, 0x5e, 0xb3, 0xd2, 0x72, 0x3b, 0x55, 0x65,
+	0xeb, 0x9e, 0x83, 0xfc, 0xc2, 0xf5, 0x9e, 0x06, 0xdf, 0xd5, 0xcb, 0xe0, 0x7f, 0x07, 0xff, 0x8a,
+	0xc0, 0x9a, 0x39, 0xf1, 0x96, 0xff, 0xd5, 0xff, 0x0c, 0x00, 0x58, 0x49, 0x32, 0xbe, 0x96, 0x18,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterAsGranter allows a cosmwasm contract to register itself as a fee granter.
+	RegisterAsGranter(ctx context.Context, in *MsgRegisterAsGranter, opts ...grpc.CallOption) (*MsgRegisterAsGranterResponse, error)
+	// UnregisterAsGranter allows a cosmwasm contract to unregister itself as a fee granter.
+	UnregisterAsGranter(ctx context.Context, in *MsgUnregisterAsGranter, opts ...grpc.CallOption) (*MsgUnregisterAsGranterResponse, error)
+	// SetGrantPolicy allows a granting contract to set the policy its grant requests are natively evaluated with.
+	SetGrantPolicy(ctx context.Context, in *MsgSetGrantPolicy, opts ...grpc.CallOption) (*MsgSetGrantPolicyResponse, error)
+	// RemoveGrantPolicy allows a granting contract to remove its grant policy.
+	RemoveGrantPolicy(ctx context.Context, in *MsgRemoveGrantPolicy, opts ...grpc.CallOption) (*MsgRemoveGrantPolicyResponse, error)
+	// UpdateParams defines a governance operation for updating the x/cwfees module parameters.
+	// The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ForceUnregisterGranter defines a governance operation for unregistering a granting contract.
+	ForceUnregisterGranter(ctx context.Context, in *MsgForceUnregisterGranter, opts ...grpc.CallOption) (*MsgForceUnregisterGranterResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterAsGranter(ctx context.Context, in *MsgRegisterAsGranter, opts ...grpc.CallOption) (*MsgRegisterAsGranterResponse, error) {
+	out := new(MsgRegisterAsGranterResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Msg/RegisterAsGranter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnregisterAsGranter(ctx context.Context, in *MsgUnregisterAsGranter, opts ...grpc.CallOption) (*MsgUnregisterAsGranterResponse, error) {
+	out := new(MsgUnregisterAsGranterResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Msg/UnregisterAsGranter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetGrantPolicy(ctx context.Context, in *MsgSetGrantPolicy, opts ...grpc.CallOption) (*MsgSetGrantPolicyResponse, error) {
+	out := new(MsgSetGrantPolicyResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Msg/SetGrantPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveGrantPolicy(ctx context.Context, in *MsgRemoveGrantPolicy, opts ...grpc.CallOption) (*MsgRemoveGrantPolicyResponse, error) {
+	out := new(MsgRemoveGrantPolicyResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Msg/RemoveGrantPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceUnregisterGranter(ctx context.Context, in *MsgForceUnregisterGranter, opts ...grpc.CallOption) (*MsgForceUnregisterGranterResponse, error) {
+	out := new(MsgForceUnregisterGranterResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Msg/ForceUnregisterGranter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RegisterAsGranter allows a cosmwasm contract to register itself as a fee granter.
+	RegisterAsGranter(context.Context, *MsgRegisterAsGranter) (*MsgRegisterAsGranterResponse, error)
+	// UnregisterAsGranter allows a cosmwasm contract to unregister itself as a fee granter.
+	UnregisterAsGranter(context.Context, *MsgUnregisterAsGranter) (*MsgUnregisterAsGranterResponse, error)
+	// SetGrantPolicy allows a granting contract to set the policy its grant requests are natively evaluated with.
+	SetGrantPolicy(context.Context, *MsgSetGrantPolicy) (*MsgSetGrantPolicyResponse, error)
+	// RemoveGrantPolicy allows a granting contract to remove its grant policy.
+	RemoveGrantPolicy(context.Context, *MsgRemoveGrantPolicy) (*MsgRemoveGrantPolicyResponse, error)
+	// UpdateParams defines a governance operation for updating the x/cwfees module parameters.
+	// The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ForceUnregisterGranter defines a governance operation for unregistering a granting contract.
+	ForceUnregisterGranter(context.Context, *MsgForceUnregisterGranter) (*MsgForceUnregisterGranterResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterAsGranter(ctx context.Context, req *MsgRegisterAsGranter) (*MsgRegisterAsGranterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAsGranter not implemented")
+}
+func (*UnimplementedMsgServer) UnregisterAsGranter(ctx context.Context, req *MsgUnregisterAsGranter) (*MsgUnregisterAsGranterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterAsGranter not implemented")
+}
+func (*UnimplementedMsgServer) SetGrantPolicy(ctx context.Context, req *MsgSetGrantPolicy) (*MsgSetGrantPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGrantPolicy not implemented")
+}
+func (*UnimplementedMsgServer) RemoveGrantPolicy(ctx context.Context, req *MsgRemoveGrantPolicy) (*MsgRemoveGrantPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGrantPolicy not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ForceUnregisterGranter(ctx context.Context, req *MsgForceUnregisterGranter) (*MsgForceUnregisterGranterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceUnregisterGranter not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterAsGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAsGranter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAsGranter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwfees.v1.Msg/RegisterAsGranter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAsGranter(ctx, req.(*MsgRegisterAsGranter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterAsGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterAsGranter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterAsGranter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwfees.v1.Msg/UnregisterAsGranter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterAsGranter(ctx, req.(*MsgUnregisterAsGranter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetGrantPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetGrantPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetGrantPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwfees.v1.Msg/SetGrantPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetGrantPolicy(ctx, req.(*MsgSetGrantPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveGrantPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveGrantPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveGrantPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwfees.v1.Msg/RemoveGrantPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveGrantPolicy(ctx, req.(*MsgRemoveGrantPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwfees.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceUnregisterGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceUnregisterGranter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceUnregisterGranter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwfees.v1.Msg/ForceUnregisterGranter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceUnregisterGranter(ctx, req.(*MsgForceUnregisterGranter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "archway.cwfees.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterAsGranter",
+			Handler:    _Msg_RegisterAsGranter_Handler,
+		},
+		{
+			MethodName: "UnregisterAsGranter",
+			Handler:    _Msg_UnregisterAsGranter_Handler,
+		},
+		{
+			MethodName: "SetGrantPolicy",
+			Handler:    _Msg_SetGrantPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveGrantPolicy",
+			Handler:    _Msg_RemoveGrantPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ForceUnregisterGranter",
+			Handler:    _Msg_ForceUnregisterGranter_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "archway/cwfees/v1/cwfees.proto",
+}
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// IsGrantingContract can be used to check if a contract is a granting contract.
+	IsGrantingContract(ctx context.Context, in *IsGrantingContractRequest, opts ...grpc.CallOption) (*IsGrantingContractResponse, error)
+	// GrantPolicy returns the grant policy of a granting contract.
+	GrantPolicy(ctx context.Context, in *GrantPolicyRequest, opts ...grpc.CallOption) (*GrantPolicyResponse, error)
+	// GrantingContracts returns the list of the granting contracts.
+	GrantingContracts(ctx context.Context, in *GrantingContractsRequest, opts ...grpc.CallOption) (*GrantingContractsResponse, error)
+	// GranterStats returns the grant usage statistics of a granting contract, or of a signer of a granting contract.
+	GranterStats(ctx context.Context, in *GranterStatsRequest, opts ...grpc.CallOption) (*GranterStatsResponse, error)
+	// GranterSuspension returns the suspension state of a granting contract.
+	GranterSuspension(ctx context.Context, in *GranterSuspensionRequest, opts ...grpc.CallOption) (*GranterSuspensionResponse, error)
+	// Params returns the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) IsGrantingContract(ctx context.Context, in *IsGrantingContractRequest, opts ...grpc.CallOption) (*IsGrantingContractResponse, error) {
+	out := new(IsGrantingContractResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Query/IsGrantingContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GrantPolicy(ctx context.Context, in *GrantPolicyRequest, opts ...grpc.CallOption) (*GrantPolicyResponse, error) {
+	out := new(GrantPolicyResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Query/GrantPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GrantingContracts(ctx context.Context, in *GrantingContractsRequest, opts ...grpc.CallOption) (*GrantingContractsResponse, error) {
+	out := new(GrantingContractsResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Query/GrantingContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GranterStats(ctx context.Context, in *GranterStatsRequest, opts ...grpc.CallOption) (*GranterStatsResponse, error) {
+	out := new(GranterStatsResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Query/GranterStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GranterSuspension(ctx context.Context, in *GranterSuspensionRequest, opts ...grpc.CallOption) (*GranterSuspensionResponse, error) {
+	out := new(GranterSuspensionResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Query/GranterSuspension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/archway.cwfees.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// IsGrantingContract can be used to check if a contract is a granting contract.
+	IsGrantingContract(context.Context, *IsGrantingContractRequest) (*IsGrantingContractResponse, error)
+	// GrantPolicy returns the grant policy of a granting contract.
+	GrantPolicy(context.Context, *GrantPolicyRequest) (*GrantPolicyResponse, error)
+	// GrantingContracts returns the list of the granting contracts.
+	GrantingContracts(context.Context, *GrantingContractsRequest) (*GrantingContractsResponse, error)
+	// GranterStats returns the grant usage statistics of a granting contract, or of a signer of a granting contract.
+	GranterStats(context.Context, *GranterStatsRequest) (*GranterStatsResponse, error)
+	// GranterSuspension returns the suspension state of a granting contract.
+	GranterSuspension(context.Context, *GranterSuspensionRequest) (*GranterSuspensionResponse, error)
+	// Params returns the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) IsGrantingContract(ctx context.Context, req *IsGrantingContractRequest) (*IsGrantingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsGrantingContract not implemented")
+}
+func (*UnimplementedQueryServer) GrantPolicy(ctx context.Context, req *GrantPolicyRequest) (*GrantPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPolicy not implemented")
+}
+func (*UnimplementedQueryServer) GrantingContracts(ctx context.Context, req *GrantingContractsRequest) (*GrantingContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantingContracts not implemented")
+}
+func (*UnimplementedQueryServer) GranterStats(ctx context.Context, req *GranterStatsRequest) (*GranterStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GranterStats not implemented")
+}
+func (*UnimplementedQueryServer) GranterSuspension(ctx context.Context, req *GranterSuspensionRequest) (*GranterSuspensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GranterSuspension not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_IsGrantingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsGrantingContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsGrantingContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwfees.v1.Query/IsGrantingContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsGrantingContract(ctx, req.(*IsGrantingContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GrantPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GrantPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwfees.v1.Query/GrantPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GrantPolicy(ctx, req.(*GrantPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GrantingContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantingContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GrantingContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwfees.v1.Query/GrantingContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GrantingContracts(ctx, req.(*GrantingContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GranterStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GranterStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GranterStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwfees.v1.Query/GranterStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GranterStats(ctx, req.(*GranterStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GranterSuspension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GranterSuspensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GranterSuspension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwfees.v1.Query/GranterSuspension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GranterSuspension(ctx, req.(*GranterSuspensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.cwfees.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "archway.cwfees.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IsGrantingContract",
+			Handler:    _Query_IsGrantingContract_Handler,
+		},
+		{
+			MethodName: "GrantPolicy",
+			Handler:    _Query_GrantPolicy_Handler,
+		},
+		{
+			MethodName: "GrantingContracts",
+			Handler:    _Query_GrantingContracts_Handler,
+		},
+		{
+			MethodName: "GranterStats",
+			Handler:    _Query_GranterStats_Handler,
+		},
+		{
+			MethodName: "GranterSuspension",
+			Handler:    _Query_GranterSuspension_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "archway/cwfees/v1/cwfees.proto",
+}
+
+func (m *MsgRegisterAsGranter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAsGranter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAsGranter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GrantingContract) > 0 {
+		i -= len(m.GrantingContract)
+		copy(dAtA[i:], m.GrantingContract)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAsGranterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAsGranterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAsGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterAsGranter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterAsGranter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterAsGranter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GrantingContract) > 0 {
+		i -= len(m.GrantingContract)
+		copy(dAtA[i:], m.GrantingContract)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterAsGranterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterAsGranterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterAsGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetGrantPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGrantPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGrantPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCwfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.GrantingContract) > 0 {
+		i -= len(m.GrantingContract)
+		copy(dAtA[i:], m.GrantingContract)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetGrantPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGrantPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGrantPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveGrantPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveGrantPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveGrantPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GrantingContract) > 0 {
+		i -= len(m.GrantingContract)
+		copy(dAtA[i:], m.GrantingContract)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveGrantPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveGrantPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveGrantPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCwfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceUnregisterGranter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceUnregisterGranter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceUnregisterGranter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GrantingContract) > 0 {
+		i -= len(m.GrantingContract)
+		copy(dAtA[i:], m.GrantingContract)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceUnregisterGranterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceUnregisterGranterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceUnregisterGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *IsGrantingContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IsGrantingContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IsGrantingContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IsGrantingContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IsGrantingContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IsGrantingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsGrantingContract {
+		i--
+		if m.IsGrantingContract {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCwfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantingContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantingContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantingContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCwfees(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantingContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantingContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantingContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCwfees(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GrantingContracts) > 0 {
+		for iNdEx := len(m.GrantingContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GrantingContracts[iNdEx])
+			copy(dAtA[i:], m.GrantingContracts[iNdEx])
+			i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GranterStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GranterStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GranterStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignerAddress) > 0 {
+		i -= len(m.SignerAddress)
+		copy(dAtA[i:], m.SignerAddress)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.SignerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GranterStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GranterStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GranterStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCwfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GranterSuspensionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GranterSuspensionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GranterSuspensionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GranterSuspensionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GranterSuspensionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GranterSuspensionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Failures.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCwfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Suspension.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCwfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCwfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockSettlementGasLimit != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.BlockSettlementGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SuspensionPeriod != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.SuspensionPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FailureWindow != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.FailureWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxConsecutiveFailures != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.MaxConsecutiveFailures))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DeniedGranters) > 0 {
+		for iNdEx := len(m.DeniedGranters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedGranters[iNdEx])
+			copy(dAtA[i:], m.DeniedGranters[iNdEx])
+			i = encodeVarintCwfees(dAtA, i, uint64(len(m.DeniedGranters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GranterSuspension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GranterSuspension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GranterSuspension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuspendedUntilHeight != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.SuspendedUntilHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SuspendedAtHeight != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.SuspendedAtHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GrantingContract) > 0 {
+		i -= len(m.GrantingContract)
+		copy(dAtA[i:], m.GrantingContract)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GranterFailures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GranterFailures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GranterFailures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GranterSuspendedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GranterSuspendedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GranterSuspendedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuspendedUntilHeight != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.SuspendedUntilHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GrantingContract) > 0 {
+		i -= len(m.GrantingContract)
+		copy(dAtA[i:], m.GrantingContract)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SudoGasUsed != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.SudoGasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastUsedHeight != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.LastUsedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RejectedRequests != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.RejectedRequests))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FeesPaid) > 0 {
+		for iNdEx := len(m.FeesPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCwfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SponsoredTxs != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.SponsoredTxs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SudoFallback {
+		i--
+		if m.SudoFallback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MaxFee) > 0 {
+		for iNdEx := len(m.MaxFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCwfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SignerQuota != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.SignerQuota))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintCwfees(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintCwfees(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.AllowedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypeUrls[iNdEx])
+			i = encodeVarintCwfees(dAtA, i, uint64(len(m.AllowedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantingContractFailures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantingContractFailures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantingContractFailures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Failures.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCwfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.GrantingContract) > 0 {
+		i -= len(m.GrantingContract)
+		copy(dAtA[i:], m.GrantingContract)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantingContractStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantingContractStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantingContractStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCwfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GrantingContract) > 0 {
+		i -= len(m.GrantingContract)
+		copy(dAtA[i:], m.GrantingContract)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantingContractSignerGrants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantingContractSignerGrants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantingContractSignerGrants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Grants != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.Grants))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GrantingContract) > 0 {
+		i -= len(m.GrantingContract)
+		copy(dAtA[i:], m.GrantingContract)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantingContractPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantingContractPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantingContractPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCwfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.GrantingContract) > 0 {
+		i -= len(m.GrantingContract)
+		copy(dAtA[i:], m.GrantingContract)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintCwfees(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FeeCovered) > 0 {
+		for iNdEx := len(m.FeeCovered) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCovered[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCwfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FeeCharged) > 0 {
+		for iNdEx := len(m.FeeCharged) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCharged[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCwfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GrantingContract) > 0 {
+		i -= len(m.GrantingContract)
+		copy(dAtA[i:], m.GrantingContract)
+		i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignerGrants) > 0 {
+		for iNdEx := len(m.SignerGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCwfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.GranterFailures) > 0 {
+		for iNdEx := len(m.GranterFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GranterFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCwfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SignerStats) > 0 {
+		for iNdEx := len(m.SignerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCwfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.GranterStats) > 0 {
+		for iNdEx := len(m.GranterStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GranterStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCwfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Suspensions) > 0 {
+		for iNdEx := len(m.Suspensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Suspensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCwfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCwfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.GrantPolicies) > 0 {
+		for iNdEx := len(m.GrantPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GrantPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCwfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GrantingContracts) > 0 {
+		for iNdEx := len(m.GrantingContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GrantingContracts[iNdEx])
+			copy(dAtA[i:], m.GrantingContracts[iNdEx])
+			i = encodeVarintCwfees(dAtA, i, uint64(len(m.GrantingContracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCwfees(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCwfees(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterAsGranter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GrantingContract)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterAsGranterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnregisterAsGranter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GrantingContract)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnregisterAsGranterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetGrantPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GrantingContract)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovCwfees(uint64(l))
+	return n
+}
+
+func (m *MsgSetGrantPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveGrantPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GrantingContract)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveGrantPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovCwfees(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForceUnregisterGranter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	l = len(m.GrantingContract)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForceUnregisterGranterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *IsGrantingContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	return n
+}
+
+func (m *IsGrantingContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsGrantingContract {
+		n += 2
+	}
+	return n
+}
+
+func (m *GrantPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovCwfees(uint64(l))
+	return n
+}
+
+func (m *GrantingContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantingContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GrantingContracts) > 0 {
+		for _, s := range m.GrantingContracts {
+			l = len(s)
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	return n
+}
+
+func (m *GranterStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	l = len(m.SignerAddress)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	return n
+}
+
+func (m *GranterStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovCwfees(uint64(l))
+	return n
+}
+
+func (m *GranterSuspensionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	return n
+}
+
+func (m *GranterSuspensionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Suspended {
+		n += 2
+	}
+	l = m.Suspension.Size()
+	n += 1 + l + sovCwfees(uint64(l))
+	l = m.Failures.Size()
+	n += 1 + l + sovCwfees(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovCwfees(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeniedGranters) > 0 {
+		for _, s := range m.DeniedGranters {
+			l = len(s)
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if m.MaxConsecutiveFailures != 0 {
+		n += 1 + sovCwfees(uint64(m.MaxConsecutiveFailures))
+	}
+	if m.FailureWindow != 0 {
+		n += 1 + sovCwfees(uint64(m.FailureWindow))
+	}
+	if m.SuspensionPeriod != 0 {
+		n += 1 + sovCwfees(uint64(m.SuspensionPeriod))
+	}
+	if m.BlockSettlementGasLimit != 0 {
+		n += 1 + sovCwfees(uint64(m.BlockSettlementGasLimit))
+	}
+	return n
+}
+
+func (m *GranterSuspension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GrantingContract)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	if m.SuspendedAtHeight != 0 {
+		n += 1 + sovCwfees(uint64(m.SuspendedAtHeight))
+	}
+	if m.SuspendedUntilHeight != 0 {
+		n += 1 + sovCwfees(uint64(m.SuspendedUntilHeight))
+	}
+	return n
+}
+
+func (m *GranterFailures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovCwfees(uint64(m.ConsecutiveFailures))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovCwfees(uint64(m.WindowStartHeight))
+	}
+	return n
+}
+
+func (m *GranterSuspendedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GrantingContract)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovCwfees(uint64(m.ConsecutiveFailures))
+	}
+	if m.SuspendedUntilHeight != 0 {
+		n += 1 + sovCwfees(uint64(m.SuspendedUntilHeight))
+	}
+	return n
+}
+
+func (m *GrantStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SponsoredTxs != 0 {
+		n += 1 + sovCwfees(uint64(m.SponsoredTxs))
+	}
+	if len(m.FeesPaid) > 0 {
+		for _, e := range m.FeesPaid {
+			l = e.Size()
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if m.RejectedRequests != 0 {
+		n += 1 + sovCwfees(uint64(m.RejectedRequests))
+	}
+	if m.LastUsedHeight != 0 {
+		n += 1 + sovCwfees(uint64(m.LastUsedHeight))
+	}
+	if m.SudoGasUsed != 0 {
+		n += 1 + sovCwfees(uint64(m.SudoGasUsed))
+	}
+	return n
+}
+
+func (m *GrantPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for _, s := range m.AllowedMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if m.SignerQuota != 0 {
+		n += 1 + sovCwfees(uint64(m.SignerQuota))
+	}
+	if len(m.MaxFee) > 0 {
+		for _, e := range m.MaxFee {
+			l = e.Size()
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if m.SudoFallback {
+		n += 2
+	}
+	return n
+}
+
+func (m *GrantingContractFailures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GrantingContract)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	l = m.Failures.Size()
+	n += 1 + l + sovCwfees(uint64(l))
+	return n
+}
+
+func (m *GrantingContractStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GrantingContract)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovCwfees(uint64(l))
+	return n
+}
+
+func (m *GrantingContractSignerGrants) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GrantingContract)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	if m.Grants != 0 {
+		n += 1 + sovCwfees(uint64(m.Grants))
+	}
+	return n
+}
+
+func (m *GrantingContractPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GrantingContract)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovCwfees(uint64(l))
+	return n
+}
+
+func (m *PendingSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GrantingContract)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovCwfees(uint64(l))
+	}
+	if len(m.FeeCharged) > 0 {
+		for _, e := range m.FeeCharged {
+			l = e.Size()
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if len(m.FeeCovered) > 0 {
+		for _, e := range m.FeeCovered {
+			l = e.Size()
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovCwfees(uint64(m.GasLimit))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovCwfees(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GrantingContracts) > 0 {
+		for _, s := range m.GrantingContracts {
+			l = len(s)
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if len(m.GrantPolicies) > 0 {
+		for _, e := range m.GrantPolicies {
+			l = e.Size()
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovCwfees(uint64(l))
+	if len(m.Suspensions) > 0 {
+		for _, e := range m.Suspensions {
+			l = e.Size()
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if len(m.GranterStats) > 0 {
+		for _, e := range m.GranterStats {
+			l = e.Size()
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if len(m.SignerStats) > 0 {
+		for _, e := range m.SignerStats {
+			l = e.Size()
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if len(m.GranterFailures) > 0 {
+		for _, e := range m.GranterFailures {
+			l = e.Size()
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	if len(m.SignerGrants) > 0 {
+		for _, e := range m.SignerGrants {
+			l = e.Size()
+			n += 1 + l + sovCwfees(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCwfees(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCwfees(x uint64) (n int) {
+	return sovCwfees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterAsGranter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAsGranter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAsGranter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantingContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantingContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAsGranterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAsGranterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAsGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterAsGranter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterAsGranter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterAsGranter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantingContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantingContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterAsGranterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterAsGranterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterAsGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetGrantPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetGrantPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetGrantPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantingContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantingContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetGrantPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetGrantPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetGrantPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveGrantPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveGrantPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveGrantPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantingContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantingContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveGrantPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveGrantPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveGrantPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceUnregisterGranter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceUnregisterGranter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceUnregisterGranter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantingContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantingContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceUnregisterGranterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceUnregisterGranterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceUnregisterGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IsGrantingContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IsGrantingContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IsGrantingContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IsGrantingContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IsGrantingContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IsGrantingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsGrantingContract", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsGrantingContract = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantingContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantingContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantingContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantingContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantingContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantingContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantingContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantingContracts = append(m.GrantingContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GranterStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GranterStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GranterStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GranterStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GranterStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GranterStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GranterSuspensionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GranterSuspensionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GranterSuspensionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GranterSuspensionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GranterSuspensionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GranterSuspensionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Suspension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Failures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedGranters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedGranters = append(m.DeniedGranters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveFailures", wireType)
+			}
+			m.MaxConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureWindow", wireType)
+			}
+			m.FailureWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspensionPeriod", wireType)
+			}
+			m.SuspensionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspensionPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSettlementGasLimit", wireType)
+			}
+			m.BlockSettlementGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSettlementGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GranterSuspension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GranterSuspension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GranterSuspension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantingContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantingContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedAtHeight", wireType)
+			}
+			m.SuspendedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspendedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedUntilHeight", wireType)
+			}
+			m.SuspendedUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspendedUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GranterFailures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GranterFailures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GranterFailures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GranterSuspendedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GranterSuspendedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GranterSuspendedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantingContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantingContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedUntilHeight", wireType)
+			}
+			m.SuspendedUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspendedUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsoredTxs", wireType)
+			}
+			m.SponsoredTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SponsoredTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesPaid = append(m.FeesPaid, types.Coin{})
+			if err := m.FeesPaid[len(m.FeesPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedRequests", wireType)
+			}
+			m.RejectedRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedRequests |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedHeight", wireType)
+			}
+			m.LastUsedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoGasUsed", wireType)
+			}
+			m.SudoGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SudoGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypeUrls = append(m.AllowedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerQuota", wireType)
+			}
+			m.SignerQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFee = append(m.MaxFee, types.Coin{})
+			if err := m.MaxFee[len(m.MaxFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoFallback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SudoFallback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantingContractFailures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantingContractFailures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantingContractFailures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantingContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantingContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Failures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantingContractStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCwfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantingContractStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantingContractStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantingContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantingContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCwfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovCwfees(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCwfees(x uint64) (n int) {
-	return sovCwfees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgRegisterAsGranter) Unmarshal(dAtA []byte) error {
+func (m *GrantingContractSignerGrants) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -894,10 +7881,10 @@ func (m *MsgRegisterAsGranter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAsGranter: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrantingContractSignerGrants: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAsGranter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrantingContractSignerGrants: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -932,6 +7919,57 @@ func (m *MsgRegisterAsGranter) Unmarshal(dAtA []byte) error {
 			}
 			m.GrantingContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			m.Grants = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Grants |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCwfees(dAtA[iNdEx:])
@@ -953,7 +7991,7 @@ func (m *MsgRegisterAsGranter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterAsGranterResponse) Unmarshal(dAtA []byte) error {
+func (m *GrantingContractPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -976,12 +8014,77 @@ func (m *MsgRegisterAsGranterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAsGranterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrantingContractPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAsGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrantingContractPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantingContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantingContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCwfees(dAtA[iNdEx:])
@@ -1003,7 +8106,7 @@ func (m *MsgRegisterAsGranterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterAsGranter) Unmarshal(dAtA []byte) error {
+func (m *PendingSettlement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1026,10 +8129,10 @@ func (m *MsgUnregisterAsGranter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterAsGranter: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingSettlement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterAsGranter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1064,56 +8167,144 @@ func (m *MsgUnregisterAsGranter) Unmarshal(dAtA []byte) error {
 			}
 			m.GrantingContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCwfees(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCwfees
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUnregisterAsGranterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCwfees
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCharged", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.FeeCharged = append(m.FeeCharged, types.Coin{})
+			if err := m.FeeCharged[len(m.FeeCharged)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCovered", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCovered = append(m.FeeCovered, types.Coin{})
+			if err := m.FeeCovered[len(m.FeeCovered)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterAsGranterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterAsGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCwfees(dAtA[iNdEx:])
@@ -1135,7 +8326,7 @@ func (m *MsgUnregisterAsGranterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IsGrantingContractRequest) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1158,15 +8349,15 @@ func (m *IsGrantingContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IsGrantingContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IsGrantingContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantingContracts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1194,63 +8385,114 @@ func (m *IsGrantingContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.GrantingContracts = append(m.GrantingContracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCwfees(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantPolicies = append(m.GrantPolicies, GrantingContractPolicy{})
+			if err := m.GrantPolicies[len(m.GrantPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthCwfees
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IsGrantingContractResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCwfees
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Suspensions = append(m.Suspensions, GranterSuspension{})
+			if err := m.Suspensions[len(m.Suspensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IsGrantingContractResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IsGrantingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsGrantingContract", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GranterStats", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCwfees
@@ -1260,67 +8502,99 @@ func (m *IsGrantingContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsGrantingContract = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCwfees(dAtA[iNdEx:])
-			if err != nil {
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GranterStats = append(m.GranterStats, GrantingContractStats{})
+			if err := m.GranterStats[len(m.GranterStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthCwfees
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCwfees
+			m.SignerStats = append(m.SignerStats, GrantingContractStats{})
+			if err := m.SignerStats[len(m.SignerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GranterFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCwfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCwfees
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.GranterFailures = append(m.GranterFailures, GrantingContractFailures{})
+			if err := m.GranterFailures[len(m.GranterFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GrantingContracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerGrants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCwfees
@@ -1330,23 +8604,25 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCwfees
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCwfees
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GrantingContracts = append(m.GrantingContracts, string(dAtA[iNdEx:postIndex]))
+			m.SignerGrants = append(m.SignerGrants, GrantingContractSignerGrants{})
+			if err := m.SignerGrants[len(m.SignerGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
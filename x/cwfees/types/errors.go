@@ -3,7 +3,14 @@ package types
 import "cosmossdk.io/errors"
 
 var (
-	ErrNotAContract   = errors.New(ModuleName, 0, "not a cosmwasm contract")
-	ErrAlreadyGranter = errors.New(ModuleName, 1, "provided contract is already a granter")
-	ErrNotAGranter    = errors.New(ModuleName, 2, "provided contract is not a granter")
+	ErrNotAContract         = errors.New(ModuleName, 0, "not a cosmwasm contract")
+	ErrAlreadyGranter       = errors.New(ModuleName, 1, "provided contract is already a granter")
+	ErrNotAGranter          = errors.New(ModuleName, 2, "provided contract is not a granter")
+	ErrInvalidGrantResponse = errors.New(ModuleName, 3, "invalid grant response")
+	ErrGrantDenied          = errors.New(ModuleName, 4, "grant denied by the grant policy")
+	ErrInvalidGrantPolicy   = errors.New(ModuleName, 5, "invalid grant policy")
+	ErrGrantPolicyNotFound  = errors.New(ModuleName, 6, "grant policy not found")
+	ErrUnauthorized         = errors.New(ModuleName, 7, "sender is not authorized")
+	ErrGranterDenied        = errors.New(ModuleName, 8, "granting contract is denied")
+	ErrGranterSuspended     = errors.New(ModuleName, 9, "granting contract is suspended")
 )
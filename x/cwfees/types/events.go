@@ -0,0 +1,19 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EmitGranterSuspendedEvent emits an event when a granting contract is suspended
+func EmitGranterSuspendedEvent(ctx sdk.Context, grantingContract string, consecutiveFailures uint64, suspendedUntilHeight int64) {
+	err := ctx.EventManager().EmitTypedEvent(&GranterSuspendedEvent{
+		GrantingContract:     grantingContract,
+		ConsecutiveFailures:  consecutiveFailures,
+		SuspendedUntilHeight: suspendedUntilHeight,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending GranterSuspendedEvent event: %w", err))
+	}
+}
@@ -6,7 +6,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultGenesisState returns the default genesis state, with no granting contracts and the default params.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{Params: DefaultParams()}
+}
+
 func (m *GenesisState) Validate() error {
+	if err := m.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
 	set := make(map[string]struct{}, len(m.GrantingContracts))
 	for i, addr := range m.GrantingContracts {
 		_, isDuplicate := set[addr]
@@ -19,5 +27,92 @@ func (m *GenesisState) Validate() error {
 		}
 		set[addr] = struct{}{}
 	}
+	for _, addr := range m.Params.DeniedGranters {
+		if _, isGranter := set[addr]; isGranter {
+			return fmt.Errorf("denied granter is a granting contract: %s", addr)
+		}
+	}
+	policies := make(map[string]GrantPolicy, len(m.GrantPolicies))
+	for i, p := range m.GrantPolicies {
+		if _, isGranter := set[p.GrantingContract]; !isGranter {
+			return fmt.Errorf("grant policy at index %d of a contract which is not a granting contract: %s", i, p.GrantingContract)
+		}
+		if _, isDuplicate := policies[p.GrantingContract]; isDuplicate {
+			return fmt.Errorf("duplicate grant policy at index %d: %s", i, p.GrantingContract)
+		}
+		if err := p.Policy.Validate(); err != nil {
+			return fmt.Errorf("invalid grant policy at index %d: %w", i, err)
+		}
+		policies[p.GrantingContract] = p.Policy
+	}
+	suspensions := make(map[string]struct{}, len(m.Suspensions))
+	for i, s := range m.Suspensions {
+		if _, err := sdk.AccAddressFromBech32(s.GrantingContract); err != nil {
+			return fmt.Errorf("invalid bech32 address of suspension %d %s: %w", i, s.GrantingContract, err)
+		}
+		if _, isDuplicate := suspensions[s.GrantingContract]; isDuplicate {
+			return fmt.Errorf("duplicate suspension at index %d: %s", i, s.GrantingContract)
+		}
+		if s.SuspendedUntilHeight <= s.SuspendedAtHeight {
+			return fmt.Errorf("suspension at index %d ends at height %d before it starts at height %d", i, s.SuspendedUntilHeight, s.SuspendedAtHeight)
+		}
+		suspensions[s.GrantingContract] = struct{}{}
+	}
+	granterStats := make(map[string]struct{}, len(m.GranterStats))
+	for i, s := range m.GranterStats {
+		if _, err := sdk.AccAddressFromBech32(s.GrantingContract); err != nil {
+			return fmt.Errorf("invalid bech32 address of granter stats %d %s: %w", i, s.GrantingContract, err)
+		}
+		if s.Signer != "" {
+			return fmt.Errorf("granter stats at index %d with a signer: %s", i, s.Signer)
+		}
+		if _, isDuplicate := granterStats[s.GrantingContract]; isDuplicate {
+			return fmt.Errorf("duplicate granter stats at index %d: %s", i, s.GrantingContract)
+		}
+		granterStats[s.GrantingContract] = struct{}{}
+	}
+	signerStats := make(map[[2]string]struct{}, len(m.SignerStats))
+	for i, s := range m.SignerStats {
+		if _, err := sdk.AccAddressFromBech32(s.GrantingContract); err != nil {
+			return fmt.Errorf("invalid bech32 address of signer stats %d %s: %w", i, s.GrantingContract, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(s.Signer); err != nil {
+			return fmt.Errorf("invalid bech32 signer address of signer stats %d %s: %w", i, s.Signer, err)
+		}
+		key := [2]string{s.GrantingContract, s.Signer}
+		if _, isDuplicate := signerStats[key]; isDuplicate {
+			return fmt.Errorf("duplicate signer stats at index %d: %s %s", i, s.GrantingContract, s.Signer)
+		}
+		signerStats[key] = struct{}{}
+	}
+	failures := make(map[string]struct{}, len(m.GranterFailures))
+	for i, f := range m.GranterFailures {
+		if _, err := sdk.AccAddressFromBech32(f.GrantingContract); err != nil {
+			return fmt.Errorf("invalid bech32 address of granter failures %d %s: %w", i, f.GrantingContract, err)
+		}
+		if _, isDuplicate := failures[f.GrantingContract]; isDuplicate {
+			return fmt.Errorf("duplicate granter failures at index %d: %s", i, f.GrantingContract)
+		}
+		failures[f.GrantingContract] = struct{}{}
+	}
+	signerGrants := make(map[[2]string]struct{}, len(m.SignerGrants))
+	for i, g := range m.SignerGrants {
+		if _, err := sdk.AccAddressFromBech32(g.Signer); err != nil {
+			return fmt.Errorf("invalid bech32 signer address of signer grants %d %s: %w", i, g.Signer, err)
+		}
+		// the grants are only counted against the signer quota of a grant policy, which they can not exceed
+		policy, hasPolicy := policies[g.GrantingContract]
+		if !hasPolicy || policy.SignerQuota == 0 {
+			return fmt.Errorf("signer grants at index %d of a contract without a signer quota: %s", i, g.GrantingContract)
+		}
+		if g.Grants == 0 || g.Grants > policy.SignerQuota {
+			return fmt.Errorf("signer grants at index %d out of the signer quota of %d: %d", i, policy.SignerQuota, g.Grants)
+		}
+		key := [2]string{g.GrantingContract, g.Signer}
+		if _, isDuplicate := signerGrants[key]; isDuplicate {
+			return fmt.Errorf("duplicate signer grants at index %d: %s %s", i, g.GrantingContract, g.Signer)
+		}
+		signerGrants[key] = struct{}{}
+	}
 	return nil
 }
@@ -18,14 +18,204 @@ func TestGenesis(t *testing.T) {
 
 	tests := map[string]tc{
 		"ok": {
-			genesis: &GenesisState{GrantingContracts: []string{alice.String(), bob.String()}},
+			genesis: &GenesisState{Params: DefaultParams(), GrantingContracts: []string{alice.String(), bob.String()}},
 		},
 		"duplicates": {
-			genesis:     &GenesisState{GrantingContracts: []string{alice.String(), alice.String()}},
+			genesis:     &GenesisState{Params: DefaultParams(), GrantingContracts: []string{alice.String(), alice.String()}},
 			errContains: "duplicate",
 		},
+		"ok with grant policy": {
+			genesis: &GenesisState{
+				Params:            DefaultParams(),
+				GrantingContracts: []string{alice.String(), bob.String()},
+				GrantPolicies:     []GrantingContractPolicy{{GrantingContract: alice.String(), Policy: GrantPolicy{SignerQuota: 1}}},
+			},
+		},
+		"grant policy of a non granting contract": {
+			genesis: &GenesisState{
+				Params:            DefaultParams(),
+				GrantingContracts: []string{alice.String()},
+				GrantPolicies:     []GrantingContractPolicy{{GrantingContract: bob.String()}},
+			},
+			errContains: "not a granting contract",
+		},
+		"duplicate grant policies": {
+			genesis: &GenesisState{
+				Params:            DefaultParams(),
+				GrantingContracts: []string{alice.String()},
+				GrantPolicies:     []GrantingContractPolicy{{GrantingContract: alice.String()}, {GrantingContract: alice.String()}},
+			},
+			errContains: "duplicate grant policy",
+		},
+		"invalid grant policy": {
+			genesis: &GenesisState{
+				Params:            DefaultParams(),
+				GrantingContracts: []string{alice.String()},
+				GrantPolicies:     []GrantingContractPolicy{{GrantingContract: alice.String(), Policy: GrantPolicy{AllowedSigners: []string{"invalid-address"}}}},
+			},
+			errContains: "invalid grant policy",
+		},
+		"ok with suspension": {
+			genesis: &GenesisState{
+				Params:            DefaultParams(),
+				GrantingContracts: []string{alice.String()},
+				Suspensions:       []GranterSuspension{{GrantingContract: alice.String(), SuspendedAtHeight: 10, SuspendedUntilHeight: 20}},
+			},
+		},
+		"ok with suspension of a non granting contract": {
+			genesis: &GenesisState{
+				Params:            DefaultParams(),
+				GrantingContracts: []string{alice.String()},
+				Suspensions:       []GranterSuspension{{GrantingContract: bob.String(), SuspendedAtHeight: 10, SuspendedUntilHeight: 20}},
+			},
+		},
+		"invalid suspension addr": {
+			genesis: &GenesisState{
+				Params:      DefaultParams(),
+				Suspensions: []GranterSuspension{{GrantingContract: "invalid-address", SuspendedAtHeight: 10, SuspendedUntilHeight: 20}},
+			},
+			errContains: "invalid bech32 address of suspension",
+		},
+		"ok with grant stats": {
+			genesis: &GenesisState{
+				Params:       DefaultParams(),
+				GranterStats: []GrantingContractStats{{GrantingContract: alice.String(), Stats: GrantStats{SponsoredTxs: 1}}},
+				SignerStats: []GrantingContractStats{
+					{GrantingContract: alice.String(), Signer: bob.String(), Stats: GrantStats{SponsoredTxs: 1}},
+					{GrantingContract: bob.String(), Signer: bob.String(), Stats: GrantStats{RejectedRequests: 1}},
+				},
+			},
+		},
+		"duplicate granter stats": {
+			genesis: &GenesisState{
+				Params:       DefaultParams(),
+				GranterStats: []GrantingContractStats{{GrantingContract: alice.String()}, {GrantingContract: alice.String()}},
+			},
+			errContains: "duplicate granter stats",
+		},
+		"granter stats with a signer": {
+			genesis: &GenesisState{
+				Params:       DefaultParams(),
+				GranterStats: []GrantingContractStats{{GrantingContract: alice.String(), Signer: bob.String()}},
+			},
+			errContains: "with a signer",
+		},
+		"duplicate signer stats": {
+			genesis: &GenesisState{
+				Params: DefaultParams(),
+				SignerStats: []GrantingContractStats{
+					{GrantingContract: alice.String(), Signer: bob.String()},
+					{GrantingContract: alice.String(), Signer: bob.String()},
+				},
+			},
+			errContains: "duplicate signer stats",
+		},
+		"signer stats without a signer": {
+			genesis: &GenesisState{
+				Params:      DefaultParams(),
+				SignerStats: []GrantingContractStats{{GrantingContract: alice.String()}},
+			},
+			errContains: "invalid bech32 signer address of signer stats",
+		},
+		"ok with granter failures": {
+			genesis: &GenesisState{
+				Params:          DefaultParams(),
+				GranterFailures: []GrantingContractFailures{{GrantingContract: alice.String(), Failures: GranterFailures{ConsecutiveFailures: 1, WindowStartHeight: 10}}},
+			},
+		},
+		"duplicate granter failures": {
+			genesis: &GenesisState{
+				Params:          DefaultParams(),
+				GranterFailures: []GrantingContractFailures{{GrantingContract: alice.String()}, {GrantingContract: alice.String()}},
+			},
+			errContains: "duplicate granter failures",
+		},
+		"invalid granter failures addr": {
+			genesis: &GenesisState{
+				Params:          DefaultParams(),
+				GranterFailures: []GrantingContractFailures{{GrantingContract: "invalid-address"}},
+			},
+			errContains: "invalid bech32 address of granter failures",
+		},
+		"ok with signer grants": {
+			genesis: &GenesisState{
+				Params:            DefaultParams(),
+				GrantingContracts: []string{alice.String()},
+				GrantPolicies:     []GrantingContractPolicy{{GrantingContract: alice.String(), Policy: GrantPolicy{SignerQuota: 2}}},
+				SignerGrants:      []GrantingContractSignerGrants{{GrantingContract: alice.String(), Signer: bob.String(), Grants: 2}},
+			},
+		},
+		"duplicate signer grants": {
+			genesis: &GenesisState{
+				Params:            DefaultParams(),
+				GrantingContracts: []string{alice.String()},
+				GrantPolicies:     []GrantingContractPolicy{{GrantingContract: alice.String(), Policy: GrantPolicy{SignerQuota: 2}}},
+				SignerGrants: []GrantingContractSignerGrants{
+					{GrantingContract: alice.String(), Signer: bob.String(), Grants: 1},
+					{GrantingContract: alice.String(), Signer: bob.String(), Grants: 1},
+				},
+			},
+			errContains: "duplicate signer grants",
+		},
+		"signer grants of a contract without a signer quota": {
+			genesis: &GenesisState{
+				Params:            DefaultParams(),
+				GrantingContracts: []string{alice.String()},
+				GrantPolicies:     []GrantingContractPolicy{{GrantingContract: alice.String()}},
+				SignerGrants:      []GrantingContractSignerGrants{{GrantingContract: alice.String(), Signer: bob.String(), Grants: 1}},
+			},
+			errContains: "without a signer quota",
+		},
+		"signer grants over the signer quota": {
+			genesis: &GenesisState{
+				Params:            DefaultParams(),
+				GrantingContracts: []string{alice.String()},
+				GrantPolicies:     []GrantingContractPolicy{{GrantingContract: alice.String(), Policy: GrantPolicy{SignerQuota: 2}}},
+				SignerGrants:      []GrantingContractSignerGrants{{GrantingContract: alice.String(), Signer: bob.String(), Grants: 3}},
+			},
+			errContains: "out of the signer quota",
+		},
+		"invalid signer grants signer addr": {
+			genesis: &GenesisState{
+				Params:            DefaultParams(),
+				GrantingContracts: []string{alice.String()},
+				GrantPolicies:     []GrantingContractPolicy{{GrantingContract: alice.String(), Policy: GrantPolicy{SignerQuota: 2}}},
+				SignerGrants:      []GrantingContractSignerGrants{{GrantingContract: alice.String(), Signer: "invalid-address", Grants: 1}},
+			},
+			errContains: "invalid bech32 signer address of signer grants",
+		},
+		"denied granting contract": {
+			genesis: &GenesisState{
+				Params:            Params{DeniedGranters: []string{alice.String()}, FailureWindow: 1, SuspensionPeriod: 1, BlockSettlementGasLimit: 1},
+				GrantingContracts: []string{alice.String()},
+			},
+			errContains: "denied granter",
+		},
+		"duplicate suspensions": {
+			genesis: &GenesisState{
+				Params:            DefaultParams(),
+				GrantingContracts: []string{alice.String()},
+				Suspensions: []GranterSuspension{
+					{GrantingContract: alice.String(), SuspendedAtHeight: 10, SuspendedUntilHeight: 20},
+					{GrantingContract: alice.String(), SuspendedAtHeight: 10, SuspendedUntilHeight: 20},
+				},
+			},
+			errContains: "duplicate suspension",
+		},
+		"suspension ending before it starts": {
+			genesis: &GenesisState{
+				Params:            DefaultParams(),
+				GrantingContracts: []string{alice.String()},
+				Suspensions:       []GranterSuspension{{GrantingContract: alice.String(), SuspendedAtHeight: 20, SuspendedUntilHeight: 20}},
+			},
+			errContains: "before it starts",
+		},
+		"invalid params": {
+			genesis:     &GenesisState{GrantingContracts: []string{alice.String()}},
+			errContains: "invalid params",
+		},
 		"invalid addr": {
-			genesis:     &GenesisState{GrantingContracts: []string{alice.String(), "invalid-address"}},
+			genesis:     &GenesisState{Params: DefaultParams(), GrantingContracts: []string{alice.String(), "invalid-address"}},
 			errContains: "decoding bech32 failed",
 		},
 	}
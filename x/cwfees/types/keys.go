@@ -5,5 +5,13 @@ import "cosmossdk.io/collections"
 const ModuleName = "cwfees"
 
 var (
-	GrantersPrefix = collections.NewPrefix(0)
+	GrantersPrefix           = collections.NewPrefix(0)
+	PendingSettlementsPrefix = collections.NewPrefix(1)
+	GrantPoliciesPrefix      = collections.NewPrefix(2)
+	SignerGrantsPrefix       = collections.NewPrefix(3)
+	GranterStatsPrefix       = collections.NewPrefix(4)
+	SignerStatsPrefix        = collections.NewPrefix(5)
+	ParamsPrefix             = collections.NewPrefix(6)
+	SuspensionsPrefix        = collections.NewPrefix(7)
+	GranterFailuresPrefix    = collections.NewPrefix(8)
 )
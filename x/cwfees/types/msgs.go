@@ -4,6 +4,10 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 
 var _ sdk.Msg = (*MsgRegisterAsGranter)(nil)
 var _ sdk.Msg = (*MsgUnregisterAsGranter)(nil)
+var _ sdk.Msg = (*MsgSetGrantPolicy)(nil)
+var _ sdk.Msg = (*MsgRemoveGrantPolicy)(nil)
+var _ sdk.Msg = (*MsgUpdateParams)(nil)
+var _ sdk.Msg = (*MsgForceUnregisterGranter)(nil)
 
 func (m *MsgRegisterAsGranter) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.GrantingContract)}
@@ -28,3 +32,52 @@ func (m *MsgUnregisterAsGranter) ValidateBasic() error {
 	}
 	return nil
 }
+
+func (m *MsgSetGrantPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.GrantingContract)}
+}
+
+func (m *MsgSetGrantPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.GrantingContract)
+	if err != nil {
+		return err
+	}
+	return m.Policy.Validate()
+}
+
+func (m *MsgRemoveGrantPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.GrantingContract)}
+}
+
+func (m *MsgRemoveGrantPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.GrantingContract)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Authority)}
+}
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+	return m.Params.Validate()
+}
+
+func (m *MsgForceUnregisterGranter) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Authority)}
+}
+
+func (m *MsgForceUnregisterGranter) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+	_, err = sdk.AccAddressFromBech32(m.GrantingContract)
+	return err
+}
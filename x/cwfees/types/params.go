@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	DefaultMaxConsecutiveFailures  = uint64(20)
+	DefaultFailureWindow           = int64(100)
+	DefaultSuspensionPeriod        = int64(14400) // roughly 1 day
+	DefaultBlockSettlementGasLimit = uint64(10_000_000)
+)
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxConsecutiveFailures:  DefaultMaxConsecutiveFailures,
+		FailureWindow:           DefaultFailureWindow,
+		SuspensionPeriod:        DefaultSuspensionPeriod,
+		BlockSettlementGasLimit: DefaultBlockSettlementGasLimit,
+	}
+}
+
+// Validate perform object fields validation.
+func (p Params) Validate() error {
+	denied := make(map[string]struct{}, len(p.DeniedGranters))
+	for i, addr := range p.DeniedGranters {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid bech32 address of denied granter %d %s: %w", i, addr, err)
+		}
+		if _, isDuplicate := denied[addr]; isDuplicate {
+			return fmt.Errorf("duplicate denied granter at index %d: %s", i, addr)
+		}
+		denied[addr] = struct{}{}
+	}
+	if p.FailureWindow <= 0 {
+		return fmt.Errorf("FailureWindow must be greater than 0. Current value: %d", p.FailureWindow)
+	}
+	if p.SuspensionPeriod <= 0 {
+		return fmt.Errorf("SuspensionPeriod must be greater than 0. Current value: %d", p.SuspensionPeriod)
+	}
+	if p.BlockSettlementGasLimit == 0 {
+		return fmt.Errorf("BlockSettlementGasLimit must be greater than 0")
+	}
+	return nil
+}
+
+// IsDeniedGranter checks if the contract is in the deny list.
+func (p Params) IsDeniedGranter(granter sdk.AccAddress) bool {
+	for _, addr := range p.DeniedGranters {
+		if addr == granter.String() {
+			return true
+		}
+	}
+	return false
+}
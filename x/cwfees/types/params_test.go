@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	type tc struct {
+		params      Params
+		errContains string // if empty, no error.
+	}
+
+	alice := sdk.AccAddress("alice")
+	bob := sdk.AccAddress("bob")
+
+	tests := map[string]tc{
+		"ok: default": {
+			params: DefaultParams(),
+		},
+		"ok": {
+			params: Params{DeniedGranters: []string{alice.String(), bob.String()}, MaxConsecutiveFailures: 0, FailureWindow: 1, SuspensionPeriod: 1, BlockSettlementGasLimit: 1},
+		},
+		"invalid denied granter": {
+			params:      Params{DeniedGranters: []string{"invalid-address"}, FailureWindow: 1, SuspensionPeriod: 1, BlockSettlementGasLimit: 1},
+			errContains: "invalid bech32 address of denied granter",
+		},
+		"duplicate denied granter": {
+			params:      Params{DeniedGranters: []string{alice.String(), alice.String()}, FailureWindow: 1, SuspensionPeriod: 1, BlockSettlementGasLimit: 1},
+			errContains: "duplicate denied granter",
+		},
+		"zero failure window": {
+			params:      Params{FailureWindow: 0, SuspensionPeriod: 1},
+			errContains: "FailureWindow",
+		},
+		"negative suspension period": {
+			params:      Params{FailureWindow: 1, SuspensionPeriod: -1},
+			errContains: "SuspensionPeriod",
+		},
+		"zero block settlement gas limit": {
+			params:      Params{FailureWindow: 1, SuspensionPeriod: 1},
+			errContains: "BlockSettlementGasLimit",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			err := test.params.Validate()
+			if test.errContains == "" {
+				require.Nilf(t, err, "unexpected error %s", err)
+			} else {
+				require.ErrorContains(t, err, test.errContains)
+			}
+		})
+	}
+}
+
+func TestParamsIsDeniedGranter(t *testing.T) {
+	alice := sdk.AccAddress("alice")
+	params := DefaultParams()
+	require.False(t, params.IsDeniedGranter(alice))
+	params.DeniedGranters = []string{alice.String()}
+	require.True(t, params.IsDeniedGranter(alice))
+	require.False(t, params.IsDeniedGranter(sdk.AccAddress("bob")))
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	wasmdtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// Validate performs basic validation of the grant policy.
+func (p GrantPolicy) Validate() error {
+	for i, typeURL := range p.AllowedMsgTypeUrls {
+		if !strings.HasPrefix(typeURL, "/") {
+			return ErrInvalidGrantPolicy.Wrapf("allowed msg type URL at index %d must start with a slash: %s", i, typeURL)
+		}
+	}
+	if err := validateAddresses(p.AllowedContracts); err != nil {
+		return ErrInvalidGrantPolicy.Wrapf("allowed contracts: %s", err)
+	}
+	if err := validateAddresses(p.AllowedSigners); err != nil {
+		return ErrInvalidGrantPolicy.Wrapf("allowed signers: %s", err)
+	}
+	if len(p.MaxFee) != 0 {
+		if err := sdk.Coins(p.MaxFee).Validate(); err != nil {
+			return ErrInvalidGrantPolicy.Wrapf("max fee: %s", err)
+		}
+	}
+	return nil
+}
+
+// Match checks if the TX with the given messages, signer and fees matches the grant policy.
+// It returns the reason why the TX does not match otherwise. Signer quota is not checked.
+func (p GrantPolicy) Match(msgs []sdk.Msg, signer sdk.AccAddress, fees sdk.Coins) error {
+	if len(p.MaxFee) != 0 && !fees.IsAllLTE(p.MaxFee) {
+		return fmt.Errorf("fee %s exceeds the max fee %s", fees, sdk.Coins(p.MaxFee))
+	}
+	if len(p.AllowedSigners) != 0 && !slices.Contains(p.AllowedSigners, signer.String()) {
+		return fmt.Errorf("signer %s is not allowed", signer)
+	}
+	return p.matchMsgs(msgs, "")
+}
+
+// matchMsgs checks the messages against the allowed message types and contracts. The messages executed through an
+// authz MsgExec are checked as well, and their index is prefixed with the index of the MsgExec.
+// Only contract executions match when the allowed contracts are set.
+func (p GrantPolicy) matchMsgs(msgs []sdk.Msg, indexPrefix string) error {
+	for i, msg := range msgs {
+		index := fmt.Sprintf("%s%d", indexPrefix, i)
+		typeURL := sdk.MsgTypeURL(msg)
+		if len(p.AllowedMsgTypeUrls) != 0 && !slices.Contains(p.AllowedMsgTypeUrls, typeURL) {
+			return fmt.Errorf("message at index %s of type %s is not allowed", index, typeURL)
+		}
+		if authzExecMsg, ok := msg.(*authz.MsgExec); ok {
+			execMsgs, err := authzExecMsg.GetMessages()
+			if err != nil {
+				return fmt.Errorf("message at index %s: %w", index, err)
+			}
+			if err := p.matchMsgs(execMsgs, index+"."); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(p.AllowedContracts) == 0 {
+			continue
+		}
+		execMsg, ok := msg.(*wasmdtypes.MsgExecuteContract)
+		if !ok {
+			return fmt.Errorf("message at index %s of type %s does not execute a contract", index, typeURL)
+		}
+		if !slices.Contains(p.AllowedContracts, execMsg.Contract) {
+			return fmt.Errorf("message at index %s executes contract %s which is not allowed", index, execMsg.Contract)
+		}
+	}
+	return nil
+}
+
+func validateAddresses(addrs []string) error {
+	set := make(map[string]struct{}, len(addrs))
+	for i, addr := range addrs {
+		if _, isDuplicate := set[addr]; isDuplicate {
+			return fmt.Errorf("duplicate address at index %d: %s", i, addr)
+		}
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid address at index %d %s: %w", i, addr, err)
+		}
+		set[addr] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"testing"
+
+	wasmdtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrantPolicyValidate(t *testing.T) {
+	type tc struct {
+		policy      GrantPolicy
+		errContains string // if empty, no error.
+	}
+
+	alice := sdk.AccAddress("alice")
+
+	tests := map[string]tc{
+		"ok: empty": {
+			policy: GrantPolicy{},
+		},
+		"ok": {
+			policy: GrantPolicy{
+				AllowedMsgTypeUrls: []string{"/cosmwasm.wasm.v1.MsgExecuteContract"},
+				AllowedContracts:   []string{alice.String()},
+				AllowedSigners:     []string{alice.String()},
+				SignerQuota:        10,
+				MaxFee:             sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+				SudoFallback:       true,
+			},
+		},
+		"type url without slash": {
+			policy:      GrantPolicy{AllowedMsgTypeUrls: []string{"cosmwasm.wasm.v1.MsgExecuteContract"}},
+			errContains: "must start with a slash",
+		},
+		"invalid contract": {
+			policy:      GrantPolicy{AllowedContracts: []string{"invalid-address"}},
+			errContains: "allowed contracts",
+		},
+		"duplicate signer": {
+			policy:      GrantPolicy{AllowedSigners: []string{alice.String(), alice.String()}},
+			errContains: "duplicate",
+		},
+		"invalid max fee": {
+			policy:      GrantPolicy{MaxFee: []sdk.Coin{{Denom: "", Amount: sdk.NewInt64Coin("stake", 1).Amount}}},
+			errContains: "max fee",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			err := test.policy.Validate()
+			if test.errContains == "" {
+				require.Nilf(t, err, "unexpected error %s", err)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidGrantPolicy)
+				require.ErrorContains(t, err, test.errContains)
+			}
+		})
+	}
+}
+
+func TestGrantPolicyMatch(t *testing.T) {
+	type tc struct {
+		policy      GrantPolicy
+		msgs        []sdk.Msg
+		fees        sdk.Coins
+		errContains string // if empty, the tx matches.
+	}
+
+	signer := sdk.AccAddress("signer")
+	contract := sdk.AccAddress("contract")
+	otherContract := sdk.AccAddress("other_contract")
+	execMsg := &wasmdtypes.MsgExecuteContract{Sender: signer.String(), Contract: contract.String()}
+	sendMsg := &banktypes.MsgSend{FromAddress: signer.String()}
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	tests := map[string]tc{
+		"empty policy matches": {
+			policy: GrantPolicy{},
+			msgs:   []sdk.Msg{execMsg, sendMsg},
+			fees:   fees,
+		},
+		"allowed msg type urls": {
+			policy: GrantPolicy{AllowedMsgTypeUrls: []string{sdk.MsgTypeURL(execMsg)}},
+			msgs:   []sdk.Msg{execMsg},
+			fees:   fees,
+		},
+		"msg type url not allowed": {
+			policy:      GrantPolicy{AllowedMsgTypeUrls: []string{sdk.MsgTypeURL(execMsg)}},
+			msgs:        []sdk.Msg{execMsg, sendMsg},
+			fees:        fees,
+			errContains: "message at index 1 of type /cosmos.bank.v1beta1.MsgSend is not allowed",
+		},
+		"allowed contracts": {
+			policy: GrantPolicy{AllowedContracts: []string{contract.String()}},
+			msgs:   []sdk.Msg{execMsg},
+			fees:   fees,
+		},
+		"contract not allowed": {
+			policy:      GrantPolicy{AllowedContracts: []string{otherContract.String()}},
+			msgs:        []sdk.Msg{execMsg},
+			fees:        fees,
+			errContains: "executes contract",
+		},
+		"allowed contracts with a msg which does not execute a contract": {
+			policy:      GrantPolicy{AllowedContracts: []string{contract.String()}},
+			msgs:        []sdk.Msg{execMsg, sendMsg},
+			fees:        fees,
+			errContains: "message at index 1 of type /cosmos.bank.v1beta1.MsgSend does not execute a contract",
+		},
+		"allowed contract executed through authz": {
+			policy: GrantPolicy{AllowedContracts: []string{contract.String()}},
+			msgs:   []sdk.Msg{newAuthzExecMsg(signer, execMsg)},
+			fees:   fees,
+		},
+		"contract not allowed executed through authz": {
+			policy:      GrantPolicy{AllowedContracts: []string{otherContract.String()}},
+			msgs:        []sdk.Msg{newAuthzExecMsg(signer, execMsg)},
+			fees:        fees,
+			errContains: "message at index 0.0 executes contract",
+		},
+		"msg which does not execute a contract wrapped in authz": {
+			policy:      GrantPolicy{AllowedContracts: []string{contract.String()}},
+			msgs:        []sdk.Msg{newAuthzExecMsg(signer, execMsg, sendMsg)},
+			fees:        fees,
+			errContains: "message at index 0.1 of type /cosmos.bank.v1beta1.MsgSend does not execute a contract",
+		},
+		"msg type url not allowed wrapped in authz": {
+			policy:      GrantPolicy{AllowedMsgTypeUrls: []string{sdk.MsgTypeURL(&authz.MsgExec{}), sdk.MsgTypeURL(execMsg)}},
+			msgs:        []sdk.Msg{newAuthzExecMsg(signer, sendMsg)},
+			fees:        fees,
+			errContains: "message at index 0.0 of type /cosmos.bank.v1beta1.MsgSend is not allowed",
+		},
+		"allowed signers": {
+			policy: GrantPolicy{AllowedSigners: []string{signer.String()}},
+			msgs:   []sdk.Msg{execMsg},
+			fees:   fees,
+		},
+		"signer not allowed": {
+			policy:      GrantPolicy{AllowedSigners: []string{contract.String()}},
+			msgs:        []sdk.Msg{execMsg},
+			fees:        fees,
+			errContains: "is not allowed",
+		},
+		"fee under max fee": {
+			policy: GrantPolicy{MaxFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))},
+			msgs:   []sdk.Msg{execMsg},
+			fees:   fees,
+		},
+		"fee exceeds max fee": {
+			policy:      GrantPolicy{MaxFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 999))},
+			msgs:        []sdk.Msg{execMsg},
+			fees:        fees,
+			errContains: "exceeds the max fee",
+		},
+		"fee denom not in max fee": {
+			policy:      GrantPolicy{MaxFee: sdk.NewCoins(sdk.NewInt64Coin("uarch", 1000))},
+			msgs:        []sdk.Msg{execMsg},
+			fees:        fees,
+			errContains: "exceeds the max fee",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			err := test.policy.Match(test.msgs, signer, test.fees)
+			if test.errContains == "" {
+				require.Nilf(t, err, "unexpected error %s", err)
+			} else {
+				require.ErrorContains(t, err, test.errContains)
+			}
+		})
+	}
+}
+
+func newAuthzExecMsg(grantee sdk.AccAddress, msgs ...sdk.Msg) *authz.MsgExec {
+	msg := authz.NewMsgExec(grantee, msgs)
+	return &msg
+}
@@ -26,7 +26,27 @@ type BankKeeper interface {
 
 type CWFeesKeeper interface {
 	IsGrantingContract(ctx context.Context, granter sdk.AccAddress) (bool, error)
-	RequestGrant(ctx context.Context, grantingContract sdk.AccAddress, txMsgs []sdk.Msg, wantFees sdk.Coins, signers []sdk.AccAddress) error
+	RequestTxGrant(ctx context.Context, grantingContract sdk.AccAddress, txMsgs []sdk.Msg, wantFees sdk.Coins, signers []sdk.AccAddress) (sdk.Coins, error)
+	TrackGrant(ctx context.Context, grantingContract, sender sdk.AccAddress, feeCharged, feeCovered sdk.Coins) error
+}
+
+const (
+	// AttributeKeyFeeGranter defines the tx event attribute of the fee granter.
+	AttributeKeyFeeGranter = "fee_granter"
+	// AttributeKeyGranterFee defines the tx event attribute of the fees paid by the fee granter.
+	AttributeKeyGranterFee = "granter_fee"
+	// AttributeKeyPayerFee defines the tx event attribute of the fees paid by the fee payer.
+	AttributeKeyPayerFee = "payer_fee"
+)
+
+// feePayers defines the entities the fees are deducted from.
+// The granter, if any, pays up to the granted fees and the payer pays the rest.
+type feePayers struct {
+	payer       sdk.AccAddress
+	granter     sdk.AccAddress
+	grantedFees sdk.Coins
+	// cwGrantSender is set to the TX signer when the granter is a x/cwfees granting contract.
+	cwGrantSender sdk.AccAddress
 }
 
 // DeductFeeDecorator deducts fees from the first signer of the tx.
@@ -65,40 +85,61 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		return ctx, fmt.Errorf("fee collector module account (%s) has not been set", authTypes.FeeCollectorName)
 	}
 
-	deductFeesFrom, err := dfd.getFeePayer(ctx, feeTx)
+	payers, err := dfd.getFeePayer(ctx, feeTx)
 	if err != nil {
 		return ctx, err
 	}
 
-	deductFeesFromAcc := dfd.ak.GetAccount(ctx, deductFeesFrom)
-	if deductFeesFromAcc == nil {
-		return ctx, errorsmod.Wrapf(sdkErrors.ErrUnknownAddress, "fee payer address (%s) does not exist", deductFeesFrom)
+	// the payer is only charged for the fees the granter does not cover
+	payerFees := feeTx.GetFee().Sub(payers.grantedFees...)
+	if payers.granter != nil && dfd.ak.GetAccount(ctx, payers.granter) == nil {
+		return ctx, errorsmod.Wrapf(sdkErrors.ErrUnknownAddress, "fee payer address (%s) does not exist", payers.granter)
+	}
+	if (payers.granter == nil || !payerFees.IsZero()) && dfd.ak.GetAccount(ctx, payers.payer) == nil {
+		return ctx, errorsmod.Wrapf(sdkErrors.ErrUnknownAddress, "fee payer address (%s) does not exist", payers.payer)
 	}
 
 	// Deduct the fees
 	if !feeTx.GetFee().IsZero() {
-		if err := dfd.deductFees(ctx, tx, deductFeesFromAcc, feeTx.GetFee()); err != nil {
+		if err := dfd.deductFees(ctx, tx, &payers, feeTx.GetFee()); err != nil {
+			return ctx, err
+		}
+	}
+
+	// the granting contract is notified of the outcome of the TX after its execution
+	if payers.cwGrantSender != nil {
+		err := dfd.cwFeesKeeper.TrackGrant(ctx, payers.granter, payers.cwGrantSender, feeTx.GetFee(), feeTx.GetFee().Sub(payerFees...))
+		if err != nil {
 			return ctx, err
 		}
 	}
 
-	events := sdk.Events{sdk.NewEvent(sdk.EventTypeTx,
-		sdk.NewAttribute(sdk.AttributeKeyFee, feeTx.GetFee().String()),
-	)}
+	attrs := []sdk.Attribute{sdk.NewAttribute(sdk.AttributeKeyFee, feeTx.GetFee().String())}
+	if payers.granter != nil {
+		attrs = append(attrs,
+			sdk.NewAttribute(AttributeKeyFeeGranter, payers.granter.String()),
+			sdk.NewAttribute(AttributeKeyGranterFee, feeTx.GetFee().Sub(payerFees...).String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, payers.payer.String()),
+			sdk.NewAttribute(AttributeKeyPayerFee, payerFees.String()),
+		)
+	}
+	events := sdk.Events{sdk.NewEvent(sdk.EventTypeTx, attrs...)}
 	ctx.EventManager().EmitEvents(events)
 
 	return next(ctx, tx, simulate)
 }
 
-// getFeePayer returns the address of the entity will we get fees from.
-func (dfd DeductFeeDecorator) getFeePayer(ctx sdk.Context, tx sdk.Tx) (payer sdk.AccAddress, err error) {
+// getFeePayer returns the entities will we get fees from.
+// A x/cwfees granting contract can cover only a part of the fees, in which case the fee payer pays the rest.
+func (dfd DeductFeeDecorator) getFeePayer(ctx sdk.Context, tx sdk.Tx) (payers feePayers, err error) {
 	feeTx, _ := tx.(sdk.FeeTx)
-	payer = feeTx.FeePayer()
-	granter := feeTx.FeeGranter()
+	payer := sdk.AccAddress(feeTx.FeePayer())
+	granter := sdk.AccAddress(feeTx.FeeGranter())
+	payers.payer = payer
 	// in case granter is nil or payer and granter are equal
 	// then we just return the fee payer as the entity who pays the fees.
 	if granter == nil || bytes.Equal(payer.Bytes(), granter) {
-		return payer, nil
+		return payers, nil
 	}
 
 	switch {
@@ -106,27 +147,28 @@ func (dfd DeductFeeDecorator) getFeePayer(ctx sdk.Context, tx sdk.Tx) (payer sdk
 	case dfd.cwFeesKeeper != nil:
 		isCWGranter, err := dfd.cwFeesKeeper.IsGrantingContract(ctx, granter)
 		if err != nil {
-			return nil, err
+			return payers, err
 		}
 		// the contract is a cw granter, so we request fees from it.
 		if isCWGranter {
 			sigTx, ok := tx.(authsigning.SigVerifiableTx)
 			if !ok {
-				return nil, errorsmod.Wrap(sdkErrors.ErrTxDecode, "Tx must be a SigVerifiableTx")
+				return payers, errorsmod.Wrap(sdkErrors.ErrTxDecode, "Tx must be a SigVerifiableTx")
 			}
 			signers, err := sigTx.GetSigners()
 			if err != nil {
-				return nil, errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "cannot get signers from tx")
+				return payers, errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "cannot get signers from tx")
 			}
 			var signerAddrs []sdk.AccAddress
 			for _, s := range signers {
 				signerAddrs = append(signerAddrs, sdk.AccAddress(s))
 			}
-			err = dfd.cwFeesKeeper.RequestGrant(ctx, granter, feeTx.GetMsgs(), feeTx.GetFee(), signerAddrs)
+			coveredFees, err := dfd.cwFeesKeeper.RequestTxGrant(ctx, granter, feeTx.GetMsgs(), feeTx.GetFee(), signerAddrs)
 			if err != nil {
-				return nil, errorsmod.Wrapf(err, "%s contract is not allowed to pay fees from %s", granter, payer)
+				return payers, errorsmod.Wrapf(err, "%s contract is not allowed to pay fees from %s", granter, payer)
 			}
-			return granter, nil
+			payers.granter, payers.grantedFees, payers.cwGrantSender = granter, coveredFees, signerAddrs[0]
+			return payers, nil
 		}
 		// cannot be handled through x/cwfees, let's try with x/feegrant
 		fallthrough
@@ -135,19 +177,20 @@ func (dfd DeductFeeDecorator) getFeePayer(ctx sdk.Context, tx sdk.Tx) (payer sdk
 	case dfd.feegrantKeeper != nil:
 		err = dfd.feegrantKeeper.UseGrantedFees(ctx, granter, payer, feeTx.GetFee(), feeTx.GetMsgs())
 		if err != nil {
-			return nil, errorsmod.Wrapf(err, "%s not allowed to pay fees from %s", granter, payer)
+			return payers, errorsmod.Wrapf(err, "%s not allowed to pay fees from %s", granter, payer)
 		}
-		return granter, nil
+		payers.granter, payers.grantedFees = granter, feeTx.GetFee()
+		return payers, nil
 	// the default case is
 	default:
-		return nil, errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "fee grants are not enabled")
+		return payers, errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "fee grants are not enabled")
 	}
 }
 
-// deductFees deducts fees from the given account if rewards calculation and distribution is enabled.
+// deductFees deducts fees from the given payers if rewards calculation and distribution is enabled.
 // If rewards module is disabled, all the fees are sent to the fee collector account.
 // NOTE: this is the only logic being changed.
-func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, fees sdk.Coins) error {
+func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, payers *feePayers, fees sdk.Coins) error {
 	if !fees.IsValid() {
 		return errorsmod.Wrapf(sdkErrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
 	}
@@ -172,14 +215,14 @@ func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, acc sdk.Acc
 	// Send everything to the fee collector account if rewards are disabled or transaction is not wasm related
 	rebateRatio := dfd.rewardsKeeper.TxFeeRebateRatio(ctx)
 	if rebateRatio.IsZero() || !hasWasmMsgs {
-		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), authTypes.FeeCollectorName, fees); err != nil {
+		if err := dfd.sendFees(ctx, payers, authTypes.FeeCollectorName, fees); err != nil {
 			return errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
 		}
 		return nil
 	}
 
 	if !flatFees.Empty() {
-		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), rewardsTypes.ContractRewardCollector, flatFees); err != nil {
+		if err := dfd.sendFees(ctx, payers, rewardsTypes.ContractRewardCollector, flatFees); err != nil {
 			return errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
 		}
 		fees = fees.Sub(flatFees...) // reduce flatfees from the sent fees amount
@@ -189,7 +232,7 @@ func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, acc sdk.Acc
 	rewardsFees, authFees := pkg.SplitCoins(fees, rebateRatio)
 
 	if !authFees.Empty() {
-		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), authTypes.FeeCollectorName, authFees); err != nil {
+		if err := dfd.sendFees(ctx, payers, authTypes.FeeCollectorName, authFees); err != nil {
 			return errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
 		}
 		// burn the auth fees.
@@ -199,7 +242,7 @@ func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, acc sdk.Acc
 	}
 
 	if !rewardsFees.Empty() {
-		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), rewardsTypes.ContractRewardCollector, rewardsFees); err != nil {
+		if err := dfd.sendFees(ctx, payers, rewardsTypes.ContractRewardCollector, rewardsFees); err != nil {
 			return errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
 		}
 	}
@@ -209,3 +252,20 @@ func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, acc sdk.Acc
 
 	return nil
 }
+
+// sendFees sends the fees to the module account, taking them from the granter first
+// as long as the granted fees are not exhausted and from the payer for the rest.
+func (dfd DeductFeeDecorator) sendFees(ctx sdk.Context, payers *feePayers, moduleName string, fees sdk.Coins) error {
+	granterFees := fees.Min(payers.grantedFees)
+	if !granterFees.IsZero() {
+		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, payers.granter, moduleName, granterFees); err != nil {
+			return err
+		}
+		payers.grantedFees = payers.grantedFees.Sub(granterFees...)
+	}
+	payerFees := fees.Sub(granterFees...)
+	if !payerFees.IsZero() {
+		return dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, payers.payer, moduleName, payerFees)
+	}
+	return nil
+}